@@ -0,0 +1,23 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AssetIDSep 资产标识中链和币种的分隔符
+const AssetIDSep = ":"
+
+// GetAssetID 资产标识 链:币种 例如 eth:usdt omni:usdt bsc:bnb
+func GetAssetID(network string, symbol string) string {
+	return fmt.Sprintf("%s%s%s", strings.ToLower(network), AssetIDSep, strings.ToLower(symbol))
+}
+
+// SplitAssetID 拆分资产标识 不是资产标识时返回false
+func SplitAssetID(assetID string) (string, string, bool) {
+	parts := strings.SplitN(assetID, AssetIDSep, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
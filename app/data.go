@@ -169,6 +169,52 @@ func SQLGetAppConfigTokenMap(ctx context.Context, tx mcommon.DbExeAble, cols []s
 	return itemMap, nil
 }
 
+// SQLGetAppConfigTokenTrxMap 获取trc20代币map
+func SQLGetAppConfigTokenTrxMap(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64) (map[int64]*model.DBTAppConfigTokenTrx, error) {
+	if !mcommon.IsStringInSlice(cols, model.DBColTAppConfigTokenTrxID) {
+		cols = append(cols, model.DBColTAppConfigTokenTrxID)
+	}
+	itemMap := make(map[int64]*model.DBTAppConfigTokenTrx)
+	itemRows, err := model.SQLSelectTAppConfigTokenTrxCol(
+		ctx,
+		tx,
+		cols,
+		ids,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, itemRow := range itemRows {
+		itemMap[itemRow.ID] = itemRow
+	}
+	return itemMap, nil
+}
+
+// SQLGetAppConfigTokenSolMap 获取spl代币map
+func SQLGetAppConfigTokenSolMap(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64) (map[int64]*model.DBTAppConfigTokenSol, error) {
+	if !mcommon.IsStringInSlice(cols, model.DBColTAppConfigTokenSolID) {
+		cols = append(cols, model.DBColTAppConfigTokenSolID)
+	}
+	itemMap := make(map[int64]*model.DBTAppConfigTokenSol)
+	itemRows, err := model.SQLSelectTAppConfigTokenSolCol(
+		ctx,
+		tx,
+		cols,
+		ids,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, itemRow := range itemRows {
+		itemMap[itemRow.ID] = itemRow
+	}
+	return itemMap, nil
+}
+
 // SQLGetAddressKeyMap 获取地址map
 func SQLGetAddressKeyMap(ctx context.Context, tx mcommon.DbExeAble, cols []string, addresses []string) (map[string]*model.DBTAddressKey, error) {
 	if !mcommon.IsStringInSlice(cols, model.DBColTAddressKeyAddress) {
@@ -189,3 +235,99 @@ func SQLGetAddressKeyMap(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	}
 	return itemMap, nil
 }
+
+// SQLGetAddressUserMap 获取地址绑定用户map
+// symbols 为使用同一个地址池的币种 eos wax tlos xrp 的memo在不同链上会重复 需要按地址池区分
+func SQLGetAddressUserMap(ctx context.Context, tx mcommon.DbExeAble, cols []string, symbols []string, addresses []string) (map[string]*model.DBTAddressUser, error) {
+	if !mcommon.IsStringInSlice(cols, model.DBColTAddressUserAddress) {
+		cols = append(cols, model.DBColTAddressUserAddress)
+	}
+	itemMap := make(map[string]*model.DBTAddressUser)
+	itemRows, err := model.SQLSelectTAddressUserColKV(
+		ctx,
+		tx,
+		cols,
+		[]string{
+			model.DBColShortTAddressUserSymbol,
+			model.DBColShortTAddressUserAddress,
+		},
+		[]interface{}{
+			symbols,
+			addresses,
+		},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, itemRow := range itemRows {
+		itemMap[itemRow.Address] = itemRow
+	}
+	return itemMap, nil
+}
+
+// GetAddressUserID 获取地址绑定的用户id 未绑定时为空
+func GetAddressUserID(addressUserMap map[string]*model.DBTAddressUser, productID int64, address string) string {
+	addressUserRow, ok := addressUserMap[address]
+	if !ok || addressUserRow.ProductID != productID {
+		return ""
+	}
+	return addressUserRow.UserID
+}
+
+// SQLGetAppConfigStrMap 获取字符串配置map 没有配置的键不在结果中
+func SQLGetAppConfigStrMap(ctx context.Context, tx mcommon.DbExeAble, keys []string) (map[string]string, error) {
+	itemMap := make(map[string]string)
+	itemRows, err := model.SQLSelectTAppConfigStrColKV(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppConfigStrK,
+			model.DBColTAppConfigStrV,
+		},
+		[]string{
+			model.DBColShortTAppConfigStrK,
+		},
+		[]interface{}{
+			keys,
+		},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, itemRow := range itemRows {
+		itemMap[itemRow.K] = itemRow.V
+	}
+	return itemMap, nil
+}
+
+// SQLGetAppConfigIntMap 获取整数配置map 没有配置的键不在结果中
+func SQLGetAppConfigIntMap(ctx context.Context, tx mcommon.DbExeAble, keys []string) (map[string]int64, error) {
+	itemMap := make(map[string]int64)
+	itemRows, err := model.SQLSelectTAppConfigIntColKV(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppConfigIntK,
+			model.DBColTAppConfigIntV,
+		},
+		[]string{
+			model.DBColShortTAppConfigIntK,
+		},
+		[]interface{}{
+			keys,
+		},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, itemRow := range itemRows {
+		itemMap[itemRow.K] = itemRow.V
+	}
+	return itemMap, nil
+}
@@ -180,6 +180,67 @@ WHERE
 	return rows, nil
 }
 
+// SQLSelectTAddressKeyColByAddressAndSymbol 根据币种和地址获取
+func SQLSelectTAddressKeyColByAddressAndSymbol(ctx context.Context, tx mcommon.DbExeAble, cols []string, symbol string, addresses []string) ([]*model.DBTAddressKey, error) {
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_address_key
+WHERE
+	symbol=:symbol
+	AND address IN (:addresses)`)
+
+	var rows []*model.DBTAddressKey
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"symbol":    symbol,
+			"addresses": addresses,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTAddressKeyColByIDGreater 获取id大于指定值的地址
+func SQLSelectTAddressKeyColByIDGreater(ctx context.Context, tx mcommon.DbExeAble, cols []string, symbol string, id int64) ([]*model.DBTAddressKey, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_address_key
+WHERE
+	symbol=:symbol
+	AND id>:id`)
+
+	var rows []*model.DBTAddressKey
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"symbol": symbol,
+			"id":     id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // SQLUpdateTAppStatusIntByK 更新
 func SQLUpdateTAppStatusIntByK(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTAppStatusInt) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
@@ -248,7 +309,7 @@ WHERE
 }
 
 // SQLSelectTTxColByOrgForUpdate 获取未整理交易
-func SQLSelectTTxColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatus int64) ([]*model.DBTTx, error) {
+func SQLSelectTTxColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatus int64, chainSymbol string) ([]*model.DBTTx, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
@@ -257,6 +318,7 @@ FROM
 	t_tx
 WHERE
 	org_status=:org_status
+	AND chain_symbol=:chain_symbol
 FOR UPDATE`)
 
 	var rows []*model.DBTTx
@@ -266,7 +328,8 @@ FOR UPDATE`)
 		&rows,
 		query.String(),
 		gin.H{
-			"org_status": orgStatus,
+			"org_status":   orgStatus,
+			"chain_symbol": chainSymbol,
 		},
 	)
 	if err != nil {
@@ -276,7 +339,7 @@ FOR UPDATE`)
 }
 
 // SQLGetTSendMaxNonce 获取地址的nonce
-func SQLGetTSendMaxNonce(ctx context.Context, tx mcommon.DbExeAble, address string) (int64, error) {
+func SQLGetTSendMaxNonce(ctx context.Context, tx mcommon.DbExeAble, address string, chainSymbol string) (int64, error) {
 	var i int64
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
@@ -288,9 +351,11 @@ FROM
 	t_send
 WHERE
 	from_address=:address
+	AND chain_symbol=:chain_symbol
 LIMIT 1`,
 		gin.H{
-			"address": address,
+			"address":      address,
+			"chain_symbol": chainSymbol,
 		},
 	)
 	if err != nil {
@@ -303,7 +368,7 @@ LIMIT 1`,
 }
 
 // SQLGetTSendPendingBalanceReal 获取地址的打包数额
-func SQLGetTSendPendingBalanceReal(ctx context.Context, tx mcommon.DbExeAble, address string) (string, error) {
+func SQLGetTSendPendingBalanceReal(ctx context.Context, tx mcommon.DbExeAble, address string, chainSymbol string) (string, error) {
 	var i string
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
@@ -315,10 +380,12 @@ FROM
 	t_send
 WHERE
 	from_address=:address
+	AND chain_symbol=:chain_symbol
 	AND handle_status<2
 LIMIT 1`,
 		gin.H{
-			"address": address,
+			"address":      address,
+			"chain_symbol": chainSymbol,
 		},
 	)
 	if err != nil {
@@ -331,22 +398,24 @@ LIMIT 1`,
 }
 
 // SQLGetTSendEosPendingBalanceReal 获取地址的打包数额
-func SQLGetTSendEosPendingBalanceReal(ctx context.Context, tx mcommon.DbExeAble, address string) (string, error) {
+func SQLGetTSendEosPendingBalanceReal(ctx context.Context, tx mcommon.DbExeAble, address string, symbol string) (string, error) {
 	var i string
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
 		&i,
 		`SELECT 
-	IFNULL(SUM(CAST(balance_real as DECIMAL(65,4))), "0")
+	IFNULL(SUM(CAST(balance_real as DECIMAL(65,18))), "0")
 FROM
 	t_send_eos
 WHERE
 	from_address=:address
+	AND symbol=:symbol
 	AND handle_status<2
 LIMIT 1`,
 		gin.H{
 			"address": address,
+			"symbol":  symbol,
 		},
 	)
 	if err != nil {
@@ -562,8 +631,30 @@ WHERE
 	return count, nil
 }
 
+// SQLUpdateTSendEosBlockNumByTxHash 更新交易所在块高
+func SQLUpdateTSendEosBlockNumByTxHash(ctx context.Context, tx mcommon.DbExeAble, row model.DBTSendEos) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_eos
+SET
+    block_num=:block_num
+WHERE
+	tx_hash=:tx_hash`,
+		gin.H{
+			"tx_hash":   row.TxHash,
+			"block_num": row.BlockNum,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 // SQLSelectTSendColByStatus 根据ids获取
-func SQLSelectTSendColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTSend, error) {
+func SQLSelectTSendColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64, chainSymbol string) ([]*model.DBTSend, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
@@ -572,6 +663,7 @@ FROM
 	t_send
 WHERE
 	handle_status=:handle_status
+	AND chain_symbol=:chain_symbol
 ORDER BY id`)
 
 	var rows []*model.DBTSend
@@ -582,6 +674,7 @@ ORDER BY id`)
 		query.String(),
 		gin.H{
 			"handle_status": status,
+			"chain_symbol":  chainSymbol,
 		},
 	)
 	if err != nil {
@@ -591,7 +684,10 @@ ORDER BY id`)
 }
 
 // SQLSelectTSendEosColByStatus 根据ids获取
-func SQLSelectTSendEosColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTSendEos, error) {
+func SQLSelectTSendEosColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64, symbols []string) ([]*model.DBTSendEos, error) {
+	if len(symbols) == 0 {
+		return nil, nil
+	}
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
@@ -600,6 +696,7 @@ FROM
 	t_send_eos
 WHERE
 	handle_status=:handle_status
+	AND symbol IN (:symbols)
 ORDER BY id`)
 
 	var rows []*model.DBTSendEos
@@ -610,6 +707,7 @@ ORDER BY id`)
 		query.String(),
 		gin.H{
 			"handle_status": status,
+			"symbols":       symbols,
 		},
 	)
 	if err != nil {
@@ -710,6 +808,39 @@ FOR UPDATE`)
 	return &row, nil
 }
 
+// SQLSelectTWithdrawColByOutSerialsForUpdate 根据商户订单号获取并锁定
+func SQLSelectTWithdrawColByOutSerialsForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, productID int64, outSerials []string) ([]*model.DBTWithdraw, error) {
+	if len(outSerials) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_withdraw
+WHERE
+	product_id=:product_id
+	AND out_serial IN (:out_serials)
+FOR UPDATE`)
+
+	var rows []*model.DBTWithdraw
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"product_id":  productID,
+			"out_serials": outSerials,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // SQLUpdateTWithdrawGenTx 更新
 func SQLUpdateTWithdrawGenTx(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTWithdraw) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
@@ -912,25 +1043,28 @@ FOR UPDATE`)
 	return &row, nil
 }
 
-// SQLSelectTTxColByStatus 根据ids获取
-func SQLSelectTTxColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTTx, error) {
+// SQLSelectTAddressKeyColFreeForUpdate 获取多个可用地址
+func SQLSelectTAddressKeyColFreeForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, symbol string, limit int64) ([]*model.DBTAddressKey, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx
+	t_address_key
 WHERE
-	handle_status=:handle_status`)
+	use_tag=0
+	AND symbol=:symbol
+`)
+	query.WriteString(fmt.Sprintf("LIMIT %d\nFOR UPDATE", limit))
 
-	var rows []*model.DBTTx
+	var rows []*model.DBTAddressKey
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
 		&rows,
 		query.String(),
 		gin.H{
-			"handle_status": status,
+			"symbol": symbol,
 		},
 	)
 	if err != nil {
@@ -939,26 +1073,71 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxEosColByStatus 根据ids获取
-func SQLSelectTTxEosColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTTxEos, error) {
+// SQLUpdateTAddressKeyUseTagByIDs 占用未使用的地址
+func SQLUpdateTAddressKeyUseTagByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, useTag int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_address_key
+SET
+    use_tag=:use_tag
+WHERE
+	id IN (:ids)
+	AND use_tag=0`,
+		gin.H{
+			"ids":     ids,
+			"use_tag": useTag,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// addressKeyProductWhere 产品占用地址的查询条件
+func addressKeyProductWhere(productID int64, symbol string) (string, gin.H) {
+	query := strings.Builder{}
+	query.WriteString(`
+WHERE
+	use_tag=:use_tag`)
+	argMap := gin.H{
+		"use_tag": productID,
+	}
+	if symbol != "" {
+		query.WriteString("\n\tAND symbol=:symbol")
+		argMap["symbol"] = symbol
+	}
+	return query.String(), argMap
+}
+
+// SQLSelectTAddressKeyColByProduct 分页获取产品占用的地址
+func SQLSelectTAddressKeyColByProduct(ctx context.Context, tx mcommon.DbExeAble, cols []string, productID int64, symbol string, offset int64, limit int64) ([]*model.DBTAddressKey, error) {
+	where, argMap := addressKeyProductWhere(productID, symbol)
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_eos
-WHERE
-	handle_status=:handle_status`)
+	t_address_key`)
+	query.WriteString(where)
+	query.WriteString(`
+ORDER BY
+	id ASC
+`)
+	query.WriteString(fmt.Sprintf("LIMIT %d,%d", offset, limit))
 
-	var rows []*model.DBTTxEos
+	var rows []*model.DBTAddressKey
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
 		&rows,
 		query.String(),
-		gin.H{
-			"handle_status": status,
-		},
+		argMap,
 	)
 	if err != nil {
 		return nil, err
@@ -966,77 +1145,90 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTProductNotifyColByStatusAndTime 根据ids获取
-func SQLSelectTProductNotifyColByStatusAndTime(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64, t int64) ([]*model.DBTProductNotify, error) {
+// SQLGetTAddressKeyCountByProduct 获取产品占用的地址数量
+func SQLGetTAddressKeyCountByProduct(ctx context.Context, tx mcommon.DbExeAble, productID int64, symbol string) (int64, error) {
+	where, argMap := addressKeyProductWhere(productID, symbol)
 	query := strings.Builder{}
-	query.WriteString("SELECT\n")
-	query.WriteString(strings.Join(cols, ",\n"))
-	query.WriteString(`
+	query.WriteString(`SELECT
+	COUNT(*)
 FROM
-	t_product_notify
-WHERE
-	handle_status=:handle_status
-	AND update_time<:update_time`)
+	t_address_key`)
+	query.WriteString(where)
 
-	var rows []*model.DBTProductNotify
-	err := mcommon.DbSelectNamedContent(
+	var i int64
+	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
-		&rows,
+		&i,
 		query.String(),
-		gin.H{
-			"handle_status": status,
-			"update_time":   t,
-		},
+		argMap,
 	)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return rows, nil
+	if !ok {
+		return 0, nil
+	}
+	return i, nil
 }
 
-// SQLUpdateTProductNotifyStatusByID 更新
-func SQLUpdateTProductNotifyStatusByID(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTProductNotify) (int64, error) {
-	count, err := mcommon.DbExecuteCountNamedContent(
+// SQLGetTAddressUserColForUpdate 获取用户绑定的地址
+func SQLGetTAddressUserColForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, productID int64, userID string, symbol string) (*model.DBTAddressUser, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_address_user
+WHERE
+	product_id=:product_id
+	AND user_id=:user_id
+	AND symbol=:symbol
+FOR UPDATE`)
+
+	var row model.DBTAddressUser
+	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
-		`UPDATE
-	t_product_notify
-SET
-    handle_status=:handle_status,
-    handle_msg=:handle_msg,
-    update_time=:update_time
-WHERE
-	id=:id`,
+		&row,
+		query.String(),
 		gin.H{
-			"id":            row.ID,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"update_time":   row.UpdateTime,
+			"product_id": productID,
+			"user_id":    userID,
+			"symbol":     symbol,
 		},
 	)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return count, nil
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
 }
 
-// SQLSelectTAppConfigTokenColAll 根据ids获取
-func SQLSelectTAppConfigTokenColAll(ctx context.Context, tx mcommon.DbExeAble, cols []string) ([]*model.DBTAppConfigToken, error) {
+// SQLSelectTTxColByStatus 根据ids获取
+func SQLSelectTTxColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64, chainSymbol string) ([]*model.DBTTx, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token`)
+	t_tx
+WHERE
+	handle_status=:handle_status
+	AND chain_symbol=:chain_symbol`)
 
-	var rows []*model.DBTAppConfigToken
+	var rows []*model.DBTTx
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
 		&rows,
 		query.String(),
-		gin.H{},
+		gin.H{
+			"handle_status": status,
+			"chain_symbol":  chainSymbol,
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -1044,18 +1236,22 @@ FROM
 	return rows, nil
 }
 
-// SQLSelectTTxErc20ColByStatus 根据ids获取
-func SQLSelectTTxErc20ColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTTxErc20, error) {
+// SQLSelectTTxEosColByStatus 根据ids获取
+func SQLSelectTTxEosColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64, symbols []string) ([]*model.DBTTxEos, error) {
+	if len(symbols) == 0 {
+		return nil, nil
+	}
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_erc20
+	t_tx_eos
 WHERE
-	handle_status=:handle_status`)
+	handle_status=:handle_status
+	AND symbol IN (:symbols)`)
 
-	var rows []*model.DBTTxErc20
+	var rows []*model.DBTTxEos
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -1063,6 +1259,7 @@ WHERE
 		query.String(),
 		gin.H{
 			"handle_status": status,
+			"symbols":       symbols,
 		},
 	)
 	if err != nil {
@@ -1071,26 +1268,28 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxErc20ColByOrgForUpdate 获取未整理交易
-func SQLSelectTTxErc20ColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatuses []int64) ([]*model.DBTTxErc20, error) {
+// SQLSelectTTxEosColByTxHashes 根据tx hash获取
+func SQLSelectTTxEosColByTxHashes(ctx context.Context, tx mcommon.DbExeAble, cols []string, txHashes []string) ([]*model.DBTTxEos, error) {
+	if len(txHashes) == 0 {
+		return nil, nil
+	}
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_erc20
+	t_tx_eos
 WHERE
-	org_status IN (:org_status)
-FOR UPDATE`)
+	tx_hash IN (:tx_hashes)`)
 
-	var rows []*model.DBTTxErc20
+	var rows []*model.DBTTxEos
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
 		&rows,
 		query.String(),
 		gin.H{
-			"org_status": orgStatuses,
+			"tx_hashes": txHashes,
 		},
 	)
 	if err != nil {
@@ -1099,8 +1298,8 @@ FOR UPDATE`)
 	return rows, nil
 }
 
-// SQLUpdateTTxErc20OrgStatusByIDs 更新
-func SQLUpdateTTxErc20OrgStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTTxErc20) (int64, error) {
+// SQLUpdateTTxEosStatusByIDsAndStatus 更新指定状态的记录
+func SQLUpdateTTxEosStatusByIDsAndStatus(ctx context.Context, tx mcommon.DbExeAble, ids []int64, status int64, row model.DBTTxEos) (int64, error) {
 	if len(ids) == 0 {
 		return 0, nil
 	}
@@ -1108,13 +1307,247 @@ func SQLUpdateTTxErc20OrgStatusByIDs(ctx context.Context, tx mcommon.DbExeAble,
 		ctx,
 		tx,
 		`UPDATE
-	t_tx_erc20
+	t_tx_eos
 SET
-    org_status=:org_status,
-    org_msg=:org_msg,
-    org_time=:org_time
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_at=:handle_at
 WHERE
-	id IN (:ids)`,
+	id IN (:ids)
+	AND handle_status=:status`,
+		gin.H{
+			"ids":           ids,
+			"status":        status,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_at":     row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTTxEosBlockByID 更新所在块和状态
+func SQLUpdateTTxEosBlockByID(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTTxEos) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_eos
+SET
+    block_num=:block_num,
+    block_id=:block_id,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_at=:handle_at
+WHERE
+	id=:id`,
+		gin.H{
+			"id":            row.ID,
+			"block_num":     row.BlockNum,
+			"block_id":      row.BlockID,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_at":     row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTTxEosRevertByBlockNum 回滚已经不可逆但是未确认的记录
+func SQLUpdateTTxEosRevertByBlockNum(ctx context.Context, tx mcommon.DbExeAble, symbols []string, blockNum int64, now int64) (int64, error) {
+	if len(symbols) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_eos
+SET
+    handle_status=:revert_status,
+    handle_msg='revert',
+    handle_at=:handle_at
+WHERE
+	block_num<=:block_num
+	AND handle_status IN (:pending_status)
+	AND symbol IN (:symbols)`,
+		gin.H{
+			"revert_status":  TxStatusRevert,
+			"handle_at":      now,
+			"block_num":      blockNum,
+			"pending_status": []int64{TxStatusPending, TxStatusPendingNotify},
+			"symbols":        symbols,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTProductNotifyColByStatusAndTime 根据ids获取
+func SQLSelectTProductNotifyColByStatusAndTime(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64, t int64) ([]*model.DBTProductNotify, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_notify
+WHERE
+	handle_status=:handle_status
+	AND update_time<:update_time`)
+
+	var rows []*model.DBTProductNotify
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"handle_status": status,
+			"update_time":   t,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTProductNotifyStatusByID 更新
+func SQLUpdateTProductNotifyStatusByID(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTProductNotify) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_product_notify
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    update_time=:update_time
+WHERE
+	id=:id`,
+		gin.H{
+			"id":            row.ID,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"update_time":   row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTAppConfigTokenColByChainSymbol 获取链上的所有代币
+func SQLSelectTAppConfigTokenColByChainSymbol(ctx context.Context, tx mcommon.DbExeAble, cols []string, chainSymbol string) ([]*model.DBTAppConfigToken, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_config_token
+WHERE
+	chain_symbol=:chain_symbol`)
+
+	var rows []*model.DBTAppConfigToken
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"chain_symbol": chainSymbol,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTTxErc20ColByStatus 根据ids获取
+func SQLSelectTTxErc20ColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64, chainSymbol string) ([]*model.DBTTxErc20, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_erc20
+WHERE
+	handle_status=:handle_status
+	AND chain_symbol=:chain_symbol`)
+
+	var rows []*model.DBTTxErc20
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"handle_status": status,
+			"chain_symbol":  chainSymbol,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTTxErc20ColByOrgForUpdate 获取未整理交易
+func SQLSelectTTxErc20ColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatuses []int64, chainSymbol string) ([]*model.DBTTxErc20, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_erc20
+WHERE
+	org_status IN (:org_status)
+	AND chain_symbol=:chain_symbol
+FOR UPDATE`)
+
+	var rows []*model.DBTTxErc20
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"org_status":   orgStatuses,
+			"chain_symbol": chainSymbol,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxErc20OrgStatusByIDs 更新
+func SQLUpdateTTxErc20OrgStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTTxErc20) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_erc20
+SET
+    org_status=:org_status,
+    org_msg=:org_msg,
+    org_time=:org_time
+WHERE
+	id IN (:ids)`,
 		gin.H{
 			"ids":        ids,
 			"org_status": row.OrgStatus,
@@ -1129,7 +1562,7 @@ WHERE
 }
 
 // SQLSelectTTxBtcUxtoColByTxIDs 根据ids获取
-func SQLSelectTTxBtcUxtoColByTxIDs(ctx context.Context, tx mcommon.DbExeAble, cols []string, txHashes []string) ([]*model.DBTTxBtcUxto, error) {
+func SQLSelectTTxBtcUxtoColByTxIDs(ctx context.Context, tx mcommon.DbExeAble, cols []string, symbol string, txHashes []string) ([]*model.DBTTxBtcUxto, error) {
 	if len(cols) == 0 {
 		return nil, nil
 	}
@@ -1140,7 +1573,8 @@ func SQLSelectTTxBtcUxtoColByTxIDs(ctx context.Context, tx mcommon.DbExeAble, co
 FROM
 	t_tx_btc_uxto
 WHERE
-	tx_id IN (:tx_ids)`)
+	symbol=:symbol
+	AND tx_id IN (:tx_ids)`)
 
 	var rows []*model.DBTTxBtcUxto
 	err := mcommon.DbSelectNamedContent(
@@ -1149,6 +1583,7 @@ WHERE
 		&rows,
 		query.String(),
 		gin.H{
+			"symbol": symbol,
 			"tx_ids": txHashes,
 		},
 	)
@@ -1256,8 +1691,37 @@ ON DUPLICATE KEY UPDATE
 	return count, nil
 }
 
+// SQLSelectTTxBtcUxtoColByIDGreater 获取id大于指定值的uxto
+func SQLSelectTTxBtcUxtoColByIDGreater(ctx context.Context, tx mcommon.DbExeAble, cols []string, symbol string, id int64) ([]*model.DBTTxBtcUxto, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_btc_uxto
+WHERE
+	symbol=:symbol
+	AND id>:id`)
+
+	var rows []*model.DBTTxBtcUxto
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"symbol": symbol,
+			"id":     id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // SQLSelectTTxBtcUxtoColToOrgForUpdate 根据ids获取
-func SQLSelectTTxBtcUxtoColToOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, uxtoType int64) ([]*model.DBTTxBtcUxto, error) {
+func SQLSelectTTxBtcUxtoColToOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, symbol string, uxtoType int64) ([]*model.DBTTxBtcUxto, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
@@ -1265,7 +1729,8 @@ func SQLSelectTTxBtcUxtoColToOrgForUpdate(ctx context.Context, tx mcommon.DbExeA
 FROM
 	t_tx_btc_uxto
 WHERE
-	handle_status=0
+	symbol=:symbol
+	AND handle_status=0
 	AND uxto_type=:uxto_type
 ORDER BY
 `)
@@ -1283,6 +1748,7 @@ ORDER BY
 		&rows,
 		query.String(),
 		gin.H{
+			"symbol":    symbol,
 			"uxto_type": uxtoType,
 		},
 	)
@@ -1293,7 +1759,7 @@ ORDER BY
 }
 
 // SQLSelectTTxBtcUxtoColByAddressAndTypeForUpdate 根据ids获取
-func SQLSelectTTxBtcUxtoColByAddressAndTypeForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, address string, uxtoType int64) ([]*model.DBTTxBtcUxto, error) {
+func SQLSelectTTxBtcUxtoColByAddressAndTypeForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, symbol string, address string, uxtoType int64) ([]*model.DBTTxBtcUxto, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
@@ -1301,7 +1767,8 @@ func SQLSelectTTxBtcUxtoColByAddressAndTypeForUpdate(ctx context.Context, tx mco
 FROM
 	t_tx_btc_uxto
 WHERE
-	vout_address=:vout_address
+	symbol=:symbol
+	AND vout_address=:vout_address
 	AND handle_status=0
 	AND uxto_type=:uxto_type
 ORDER BY
@@ -1324,6 +1791,7 @@ ORDER BY
 		&rows,
 		query.String(),
 		gin.H{
+			"symbol":       symbol,
 			"vout_address": address,
 			"uxto_type":    uxtoType,
 		},
@@ -1377,7 +1845,7 @@ ORDER BY
 }
 
 // SQLSelectTSendBtcColByStatus 根据ids获取
-func SQLSelectTSendBtcColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTSendBtc, error) {
+func SQLSelectTSendBtcColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, symbol string, status int64) ([]*model.DBTSendBtc, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
@@ -1385,7 +1853,8 @@ func SQLSelectTSendBtcColByStatus(ctx context.Context, tx mcommon.DbExeAble, col
 FROM
 	t_send_btc
 WHERE
-	handle_status=:handle_status`)
+	symbol=:symbol
+	AND handle_status=:handle_status`)
 
 	var rows []*model.DBTSendBtc
 	err := mcommon.DbSelectNamedContent(
@@ -1394,6 +1863,7 @@ WHERE
 		&rows,
 		query.String(),
 		gin.H{
+			"symbol":        symbol,
 			"handle_status": status,
 		},
 	)
@@ -1432,6 +1902,28 @@ WHERE
 	return count, nil
 }
 
+// SQLUpdateTSendBtcBlockNumByTxID 更新交易所在块高
+func SQLUpdateTSendBtcBlockNumByTxID(ctx context.Context, tx mcommon.DbExeAble, row model.DBTSendBtc) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_btc
+SET
+    block_num=:block_num
+WHERE
+	tx_id=:tx_id`,
+		gin.H{
+			"tx_id":     row.TxID,
+			"block_num": row.BlockNum,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 // SQLCreateManyTWithdrawUpdate 创建多个
 func SQLCreateManyTWithdrawUpdate(ctx context.Context, tx mcommon.DbExeAble, rows []*model.DBTWithdraw) (int64, error) {
 	if len(rows) == 0 {
@@ -1541,7 +2033,7 @@ ON DUPLICATE KEY UPDATE
 }
 
 // SQLSelectTTxBtcColByStatus 根据ids获取
-func SQLSelectTTxBtcColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTTxBtc, error) {
+func SQLSelectTTxBtcColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, symbol string, status int64) ([]*model.DBTTxBtc, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
@@ -1549,7 +2041,8 @@ func SQLSelectTTxBtcColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols
 FROM
 	t_tx_btc
 WHERE
-	handle_status=:handle_status`)
+	symbol=:symbol
+	AND handle_status=:handle_status`)
 
 	var rows []*model.DBTTxBtc
 	err := mcommon.DbSelectNamedContent(
@@ -1558,6 +2051,7 @@ WHERE
 		&rows,
 		query.String(),
 		gin.H{
+			"symbol":        symbol,
 			"handle_status": status,
 		},
 	)
@@ -1596,22 +2090,28 @@ WHERE
 	return count, nil
 }
 
-// SQLSelectTAppConfigTokenBtcColAll 根据ids获取
-func SQLSelectTAppConfigTokenBtcColAll(ctx context.Context, tx mcommon.DbExeAble, cols []string) ([]*model.DBTAppConfigTokenBtc, error) {
+// SQLSelectTTxBtcMempoolColByStatuses 根据状态获取
+func SQLSelectTTxBtcMempoolColByStatuses(ctx context.Context, tx mcommon.DbExeAble, cols []string, symbol string, statuses []int64) ([]*model.DBTTxBtcMempool, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token_btc`)
+	t_tx_btc_mempool
+WHERE
+	symbol=:symbol
+	AND handle_status IN (:handle_statuses)`)
 
-	var rows []*model.DBTAppConfigTokenBtc
+	var rows []*model.DBTTxBtcMempool
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
 		&rows,
 		query.String(),
-		gin.H{},
+		gin.H{
+			"symbol":          symbol,
+			"handle_statuses": statuses,
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -1619,9 +2119,88 @@ FROM
 	return rows, nil
 }
 
-// SQLSelectTTxBtcTokenColByOrgStatusForUpdate 根据ids获取
-func SQLSelectTTxBtcTokenColByOrgStatusForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatus int64) ([]*model.DBTTxBtcToken, error) {
-	query := strings.Builder{}
+// SQLUpdateTTxBtcMempoolStatusByIDs 更新
+func SQLUpdateTTxBtcMempoolStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTTxBtcMempool) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_btc_mempool
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":           ids,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTAppConfigTokenBtcColAll 根据ids获取
+func SQLSelectTAppConfigTokenBtcColAll(ctx context.Context, tx mcommon.DbExeAble, cols []string) ([]*model.DBTAppConfigTokenBtc, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_config_token_btc`)
+
+	var rows []*model.DBTAppConfigTokenBtc
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTAppConfigTokenEosColByChainSymbol 获取链上的所有代币
+func SQLSelectTAppConfigTokenEosColByChainSymbol(ctx context.Context, tx mcommon.DbExeAble, cols []string, chainSymbol string) ([]*model.DBTAppConfigTokenEos, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_config_token_eos
+WHERE
+	chain_symbol=:chain_symbol`)
+
+	var rows []*model.DBTAppConfigTokenEos
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"chain_symbol": chainSymbol,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTTxBtcTokenColByOrgStatusForUpdate 根据ids获取
+func SQLSelectTTxBtcTokenColByOrgStatusForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatus int64) ([]*model.DBTTxBtcToken, error) {
+	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
@@ -1793,7 +2372,7 @@ LIMIT 1`,
 }
 
 // SQLGetTAddressMaxIntOfEos 根据id查询
-func SQLGetTAddressMaxIntOfEos(ctx context.Context, tx mcommon.DbExeAble) (int64, error) {
+func SQLGetTAddressMaxIntOfEos(ctx context.Context, tx mcommon.DbExeAble, symbol string) (int64, error) {
 	var address string
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
@@ -1804,8 +2383,10 @@ func SQLGetTAddressMaxIntOfEos(ctx context.Context, tx mcommon.DbExeAble) (int64
 FROM
 	t_address_key
 WHERE
-	symbol="eos"`,
-		gin.H{},
+	symbol=:symbol`,
+		gin.H{
+			"symbol": symbol,
+		},
 	)
 	if err != nil {
 		return 0, err
@@ -1819,3 +2400,1260 @@ WHERE
 	}
 	return addressInt, nil
 }
+
+// SQLSelectTAppConfigTokenTrxColAll 获取所有trc20代币
+func SQLSelectTAppConfigTokenTrxColAll(ctx context.Context, tx mcommon.DbExeAble, cols []string) ([]*model.DBTAppConfigTokenTrx, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_config_token_trx`)
+
+	var rows []*model.DBTAppConfigTokenTrx
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTTxTrxColByStatus 根据状态获取
+func SQLSelectTTxTrxColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTTxTrx, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_trx
+WHERE
+	handle_status=:handle_status`)
+
+	var rows []*model.DBTTxTrx
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"handle_status": status,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxTrxStatusByIDs 更新
+func SQLUpdateTTxTrxStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTTxTrx) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_trx
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":           ids,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTTxTrxColByOrgForUpdate 获取未整理交易
+func SQLSelectTTxTrxColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatuses []int64) ([]*model.DBTTxTrx, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_trx
+WHERE
+	org_status IN (:org_status)
+FOR UPDATE`)
+
+	var rows []*model.DBTTxTrx
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"org_status": orgStatuses,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxTrxOrgStatusByIDs 更新
+func SQLUpdateTTxTrxOrgStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTTxTrx) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_trx
+SET
+    org_status=:org_status,
+    org_msg=:org_msg,
+    org_time=:org_time
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":        ids,
+			"org_status": row.OrgStatus,
+			"org_msg":    row.OrgMsg,
+			"org_time":   row.OrgTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTTxTrxOrgPendingCountByAddress 获取地址未完成整理的代币交易数
+func SQLGetTTxTrxOrgPendingCountByAddress(ctx context.Context, tx mcommon.DbExeAble, address string) (int64, error) {
+	var i int64
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&i,
+		`SELECT
+	COUNT(*)
+FROM
+	t_tx_trx
+WHERE
+	to_address=:address
+	AND token_id>0
+	AND org_status NOT IN (:org_status)`,
+		gin.H{
+			"address": address,
+			"org_status": []int64{
+				TxOrgStatusConfirm,
+				TxOrgStatusFail,
+			},
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+	return i, nil
+}
+
+// SQLSelectTSendTrxColByStatus 根据状态获取
+func SQLSelectTSendTrxColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTSendTrx, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_trx
+WHERE
+	handle_status=:handle_status`)
+
+	var rows []*model.DBTSendTrx
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"handle_status": status,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSendTrxStatusByIDs 更新
+func SQLUpdateTSendTrxStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTSendTrx) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_trx
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":           ids,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTSendTrxBlockNumFeeByTxID 更新交易所在块高和实际手续费
+func SQLUpdateTSendTrxBlockNumFeeByTxID(ctx context.Context, tx mcommon.DbExeAble, row model.DBTSendTrx) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_trx
+SET
+    block_num=:block_num,
+    fee=:fee
+WHERE
+	tx_id=:tx_id`,
+		gin.H{
+			"tx_id":     row.TxID,
+			"block_num": row.BlockNum,
+			"fee":       row.Fee,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendTrxPendingCountByRelatedType 获取发往地址的未完成的发送数量
+func SQLGetTSendTrxPendingCountByRelatedType(ctx context.Context, tx mcommon.DbExeAble, relatedType int64, toAddress string) (int64, error) {
+	var i int64
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&i,
+		`SELECT
+	COUNT(1)
+FROM
+	t_send_trx
+WHERE
+	related_type=:related_type
+	AND to_address=:to_address
+	AND handle_status<2`,
+		gin.H{
+			"related_type": relatedType,
+			"to_address":   toAddress,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+	return i, nil
+}
+
+// SQLGetTSendTrxPendingBalanceReal 获取地址的打包数额
+func SQLGetTSendTrxPendingBalanceReal(ctx context.Context, tx mcommon.DbExeAble, address string, tokenID int64) (string, error) {
+	var i string
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&i,
+		`SELECT 
+	IFNULL(SUM(CAST(balance_real as DECIMAL(65,18))), "0")
+FROM
+	t_send_trx
+WHERE
+	from_address=:address
+	AND token_id=:token_id
+	AND handle_status<2
+LIMIT 1`,
+		gin.H{
+			"address":  address,
+			"token_id": tokenID,
+		},
+	)
+	if err != nil {
+		return "0", err
+	}
+	if !ok {
+		return "0", nil
+	}
+	return i, nil
+}
+
+// SQLSelectTAppConfigTokenSolColAll 获取所有spl代币
+func SQLSelectTAppConfigTokenSolColAll(ctx context.Context, tx mcommon.DbExeAble, cols []string) ([]*model.DBTAppConfigTokenSol, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_config_token_sol`)
+
+	var rows []*model.DBTAppConfigTokenSol
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTTxSolColByStatus 根据状态获取
+func SQLSelectTTxSolColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTTxSol, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_sol
+WHERE
+	handle_status=:handle_status`)
+
+	var rows []*model.DBTTxSol
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"handle_status": status,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxSolStatusByIDs 更新
+func SQLUpdateTTxSolStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTTxSol) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_sol
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":           ids,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTTxSolColByOrgForUpdate 获取未整理交易
+func SQLSelectTTxSolColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatuses []int64) ([]*model.DBTTxSol, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_sol
+WHERE
+	org_status IN (:org_status)
+FOR UPDATE`)
+
+	var rows []*model.DBTTxSol
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"org_status": orgStatuses,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxSolOrgStatusByIDs 更新
+func SQLUpdateTTxSolOrgStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTTxSol) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_sol
+SET
+    org_status=:org_status,
+    org_msg=:org_msg,
+    org_time=:org_time
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":        ids,
+			"org_status": row.OrgStatus,
+			"org_msg":    row.OrgMsg,
+			"org_time":   row.OrgTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTSendSolColByStatus 根据状态获取
+func SQLSelectTSendSolColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTSendSol, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_sol
+WHERE
+	handle_status=:handle_status`)
+
+	var rows []*model.DBTSendSol
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"handle_status": status,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSendSolStatusByIDs 更新
+func SQLUpdateTSendSolStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTSendSol) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_sol
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":           ids,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTSendSolBlockNumFeeByTxID 更新交易所在slot和实际手续费
+func SQLUpdateTSendSolBlockNumFeeByTxID(ctx context.Context, tx mcommon.DbExeAble, row model.DBTSendSol) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_sol
+SET
+    block_num=:block_num,
+    fee=:fee
+WHERE
+	tx_id=:tx_id`,
+		gin.H{
+			"tx_id":     row.TxID,
+			"block_num": row.BlockNum,
+			"fee":       row.Fee,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendSolPendingBalanceReal 获取地址的打包数额
+func SQLGetTSendSolPendingBalanceReal(ctx context.Context, tx mcommon.DbExeAble, address string, tokenID int64) (string, error) {
+	var i string
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&i,
+		`SELECT 
+	IFNULL(SUM(CAST(balance_real as DECIMAL(65,18))), "0")
+FROM
+	t_send_sol
+WHERE
+	from_address=:address
+	AND token_id=:token_id
+	AND handle_status<2
+LIMIT 1`,
+		gin.H{
+			"address":  address,
+			"token_id": tokenID,
+		},
+	)
+	if err != nil {
+		return "0", err
+	}
+	if !ok {
+		return "0", nil
+	}
+	return i, nil
+}
+
+// SQLSelectTTxXrpColByStatus 根据状态获取
+func SQLSelectTTxXrpColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTTxXrp, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_xrp
+WHERE
+	handle_status=:handle_status`)
+
+	var rows []*model.DBTTxXrp
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"handle_status": status,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxXrpStatusByIDs 更新
+func SQLUpdateTTxXrpStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTTxXrp) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_xrp
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_at=:handle_at
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":           ids,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_at":     row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTSendXrpColByStatus 根据状态获取
+func SQLSelectTSendXrpColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTSendXrp, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_xrp
+WHERE
+	handle_status=:handle_status
+ORDER BY sequence, id`)
+
+	var rows []*model.DBTSendXrp
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"handle_status": status,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSendXrpStatusByIDs 更新
+func SQLUpdateTSendXrpStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTSendXrp) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_xrp
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_at=:handle_at
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":           ids,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_at":     row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTSendXrpBlockNumFeeByTxHash 更新交易所在ledger和实际手续费
+func SQLUpdateTSendXrpBlockNumFeeByTxHash(ctx context.Context, tx mcommon.DbExeAble, row model.DBTSendXrp) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_xrp
+SET
+    block_num=:block_num,
+    fee=:fee
+WHERE
+	tx_hash=:tx_hash`,
+		gin.H{
+			"tx_hash":   row.TxHash,
+			"block_num": row.BlockNum,
+			"fee":       row.Fee,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendXrpPendingCount 获取未确认的发送数
+func SQLGetTSendXrpPendingCount(ctx context.Context, tx mcommon.DbExeAble, address string) (int64, error) {
+	var i int64
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&i,
+		`SELECT 
+	COUNT(*)
+FROM
+	t_send_xrp
+WHERE
+	from_address=:address
+	AND handle_status<2
+LIMIT 1`,
+		gin.H{
+			"address": address,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+	return i, nil
+}
+
+// withdrawFilterWhere 提币列表的查询条件
+func withdrawFilterWhere(productID int64, symbol string, status int64, startTime int64, endTime int64) (string, gin.H) {
+	query := strings.Builder{}
+	query.WriteString(`
+WHERE
+	product_id=:product_id`)
+	argMap := gin.H{
+		"product_id": productID,
+	}
+	if symbol != "" {
+		query.WriteString("\n\tAND symbol=:symbol")
+		argMap["symbol"] = symbol
+	}
+	if status >= 0 {
+		query.WriteString("\n\tAND handle_status=:handle_status")
+		argMap["handle_status"] = status
+	}
+	if startTime > 0 {
+		query.WriteString("\n\tAND create_time>=:start_time")
+		argMap["start_time"] = startTime
+	}
+	if endTime > 0 {
+		query.WriteString("\n\tAND create_time<:end_time")
+		argMap["end_time"] = endTime
+	}
+	return query.String(), argMap
+}
+
+// SQLSelectTWithdrawColByFilter 根据条件分页获取提币 status<0 时不过滤状态
+func SQLSelectTWithdrawColByFilter(ctx context.Context, tx mcommon.DbExeAble, cols []string, productID int64, symbol string, status int64, startTime int64, endTime int64, offset int64, limit int64) ([]*model.DBTWithdraw, error) {
+	where, argMap := withdrawFilterWhere(productID, symbol, status, startTime, endTime)
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_withdraw`)
+	query.WriteString(where)
+	query.WriteString(`
+ORDER BY
+	id DESC
+`)
+	query.WriteString(fmt.Sprintf("LIMIT %d,%d", offset, limit))
+
+	var rows []*model.DBTWithdraw
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLGetTWithdrawCountByFilter 根据条件获取提币数量 status<0 时不过滤状态
+func SQLGetTWithdrawCountByFilter(ctx context.Context, tx mcommon.DbExeAble, productID int64, symbol string, status int64, startTime int64, endTime int64) (int64, error) {
+	where, argMap := withdrawFilterWhere(productID, symbol, status, startTime, endTime)
+	query := strings.Builder{}
+	query.WriteString(`SELECT
+	COUNT(*)
+FROM
+	t_withdraw`)
+	query.WriteString(where)
+
+	var i int64
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&i,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+	return i, nil
+}
+
+// DBDeposit 各个充币表的统一字段
+type DBDeposit struct {
+	Source       int64  `db:"-"`
+	ID           int64  `db:"id"`
+	ChainSymbol  string `db:"chain_symbol"`
+	TokenID      int64  `db:"token_id"`
+	Symbol       string `db:"symbol"`
+	TxHash       string `db:"tx_hash"`
+	TxIndex      int64  `db:"tx_index"`
+	BlockNum     int64  `db:"block_num"`
+	Address      string `db:"address"`
+	Memo         string `db:"memo"`
+	BalanceReal  string `db:"balance_real"`
+	CreateTime   int64  `db:"create_time"`
+	HandleStatus int64  `db:"handle_status"`
+}
+
+// depositTable 充币表的查询字段
+type depositTable struct {
+	table      string
+	cols       string
+	timeCol    string
+	txCol      string
+	addressCol string
+	memoCol    string // 使用memo区分用户的链 地址查询时同时匹配memo
+}
+
+// depositTableMap 充币来源 => 充币表
+var depositTableMap = map[int64]*depositTable{
+	DepositSourceTx: {
+		table: "t_tx",
+		cols: `id,
+	chain_symbol,
+	0 AS token_id,
+	'' AS symbol,
+	tx_id AS tx_hash,
+	0 AS tx_index,
+	block_num,
+	to_address AS address,
+	'' AS memo,
+	balance_real,
+	create_time,
+	handle_status`,
+		timeCol:    "create_time",
+		txCol:      "tx_id",
+		addressCol: "to_address",
+	},
+	DepositSourceTxErc20: {
+		table: "t_tx_erc20",
+		cols: `id,
+	chain_symbol,
+	token_id,
+	'' AS symbol,
+	tx_id AS tx_hash,
+	0 AS tx_index,
+	block_num,
+	to_address AS address,
+	'' AS memo,
+	balance_real,
+	create_time,
+	handle_status`,
+		timeCol:    "create_time",
+		txCol:      "tx_id",
+		addressCol: "to_address",
+	},
+	DepositSourceBtc: {
+		table: "t_tx_btc",
+		cols: `id,
+	'' AS chain_symbol,
+	0 AS token_id,
+	symbol,
+	tx_id AS tx_hash,
+	vout_n AS tx_index,
+	block_num,
+	vout_address AS address,
+	'' AS memo,
+	vout_value AS balance_real,
+	create_time,
+	handle_status`,
+		timeCol:    "create_time",
+		txCol:      "tx_id",
+		addressCol: "vout_address",
+	},
+	DepositSourceBtcToken: {
+		table: "t_tx_btc_token",
+		cols: `id,
+	'' AS chain_symbol,
+	token_index AS token_id,
+	LOWER(token_symbol) AS symbol,
+	tx_id AS tx_hash,
+	0 AS tx_index,
+	block_num,
+	to_address AS address,
+	'' AS memo,
+	value AS balance_real,
+	create_at AS create_time,
+	handle_status`,
+		timeCol:    "create_at",
+		txCol:      "tx_id",
+		addressCol: "to_address",
+	},
+	DepositSourceEos: {
+		table: "t_tx_eos",
+		cols: `id,
+	'' AS chain_symbol,
+	0 AS token_id,
+	symbol,
+	tx_hash,
+	log_index AS tx_index,
+	block_num,
+	to_address AS address,
+	memo,
+	balance_real,
+	create_at AS create_time,
+	handle_status`,
+		timeCol:    "create_at",
+		txCol:      "tx_hash",
+		addressCol: "to_address",
+		memoCol:    "memo",
+	},
+	DepositSourceTrx: {
+		table: "t_tx_trx",
+		cols: `id,
+	'' AS chain_symbol,
+	token_id,
+	'' AS symbol,
+	tx_id AS tx_hash,
+	log_index AS tx_index,
+	block_num,
+	to_address AS address,
+	'' AS memo,
+	balance_real,
+	create_time,
+	handle_status`,
+		timeCol:    "create_time",
+		txCol:      "tx_id",
+		addressCol: "to_address",
+	},
+	DepositSourceSol: {
+		table: "t_tx_sol",
+		cols: `id,
+	'' AS chain_symbol,
+	token_id,
+	'' AS symbol,
+	tx_id AS tx_hash,
+	ix_index AS tx_index,
+	block_num,
+	to_address AS address,
+	'' AS memo,
+	balance_real,
+	create_time,
+	handle_status`,
+		timeCol:    "create_time",
+		txCol:      "tx_id",
+		addressCol: "to_address",
+	},
+	DepositSourceXrp: {
+		table: "t_tx_xrp",
+		cols: `id,
+	'' AS chain_symbol,
+	0 AS token_id,
+	'xrp' AS symbol,
+	tx_hash,
+	0 AS tx_index,
+	ledger_index AS block_num,
+	to_address AS address,
+	memo,
+	balance_real,
+	create_at AS create_time,
+	handle_status`,
+		timeCol:    "create_at",
+		txCol:      "tx_hash",
+		addressCol: "to_address",
+		memoCol:    "memo",
+	},
+}
+
+// DepositSources 所有充币来源 按排序顺序
+var DepositSources = []int64{
+	DepositSourceTx,
+	DepositSourceTxErc20,
+	DepositSourceBtc,
+	DepositSourceBtcToken,
+	DepositSourceEos,
+	DepositSourceTrx,
+	DepositSourceSol,
+	DepositSourceXrp,
+}
+
+// StDepositCursor 充币列表游标 按 create_time DESC, source ASC, id DESC 排序
+type StDepositCursor struct {
+	CreateTime int64
+	Source     int64
+	ID         int64
+}
+
+// SQLSelectDepositByCursor 获取充币来源中游标之后的充币
+// txHash address 为空时不过滤, cursor 为nil时从最新的开始
+func SQLSelectDepositByCursor(ctx context.Context, tx mcommon.DbExeAble, source int64, productID int64, txHash string, address string, cursor *StDepositCursor, limit int64) ([]*DBDeposit, error) {
+	table, ok := depositTableMap[source]
+	if !ok {
+		return nil, fmt.Errorf("no deposit source: %d", source)
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n\t")
+	query.WriteString(table.cols)
+	query.WriteString("\nFROM\n\t")
+	query.WriteString(table.table)
+	query.WriteString(`
+WHERE
+	product_id=:product_id`)
+	argMap := gin.H{
+		"product_id": productID,
+	}
+	if txHash != "" {
+		query.WriteString(fmt.Sprintf("\n\tAND %s=:tx_hash", table.txCol))
+		argMap["tx_hash"] = txHash
+	}
+	if address != "" {
+		if table.memoCol != "" {
+			query.WriteString(fmt.Sprintf("\n\tAND (%s=:address OR %s=:address)", table.addressCol, table.memoCol))
+		} else {
+			query.WriteString(fmt.Sprintf("\n\tAND %s=:address", table.addressCol))
+		}
+		argMap["address"] = address
+	}
+	if cursor != nil {
+		switch {
+		case source > cursor.Source:
+			query.WriteString(fmt.Sprintf("\n\tAND %s<=:cursor_time", table.timeCol))
+		case source == cursor.Source:
+			query.WriteString(fmt.Sprintf("\n\tAND (%s<:cursor_time OR (%s=:cursor_time AND id<:cursor_id))", table.timeCol, table.timeCol))
+			argMap["cursor_id"] = cursor.ID
+		default:
+			query.WriteString(fmt.Sprintf("\n\tAND %s<:cursor_time", table.timeCol))
+		}
+		argMap["cursor_time"] = cursor.CreateTime
+	}
+	query.WriteString(fmt.Sprintf(`
+ORDER BY
+	%s DESC,
+	id DESC
+LIMIT %d`, table.timeCol, limit))
+
+	var rows []*DBDeposit
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		row.Source = source
+	}
+	return rows, nil
+}
+
+// SQLGetWithdrawSendCount 获取提币已经生成的打币数量
+func SQLGetWithdrawSendCount(ctx context.Context, tx mcommon.DbExeAble, withdrawID int64) (int64, error) {
+	var i int64
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&i,
+		`SELECT
+	(SELECT COUNT(*) FROM t_send WHERE related_type=:related_type AND related_id=:id)
+	+ (SELECT COUNT(*) FROM t_send_btc WHERE related_type=:related_type AND related_id=:id)
+	+ (SELECT COUNT(*) FROM t_send_eos WHERE withdraw_id=:id)
+	+ (SELECT COUNT(*) FROM t_send_trx WHERE related_type=:related_type AND related_id=:id)
+	+ (SELECT COUNT(*) FROM t_send_sol WHERE related_type=:related_type AND related_id=:id)
+	+ (SELECT COUNT(*) FROM t_send_xrp WHERE withdraw_id=:id)`,
+		gin.H{
+			"related_type": SendRelationTypeWithdraw,
+			"id":           withdrawID,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+	return i, nil
+}
+
+// SQLSelectTWithdrawColByLegacySymbol 获取币种不是资产标识的提币
+func SQLSelectTWithdrawColByLegacySymbol(ctx context.Context, tx mcommon.DbExeAble, cols []string) ([]*model.DBTWithdraw, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_withdraw
+WHERE
+	symbol NOT LIKE :like
+ORDER BY id`)
+
+	var rows []*model.DBTWithdraw
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"like": "%" + AssetIDSep + "%",
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTWithdrawSymbolByIDs 更新提币币种
+func SQLUpdateTWithdrawSymbolByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, legacySymbol string, symbol string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_withdraw
+SET
+    symbol=:symbol
+WHERE
+	id IN (:ids)
+	AND symbol=:legacy_symbol`,
+		gin.H{
+			"ids":           ids,
+			"symbol":        symbol,
+			"legacy_symbol": legacySymbol,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTTxEosColByLegacySymbol 获取币种不是资产标识的eos充币
+func SQLSelectTTxEosColByLegacySymbol(ctx context.Context, tx mcommon.DbExeAble, cols []string) ([]*model.DBTTxEos, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_eos
+WHERE
+	symbol NOT LIKE :like
+ORDER BY id`)
+
+	var rows []*model.DBTTxEos
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"like": "%" + AssetIDSep + "%",
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxEosSymbolByIDs 更新eos充币币种
+func SQLUpdateTTxEosSymbolByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, legacySymbol string, symbol string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_eos
+SET
+    symbol=:symbol
+WHERE
+	id IN (:ids)
+	AND symbol=:legacy_symbol`,
+		gin.H{
+			"ids":           ids,
+			"symbol":        symbol,
+			"legacy_symbol": legacySymbol,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTSendEosColByLegacySymbol 获取币种不是资产标识的eos打币
+func SQLSelectTSendEosColByLegacySymbol(ctx context.Context, tx mcommon.DbExeAble, cols []string) ([]*model.DBTSendEos, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_eos
+WHERE
+	symbol NOT LIKE :like
+ORDER BY id`)
+
+	var rows []*model.DBTSendEos
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"like": "%" + AssetIDSep + "%",
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSendEosSymbolByIDs 更新eos打币币种
+func SQLUpdateTSendEosSymbolByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, legacySymbol string, symbol string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_eos
+SET
+    symbol=:symbol
+WHERE
+	id IN (:ids)
+	AND symbol=:legacy_symbol`,
+		gin.H{
+			"ids":           ids,
+			"symbol":        symbol,
+			"legacy_symbol": legacySymbol,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
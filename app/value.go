@@ -2,8 +2,12 @@ package app
 
 // 交易状态
 const (
-	TxStatusInit   = 0
-	TxStatusNotify = 1
+	TxStatusInit          = 0
+	TxStatusNotify        = 1
+	TxStatusPending       = 2 // 未不可逆
+	TxStatusPendingNotify = 3 // 未不可逆 已通知
+	TxStatusRevert        = 4 // 所在块被回滚
+	TxStatusRevertNotify  = 5 // 所在块被回滚 已通知
 )
 
 // 零钱整理状态
@@ -15,6 +19,7 @@ const (
 	TxOrgStatusFeeHex     = 4
 	TxOrgStatusFeeSend    = 5
 	TxOrgStatusFeeConfirm = 6
+	TxOrgStatusFail       = 7 // 整理交易执行失败 需要人工处理
 )
 
 // 发送状态
@@ -22,6 +27,8 @@ const (
 	SendStatusInit    = 0
 	SendStatusSend    = 1
 	SendStatusConfirm = 2
+	SendStatusExpired = 3 // 交易过期 需要重新生成
+	SendStatusFail    = 4 // 交易上链但执行失败
 )
 
 // 发送类型
@@ -32,6 +39,8 @@ const (
 	SendRelationTypeTxErc20Fee = 4
 	SendRelationTypeUXTOOrg    = 5
 	SendRelationTypeOmniOrg    = 6
+	SendRelationTypeTxMempool  = 7
+	SendRelationTypeEnergy     = 8 // trx收回代理的能量
 )
 
 // 通知状态
@@ -46,6 +55,18 @@ const (
 	NotifyTypeTx              = 1
 	NotifyTypeWithdrawSend    = 2
 	NotifyTypeWithdrawConfirm = 3
+	NotifyTypeTxPending       = 4
+	NotifyTypeWithdrawCancel  = 5
+	NotifyTypeWithdrawFail    = 6
+	NotifyTypeTxRevert        = 7
+)
+
+// mempool 交易状态
+const (
+	MempoolStatusInit    = 0
+	MempoolStatusNotify  = 1
+	MempoolStatusConfirm = 2
+	MempoolStatusDrop    = 3
 )
 
 // 提币状态
@@ -54,6 +75,8 @@ const (
 	WithdrawStatusHex     = 1
 	WithdrawStatusSend    = 2
 	WithdrawStatusConfirm = 3
+	WithdrawStatusCancel  = 4 // 广播前被产品取消
+	WithdrawStatusFail    = 5 // 交易上链但执行失败 需要人工处理
 )
 
 // uxto 类型
@@ -71,3 +94,15 @@ const (
 	UxtoHandleStatusUse     = 1
 	UxtoHandleStatusConfirm = 2
 )
+
+// 充币来源 对应各个充币表
+const (
+	DepositSourceTx       = 1 // t_tx
+	DepositSourceTxErc20  = 2 // t_tx_erc20
+	DepositSourceBtc      = 3 // t_tx_btc
+	DepositSourceBtcToken = 4 // t_tx_btc_token
+	DepositSourceEos      = 5 // t_tx_eos
+	DepositSourceTrx      = 6 // t_tx_trx
+	DepositSourceSol      = 7 // t_tx_sol
+	DepositSourceXrp      = 8 // t_tx_xrp
+)
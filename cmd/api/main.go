@@ -2,6 +2,7 @@
 package main
 
 import (
+	"go-dc-wallet/hbtc"
 	"go-dc-wallet/web"
 	"go-dc-wallet/xenv"
 	"time"
@@ -15,6 +16,11 @@ import (
 func main() {
 	xenv.EnvCreate()
 	defer xenv.EnvDestroy()
+	// 检测 utxo 链网络类型
+	err := hbtc.CheckChains()
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
 	// 初始化gin
 	if !xenv.Cfg.IsDebug {
 		gin.SetMode(gin.ReleaseMode)
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"context"
+	"go-dc-wallet/hbtc"
+	"go-dc-wallet/web"
+	"go-dc-wallet/xenv"
+
+	"github.com/moremorefun/mcommon"
+)
+
+// 升级到资产标识后执行一次 将已有记录中的旧版本币种转换为资产标识
+func main() {
+	xenv.EnvCreate()
+	defer xenv.EnvDestroy()
+	// 检测 utxo 链网络类型
+	err := hbtc.CheckChains()
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
+
+	err = web.MigrateLegacySymbols(context.Background())
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
+}
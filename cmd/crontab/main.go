@@ -6,6 +6,9 @@ import (
 	"go-dc-wallet/hbtc"
 	"go-dc-wallet/heos"
 	"go-dc-wallet/heth"
+	"go-dc-wallet/hsol"
+	"go-dc-wallet/htrx"
+	"go-dc-wallet/hxrp"
 	"go-dc-wallet/xenv"
 
 	"github.com/moremorefun/mcommon"
@@ -15,6 +18,11 @@ import (
 func main() {
 	xenv.EnvCreate()
 	defer xenv.EnvDestroy()
+	// 检测 utxo 链网络类型
+	err := hbtc.CheckChains()
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
 
 	c := cron.New(
 		cron.WithSeconds(),
@@ -22,85 +30,94 @@ func main() {
 			cron.Recover(cron.DefaultLogger),
 		),
 	)
-	var err error
 	// --- common --
 	// 检测 通知发送
 	_, err = c.AddFunc("@every 1m", app.CheckDoNotify)
 	if err != nil {
 		mcommon.Log.Errorf("cron add func error: %#v", err)
 	}
-	// --- eth ---
-	// 检测 eth 生成地址
-	_, err = c.AddFunc("@every 1m", heth.CheckAddressFree)
-	if err != nil {
-		mcommon.Log.Errorf("cron add func error: %#v", err)
-	}
-	// 检测 eth 冲币
-	_, err = c.AddFunc("@every 5s", heth.CheckBlockSeek)
-	if err != nil {
-		mcommon.Log.Errorf("cron add func error: %#v", err)
-	}
-	// 检测 eth 零钱整理
-	_, err = c.AddFunc("@every 10m", heth.CheckAddressOrg)
-	if err != nil {
-		mcommon.Log.Errorf("cron add func error: %#v", err)
-	}
-	// 检测 eth 提币
-	_, err = c.AddFunc("@every 3m", heth.CheckWithdraw)
-	if err != nil {
-		mcommon.Log.Errorf("cron add func error: %#v", err)
-	}
-	// 检测 eth 发送交易
-	_, err = c.AddFunc("@every 1m", heth.CheckRawTxSend)
-	if err != nil {
-		mcommon.Log.Errorf("cron add func error: %#v", err)
-	}
-	// 检测 eth 交易上链
-	_, err = c.AddFunc("@every 5s", heth.CheckRawTxConfirm)
-	if err != nil {
-		mcommon.Log.Errorf("cron add func error: %#v", err)
-	}
-	// 检测 eth 通知到账
-	_, err = c.AddFunc("@every 5s", heth.CheckTxNotify)
-	if err != nil {
-		mcommon.Log.Errorf("cron add func error: %#v", err)
-	}
-	// 检测 eth gas price
-	_, err = c.AddFunc("@every 2m", heth.CheckGasPrice)
-	if err != nil {
-		mcommon.Log.Errorf("cron add func error: %#v", err)
+	for _, chain := range heth.GetEnableChains() {
+		// --- eth bsc polygon arbitrum ---
+		// 检测 生成地址
+		_, err = c.AddFunc("@every 1m", chain.CheckAddressFree)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 冲币
+		_, err = c.AddFunc("@every 5s", chain.CheckBlockSeek)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 零钱整理
+		_, err = c.AddFunc("@every 10m", chain.CheckAddressOrg)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 提币
+		_, err = c.AddFunc("@every 3m", chain.CheckWithdraw)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 发送交易
+		_, err = c.AddFunc("@every 1m", chain.CheckRawTxSend)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 交易上链
+		_, err = c.AddFunc("@every 5s", chain.CheckRawTxConfirm)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 通知到账
+		_, err = c.AddFunc("@every 5s", chain.CheckTxNotify)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 gas price
+		_, err = c.AddFunc("@every 2m", chain.CheckGasPrice)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 erc20 冲币
+		_, err = c.AddFunc("@every 5s", chain.CheckErc20BlockSeek)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 erc20 通知到账
+		_, err = c.AddFunc("@every 5s", chain.CheckErc20TxNotify)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 erc20 零钱整理
+		_, err = c.AddFunc("@every 10m", chain.CheckErc20TxOrg)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 erc20 提币
+		_, err = c.AddFunc("@every 3m", chain.CheckErc20Withdraw)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
 	}
 
-	// --- erc20 ---
-	// 检测 erc20 冲币
-	_, err = c.AddFunc("@every 5s", heth.CheckErc20BlockSeek)
-	if err != nil {
-		mcommon.Log.Errorf("cron add func error: %#v", err)
-	}
-	// 检测 erc20 通知到账
-	_, err = c.AddFunc("@every 5s", heth.CheckErc20TxNotify)
-	if err != nil {
-		mcommon.Log.Errorf("cron add func error: %#v", err)
-	}
-	// 检测 erc20 零钱整理
-	_, err = c.AddFunc("@every 10m", heth.CheckErc20TxOrg)
+	// --- btc ---
+	// 检测 btc 生成地址
+	_, err = c.AddFunc("@every 1m", hbtc.CheckAddressFree)
 	if err != nil {
 		mcommon.Log.Errorf("cron add func error: %#v", err)
 	}
-	// 检测 erc20 提币
-	_, err = c.AddFunc("@every 3m", heth.CheckErc20Withdraw)
+	// 检测 btc 冲币
+	_, err = c.AddFunc("@every 5m", hbtc.CheckBlockSeek)
 	if err != nil {
 		mcommon.Log.Errorf("cron add func error: %#v", err)
 	}
-
-	// --- btc ---
-	// 检测 btc 生成地址
-	_, err = c.AddFunc("@every 1m", hbtc.CheckAddressFree)
+	// 检测 btc 内存池充币
+	_, err = c.AddFunc("@every 10s", hbtc.CheckMempool)
 	if err != nil {
 		mcommon.Log.Errorf("cron add func error: %#v", err)
 	}
-	// 检测 btc 冲币
-	_, err = c.AddFunc("@every 5m", hbtc.CheckBlockSeek)
+	// 检测 btc 内存池充币通知
+	_, err = c.AddFunc("@every 5s", hbtc.CheckMempoolNotify)
 	if err != nil {
 		mcommon.Log.Errorf("cron add func error: %#v", err)
 	}
@@ -161,35 +178,223 @@ func main() {
 	if err != nil {
 		mcommon.Log.Errorf("cron add func error: %#v", err)
 	}
-	if xenv.Cfg.EosEnable {
-		// --- eos ---
-		// 检测 eos 生成地址
-		_, err = c.AddFunc("@every 1m", heos.CheckAddressFree)
+	// --- ltc doge bch ---
+	for _, chain := range hbtc.GetEnableChains() {
+		if chain == hbtc.Btc {
+			continue
+		}
+		// 检测 生成地址
+		_, err = c.AddFunc("@every 1m", chain.CheckAddressFree)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 冲币
+		_, err = c.AddFunc("@every 1m", chain.CheckBlockSeek)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 hot uxto
+		_, err = c.AddFunc("@every 1m", chain.CheckBlockSeekHotAndFee)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 零钱整理
+		_, err = c.AddFunc("@every 10m", chain.CheckTxOrg)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 提币
+		_, err = c.AddFunc("@every 3m", chain.CheckWithdraw)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 发送交易
+		_, err = c.AddFunc("@every 1m", chain.CheckRawTxSend)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 交易上链
+		_, err = c.AddFunc("@every 1m", chain.CheckRawTxConfirm)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 通知到账
+		_, err = c.AddFunc("@every 5s", chain.CheckTxNotify)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+	}
+	for _, chain := range heos.GetEnableChains() {
+		// --- eos wax tlos ---
+		// 检测 生成地址
+		_, err = c.AddFunc("@every 1m", chain.CheckAddressFree)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 冲币
+		_, err = c.AddFunc("@every 3s", chain.CheckBlockSeek)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 提币
+		_, err = c.AddFunc("@every 3m", chain.CheckWithdraw)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 发送交易
+		_, err = c.AddFunc("@every 1s", chain.CheckRawTxSend)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 交易上链
+		_, err = c.AddFunc("@every 3s", chain.CheckRawTxConfirm)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 通知到账
+		_, err = c.AddFunc("@every 3s", chain.CheckTxNotify)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 热钱包资源
+		_, err = c.AddFunc("@every 1m", chain.CheckResource)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+	}
+
+	if xenv.Cfg.TrxEnable {
+		// --- trx trc20 ---
+		// 检测 trx 生成地址
+		_, err = c.AddFunc("@every 1m", htrx.CheckAddressFree)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 trx 冲币
+		_, err = c.AddFunc("@every 3s", htrx.CheckBlockSeek)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 trx 通知到账
+		_, err = c.AddFunc("@every 3s", htrx.CheckTxNotify)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 trx 零钱整理
+		_, err = c.AddFunc("@every 10m", htrx.CheckTxOrg)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 trc20 零钱整理
+		_, err = c.AddFunc("@every 10m", htrx.CheckTrc20TxOrg)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 trx 收回代理能量
+		_, err = c.AddFunc("@every 10m", htrx.CheckEnergyReclaim)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 trx 提币
+		_, err = c.AddFunc("@every 3m", htrx.CheckWithdraw)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 trc20 提币
+		_, err = c.AddFunc("@every 3m", htrx.CheckTrc20Withdraw)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 trx 发送交易
+		_, err = c.AddFunc("@every 1m", htrx.CheckRawTxSend)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 trx 交易上链
+		_, err = c.AddFunc("@every 10s", htrx.CheckRawTxConfirm)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+	}
+
+	if xenv.Cfg.SolEnable {
+		// --- sol spl ---
+		// 检测 sol 生成地址
+		_, err = c.AddFunc("@every 1m", hsol.CheckAddressFree)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 sol 冲币
+		_, err = c.AddFunc("@every 3s", hsol.CheckBlockSeek)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 sol 通知到账
+		_, err = c.AddFunc("@every 3s", hsol.CheckTxNotify)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 sol 零钱整理
+		_, err = c.AddFunc("@every 10m", hsol.CheckTxOrg)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 spl 零钱整理
+		_, err = c.AddFunc("@every 10m", hsol.CheckSplTxOrg)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 sol 提币
+		_, err = c.AddFunc("@every 3m", hsol.CheckWithdraw)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 spl 提币
+		_, err = c.AddFunc("@every 3m", hsol.CheckSplWithdraw)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 sol 发送交易
+		_, err = c.AddFunc("@every 1m", hsol.CheckRawTxSend)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+		// 检测 sol 交易确认
+		_, err = c.AddFunc("@every 10s", hsol.CheckRawTxConfirm)
+		if err != nil {
+			mcommon.Log.Errorf("cron add func error: %#v", err)
+		}
+	}
+
+	if xenv.Cfg.XrpEnable {
+		// --- xrp ---
+		// 检测 xrp 生成 destination tag
+		_, err = c.AddFunc("@every 1m", hxrp.CheckAddressFree)
 		if err != nil {
 			mcommon.Log.Errorf("cron add func error: %#v", err)
 		}
-		// 检测 eos 冲币
-		_, err = c.AddFunc("@every 3s", heos.CheckBlockSeek)
+		// 检测 xrp 冲币
+		_, err = c.AddFunc("@every 5s", hxrp.CheckBlockSeek)
 		if err != nil {
 			mcommon.Log.Errorf("cron add func error: %#v", err)
 		}
-		// 检测 eos 提币
-		_, err = c.AddFunc("@every 3m", heos.CheckWithdraw)
+		// 检测 xrp 通知到账
+		_, err = c.AddFunc("@every 5s", hxrp.CheckTxNotify)
 		if err != nil {
 			mcommon.Log.Errorf("cron add func error: %#v", err)
 		}
-		// 检测 eos 发送交易
-		_, err = c.AddFunc("@every 1s", heos.CheckRawTxSend)
+		// 检测 xrp 提币
+		_, err = c.AddFunc("@every 1m", hxrp.CheckWithdraw)
 		if err != nil {
 			mcommon.Log.Errorf("cron add func error: %#v", err)
 		}
-		// 检测 eos 交易上链
-		_, err = c.AddFunc("@every 3s", heos.CheckRawTxConfirm)
+		// 检测 xrp 发送交易
+		_, err = c.AddFunc("@every 10s", hxrp.CheckRawTxSend)
 		if err != nil {
 			mcommon.Log.Errorf("cron add func error: %#v", err)
 		}
-		// 检测 eos 通知到账
-		_, err = c.AddFunc("@every 3s", heos.CheckTxNotify)
+		// 检测 xrp 交易确认
+		_, err = c.AddFunc("@every 10s", hxrp.CheckRawTxConfirm)
 		if err != nil {
 			mcommon.Log.Errorf("cron add func error: %#v", err)
 		}
@@ -9,10 +9,16 @@ import (
 	"go-dc-wallet/eosclient"
 	"go-dc-wallet/ethclient"
 	"go-dc-wallet/hbtc"
+	"go-dc-wallet/heos"
 	"go-dc-wallet/heth"
+	"go-dc-wallet/hsol"
+	"go-dc-wallet/htrx"
 	"go-dc-wallet/model"
 	"go-dc-wallet/omniclient"
+	"go-dc-wallet/solclient"
+	"go-dc-wallet/tronclient"
 	"go-dc-wallet/xenv"
+	"go-dc-wallet/xrpclient"
 	"math"
 	"net/http"
 	"strings"
@@ -25,6 +31,11 @@ import (
 func main() {
 	xenv.EnvCreate()
 	defer xenv.EnvDestroy()
+	// 检测 utxo 链网络类型
+	err := hbtc.CheckChains()
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
 
 	// 1. 初始化 t_app_config_int
 	configIntRows := []*model.DBTAppConfigInt{
@@ -38,6 +49,11 @@ func main() {
 			K: "block_confirm_num",
 			V: 15,
 		},
+		{
+			// eth 转账 gas
+			K: "gas_use",
+			V: heth.Eth.GasUse,
+		},
 		{
 			// erc20 默认转账 gas
 			K: "erc20_gas_use",
@@ -48,8 +64,23 @@ func main() {
 			K: "btc_block_confirm_num",
 			V: 2,
 		},
+		{
+			// eos 热钱包资源报警百分比
+			K: "eos_resource_alert_percent",
+			V: 20,
+		},
+		{
+			// eos 提币需要的最小cpu 微秒
+			K: "eos_resource_min_cpu",
+			V: 1000,
+		},
+		{
+			// eos 提币需要的最小net 字节
+			K: "eos_resource_min_net",
+			V: 500,
+		},
 	}
-	_, err := model.SQLCreateManyTAppConfigInt(
+	_, err = model.SQLCreateManyTAppConfigInt(
 		context.Background(),
 		xenv.DbCon,
 		configIntRows,
@@ -166,6 +197,21 @@ func main() {
 			K: "hot_wallet_key_eos",
 			V: "",
 		},
+		{
+			// eos 热钱包签名权限 为空时使用 active
+			K: "hot_wallet_permission_eos",
+			V: "active",
+		},
+		{
+			// eos 资源补充方式 为空不自动补充 可选 stake powerup
+			K: "eos_resource_mode",
+			V: "",
+		},
+		{
+			// eos 每日补充资源最多花费
+			K: "eos_resource_day_limit",
+			V: "0",
+		},
 	}
 	_, err = model.SQLCreateManyTAppConfigStr(
 		context.Background(),
@@ -183,6 +229,7 @@ func main() {
 	configTokenRows := []*model.DBTAppConfigToken{
 		{
 			// erc20 token配置
+			ChainSymbol:   heth.Eth.Symbol,
 			TokenAddress:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
 			TokenDecimals: 6,
 			TokenSymbol:   "erc20_usdt",
@@ -227,6 +274,28 @@ func main() {
 		return
 	}
 
+	// 4.1 初始化 t_app_config_token_eos
+	configTokenEosRows := []*model.DBTAppConfigTokenEos{
+		{
+			// eos 原生代币
+			ChainSymbol:   heos.Eos.Symbol,
+			TokenAddress:  heos.EosTokenAddress,
+			TokenSymbol:   heos.EosTokenSymbol,
+			TokenDecimals: heos.EosTokenDecimals,
+			CreateAt:      now,
+		},
+	}
+	_, err = model.SQLCreateManyTAppConfigTokenEos(
+		context.Background(),
+		xenv.DbCon,
+		configTokenEosRows,
+		true,
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		return
+	}
+
 	// 5. 初始化 t_app_status_int
 	ethRpcBlockNum, err := ethclient.RpcBlockNumber(context.Background())
 	if err != nil {
@@ -335,6 +404,11 @@ func main() {
 			K: "eos_seek_num",
 			V: rpcChainInfo.LastIrreversibleBlockNum,
 		},
+		{
+			// eos 未不可逆 blocknum
+			K: "eos_head_seek_num",
+			V: rpcChainInfo.LastIrreversibleBlockNum,
+		},
 		{
 			// eth 到冷钱包手续费
 			K: "to_cold_gas_price",
@@ -345,6 +419,11 @@ func main() {
 			K: "to_user_gas_price",
 			V: ethToUserGasPrice,
 		},
+		{
+			// eth 最高手续费
+			K: "max_gas_price_eth",
+			V: heth.Eth.MaxGasPrice,
+		},
 		{
 			// btc 到冷钱包手续费
 			K: "to_cold_gas_price_btc",
@@ -355,6 +434,11 @@ func main() {
 			K: "to_user_gas_price_btc",
 			V: btcToUserGasPrice,
 		},
+		{
+			// btc 最后使用的找零地址id
+			K: "hot_change_address_id_btc",
+			V: 0,
+		},
 	}
 	_, err = model.SQLCreateManyTAppStatusInt(
 		context.Background(),
@@ -407,4 +491,645 @@ func main() {
 		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 		return
 	}
+
+	// 7. 初始化 ltc doge bch
+	// 默认手续费 satoshis/byte
+	forkGasPriceMap := map[string]int64{
+		hbtc.Ltc.Symbol:  10,
+		hbtc.Doge.Symbol: 1000,
+		hbtc.Bch.Symbol:  2,
+	}
+	for _, chain := range hbtc.GetEnableChains() {
+		if chain == hbtc.Btc {
+			continue
+		}
+		// 获取可用地址
+		forkAddressRows, err := app.SQLSelectTAddressKeyColByTagAndSymbol(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressKeyAddress,
+			},
+			-1,
+			chain.Symbol,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var forkAddresses []string
+		for _, forkAddressRow := range forkAddressRows {
+			forkAddresses = append(forkAddresses, forkAddressRow.Address)
+		}
+		if len(forkAddresses) < 10 {
+			forkAddresses, err = chain.CreateHotAddress(50)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		_, err = model.SQLCreateManyTAppConfigInt(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppConfigInt{
+				{
+					// 确认延迟数
+					K: fmt.Sprintf("%s_block_confirm_num", chain.Symbol),
+					V: 2,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = model.SQLCreateManyTAppConfigStr(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppConfigStr{
+				{
+					// 冷钱包地址
+					K: fmt.Sprintf("cold_wallet_address_%s", chain.Symbol),
+					V: "",
+				},
+				{
+					// 热钱包地址
+					K: fmt.Sprintf("hot_wallet_address_%s", chain.Symbol),
+					V: forkAddresses[0],
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		forkRpcBlockNum, err := chain.GetClient().RpcGetBlockCount()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = model.SQLCreateManyTAppStatusInt(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppStatusInt{
+				{
+					// blocknum
+					K: fmt.Sprintf("%s_seek_num", chain.Symbol),
+					V: forkRpcBlockNum,
+				},
+				{
+					// hot blocknum
+					K: fmt.Sprintf("%s_hot_fee_seek_num", chain.Symbol),
+					V: forkRpcBlockNum,
+				},
+				{
+					// 到冷钱包手续费
+					K: fmt.Sprintf("to_cold_gas_price_%s", chain.Symbol),
+					V: forkGasPriceMap[chain.Symbol],
+				},
+				{
+					// 到用户手续费
+					K: fmt.Sprintf("to_user_gas_price_%s", chain.Symbol),
+					V: forkGasPriceMap[chain.Symbol],
+				},
+				{
+					// 最后使用的找零地址id
+					K: fmt.Sprintf("hot_change_address_id_%s", chain.Symbol),
+					V: 0,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	}
+
+	// 8. 初始化 wax tlos
+	for _, chain := range heos.GetEnableChains() {
+		if chain == heos.Eos {
+			continue
+		}
+		_, err = model.SQLCreateManyTAppConfigStr(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppConfigStr{
+				{
+					// 冷钱包地址
+					K: fmt.Sprintf("cold_wallet_address_%s", chain.Symbol),
+					V: "",
+				},
+				{
+					// 热钱包地址
+					K: fmt.Sprintf("hot_wallet_address_%s", chain.Symbol),
+					V: "",
+				},
+				{
+					// 热钱包加密私钥
+					K: fmt.Sprintf("hot_wallet_key_%s", chain.Symbol),
+					V: "",
+				},
+				{
+					// 热钱包签名权限 为空时使用 active
+					K: fmt.Sprintf("hot_wallet_permission_%s", chain.Symbol),
+					V: "active",
+				},
+				{
+					// 资源补充方式 为空不自动补充 可选 stake powerup
+					K: fmt.Sprintf("%s_resource_mode", chain.Symbol),
+					V: "",
+				},
+				{
+					// 每日补充资源最多花费
+					K: fmt.Sprintf("%s_resource_day_limit", chain.Symbol),
+					V: "0",
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = model.SQLCreateManyTAppConfigInt(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppConfigInt{
+				{
+					// 热钱包资源报警百分比
+					K: fmt.Sprintf("%s_resource_alert_percent", chain.Symbol),
+					V: 20,
+				},
+				{
+					// 提币需要的最小cpu 微秒
+					K: fmt.Sprintf("%s_resource_min_cpu", chain.Symbol),
+					V: 1000,
+				},
+				{
+					// 提币需要的最小net 字节
+					K: fmt.Sprintf("%s_resource_min_net", chain.Symbol),
+					V: 500,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = model.SQLCreateManyTAppConfigTokenEos(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppConfigTokenEos{
+				{
+					// 原生代币
+					ChainSymbol:   chain.Symbol,
+					TokenAddress:  chain.TokenAddress,
+					TokenSymbol:   chain.TokenSymbol,
+					TokenDecimals: chain.TokenDecimals,
+					CreateAt:      now,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		antelopeChainInfo, err := chain.GetClient().RpcChainGetInfo()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = model.SQLCreateManyTAppStatusInt(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppStatusInt{
+				{
+					// blocknum
+					K: fmt.Sprintf("%s_seek_num", chain.Symbol),
+					V: antelopeChainInfo.LastIrreversibleBlockNum,
+				},
+				{
+					// 未不可逆 blocknum
+					K: fmt.Sprintf("%s_head_seek_num", chain.Symbol),
+					V: antelopeChainInfo.LastIrreversibleBlockNum,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	}
+
+	// 9. 初始化 trx
+	if xenv.Cfg.TrxEnable {
+		// 获取可用地址 第一个为热钱包 第二个为手续费钱包
+		trxAddressRows, err := app.SQLSelectTAddressKeyColByTagAndSymbol(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressKeyAddress,
+			},
+			-1,
+			htrx.CoinSymbol,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var trxAddresses []string
+		for _, trxAddressRow := range trxAddressRows {
+			trxAddresses = append(trxAddresses, trxAddressRow.Address)
+		}
+		if len(trxAddresses) < 2 {
+			trxAddresses, err = htrx.CreateHotAddress(2)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		_, err = model.SQLCreateManyTAppConfigInt(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppConfigInt{
+				{
+					// 确认延迟数 19个块后不可逆
+					K: "trx_block_confirm_num",
+					V: 19,
+				},
+				{
+					// trx转账预留的带宽手续费 sun
+					K: "trx_transfer_fee",
+					V: 300000,
+				},
+				{
+					// trc20转账最多消耗的trx sun
+					K: "trx_trc20_fee_limit",
+					V: 30000000,
+				},
+				{
+					// trc20转账需要的trx sun 手续费方式为trx时使用
+					K: "trx_trc20_fee",
+					V: 15000000,
+				},
+				{
+					// trc20转账消耗的能量
+					K: "trx_trc20_energy",
+					V: 65000,
+				},
+				{
+					// 每次代理能量质押的trx sun 手续费方式为energy时使用
+					K: "trx_energy_delegate_balance",
+					V: 200000000,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = model.SQLCreateManyTAppConfigStr(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppConfigStr{
+				{
+					// 冷钱包地址
+					K: "cold_wallet_address_trx",
+					V: "",
+				},
+				{
+					// 热钱包地址
+					K: "hot_wallet_address_trx",
+					V: trxAddresses[0],
+				},
+				{
+					// 手续费钱包地址
+					K: "fee_wallet_address_trx",
+					V: trxAddresses[1],
+				},
+				{
+					// trc20整理手续费方式 trx energy
+					K: "trx_fee_mode",
+					V: htrx.FeeModeTrx,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		trxNowBlock, err := tronclient.RpcGetNowBlock()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = model.SQLCreateManyTAppStatusInt(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppStatusInt{
+				{
+					// blocknum
+					K: "trx_seek_num",
+					V: trxNowBlock.BlockHeader.RawData.Number,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	}
+
+	// 10. 初始化 bsc polygon arbitrum
+	// 默认确认延迟数
+	evmConfirmNumMap := map[string]int64{
+		heth.Bsc.Symbol:      15,
+		heth.Polygon.Symbol:  128,
+		heth.Arbitrum.Symbol: 20,
+	}
+	for _, chain := range heth.GetEnableChains() {
+		if chain == heth.Eth {
+			continue
+		}
+		// 获取可用地址
+		evmAddressRows, err := app.SQLSelectTAddressKeyColByTagAndSymbol(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressKeyAddress,
+			},
+			-1,
+			chain.GetAddressSymbol(),
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var evmAddresses []string
+		for _, evmAddressRow := range evmAddressRows {
+			evmAddresses = append(evmAddresses, evmAddressRow.Address)
+		}
+		if len(evmAddresses) < 10 {
+			evmAddresses, err = chain.CreateHotAddress(50)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		_, err = model.SQLCreateManyTAppConfigInt(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppConfigInt{
+				{
+					// 确认延迟数
+					K: fmt.Sprintf("%s_block_confirm_num", chain.KeyPrefix),
+					V: evmConfirmNumMap[chain.Symbol],
+				},
+				{
+					// 原生币转账 gas
+					K: fmt.Sprintf("%s_gas_use", chain.KeyPrefix),
+					V: chain.GasUse,
+				},
+				{
+					// 代币默认转账 gas
+					K: fmt.Sprintf("%s_erc20_gas_use", chain.KeyPrefix),
+					V: 90000,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = model.SQLCreateManyTAppConfigStr(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppConfigStr{
+				{
+					// 冷钱包地址
+					K: fmt.Sprintf("%s_cold_wallet_address", chain.KeyPrefix),
+					V: "",
+				},
+				{
+					// 热钱包地址
+					K: fmt.Sprintf("%s_hot_wallet_address", chain.KeyPrefix),
+					V: evmAddresses[0],
+				},
+				{
+					// 代币零钱整理手续费 热钱包地址
+					K: fmt.Sprintf("%s_fee_wallet_address", chain.KeyPrefix),
+					V: evmAddresses[1],
+				},
+				{
+					// 代币零钱整理手续费 热钱包地址 列表
+					K: fmt.Sprintf("%s_fee_wallet_address_list", chain.KeyPrefix),
+					V: evmAddresses[1],
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		evmRpcBlockNum, err := chain.GetClient().RpcBlockNumber(context.Background())
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		evmGasPrice, err := chain.GetClient().RpcSuggestGasPrice(context.Background())
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = model.SQLCreateManyTAppStatusInt(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppStatusInt{
+				{
+					// blocknum
+					K: fmt.Sprintf("%s_seek_num", chain.KeyPrefix),
+					V: evmRpcBlockNum,
+				},
+				{
+					// 代币 blocknum
+					K: fmt.Sprintf("%s_erc20_seek_num", chain.KeyPrefix),
+					V: evmRpcBlockNum,
+				},
+				{
+					// 到冷钱包手续费
+					K: fmt.Sprintf("%s_to_cold_gas_price", chain.KeyPrefix),
+					V: evmGasPrice,
+				},
+				{
+					// 到用户手续费
+					K: fmt.Sprintf("%s_to_user_gas_price", chain.KeyPrefix),
+					V: evmGasPrice,
+				},
+				{
+					// 最高手续费
+					K: fmt.Sprintf("%s_max_gas_price", chain.KeyPrefix),
+					V: chain.MaxGasPrice,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	}
+
+	// 11. 初始化 sol
+	if xenv.Cfg.SolEnable {
+		// 获取可用地址 第一个为热钱包 第二个为手续费钱包
+		solAddressRows, err := app.SQLSelectTAddressKeyColByTagAndSymbol(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressKeyAddress,
+			},
+			-1,
+			hsol.CoinSymbol,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var solAddresses []string
+		for _, solAddressRow := range solAddressRows {
+			solAddresses = append(solAddresses, solAddressRow.Address)
+		}
+		if len(solAddresses) < 2 {
+			solAddresses, err = hsol.CreateHotAddress(2)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		_, err = model.SQLCreateManyTAppConfigStr(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppConfigStr{
+				{
+					// 冷钱包地址
+					K: "cold_wallet_address_sol",
+					V: "",
+				},
+				{
+					// 热钱包地址
+					K: "hot_wallet_address_sol",
+					V: solAddresses[0],
+				},
+				{
+					// 手续费钱包地址 支付spl整理的手续费和租金
+					K: "fee_wallet_address_sol",
+					V: solAddresses[1],
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		solSlot, err := solclient.RpcGetSlot(solclient.CommitmentFinalized)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = model.SQLCreateManyTAppStatusInt(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppStatusInt{
+				{
+					// finalized slot
+					K: "sol_seek_num",
+					V: solSlot,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	}
+
+	// 12. 初始化 xrp
+	if xenv.Cfg.XrpEnable {
+		_, err = model.SQLCreateManyTAppConfigStr(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppConfigStr{
+				{
+					// 冷钱包地址 充币地址 使用 destination tag 区分用户
+					K: "cold_wallet_address_xrp",
+					V: "",
+				},
+				{
+					// 热钱包地址
+					K: "hot_wallet_address_xrp",
+					V: "",
+				},
+				{
+					// 热钱包加密后的 ed25519 种子
+					K: "hot_wallet_key_xrp",
+					V: "",
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = model.SQLCreateManyTAppConfigInt(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppConfigInt{
+				{
+					// 提币手续费 drops
+					K: "xrp_tx_fee",
+					V: 12,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		xrpLedgerIndex, err := xrpclient.RpcLedgerIndex(xrpclient.LedgerValidated)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = model.SQLCreateManyTAppStatusInt(
+			context.Background(),
+			xenv.DbCon,
+			[]*model.DBTAppStatusInt{
+				{
+					// validated ledger
+					K: "xrp_seek_num",
+					V: xrpLedgerIndex,
+				},
+			},
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	}
 }
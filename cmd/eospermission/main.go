@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/heos"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"strings"
+
+	"github.com/moremorefun/mcommon"
+)
+
+func main() {
+	// 读取运行参数
+	var symbol = flag.String("s", "eos", "币种 eos wax tlos")
+	var activeKey = flag.String("k", "", "热钱包账号 active 原始私钥")
+	var permission = flag.String("p", "transfer", "自定义权限名")
+	var isLinkResource = flag.Bool("r", false, "同时关联 powerup 用于资源自动补充")
+	var h = flag.Bool("h", false, "help message")
+	flag.Parse()
+	if *h {
+		flag.Usage()
+		return
+	}
+	*activeKey = strings.TrimSpace(*activeKey)
+	if *activeKey == "" {
+		flag.Usage()
+		return
+	}
+	xenv.EnvCreate()
+	defer xenv.EnvDestroy()
+
+	chain := heos.GetChain(strings.ToLower(*symbol))
+	if chain == nil {
+		mcommon.Log.Fatalf("no chain of %s", *symbol)
+	}
+	// 创建权限并关联 action
+	txID, err := chain.SetupHotPermission(*activeKey, *permission, *isLinkResource)
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
+	// 更新签名权限配置
+	k := fmt.Sprintf("hot_wallet_permission_%s", chain.Symbol)
+	_, err = model.SQLCreateTAppConfigStr(
+		context.Background(),
+		xenv.DbCon,
+		&model.DBTAppConfigStr{
+			K: k,
+			V: *permission,
+		},
+		true,
+	)
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
+	_, err = app.SQLUpdateTAppConfigStrByK(
+		context.Background(),
+		xenv.DbCon,
+		&model.DBTAppConfigStr{
+			K: k,
+			V: *permission,
+		},
+	)
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
+	fmt.Printf("%s\n", txID)
+}
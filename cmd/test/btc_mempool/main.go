@@ -0,0 +1,14 @@
+package main
+
+import (
+	"go-dc-wallet/hbtc"
+	"go-dc-wallet/xenv"
+)
+
+func main() {
+	xenv.EnvCreate()
+	defer xenv.EnvDestroy()
+
+	hbtc.CheckMempool()
+	hbtc.CheckMempoolNotify()
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"go-dc-wallet/heos"
+	"go-dc-wallet/xenv"
+)
+
+func main() {
+	xenv.EnvCreate()
+	defer xenv.EnvDestroy()
+
+	heos.CheckResource()
+}
@@ -0,0 +1,910 @@
+// 本地测试用的 solana json rpc 服务
+// 内存账本 每笔交易生成一个块 所有数据立即 finalized
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go-dc-wallet/hsol"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// blockhashValidBlocks blockhash 有效的块数
+	blockhashValidBlocks = 150
+	// rpc 错误码
+	errorCodeInvalidParams   = -32602
+	errorCodeTxFailed        = -32002
+	errorCodeSlotSkipped     = -32007
+	errorCodeBlockNotAvail   = -32004
+	errorCodeMethodNotFound  = -32601
+	errorCodeInvalidRequest  = -32600
+	faucetTokenAccountAmount = ^uint64(0)
+)
+
+// rpcError 接口错误
+type rpcError struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+// tokenAccount 代币账户
+type tokenAccount struct {
+	Mint   string
+	Owner  string
+	Amount uint64
+}
+
+// ledger 内存账本
+type ledger struct {
+	sync.Mutex
+	slot          int64
+	height        int64
+	blocks        map[int64]gin.H
+	lamports      map[string]int64
+	tokenAccounts map[string]*tokenAccount
+	mints         map[string]int64 // map[mint] => decimals
+	blockhashes   map[string]int64 // map[blockhash] => last valid height
+	latest        string
+	statuses      map[string]int64 // map[签名] => slot
+	dropNext      bool
+	faucet        ed25519.PrivateKey
+}
+
+func newLedger() *ledger {
+	_, faucet, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		log.Fatalf("gen faucet key err: %s", err.Error())
+	}
+	l := &ledger{
+		blocks:        make(map[int64]gin.H),
+		lamports:      make(map[string]int64),
+		tokenAccounts: make(map[string]*tokenAccount),
+		mints:         make(map[string]int64),
+		blockhashes:   make(map[string]int64),
+		statuses:      make(map[string]int64),
+		faucet:        faucet,
+	}
+	l.latest = randomBase58(32)
+	l.blockhashes[l.latest] = blockhashValidBlocks
+	return l
+}
+
+// rentMin 免租金的最小余额 和主网参数一致
+func rentMin(dataSize int64) int64 {
+	return (128 + dataSize) * 3480 * 2
+}
+
+func randomBase58(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return base58.Encode(b)
+}
+
+func (l *ledger) faucetAddress() string {
+	return hsol.PrivateKeyToPublicKey(l.faucet).String()
+}
+
+// produceBlock 生成新块 txs为空时生成空块
+func (l *ledger) produceBlock(txs []gin.H) int64 {
+	l.slot++
+	l.height++
+	blockhash := randomBase58(32)
+	l.blockhashes[blockhash] = l.height + blockhashValidBlocks
+	l.latest = blockhash
+	if txs == nil {
+		txs = []gin.H{}
+	}
+	l.blocks[l.slot] = gin.H{
+		"blockHeight":  l.height,
+		"blockTime":    time.Now().Unix(),
+		"blockhash":    blockhash,
+		"parentSlot":   l.slot - 1,
+		"transactions": txs,
+	}
+	return l.slot
+}
+
+// tokenBalances 生成交易中代币账户的余额信息
+func (l *ledger) tokenBalances(keys []string) []gin.H {
+	balances := []gin.H{}
+	for i, key := range keys {
+		account, ok := l.tokenAccounts[key]
+		if !ok {
+			continue
+		}
+		balances = append(balances, gin.H{
+			"accountIndex": i,
+			"mint":         account.Mint,
+			"owner":        account.Owner,
+			"programId":    hsol.TokenProgramID.String(),
+			"uiTokenAmount": gin.H{
+				"amount":   strconv.FormatUint(account.Amount, 10),
+				"decimals": l.mints[account.Mint],
+			},
+		})
+	}
+	return balances
+}
+
+// accountKeys 生成 jsonParsed 格式的账户列表
+func accountKeys(keys []string, signerCount int, writable func(int) bool) []gin.H {
+	var rows []gin.H
+	for i, key := range keys {
+		rows = append(rows, gin.H{
+			"pubkey":   key,
+			"signer":   i < signerCount,
+			"writable": writable(i),
+			"source":   "transaction",
+		})
+	}
+	return rows
+}
+
+// recordTx 记录交易并生成块
+func (l *ledger) recordTx(signatures []string, keys []string, signerCount int, writable func(int) bool, instructions []gin.H, fee int64, preTokenBalances []gin.H) int64 {
+	tx := gin.H{
+		"meta": gin.H{
+			"err":               nil,
+			"fee":               fee,
+			"preBalances":       []int64{},
+			"postBalances":      []int64{},
+			"preTokenBalances":  preTokenBalances,
+			"postTokenBalances": l.tokenBalances(keys),
+			"innerInstructions": []gin.H{},
+		},
+		"transaction": gin.H{
+			"signatures": signatures,
+			"message": gin.H{
+				"accountKeys":     accountKeys(keys, signerCount, writable),
+				"instructions":    instructions,
+				"recentBlockhash": l.latest,
+			},
+		},
+	}
+	slot := l.produceBlock([]gin.H{tx})
+	for _, signature := range signatures[:1] {
+		l.statuses[signature] = slot
+	}
+	return slot
+}
+
+// airdrop 从水龙头转入sol
+func (l *ledger) airdrop(address string, lamports int64) (string, error) {
+	if _, err := hsol.PublicKeyFromBase58(address); err != nil {
+		return "", err
+	}
+	if lamports <= 0 {
+		return "", errors.New("error lamports")
+	}
+	if l.lamports[address] == 0 && lamports < rentMin(0) {
+		return "", errors.New("insufficient funds for rent")
+	}
+	l.lamports[address] += lamports
+	faucetAddress := l.faucetAddress()
+	signature := randomBase58(64)
+	keys := []string{faucetAddress, address, hsol.SystemProgramID.String()}
+	l.recordTx(
+		[]string{signature},
+		keys,
+		1,
+		func(i int) bool { return i < 2 },
+		[]gin.H{
+			{
+				"program":   "system",
+				"programId": hsol.SystemProgramID.String(),
+				"parsed": gin.H{
+					"type": "transfer",
+					"info": gin.H{
+						"source":      faucetAddress,
+						"destination": address,
+						"lamports":    lamports,
+					},
+				},
+			},
+		},
+		5000,
+		[]gin.H{},
+	)
+	return signature, nil
+}
+
+// mintTo 从水龙头转入代币 接收地址的关联代币账户不存在时自动创建
+func (l *ledger) mintTo(mint string, decimals int64, owner string, amount uint64) (string, error) {
+	if amount == 0 {
+		return "", errors.New("error amount")
+	}
+	if d, ok := l.mints[mint]; ok && d != decimals {
+		return "", fmt.Errorf("error decimals of mint: %d", d)
+	}
+	l.mints[mint] = decimals
+	faucetAddress := l.faucetAddress()
+	faucetTokenAccount, err := hsol.GetAssociatedTokenAddress(faucetAddress, mint)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := l.tokenAccounts[faucetTokenAccount]; !ok {
+		l.tokenAccounts[faucetTokenAccount] = &tokenAccount{
+			Mint:   mint,
+			Owner:  faucetAddress,
+			Amount: faucetTokenAccountAmount,
+		}
+	}
+	destination, err := hsol.GetAssociatedTokenAddress(owner, mint)
+	if err != nil {
+		return "", err
+	}
+	keys := []string{faucetAddress, faucetTokenAccount, destination, mint, hsol.TokenProgramID.String()}
+	preTokenBalances := l.tokenBalances(keys)
+	if _, ok := l.tokenAccounts[destination]; !ok {
+		l.tokenAccounts[destination] = &tokenAccount{
+			Mint:  mint,
+			Owner: owner,
+		}
+	}
+	l.tokenAccounts[destination].Amount += amount
+	signature := randomBase58(64)
+	l.recordTx(
+		[]string{signature},
+		keys,
+		1,
+		func(i int) bool { return i < 3 },
+		[]gin.H{
+			{
+				"program":   "spl-token",
+				"programId": hsol.TokenProgramID.String(),
+				"parsed": gin.H{
+					"type": "transferChecked",
+					"info": gin.H{
+						"source":      faucetTokenAccount,
+						"destination": destination,
+						"authority":   faucetAddress,
+						"mint":        mint,
+						"tokenAmount": gin.H{
+							"amount":   strconv.FormatUint(amount, 10),
+							"decimals": decimals,
+						},
+					},
+				},
+			},
+		},
+		5000,
+		preTokenBalances,
+	)
+	return signature, nil
+}
+
+// stMessageInstruction 交易中的指令
+type stMessageInstruction struct {
+	ProgramIndex int
+	Accounts     []int
+	Data         []byte
+}
+
+// stMessage 解析后的交易
+type stMessage struct {
+	Signatures        [][]byte
+	Raw               []byte
+	NumRequiredSigs   int
+	NumReadonlySigned int
+	NumReadonly       int
+	Keys              []string
+	Blockhash         string
+	Instructions      []*stMessageInstruction
+}
+
+func (m *stMessage) writable(i int) bool {
+	if i < m.NumRequiredSigs {
+		return i < m.NumRequiredSigs-m.NumReadonlySigned
+	}
+	return i < len(m.Keys)-m.NumReadonly
+}
+
+// reader 按字节读取
+type reader struct {
+	b   []byte
+	pos int
+}
+
+func (r *reader) read(n int) ([]byte, error) {
+	if n < 0 || r.pos+n > len(r.b) {
+		return nil, errors.New("unexpected end of tx")
+	}
+	b := r.b[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *reader) readCompactU16() (int, error) {
+	n := 0
+	for i := 0; i < 3; i++ {
+		b, err := r.read(1)
+		if err != nil {
+			return 0, err
+		}
+		n |= int(b[0]&0x7f) << (7 * uint(i))
+		if b[0]&0x80 == 0 {
+			return n, nil
+		}
+	}
+	return 0, errors.New("error compact-u16")
+}
+
+// decodeTx 解析 legacy 格式的交易
+func decodeTx(raw []byte) (*stMessage, error) {
+	r := &reader{b: raw}
+	sigCount, err := r.readCompactU16()
+	if err != nil {
+		return nil, err
+	}
+	m := &stMessage{}
+	for i := 0; i < sigCount; i++ {
+		sig, err := r.read(ed25519.SignatureSize)
+		if err != nil {
+			return nil, err
+		}
+		m.Signatures = append(m.Signatures, sig)
+	}
+	m.Raw = raw[r.pos:]
+	header, err := r.read(3)
+	if err != nil {
+		return nil, err
+	}
+	if header[0]&0x80 != 0 {
+		return nil, errors.New("versioned tx not supported")
+	}
+	m.NumRequiredSigs = int(header[0])
+	m.NumReadonlySigned = int(header[1])
+	m.NumReadonly = int(header[2])
+	keyCount, err := r.readCompactU16()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < keyCount; i++ {
+		key, err := r.read(32)
+		if err != nil {
+			return nil, err
+		}
+		m.Keys = append(m.Keys, base58.Encode(key))
+	}
+	blockhash, err := r.read(32)
+	if err != nil {
+		return nil, err
+	}
+	m.Blockhash = base58.Encode(blockhash)
+	ixCount, err := r.readCompactU16()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < ixCount; i++ {
+		programIndex, err := r.read(1)
+		if err != nil {
+			return nil, err
+		}
+		ix := &stMessageInstruction{ProgramIndex: int(programIndex[0])}
+		accountCount, err := r.readCompactU16()
+		if err != nil {
+			return nil, err
+		}
+		accounts, err := r.read(accountCount)
+		if err != nil {
+			return nil, err
+		}
+		for _, account := range accounts {
+			if int(account) >= len(m.Keys) {
+				return nil, errors.New("error account index")
+			}
+			ix.Accounts = append(ix.Accounts, int(account))
+		}
+		dataLen, err := r.readCompactU16()
+		if err != nil {
+			return nil, err
+		}
+		ix.Data, err = r.read(dataLen)
+		if err != nil {
+			return nil, err
+		}
+		if ix.ProgramIndex >= len(m.Keys) {
+			return nil, errors.New("error program index")
+		}
+		m.Instructions = append(m.Instructions, ix)
+	}
+	if r.pos != len(raw) {
+		return nil, errors.New("trailing bytes in tx")
+	}
+	if sigCount != m.NumRequiredSigs || sigCount == 0 {
+		return nil, errors.New("error signature count")
+	}
+	for i, sig := range m.Signatures {
+		publicKey := base58.Decode(m.Keys[i])
+		if !ed25519.Verify(publicKey, m.Raw, sig) {
+			return nil, errors.New("signature verification failure")
+		}
+	}
+	return m, nil
+}
+
+// execTx 执行交易 先在副本上执行 全部成功后写入账本
+func (l *ledger) execTx(m *stMessage) error {
+	lamports := make(map[string]int64)
+	tokenAccounts := make(map[string]*tokenAccount)
+	getLamports := func(address string) int64 {
+		if v, ok := lamports[address]; ok {
+			return v
+		}
+		return l.lamports[address]
+	}
+	getTokenAccount := func(address string) *tokenAccount {
+		if v, ok := tokenAccounts[address]; ok {
+			return v
+		}
+		if v, ok := l.tokenAccounts[address]; ok {
+			c := *v
+			tokenAccounts[address] = &c
+			return &c
+		}
+		return nil
+	}
+	isSigner := func(i int) bool {
+		return i < m.NumRequiredSigs
+	}
+	// 手续费
+	fee := int64(5000 * len(m.Signatures))
+	feePayer := m.Keys[0]
+	if getLamports(feePayer) < fee {
+		return errors.New("Attempt to debit an account but found no record of a prior credit.")
+	}
+	lamports[feePayer] = getLamports(feePayer) - fee
+	var parsedInstructions []gin.H
+	for ixIndex, ix := range m.Instructions {
+		programID := m.Keys[ix.ProgramIndex]
+		accounts := func(i int) string {
+			return m.Keys[ix.Accounts[i]]
+		}
+		switch programID {
+		case hsol.SystemProgramID.String():
+			if len(ix.Data) != 12 || binary.LittleEndian.Uint32(ix.Data[0:4]) != 2 || len(ix.Accounts) < 2 {
+				return fmt.Errorf("instruction %d: unsupported system instruction", ixIndex)
+			}
+			if !isSigner(ix.Accounts[0]) {
+				return fmt.Errorf("instruction %d: missing required signature", ixIndex)
+			}
+			amount := int64(binary.LittleEndian.Uint64(ix.Data[4:12]))
+			from, to := accounts(0), accounts(1)
+			if amount < 0 || getLamports(from) < amount {
+				return fmt.Errorf("instruction %d: insufficient lamports", ixIndex)
+			}
+			lamports[from] = getLamports(from) - amount
+			lamports[to] = getLamports(to) + amount
+			parsedInstructions = append(parsedInstructions, gin.H{
+				"program":   "system",
+				"programId": programID,
+				"parsed": gin.H{
+					"type": "transfer",
+					"info": gin.H{
+						"source":      from,
+						"destination": to,
+						"lamports":    amount,
+					},
+				},
+			})
+		case hsol.AssociatedTokenAccountProgramID.String():
+			if len(ix.Accounts) < 4 || (len(ix.Data) > 0 && ix.Data[0] != 1) {
+				return fmt.Errorf("instruction %d: unsupported associated token instruction", ixIndex)
+			}
+			payer, account, owner, mint := accounts(0), accounts(1), accounts(2), accounts(3)
+			if _, ok := l.mints[mint]; !ok {
+				return fmt.Errorf("instruction %d: invalid mint", ixIndex)
+			}
+			expected, err := hsol.GetAssociatedTokenAddress(owner, mint)
+			if err != nil || expected != account {
+				return fmt.Errorf("instruction %d: invalid seeds", ixIndex)
+			}
+			if existing := getTokenAccount(account); existing != nil {
+				if len(ix.Data) == 0 {
+					return fmt.Errorf("instruction %d: account already in use", ixIndex)
+				}
+			} else {
+				rent := rentMin(hsol.TokenAccountSize)
+				if getLamports(payer) < rent {
+					return fmt.Errorf("instruction %d: insufficient lamports for rent", ixIndex)
+				}
+				lamports[payer] = getLamports(payer) - rent
+				tokenAccounts[account] = &tokenAccount{
+					Mint:  mint,
+					Owner: owner,
+				}
+			}
+			parsedInstructions = append(parsedInstructions, gin.H{
+				"program":   "spl-associated-token-account",
+				"programId": programID,
+				"parsed": gin.H{
+					"type": "createIdempotent",
+					"info": gin.H{
+						"source":        payer,
+						"account":       account,
+						"wallet":        owner,
+						"mint":          mint,
+						"systemProgram": hsol.SystemProgramID.String(),
+						"tokenProgram":  hsol.TokenProgramID.String(),
+					},
+				},
+			})
+		case hsol.TokenProgramID.String():
+			if len(ix.Data) != 10 || ix.Data[0] != 12 || len(ix.Accounts) < 4 {
+				return fmt.Errorf("instruction %d: unsupported token instruction", ixIndex)
+			}
+			if !isSigner(ix.Accounts[3]) {
+				return fmt.Errorf("instruction %d: missing required signature", ixIndex)
+			}
+			amount := binary.LittleEndian.Uint64(ix.Data[1:9])
+			decimals := int64(ix.Data[9])
+			sourceAddress, mint, destinationAddress, authority := accounts(0), accounts(1), accounts(2), accounts(3)
+			source := getTokenAccount(sourceAddress)
+			destination := getTokenAccount(destinationAddress)
+			if source == nil || destination == nil {
+				return fmt.Errorf("instruction %d: invalid account data", ixIndex)
+			}
+			if source.Mint != mint || destination.Mint != mint || l.mints[mint] != decimals {
+				return fmt.Errorf("instruction %d: mint mismatch", ixIndex)
+			}
+			if source.Owner != authority {
+				return fmt.Errorf("instruction %d: owner does not match", ixIndex)
+			}
+			if source.Amount < amount {
+				return fmt.Errorf("instruction %d: insufficient funds", ixIndex)
+			}
+			source.Amount -= amount
+			destination.Amount += amount
+			parsedInstructions = append(parsedInstructions, gin.H{
+				"program":   "spl-token",
+				"programId": programID,
+				"parsed": gin.H{
+					"type": "transferChecked",
+					"info": gin.H{
+						"source":      sourceAddress,
+						"destination": destinationAddress,
+						"authority":   authority,
+						"mint":        mint,
+						"tokenAmount": gin.H{
+							"amount":   strconv.FormatUint(amount, 10),
+							"decimals": decimals,
+						},
+					},
+				},
+			})
+		default:
+			return fmt.Errorf("instruction %d: unsupported program %s", ixIndex, programID)
+		}
+	}
+	// 余额大于0的账户需要满足免租金的最小余额
+	for address, v := range lamports {
+		if v > 0 && v < rentMin(0) {
+			return fmt.Errorf("Transaction results in an account (%s) with insufficient funds for rent", address)
+		}
+	}
+	// 写入账本
+	preTokenBalances := l.tokenBalances(m.Keys)
+	for address, v := range lamports {
+		l.lamports[address] = v
+	}
+	for address, v := range tokenAccounts {
+		l.tokenAccounts[address] = v
+	}
+	var signatures []string
+	for _, sig := range m.Signatures {
+		signatures = append(signatures, base58.Encode(sig))
+	}
+	l.recordTx(signatures, m.Keys, m.NumRequiredSigs, m.writable, parsedInstructions, fee, preTokenBalances)
+	return nil
+}
+
+// sendTransaction 发送交易
+func (l *ledger) sendTransaction(txBase64 string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(txBase64)
+	if err != nil {
+		return "", &rpcError{Code: errorCodeInvalidParams, Message: err.Error()}
+	}
+	m, err := decodeTx(raw)
+	if err != nil {
+		return "", &rpcError{Code: errorCodeInvalidParams, Message: err.Error()}
+	}
+	signature := base58.Encode(m.Signatures[0])
+	if _, ok := l.statuses[signature]; ok {
+		return "", &rpcError{Code: errorCodeTxFailed, Message: "Transaction simulation failed: This transaction has already been processed"}
+	}
+	lastValidHeight, ok := l.blockhashes[m.Blockhash]
+	if !ok || l.height > lastValidHeight {
+		return "", &rpcError{Code: errorCodeTxFailed, Message: "Transaction simulation failed: Blockhash not found"}
+	}
+	if l.dropNext {
+		// 模拟交易未上链
+		l.dropNext = false
+		return signature, nil
+	}
+	err = l.execTx(m)
+	if err != nil {
+		return "", &rpcError{Code: errorCodeTxFailed, Message: "Transaction simulation failed: " + err.Error()}
+	}
+	return signature, nil
+}
+
+// stRpcReq json rpc 请求
+type stRpcReq struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// param 解析第i个参数
+func (req *stRpcReq) param(i int, v interface{}) error {
+	if i >= len(req.Params) {
+		return &rpcError{Code: errorCodeInvalidParams, Message: "missing params"}
+	}
+	err := json.Unmarshal(req.Params[i], v)
+	if err != nil {
+		return &rpcError{Code: errorCodeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+// withContext 带context的返回值
+func (l *ledger) withContext(value interface{}) gin.H {
+	return gin.H{
+		"context": gin.H{
+			"slot": l.slot,
+		},
+		"value": value,
+	}
+}
+
+// handle 处理 json rpc 请求
+func (l *ledger) handle(req *stRpcReq) (interface{}, error) {
+	l.Lock()
+	defer l.Unlock()
+
+	switch req.Method {
+	case "getSlot":
+		return l.slot, nil
+	case "getBlockHeight":
+		return l.height, nil
+	case "getBlock":
+		var slot int64
+		if err := req.param(0, &slot); err != nil {
+			return nil, err
+		}
+		if slot > l.slot {
+			return nil, &rpcError{Code: errorCodeBlockNotAvail, Message: fmt.Sprintf("Block not available for slot %d", slot)}
+		}
+		block, ok := l.blocks[slot]
+		if !ok {
+			return nil, &rpcError{Code: errorCodeSlotSkipped, Message: fmt.Sprintf("Slot %d was skipped, or missing due to ledger jump to recent snapshot", slot)}
+		}
+		return block, nil
+	case "getBalance":
+		var address string
+		if err := req.param(0, &address); err != nil {
+			return nil, err
+		}
+		return l.withContext(l.lamports[address]), nil
+	case "getAccountInfo":
+		var address string
+		if err := req.param(0, &address); err != nil {
+			return nil, err
+		}
+		if _, ok := l.tokenAccounts[address]; ok {
+			return l.withContext(gin.H{
+				"lamports":   rentMin(hsol.TokenAccountSize),
+				"owner":      hsol.TokenProgramID.String(),
+				"executable": false,
+				"rentEpoch":  0,
+				"data":       []string{"", "base64"},
+			}), nil
+		}
+		if l.lamports[address] > 0 {
+			return l.withContext(gin.H{
+				"lamports":   l.lamports[address],
+				"owner":      hsol.SystemProgramID.String(),
+				"executable": false,
+				"rentEpoch":  0,
+				"data":       []string{"", "base64"},
+			}), nil
+		}
+		return l.withContext(nil), nil
+	case "getTokenAccountBalance":
+		var address string
+		if err := req.param(0, &address); err != nil {
+			return nil, err
+		}
+		account, ok := l.tokenAccounts[address]
+		if !ok {
+			return nil, &rpcError{Code: errorCodeInvalidParams, Message: "Invalid param: could not find account"}
+		}
+		return l.withContext(gin.H{
+			"amount":   strconv.FormatUint(account.Amount, 10),
+			"decimals": l.mints[account.Mint],
+		}), nil
+	case "getMinimumBalanceForRentExemption":
+		var dataSize int64
+		if err := req.param(0, &dataSize); err != nil {
+			return nil, err
+		}
+		return rentMin(dataSize), nil
+	case "getLatestBlockhash":
+		return l.withContext(gin.H{
+			"blockhash":            l.latest,
+			"lastValidBlockHeight": l.blockhashes[l.latest],
+		}), nil
+	case "sendTransaction":
+		var txBase64 string
+		if err := req.param(0, &txBase64); err != nil {
+			return nil, err
+		}
+		return l.sendTransaction(txBase64)
+	case "getSignatureStatuses":
+		var signatures []string
+		if err := req.param(0, &signatures); err != nil {
+			return nil, err
+		}
+		var statuses []interface{}
+		for _, signature := range signatures {
+			slot, ok := l.statuses[signature]
+			if !ok {
+				statuses = append(statuses, nil)
+				continue
+			}
+			statuses = append(statuses, gin.H{
+				"slot":               slot,
+				"confirmations":      nil,
+				"err":                nil,
+				"status":             gin.H{"Ok": nil},
+				"confirmationStatus": "finalized",
+			})
+		}
+		return l.withContext(statuses), nil
+	}
+	return nil, &rpcError{Code: errorCodeMethodNotFound, Message: "Method not found"}
+}
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:8899", "listen address")
+	flag.Parse()
+
+	l := newLedger()
+	r := gin.Default()
+	// json rpc
+	r.POST("/", func(c *gin.Context) {
+		var req stRpcReq
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			c.JSON(http.StatusOK, gin.H{
+				"jsonrpc": "2.0",
+				"id":      nil,
+				"error":   &rpcError{Code: errorCodeInvalidRequest, Message: err.Error()},
+			})
+			return
+		}
+		result, err := l.handle(&req)
+		if err != nil {
+			rpcErr, ok := err.(*rpcError)
+			if !ok {
+				rpcErr = &rpcError{Code: errorCodeInvalidParams, Message: err.Error()}
+			}
+			c.JSON(http.StatusOK, gin.H{
+				"jsonrpc": "2.0",
+				"id":      req.ID,
+				"error":   rpcErr,
+			})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  result,
+		})
+	})
+	// 转入sol {"address": "", "lamports": 1000000000}
+	r.POST("/admin/airdrop", func(c *gin.Context) {
+		var req struct {
+			Address  string `json:"address" binding:"required"`
+			Lamports int64  `json:"lamports" binding:"required"`
+		}
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		l.Lock()
+		defer l.Unlock()
+		signature, err := l.airdrop(req.Address, req.Lamports)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"signature": signature, "slot": l.slot})
+	})
+	// 转入代币 {"mint": "", "decimals": 6, "owner": "", "amount": 1000000}
+	r.POST("/admin/token", func(c *gin.Context) {
+		var req struct {
+			Mint     string `json:"mint" binding:"required"`
+			Decimals int64  `json:"decimals"`
+			Owner    string `json:"owner" binding:"required"`
+			Amount   uint64 `json:"amount" binding:"required"`
+		}
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		l.Lock()
+		defer l.Unlock()
+		signature, err := l.mintTo(req.Mint, req.Decimals, req.Owner, req.Amount)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"signature": signature, "slot": l.slot})
+	})
+	// 生成空块 用于测试blockhash过期 {"blocks": 151}
+	r.POST("/admin/advance", func(c *gin.Context) {
+		var req struct {
+			Blocks int64 `json:"blocks" binding:"required"`
+		}
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		l.Lock()
+		defer l.Unlock()
+		for i := int64(0); i < req.Blocks; i++ {
+			l.produceBlock(nil)
+		}
+		c.JSON(http.StatusOK, gin.H{"slot": l.slot, "height": l.height})
+	})
+	// 跳过slot 用于测试没有块的slot {"slots": 3}
+	r.POST("/admin/skip", func(c *gin.Context) {
+		var req struct {
+			Slots int64 `json:"slots" binding:"required"`
+		}
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		l.Lock()
+		defer l.Unlock()
+		l.slot += req.Slots
+		c.JSON(http.StatusOK, gin.H{"slot": l.slot, "height": l.height})
+	})
+	// 下一笔发送的交易返回成功但不上链 用于测试交易过期
+	r.POST("/admin/drop_next", func(c *gin.Context) {
+		l.Lock()
+		defer l.Unlock()
+		l.dropNext = true
+		c.JSON(http.StatusOK, gin.H{"drop_next": true})
+	})
+
+	log.Printf("sol mock rpc listen on: %s", *addr)
+	err := r.Run(*addr)
+	if err != nil {
+		log.Fatalf("run err: %s", err.Error())
+	}
+}
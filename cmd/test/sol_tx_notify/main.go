@@ -0,0 +1,13 @@
+package main
+
+import (
+	"go-dc-wallet/hsol"
+	"go-dc-wallet/xenv"
+)
+
+func main() {
+	xenv.EnvCreate()
+	defer xenv.EnvDestroy()
+
+	hsol.CheckTxNotify()
+}
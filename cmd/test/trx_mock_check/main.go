@@ -0,0 +1,290 @@
+// 使用 trx_mock_rpc 检测交易的签名 广播 确认
+// 先启动 go run cmd/test/trx_mock_rpc/main.go
+package main
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go-dc-wallet/htrx"
+	"go-dc-wallet/tronclient"
+	"log"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gin-gonic/gin"
+	"github.com/parnurzeal/gorequest"
+)
+
+var rpcURI string
+
+// admin 调用mock服务的测试数据接口
+func admin(name string, args gin.H) {
+	gresp, body, errs := gorequest.New().
+		Post(rpcURI + "/admin/" + name).
+		Send(args).
+		EndBytes()
+	if errs != nil {
+		log.Fatalf("admin %s err: %s", name, errs[0].Error())
+	}
+	if gresp.StatusCode != 200 {
+		log.Fatalf("admin %s err: %s", name, body)
+	}
+}
+
+// newKey 生成地址
+func newKey() (*ecdsa.PrivateKey, string) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		log.Fatalf("gen key err: %s", err.Error())
+	}
+	return key, htrx.PubkeyToAddress(key.PublicKey)
+}
+
+// sign 使用最新块签名
+func sign(f func(*htrx.StRefBlock) (*htrx.StTx, error)) *htrx.StTx {
+	refBlock, err := htrx.GetRefBlock()
+	if err != nil {
+		log.Fatalf("ref block err: %s", err.Error())
+	}
+	signedTx, err := f(refBlock)
+	if err != nil {
+		log.Fatalf("sign err: %s", err.Error())
+	}
+	return signedTx
+}
+
+// broadcast 广播交易并检测错误码 code为空时需要成功
+func broadcast(name string, signedTx *htrx.StTx, code string) {
+	rpcResp, err := tronclient.RpcBroadcastHex(signedTx.Hex)
+	if err != nil {
+		log.Fatalf("%s broadcast err: %s", name, err.Error())
+	}
+	if code == "" && !rpcResp.Result {
+		log.Fatalf("%s broadcast fail: %s %s", name, rpcResp.Code, rpcResp.Message)
+	}
+	if code != "" && (rpcResp.Result || rpcResp.Code != code) {
+		log.Fatalf("%s broadcast need %s: %v %s %s", name, code, rpcResp.Result, rpcResp.Code, rpcResp.Message)
+	}
+	if rpcResp.TxID != signedTx.TxID {
+		log.Fatalf("%s tx id error: %s %s", name, rpcResp.TxID, signedTx.TxID)
+	}
+	log.Printf("ok %s broadcast %s", name, code)
+}
+
+// confirm 检测交易执行结果 result为 SUCCESS 或失败原因
+func confirm(name string, txID string, result string) {
+	rpcTxInfo, err := tronclient.RpcGetTransactionInfoByID(txID)
+	if err != nil {
+		log.Fatalf("%s tx info err: %s", name, err.Error())
+	}
+	if rpcTxInfo == nil || rpcTxInfo.BlockNumber <= 0 {
+		log.Fatalf("%s not confirm", name)
+	}
+	isFail := rpcTxInfo.Result == "FAILED" || (rpcTxInfo.Receipt.Result != "" && rpcTxInfo.Receipt.Result != "SUCCESS")
+	if result == "SUCCESS" && isFail {
+		log.Fatalf("%s need success: %s %s", name, rpcTxInfo.Result, rpcTxInfo.Receipt.Result)
+	}
+	if result != "SUCCESS" && (!isFail || rpcTxInfo.Receipt.Result != result) {
+		log.Fatalf("%s need %s: %s %s", name, result, rpcTxInfo.Result, rpcTxInfo.Receipt.Result)
+	}
+	log.Printf("ok %s confirm %s block %d", name, result, rpcTxInfo.BlockNumber)
+}
+
+// checkBalance 检测trx余额
+func checkBalance(address string, balance int64) {
+	rpcAccount, err := tronclient.RpcGetAccount(address)
+	if err != nil {
+		log.Fatalf("get account err: %s", err.Error())
+	}
+	if rpcAccount.Balance != balance {
+		log.Fatalf("%s balance %d need %d", address, rpcAccount.Balance, balance)
+	}
+}
+
+// checkTokenBalance 检测代币余额
+func checkTokenBalance(contract string, address string, balance int64) {
+	tokenBalance, err := htrx.RpcTokenBalance(contract, address)
+	if err != nil {
+		log.Fatalf("token balance err: %s", err.Error())
+	}
+	if tokenBalance.Cmp(big.NewInt(balance)) != 0 {
+		log.Fatalf("%s token balance %s need %d", address, tokenBalance.String(), balance)
+	}
+}
+
+// transferData trc20 transfer 调用数据
+func transferData(toAddress string, amount int64) []byte {
+	toBytes, err := htrx.AddressToBytes(toAddress)
+	if err != nil {
+		log.Fatalf("address err: %s", err.Error())
+	}
+	data := append(common.Hex2Bytes("a9059cbb"), common.LeftPadBytes(toBytes[1:], 32)...)
+	return append(data, common.LeftPadBytes(big.NewInt(amount).Bytes(), 32)...)
+}
+
+func main() {
+	flag.StringVar(&rpcURI, "rpc", "http://127.0.0.1:8090", "mock rpc uri")
+	flag.Parse()
+	tronclient.InitClient(rpcURI, "")
+
+	feeKey, feeAddress := newKey()
+	userKey, userAddress := newKey()
+	_, coldAddress := newKey()
+	_, tokenAddress := newKey()
+
+	// trx 转账 激活新地址
+	admin("airdrop", gin.H{"address": feeAddress, "amount": 1000000000})
+	checkBalance(feeAddress, 1000000000)
+	transferTx := sign(func(refBlock *htrx.StRefBlock) (*htrx.StTx, error) {
+		return htrx.SignTransfer(refBlock, feeKey, userAddress, 100000000)
+	})
+	broadcast("transfer", transferTx, "")
+	confirm("transfer", transferTx.TxID, "SUCCESS")
+	checkBalance(userAddress, 100000000)
+	checkBalance(feeAddress, 1000000000-100000000-1100000)
+	broadcast("transfer dup", transferTx, "DUP_TRANSACTION_ERROR")
+
+	// 块中的转账
+	rpcTxInfo, err := tronclient.RpcGetTransactionInfoByID(transferTx.TxID)
+	if err != nil {
+		log.Fatalf("tx info err: %s", err.Error())
+	}
+	rpcBlock, err := tronclient.RpcGetBlockByNum(rpcTxInfo.BlockNumber)
+	if err != nil {
+		log.Fatalf("get block err: %s", err.Error())
+	}
+	if len(rpcBlock.Transactions) != 1 || rpcBlock.Transactions[0].TxID != transferTx.TxID {
+		log.Fatalf("block txs error: %d", len(rpcBlock.Transactions))
+	}
+	var transfer tronclient.StTransferContract
+	err = json.Unmarshal(rpcBlock.Transactions[0].RawData.Contract[0].Parameter.Value, &transfer)
+	if err != nil {
+		log.Fatalf("transfer value err: %s", err.Error())
+	}
+	if transfer.OwnerAddress != feeAddress || transfer.ToAddress != userAddress || transfer.Amount != 100000000 {
+		log.Fatalf("transfer value error: %#v", transfer)
+	}
+	log.Printf("ok block transfer")
+
+	// 错误的签名
+	badTx := sign(func(refBlock *htrx.StRefBlock) (*htrx.StTx, error) {
+		return htrx.SignTransfer(refBlock, userKey, coldAddress, 1000000)
+	})
+	badTx.Hex = badTx.Hex[:len(badTx.Hex)-4] + "0000"
+	broadcast("bad signature", badTx, "SIGERROR")
+
+	// 未激活的地址不能接收代理的能量
+	admin("freeze", gin.H{"address": feeAddress, "amount": 500000000})
+	delegateTx := sign(func(refBlock *htrx.StRefBlock) (*htrx.StTx, error) {
+		return htrx.SignDelegateEnergy(refBlock, feeKey, coldAddress, 200000000, false)
+	})
+	broadcast("delegate inactive", delegateTx, "CONTRACT_VALIDATE_ERROR")
+	rpcAccount, err := tronclient.RpcGetAccount(coldAddress)
+	if err != nil {
+		log.Fatalf("get account err: %s", err.Error())
+	}
+	if rpcAccount.Address != "" {
+		log.Fatalf("cold address need inactive")
+	}
+
+	// 代理能量
+	delegateTx = sign(func(refBlock *htrx.StRefBlock) (*htrx.StTx, error) {
+		return htrx.SignDelegateEnergy(refBlock, feeKey, userAddress, 200000000, false)
+	})
+	broadcast("delegate", delegateTx, "")
+	confirm("delegate", delegateTx.TxID, "SUCCESS")
+	toAddresses, err := tronclient.RpcGetDelegatedResourceAccountIndex(feeAddress)
+	if err != nil {
+		log.Fatalf("delegate index err: %s", err.Error())
+	}
+	if len(toAddresses) != 1 || toAddresses[0] != userAddress {
+		log.Fatalf("delegate index error: %v", toAddresses)
+	}
+
+	// trc20 转账 使用代理的能量
+	admin("token", gin.H{"contract": tokenAddress, "address": userAddress, "amount": 5000000})
+	checkTokenBalance(tokenAddress, userAddress, 5000000)
+	tokenTx := sign(func(refBlock *htrx.StRefBlock) (*htrx.StTx, error) {
+		return htrx.SignTriggerSmartContract(refBlock, userKey, tokenAddress, transferData(coldAddress, 3000000), 30000000)
+	})
+	broadcast("trc20", tokenTx, "")
+	confirm("trc20", tokenTx.TxID, "SUCCESS")
+	checkTokenBalance(tokenAddress, userAddress, 2000000)
+	checkTokenBalance(tokenAddress, coldAddress, 3000000)
+	checkBalance(userAddress, 100000000)
+	rpcTxInfo, err = tronclient.RpcGetTransactionInfoByID(tokenTx.TxID)
+	if err != nil {
+		log.Fatalf("tx info err: %s", err.Error())
+	}
+	if len(rpcTxInfo.Log) != 1 || len(rpcTxInfo.Log[0].Topics) != 3 {
+		log.Fatalf("trc20 log error: %#v", rpcTxInfo.Log)
+	}
+	logAddress, err := htrx.HexToAddress(rpcTxInfo.Log[0].Address)
+	if err != nil || logAddress != tokenAddress {
+		log.Fatalf("trc20 log address error: %s", rpcTxInfo.Log[0].Address)
+	}
+	logTo, err := htrx.TopicToAddress(rpcTxInfo.Log[0].Topics[2])
+	if err != nil || logTo != coldAddress {
+		log.Fatalf("trc20 log to error: %s", rpcTxInfo.Log[0].Topics[2])
+	}
+	log.Printf("ok trc20 log")
+
+	// 执行失败的合约调用
+	admin("fail_next", gin.H{})
+	failTx := sign(func(refBlock *htrx.StRefBlock) (*htrx.StTx, error) {
+		return htrx.SignTriggerSmartContract(refBlock, userKey, tokenAddress, transferData(coldAddress, 1000000), 30000000)
+	})
+	broadcast("trc20 revert", failTx, "")
+	confirm("trc20 revert", failTx.TxID, "REVERT")
+	checkTokenBalance(tokenAddress, userAddress, 2000000)
+
+	// 能量和fee limit都不足
+	outTx := sign(func(refBlock *htrx.StRefBlock) (*htrx.StTx, error) {
+		return htrx.SignTriggerSmartContract(refBlock, userKey, tokenAddress, transferData(coldAddress, 1000000), 1000000)
+	})
+	broadcast("trc20 out of energy", outTx, "")
+	confirm("trc20 out of energy", outTx.TxID, "OUT_OF_ENERGY")
+	checkTokenBalance(tokenAddress, userAddress, 2000000)
+
+	// 收回能量
+	reclaimTx := sign(func(refBlock *htrx.StRefBlock) (*htrx.StTx, error) {
+		return htrx.SignDelegateEnergy(refBlock, feeKey, userAddress, 200000000, true)
+	})
+	broadcast("undelegate", reclaimTx, "")
+	confirm("undelegate", reclaimTx.TxID, "SUCCESS")
+	rpcResources, err := tronclient.RpcGetDelegatedResource(feeAddress, userAddress)
+	if err != nil {
+		log.Fatalf("delegate resource err: %s", err.Error())
+	}
+	if len(rpcResources) != 0 {
+		log.Fatalf("delegate resource need empty: %d", len(rpcResources))
+	}
+
+	// 广播成功但未上链的交易过期
+	admin("drop_next", gin.H{})
+	dropTx := sign(func(refBlock *htrx.StRefBlock) (*htrx.StTx, error) {
+		return htrx.SignTransfer(refBlock, userKey, coldAddress, 1000000)
+	})
+	broadcast("drop", dropTx, "")
+	rpcTxInfo, err = tronclient.RpcGetTransactionInfoByID(dropTx.TxID)
+	if err != nil {
+		log.Fatalf("tx info err: %s", err.Error())
+	}
+	if rpcTxInfo != nil {
+		log.Fatalf("drop tx need pending")
+	}
+	admin("advance", gin.H{"blocks": 1201})
+	rpcBlock, err = tronclient.RpcGetNowBlock()
+	if err != nil {
+		log.Fatalf("now block err: %s", err.Error())
+	}
+	if rpcBlock.BlockHeader.RawData.Timestamp <= dropTx.Expiration {
+		log.Fatalf("block time %d need after expiration %d", rpcBlock.BlockHeader.RawData.Timestamp, dropTx.Expiration)
+	}
+	broadcast("expired", dropTx, "TRANSACTION_EXPIRATION_ERROR")
+
+	fmt.Println("all ok")
+}
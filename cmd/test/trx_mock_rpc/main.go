@@ -0,0 +1,915 @@
+// 本地测试用的 tron http api 服务
+// 内存账本 每笔交易生成一个块 所有数据立即不可逆
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"go-dc-wallet/htrx"
+	"log"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// blockInterval 出块间隔 毫秒
+	blockInterval = 3000
+	// faucetBalance 水龙头余额 sun
+	faucetBalance = int64(1e17)
+	// accountCreateFee 转账激活新账户的手续费 sun
+	accountCreateFee = 1100000
+	// energyPerTrx 质押1trx获得的能量
+	energyPerTrx = 400
+	// energyPrice 能量不足时燃烧的trx sun/能量
+	energyPrice = 210
+	// trc20Energy 一次trc20转账消耗的能量
+	trc20Energy = 65000
+	// transferSelector transfer(address,uint256)
+	transferSelector = "a9059cbb"
+	// transferTopic Transfer(address,address,uint256)
+	transferTopic = "ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+)
+
+// 合约类型
+const (
+	contractTypeTransfer           = 1
+	contractTypeTriggerSmart       = 31
+	contractTypeDelegateResource   = 57
+	contractTypeUnDelegateResource = 58
+)
+
+// contractTypeNameMap 合约类型对应的名称
+var contractTypeNameMap = map[uint64]string{
+	contractTypeTransfer:           "TransferContract",
+	contractTypeTriggerSmart:       "TriggerSmartContract",
+	contractTypeDelegateResource:   "DelegateResourceContract",
+	contractTypeUnDelegateResource: "UnDelegateResourceContract",
+}
+
+// 广播错误码
+const (
+	codeSigError        = "SIGERROR"
+	codeTaposError      = "TAPOS_ERROR"
+	codeExpirationError = "TRANSACTION_EXPIRATION_ERROR"
+	codeDupError        = "DUP_TRANSACTION_ERROR"
+	codeValidateError   = "CONTRACT_VALIDATE_ERROR"
+	codeOtherError      = "OTHER_ERROR"
+)
+
+// broadcastError 广播错误
+type broadcastError struct {
+	Code    string
+	Message string
+}
+
+func (e *broadcastError) Error() string {
+	return e.Message
+}
+
+// account 账户
+type account struct {
+	Balance    int64
+	CreateTime int64
+	Frozen     int64 // 质押获得能量且未代理的trx sun
+	EnergyUsed int64
+}
+
+// block 块
+type block struct {
+	Number    int64
+	ID        []byte
+	Timestamp int64
+	Txs       []gin.H
+	Infos     []gin.H
+}
+
+// ledger 内存账本
+type ledger struct {
+	sync.Mutex
+	blocks    []*block
+	accounts  map[string]*account
+	tokens    map[string]map[string]*big.Int // map[合约地址] => map[地址] => 余额
+	delegates map[string]map[string]int64    // map[代理地址] => map[接收地址] => trx sun
+	txInfos   map[string]gin.H               // map[tx id] => 执行结果
+	dropNext  bool
+	failNext  bool
+	faucet    *ecdsa.PrivateKey
+}
+
+func newLedger() *ledger {
+	faucet, err := crypto.GenerateKey()
+	if err != nil {
+		log.Fatalf("gen faucet key err: %s", err.Error())
+	}
+	l := &ledger{
+		accounts:  make(map[string]*account),
+		tokens:    make(map[string]map[string]*big.Int),
+		delegates: make(map[string]map[string]int64),
+		txInfos:   make(map[string]gin.H),
+		faucet:    faucet,
+	}
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	l.blocks = append(l.blocks, &block{
+		Number:    0,
+		ID:        make([]byte, 32),
+		Timestamp: now,
+	})
+	l.accounts[l.faucetAddress()] = &account{
+		Balance:    faucetBalance,
+		CreateTime: now,
+	}
+	return l
+}
+
+func (l *ledger) faucetAddress() string {
+	return htrx.PubkeyToAddress(l.faucet.PublicKey)
+}
+
+func (l *ledger) head() *block {
+	return l.blocks[len(l.blocks)-1]
+}
+
+// produceBlock 生成新块 txs为空时生成空块
+func (l *ledger) produceBlock(txs []gin.H, infos []gin.H) *block {
+	parent := l.head()
+	number := parent.Number + 1
+	h := sha256.New()
+	h.Write(parent.ID)
+	for _, tx := range txs {
+		h.Write([]byte(tx["txID"].(string)))
+	}
+	id := h.Sum(nil)
+	binary.BigEndian.PutUint64(id[:8], uint64(number))
+	b := &block{
+		Number:    number,
+		ID:        id,
+		Timestamp: parent.Timestamp + blockInterval,
+		Txs:       txs,
+		Infos:     infos,
+	}
+	for _, info := range infos {
+		info["blockNumber"] = b.Number
+		info["blockTimeStamp"] = b.Timestamp
+		l.txInfos[info["id"].(string)] = info
+	}
+	l.blocks = append(l.blocks, b)
+	return b
+}
+
+// refBlock 当前块作为交易的引用块
+func (l *ledger) refBlock() *htrx.StRefBlock {
+	b := l.head()
+	numBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(numBytes, uint64(b.Number))
+	return &htrx.StRefBlock{
+		Bytes:     numBytes[6:8],
+		Hash:      b.ID[8:16],
+		Timestamp: b.Timestamp,
+	}
+}
+
+// energyLimit 地址可用的能量上限 包括自己质押的和收到代理的
+func (l *ledger) energyLimit(address string) int64 {
+	var frozen int64
+	if a, ok := l.accounts[address]; ok {
+		frozen += a.Frozen
+	}
+	for _, toMap := range l.delegates {
+		frozen += toMap[address]
+	}
+	return frozen * energyPerTrx / 1e6
+}
+
+// pbValue protobuf 字段值 只支持 varint 和 bytes
+type pbValue struct {
+	Int   uint64
+	Bytes []byte
+}
+
+// pbDecode 解析 protobuf 消息
+func pbDecode(b []byte) (map[int]pbValue, error) {
+	fields := make(map[int]pbValue)
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return nil, errors.New("error pb key")
+		}
+		b = b[n:]
+		field := int(key >> 3)
+		switch key & 7 {
+		case 0:
+			v, n := binary.Uvarint(b)
+			if n <= 0 {
+				return nil, errors.New("error pb varint")
+			}
+			b = b[n:]
+			fields[field] = pbValue{Int: v}
+		case 2:
+			size, n := binary.Uvarint(b)
+			if n <= 0 || uint64(len(b)-n) < size {
+				return nil, errors.New("error pb bytes")
+			}
+			fields[field] = pbValue{Bytes: b[n : n+int(size)]}
+			b = b[n+int(size):]
+		default:
+			return nil, fmt.Errorf("pb wire type not support: %d", key&7)
+		}
+	}
+	return fields, nil
+}
+
+// bytesToAddress 带41前缀的21字节转换为base58地址
+func bytesToAddress(b []byte) (string, error) {
+	return htrx.HexToAddress(hex.EncodeToString(b))
+}
+
+// stTx 解析后的交易
+type stTx struct {
+	TxID          string
+	RawHex        string
+	RefBlockBytes []byte
+	RefBlockHash  []byte
+	Expiration    int64
+	Timestamp     int64
+	FeeLimit      int64
+	ContractType  uint64
+	TypeURL       string
+	Parameter     map[int]pbValue
+	Owner         string
+	Signature     []byte
+}
+
+// decodeTx 解析签名后的交易 并校验签名 签名错误时也返回解析后的交易
+func decodeTx(txHex string) (*stTx, error) {
+	txBytes, err := hex.DecodeString(txHex)
+	if err != nil {
+		return nil, &broadcastError{Code: codeOtherError, Message: err.Error()}
+	}
+	txFields, err := pbDecode(txBytes)
+	if err != nil {
+		return nil, &broadcastError{Code: codeOtherError, Message: err.Error()}
+	}
+	raw := txFields[1].Bytes
+	rawFields, err := pbDecode(raw)
+	if err != nil {
+		return nil, &broadcastError{Code: codeOtherError, Message: err.Error()}
+	}
+	contractFields, err := pbDecode(rawFields[11].Bytes)
+	if err != nil {
+		return nil, &broadcastError{Code: codeOtherError, Message: err.Error()}
+	}
+	anyFields, err := pbDecode(contractFields[2].Bytes)
+	if err != nil {
+		return nil, &broadcastError{Code: codeOtherError, Message: err.Error()}
+	}
+	parameter, err := pbDecode(anyFields[2].Bytes)
+	if err != nil {
+		return nil, &broadcastError{Code: codeOtherError, Message: err.Error()}
+	}
+	owner, err := bytesToAddress(parameter[1].Bytes)
+	if err != nil {
+		return nil, &broadcastError{Code: codeValidateError, Message: "invalid owner address"}
+	}
+	txHash := sha256.Sum256(raw)
+	tx := &stTx{
+		TxID:          hex.EncodeToString(txHash[:]),
+		RawHex:        hex.EncodeToString(raw),
+		RefBlockBytes: rawFields[1].Bytes,
+		RefBlockHash:  rawFields[4].Bytes,
+		Expiration:    int64(rawFields[8].Int),
+		Timestamp:     int64(rawFields[14].Int),
+		FeeLimit:      int64(rawFields[18].Int),
+		ContractType:  contractFields[1].Int,
+		TypeURL:       string(anyFields[1].Bytes),
+		Parameter:     parameter,
+		Owner:         owner,
+		Signature:     txFields[2].Bytes,
+	}
+	if _, ok := contractTypeNameMap[tx.ContractType]; !ok {
+		return nil, &broadcastError{Code: codeValidateError, Message: fmt.Sprintf("contract type not support: %d", tx.ContractType)}
+	}
+	// 校验签名
+	if len(tx.Signature) != 65 {
+		return tx, &broadcastError{Code: codeSigError, Message: "error signature length"}
+	}
+	sig := make([]byte, 65)
+	copy(sig, tx.Signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	pub, err := crypto.SigToPub(txHash[:], sig)
+	if err != nil {
+		return tx, &broadcastError{Code: codeSigError, Message: err.Error()}
+	}
+	if htrx.PubkeyToAddress(*pub) != owner {
+		return tx, &broadcastError{Code: codeSigError, Message: "signature not match owner"}
+	}
+	return tx, nil
+}
+
+// checkTapos 校验引用块和过期时间
+func (l *ledger) checkTapos(tx *stTx) error {
+	found := false
+	for _, b := range l.blocks {
+		numBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(numBytes, uint64(b.Number))
+		if bytes.Equal(numBytes[6:8], tx.RefBlockBytes) && bytes.Equal(b.ID[8:16], tx.RefBlockHash) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return &broadcastError{Code: codeTaposError, Message: "ref block not found"}
+	}
+	if tx.Expiration <= l.head().Timestamp {
+		return &broadcastError{Code: codeExpirationError, Message: fmt.Sprintf("transaction expired: %d <= %d", tx.Expiration, l.head().Timestamp)}
+	}
+	return nil
+}
+
+// txJSON 生成块中的交易
+func txJSON(tx *stTx, value gin.H, contractRet string) gin.H {
+	return gin.H{
+		"txID": tx.TxID,
+		"ret": []gin.H{
+			{"contractRet": contractRet},
+		},
+		"raw_data": gin.H{
+			"contract": []gin.H{
+				{
+					"type": contractTypeNameMap[tx.ContractType],
+					"parameter": gin.H{
+						"value":    value,
+						"type_url": tx.TypeURL,
+					},
+				},
+			},
+			"ref_block_bytes": hex.EncodeToString(tx.RefBlockBytes),
+			"ref_block_hash":  hex.EncodeToString(tx.RefBlockHash),
+			"expiration":      tx.Expiration,
+			"fee_limit":       tx.FeeLimit,
+			"timestamp":       tx.Timestamp,
+		},
+		"raw_data_hex": tx.RawHex,
+		"signature":    []string{hex.EncodeToString(tx.Signature)},
+	}
+}
+
+// execTx 校验并执行交易 校验失败时不上链 执行失败时上链并记录失败结果
+func (l *ledger) execTx(tx *stTx) (gin.H, gin.H, error) {
+	owner, ok := l.accounts[tx.Owner]
+	if !ok {
+		return nil, nil, &broadcastError{Code: codeValidateError, Message: fmt.Sprintf("Account[%s] does not exist", tx.Owner)}
+	}
+	info := gin.H{
+		"id":             tx.TxID,
+		"contractResult": []string{""},
+		"receipt":        gin.H{},
+	}
+	now := l.head().Timestamp
+	switch tx.ContractType {
+	case contractTypeTransfer:
+		to, err := bytesToAddress(tx.Parameter[2].Bytes)
+		if err != nil {
+			return nil, nil, &broadcastError{Code: codeValidateError, Message: "Invalid toAddress"}
+		}
+		amount := int64(tx.Parameter[3].Int)
+		if amount <= 0 {
+			return nil, nil, &broadcastError{Code: codeValidateError, Message: "Amount must be greater than 0"}
+		}
+		if to == tx.Owner {
+			return nil, nil, &broadcastError{Code: codeValidateError, Message: "Cannot transfer TRX to yourself"}
+		}
+		var fee int64
+		toAccount, ok := l.accounts[to]
+		if !ok {
+			fee = accountCreateFee
+		}
+		if owner.Balance < amount+fee {
+			return nil, nil, &broadcastError{Code: codeValidateError, Message: "balance is not sufficient"}
+		}
+		if toAccount == nil {
+			toAccount = &account{CreateTime: now}
+			l.accounts[to] = toAccount
+		}
+		owner.Balance -= amount + fee
+		toAccount.Balance += amount
+		if fee > 0 {
+			info["fee"] = fee
+			info["receipt"] = gin.H{"net_fee": fee}
+		}
+		return txJSON(tx, gin.H{
+			"owner_address": tx.Owner,
+			"to_address":    to,
+			"amount":        amount,
+		}, "SUCCESS"), info, nil
+	case contractTypeTriggerSmart:
+		contract, err := bytesToAddress(tx.Parameter[2].Bytes)
+		if err != nil {
+			return nil, nil, &broadcastError{Code: codeValidateError, Message: "Invalid contract address"}
+		}
+		tokenMap, ok := l.tokens[contract]
+		if !ok {
+			return nil, nil, &broadcastError{Code: codeValidateError, Message: "No contract or not a smart contract"}
+		}
+		data := tx.Parameter[4].Bytes
+		value := gin.H{
+			"owner_address":    tx.Owner,
+			"contract_address": contract,
+			"data":             hex.EncodeToString(data),
+		}
+		contractHex := hex.EncodeToString(tx.Parameter[2].Bytes)
+		info["contract_address"] = contractHex
+		// 先使用能量 不足部分燃烧trx
+		energy := l.energyLimit(tx.Owner) - owner.EnergyUsed
+		if energy < 0 {
+			energy = 0
+		}
+		if energy > trc20Energy {
+			energy = trc20Energy
+		}
+		burn := (trc20Energy - energy) * energyPrice
+		if burn > tx.FeeLimit || burn > owner.Balance {
+			// 能量不足 燃烧的trx不超过 fee limit
+			burn = tx.FeeLimit
+			if burn > owner.Balance {
+				burn = owner.Balance
+			}
+			owner.EnergyUsed += energy
+			owner.Balance -= burn
+			info["fee"] = burn
+			info["receipt"] = gin.H{
+				"energy_fee":         burn,
+				"energy_usage_total": energy + burn/energyPrice,
+				"result":             "OUT_OF_ENERGY",
+			}
+			info["result"] = "FAILED"
+			info["resMessage"] = hex.EncodeToString([]byte("Not enough energy"))
+			return txJSON(tx, value, "OUT_OF_ENERGY"), info, nil
+		}
+		owner.EnergyUsed += energy
+		owner.Balance -= burn
+		info["fee"] = burn
+		receipt := gin.H{
+			"energy_fee":         burn,
+			"energy_usage_total": trc20Energy,
+			"result":             "SUCCESS",
+		}
+		info["receipt"] = receipt
+		// 执行 transfer
+		isRevert := l.failNext
+		l.failNext = false
+		var to string
+		amount := new(big.Int)
+		if !isRevert {
+			if len(data) != 68 || hex.EncodeToString(data[:4]) != transferSelector {
+				isRevert = true
+			} else {
+				to, err = htrx.HexToAddress(hex.EncodeToString(data[16:36]))
+				if err != nil {
+					isRevert = true
+				}
+				amount.SetBytes(data[36:68])
+			}
+		}
+		if !isRevert {
+			fromBalance, ok := tokenMap[tx.Owner]
+			if !ok || fromBalance.Cmp(amount) < 0 {
+				isRevert = true
+			}
+		}
+		if isRevert {
+			receipt["result"] = "REVERT"
+			info["result"] = "FAILED"
+			info["resMessage"] = hex.EncodeToString([]byte("REVERT opcode executed"))
+			return txJSON(tx, value, "REVERT"), info, nil
+		}
+		tokenMap[tx.Owner].Sub(tokenMap[tx.Owner], amount)
+		if _, ok := tokenMap[to]; !ok {
+			tokenMap[to] = new(big.Int)
+		}
+		tokenMap[to].Add(tokenMap[to], amount)
+		toBytes, _ := htrx.AddressToBytes(to)
+		info["contractResult"] = []string{common.Bytes2Hex(common.LeftPadBytes([]byte{1}, 32))}
+		info["log"] = []gin.H{
+			{
+				"address": contractHex[2:],
+				"topics": []string{
+					transferTopic,
+					common.Bytes2Hex(common.LeftPadBytes(tx.Parameter[1].Bytes[1:], 32)),
+					common.Bytes2Hex(common.LeftPadBytes(toBytes[1:], 32)),
+				},
+				"data": common.Bytes2Hex(common.LeftPadBytes(amount.Bytes(), 32)),
+			},
+		}
+		return txJSON(tx, value, "SUCCESS"), info, nil
+	case contractTypeDelegateResource, contractTypeUnDelegateResource:
+		if tx.Parameter[2].Int != 1 {
+			return nil, nil, &broadcastError{Code: codeValidateError, Message: "only support ENERGY"}
+		}
+		balance := int64(tx.Parameter[3].Int)
+		receiver, err := bytesToAddress(tx.Parameter[4].Bytes)
+		if err != nil {
+			return nil, nil, &broadcastError{Code: codeValidateError, Message: "Invalid receiverAddress"}
+		}
+		if receiver == tx.Owner {
+			return nil, nil, &broadcastError{Code: codeValidateError, Message: "receiverAddress must not be the same as ownerAddress"}
+		}
+		if _, ok := l.accounts[receiver]; !ok {
+			return nil, nil, &broadcastError{Code: codeValidateError, Message: fmt.Sprintf("Account[%s] does not exist", receiver)}
+		}
+		if balance < 1e6 {
+			return nil, nil, &broadcastError{Code: codeValidateError, Message: "delegateBalance must be greater than or equal to 1 TRX"}
+		}
+		toMap, ok := l.delegates[tx.Owner]
+		if !ok {
+			toMap = make(map[string]int64)
+			l.delegates[tx.Owner] = toMap
+		}
+		if tx.ContractType == contractTypeDelegateResource {
+			if owner.Frozen < balance {
+				return nil, nil, &broadcastError{Code: codeValidateError, Message: "delegateBalance must be less than or equal to available FreezeEnergyV2 balance"}
+			}
+			owner.Frozen -= balance
+			toMap[receiver] += balance
+		} else {
+			if toMap[receiver] < balance {
+				return nil, nil, &broadcastError{Code: codeValidateError, Message: "insufficient delegatedFrozenBalance(Energy)"}
+			}
+			toMap[receiver] -= balance
+			if toMap[receiver] == 0 {
+				delete(toMap, receiver)
+			}
+			owner.Frozen += balance
+		}
+		return txJSON(tx, gin.H{
+			"owner_address":    tx.Owner,
+			"resource":         "ENERGY",
+			"balance":          balance,
+			"receiver_address": receiver,
+		}, "SUCCESS"), info, nil
+	}
+	return nil, nil, &broadcastError{Code: codeValidateError, Message: "contract type not support"}
+}
+
+// broadcast 广播交易 成功后生成块
+func (l *ledger) broadcast(txHex string) (string, error) {
+	tx, err := decodeTx(txHex)
+	if err != nil {
+		if tx != nil {
+			return tx.TxID, err
+		}
+		return "", err
+	}
+	if _, ok := l.txInfos[tx.TxID]; ok {
+		return tx.TxID, &broadcastError{Code: codeDupError, Message: "dup transaction"}
+	}
+	err = l.checkTapos(tx)
+	if err != nil {
+		return tx.TxID, err
+	}
+	if l.dropNext {
+		// 返回成功但不上链
+		l.dropNext = false
+		return tx.TxID, nil
+	}
+	txValue, info, err := l.execTx(tx)
+	if err != nil {
+		return tx.TxID, err
+	}
+	l.produceBlock([]gin.H{txValue}, []gin.H{info})
+	return tx.TxID, nil
+}
+
+// blockJSON 生成块信息
+func blockJSON(b *block) gin.H {
+	resp := gin.H{
+		"blockID": hex.EncodeToString(b.ID),
+		"block_header": gin.H{
+			"raw_data": gin.H{
+				"number":    b.Number,
+				"timestamp": b.Timestamp,
+			},
+		},
+	}
+	if len(b.Txs) > 0 {
+		resp["transactions"] = b.Txs
+	}
+	return resp
+}
+
+// stReq 请求参数
+type stReq struct {
+	Num              int64  `json:"num"`
+	Value            string `json:"value"`
+	Address          string `json:"address"`
+	OwnerAddress     string `json:"owner_address"`
+	ContractAddress  string `json:"contract_address"`
+	FunctionSelector string `json:"function_selector"`
+	Parameter        string `json:"parameter"`
+	Transaction      string `json:"transaction"`
+	FromAddress      string `json:"fromAddress"`
+	ToAddress        string `json:"toAddress"`
+}
+
+// handle 处理 http api 请求
+func (l *ledger) handle(name string, req *stReq) (interface{}, error) {
+	l.Lock()
+	defer l.Unlock()
+
+	switch name {
+	case "getnowblock":
+		return blockJSON(l.head()), nil
+	case "getblockbynum":
+		if req.Num < 0 || req.Num >= int64(len(l.blocks)) {
+			return gin.H{}, nil
+		}
+		return blockJSON(l.blocks[req.Num]), nil
+	case "gettransactioninfobyblocknum":
+		if req.Num < 0 || req.Num >= int64(len(l.blocks)) {
+			return []gin.H{}, nil
+		}
+		infos := l.blocks[req.Num].Infos
+		if infos == nil {
+			infos = []gin.H{}
+		}
+		return infos, nil
+	case "gettransactioninfobyid":
+		info, ok := l.txInfos[req.Value]
+		if !ok {
+			return gin.H{}, nil
+		}
+		return info, nil
+	case "getaccount":
+		a, ok := l.accounts[req.Address]
+		if !ok {
+			return gin.H{}, nil
+		}
+		return gin.H{
+			"address":     req.Address,
+			"balance":     a.Balance,
+			"create_time": a.CreateTime,
+		}, nil
+	case "getaccountresource":
+		resp := gin.H{
+			"freeNetLimit": 600,
+		}
+		if a, ok := l.accounts[req.Address]; ok {
+			resp["EnergyLimit"] = l.energyLimit(req.Address)
+			resp["EnergyUsed"] = a.EnergyUsed
+		}
+		return resp, nil
+	case "triggerconstantcontract":
+		tokenMap, ok := l.tokens[req.ContractAddress]
+		if !ok {
+			return gin.H{
+				"result": gin.H{
+					"code":    codeValidateError,
+					"message": hex.EncodeToString([]byte("No contract or not a smart contract")),
+				},
+			}, nil
+		}
+		if req.FunctionSelector != "balanceOf(address)" || len(req.Parameter) != 64 {
+			return gin.H{
+				"result": gin.H{
+					"code":    codeOtherError,
+					"message": hex.EncodeToString([]byte("function not support")),
+				},
+			}, nil
+		}
+		address, err := htrx.HexToAddress(req.Parameter[24:])
+		if err != nil {
+			return nil, err
+		}
+		balance := new(big.Int)
+		if tokenBalance, ok := tokenMap[address]; ok {
+			balance.Set(tokenBalance)
+		}
+		return gin.H{
+			"result": gin.H{
+				"result": true,
+			},
+			"energy_used":     900,
+			"constant_result": []string{common.Bytes2Hex(common.LeftPadBytes(balance.Bytes(), 32))},
+		}, nil
+	case "broadcasthex":
+		txID, err := l.broadcast(req.Transaction)
+		if err != nil {
+			bErr, ok := err.(*broadcastError)
+			if !ok {
+				bErr = &broadcastError{Code: codeOtherError, Message: err.Error()}
+			}
+			return gin.H{
+				"result":  false,
+				"txid":    txID,
+				"code":    bErr.Code,
+				"message": bErr.Message,
+			}, nil
+		}
+		return gin.H{
+			"result": true,
+			"txid":   txID,
+		}, nil
+	case "getdelegatedresourceaccountindexv2":
+		var toAccounts []string
+		for to, balance := range l.delegates[req.Value] {
+			if balance > 0 {
+				toAccounts = append(toAccounts, to)
+			}
+		}
+		resp := gin.H{
+			"account": req.Value,
+		}
+		if len(toAccounts) > 0 {
+			resp["toAccounts"] = toAccounts
+		}
+		return resp, nil
+	case "getdelegatedresourcev2":
+		balance := l.delegates[req.FromAddress][req.ToAddress]
+		if balance <= 0 {
+			return gin.H{}, nil
+		}
+		return gin.H{
+			"delegatedResource": []gin.H{
+				{
+					"from":                      req.FromAddress,
+					"to":                        req.ToAddress,
+					"frozen_balance_for_energy": balance,
+				},
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("api not support: %s", name)
+}
+
+// signAndBroadcast 水龙头签名并广播交易
+func (l *ledger) signAndBroadcast(sign func(*htrx.StRefBlock, *ecdsa.PrivateKey) (*htrx.StTx, error)) (string, error) {
+	signedTx, err := sign(l.refBlock(), l.faucet)
+	if err != nil {
+		return "", err
+	}
+	return l.broadcast(signedTx.Hex)
+}
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:8090", "listen address")
+	flag.Parse()
+
+	l := newLedger()
+	r := gin.Default()
+	// http api
+	r.POST("/wallet/:name", func(c *gin.Context) {
+		var req stReq
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			c.JSON(http.StatusOK, gin.H{"Error": err.Error()})
+			return
+		}
+		resp, err := l.handle(c.Param("name"), &req)
+		if err != nil {
+			c.JSON(http.StatusOK, gin.H{"Error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+	// 从水龙头转入trx {"address": "", "amount": 1000000}
+	r.POST("/admin/airdrop", func(c *gin.Context) {
+		var req struct {
+			Address string `json:"address" binding:"required"`
+			Amount  int64  `json:"amount" binding:"required"`
+		}
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		l.Lock()
+		defer l.Unlock()
+		txID, err := l.signAndBroadcast(func(refBlock *htrx.StRefBlock, key *ecdsa.PrivateKey) (*htrx.StTx, error) {
+			return htrx.SignTransfer(refBlock, key, req.Address, req.Amount)
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"txid": txID, "num": l.head().Number})
+	})
+	// 从水龙头转入trc20代币 合约第一次使用时注册 {"contract": "", "address": "", "amount": 1000000}
+	r.POST("/admin/token", func(c *gin.Context) {
+		var req struct {
+			Contract string `json:"contract" binding:"required"`
+			Address  string `json:"address" binding:"required"`
+			Amount   int64  `json:"amount" binding:"required"`
+		}
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		contractBytes, err := htrx.AddressToBytes(req.Contract)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		toBytes, err := htrx.AddressToBytes(req.Address)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		l.Lock()
+		defer l.Unlock()
+		tokenMap, ok := l.tokens[req.Contract]
+		if !ok {
+			tokenMap = make(map[string]*big.Int)
+			l.tokens[req.Contract] = tokenMap
+			l.accounts[req.Contract] = &account{CreateTime: l.head().Timestamp}
+		}
+		faucetAddress := l.faucetAddress()
+		if _, ok := tokenMap[faucetAddress]; !ok {
+			tokenMap[faucetAddress] = new(big.Int)
+		}
+		amount := big.NewInt(req.Amount)
+		tokenMap[faucetAddress].Add(tokenMap[faucetAddress], amount)
+		data := append(common.Hex2Bytes(transferSelector), common.LeftPadBytes(toBytes[1:], 32)...)
+		data = append(data, common.LeftPadBytes(amount.Bytes(), 32)...)
+		txID, err := l.signAndBroadcast(func(refBlock *htrx.StRefBlock, key *ecdsa.PrivateKey) (*htrx.StTx, error) {
+			return htrx.SignTriggerSmartContract(refBlock, key, req.Contract, data, 1e9)
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"txid": txID, "num": l.head().Number, "contract": hex.EncodeToString(contractBytes)})
+	})
+	// 质押trx获得能量 {"address": "", "amount": 1000000000}
+	r.POST("/admin/freeze", func(c *gin.Context) {
+		var req struct {
+			Address string `json:"address" binding:"required"`
+			Amount  int64  `json:"amount" binding:"required"`
+		}
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		l.Lock()
+		defer l.Unlock()
+		a, ok := l.accounts[req.Address]
+		if !ok || req.Amount <= 0 || a.Balance < req.Amount {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "balance is not sufficient"})
+			return
+		}
+		a.Balance -= req.Amount
+		a.Frozen += req.Amount
+		c.JSON(http.StatusOK, gin.H{"balance": a.Balance, "frozen": a.Frozen, "energy_limit": l.energyLimit(req.Address)})
+	})
+	// 生成空块 用于测试交易过期 一小时为1200块 {"blocks": 1201}
+	r.POST("/admin/advance", func(c *gin.Context) {
+		var req struct {
+			Blocks int64 `json:"blocks" binding:"required"`
+		}
+		err := c.ShouldBindJSON(&req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		l.Lock()
+		defer l.Unlock()
+		for i := int64(0); i < req.Blocks; i++ {
+			l.produceBlock(nil, nil)
+		}
+		c.JSON(http.StatusOK, gin.H{"num": l.head().Number, "timestamp": l.head().Timestamp})
+	})
+	// 下一笔广播的交易返回成功但不上链 用于测试交易过期
+	r.POST("/admin/drop_next", func(c *gin.Context) {
+		l.Lock()
+		defer l.Unlock()
+		l.dropNext = true
+		c.JSON(http.StatusOK, gin.H{"drop_next": true})
+	})
+	// 下一笔合约调用上链但执行失败 用于测试失败的交易
+	r.POST("/admin/fail_next", func(c *gin.Context) {
+		l.Lock()
+		defer l.Unlock()
+		l.failNext = true
+		c.JSON(http.StatusOK, gin.H{"fail_next": true})
+	})
+
+	log.Printf("trx mock rpc listen on: %s faucet: %s", *addr, l.faucetAddress())
+	err := r.Run(*addr)
+	if err != nil {
+		log.Fatalf("run err: %s", err.Error())
+	}
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"go-dc-wallet/hxrp"
+	"go-dc-wallet/xenv"
+)
+
+func main() {
+	xenv.EnvCreate()
+	defer xenv.EnvDestroy()
+
+	hxrp.CheckTxNotify()
+}
@@ -3,6 +3,7 @@ package eosclient
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -10,7 +11,13 @@ import (
 	"github.com/parnurzeal/gorequest"
 )
 
-var rpcURI string
+// Client rpc客户端
+type Client struct {
+	rpcURI   string
+	traceURI string
+}
+
+var defaultClient = &Client{}
 
 type StRpcRespError struct {
 	Code     int64  `json:"code"`
@@ -35,6 +42,24 @@ func (e *StRpcRespError) Error() string {
 	return fmt.Sprintf("%d[%d] %s-%s", e.Code, e.ErrorInv.Code, e.Message, e.ErrorInv.What)
 }
 
+// IsUnknownAccountError 是否为账号不存在的错误
+// 旧版本节点返回 unknown key 新版本返回 account_query_exception
+func IsUnknownAccountError(err error) bool {
+	rpcErr, ok := err.(*StRpcRespError)
+	if !ok {
+		return false
+	}
+	if rpcErr.ErrorInv.Code == 3060002 {
+		return true
+	}
+	for _, detail := range rpcErr.ErrorInv.Details {
+		if strings.Contains(detail.Message, "unknown key") {
+			return true
+		}
+	}
+	return false
+}
+
 type StChainGetInfo struct {
 	ServerVersion            string `json:"server_version"`
 	ChainID                  string `json:"chain_id"`
@@ -93,6 +118,15 @@ type StAccount struct {
 		NetAmount   string `json:"net_amount"`
 		CPUAmount   string `json:"cpu_amount"`
 	} `json:"refund_request"`
+	// linked_actions 需要 nodeos v3.1 以上
+	Permissions []struct {
+		PermName      string `json:"perm_name"`
+		Parent        string `json:"parent"`
+		LinkedActions []struct {
+			Account string `json:"account"`
+			Action  string `json:"action"`
+		} `json:"linked_actions"`
+	} `json:"permissions"`
 }
 
 type StAction struct {
@@ -153,6 +187,38 @@ type StBlock struct {
 	RefBlockPrefix    int             `json:"ref_block_prefix"`
 }
 
+type StTraceAction struct {
+	GlobalSequence int64  `json:"global_sequence"`
+	Receiver       string `json:"receiver"`
+	Account        string `json:"account"`
+	Action         string `json:"action"`
+	Authorization  []struct {
+		Account    string `json:"account"`
+		Permission string `json:"permission"`
+	} `json:"authorization"`
+	Data   string          `json:"data"`
+	Params json.RawMessage `json:"params"`
+}
+
+type StTraceTransaction struct {
+	ID            string          `json:"id"`
+	BlockNum      int64           `json:"block_num"`
+	Status        string          `json:"status"`
+	CPUUsageUs    int64           `json:"cpu_usage_us"`
+	NetUsageWords int64           `json:"net_usage_words"`
+	Actions       []StTraceAction `json:"actions"`
+}
+
+type StTraceBlock struct {
+	ID           string               `json:"id"`
+	Number       int64                `json:"number"`
+	PreviousID   string               `json:"previous_id"`
+	Status       string               `json:"status"`
+	Timestamp    string               `json:"timestamp"`
+	Producer     string               `json:"producer"`
+	Transactions []StTraceTransaction `json:"transactions"`
+}
+
 type StPushTransaction struct {
 	TransactionID string `json:"transaction_id"`
 	Processed     struct {
@@ -180,6 +246,14 @@ type StPushTransactionArg struct {
 	PackedTrx             string   `json:"packed_trx"`
 }
 
+// StHistoryTransaction history/get_transaction 返回的交易所在块信息
+type StHistoryTransaction struct {
+	ID                    string `json:"id"`
+	BlockNum              int64  `json:"block_num"`
+	BlockTime             string `json:"block_time"`
+	LastIrreversibleBlock int64  `json:"last_irreversible_block"`
+}
+
 type StGetTransaction struct {
 	BlockTime             time.Time `json:"block_time"`
 	BlockNum              int64     `json:"block_num"`
@@ -230,12 +304,33 @@ type StGetTransaction struct {
 }
 
 // InitClient 初始化客户端
-func InitClient(uri string) {
-	rpcURI = uri
+// traceUri 为空时使用 uri 请求 trace_api
+func InitClient(uri string, traceUri string) {
+	defaultClient = NewClient(uri, traceUri)
 }
 
-func doReq(funURI string, arqs interface{}, resp interface{}) error {
-	_, body, errs := gorequest.New().Timeout(time.Minute * 5).Post(rpcURI + funURI).Send(arqs).EndBytes()
+// NewClient 创建客户端
+func NewClient(uri string, traceUri string) *Client {
+	if traceUri == "" {
+		traceUri = uri
+	}
+	return &Client{
+		rpcURI:   uri,
+		traceURI: traceUri,
+	}
+}
+
+// DefaultClient 默认客户端
+func DefaultClient() *Client {
+	return defaultClient
+}
+
+func (client *Client) doReq(funURI string, arqs interface{}, resp interface{}) error {
+	return doReqURI(client.rpcURI, funURI, arqs, resp)
+}
+
+func doReqURI(uri string, funURI string, arqs interface{}, resp interface{}) error {
+	_, body, errs := gorequest.New().Timeout(time.Minute * 5).Post(uri + funURI).Send(arqs).EndBytes()
 	if errs != nil {
 		return errs[0]
 	}
@@ -248,11 +343,16 @@ func doReq(funURI string, arqs interface{}, resp interface{}) error {
 
 // RpcChainGetInfo 获取链信息
 func RpcChainGetInfo() (*StChainGetInfo, error) {
+	return defaultClient.RpcChainGetInfo()
+}
+
+// RpcChainGetInfo 获取链信息
+func (client *Client) RpcChainGetInfo() (*StChainGetInfo, error) {
 	resp := struct {
 		StRpcRespError
 		StChainGetInfo
 	}{}
-	err := doReq(
+	err := client.doReq(
 		"/v1/chain/get_info",
 		nil,
 		&resp,
@@ -268,11 +368,16 @@ func RpcChainGetInfo() (*StChainGetInfo, error) {
 
 // RpcChainGetAccount 获取账户信息
 func RpcChainGetAccount(account string) (*StAccount, error) {
+	return defaultClient.RpcChainGetAccount(account)
+}
+
+// RpcChainGetAccount 获取账户信息
+func (client *Client) RpcChainGetAccount(account string) (*StAccount, error) {
 	resp := struct {
 		StRpcRespError
 		StAccount
 	}{}
-	err := doReq(
+	err := client.doReq(
 		"/v1/chain/get_account",
 		gin.H{
 			"account_name": account,
@@ -288,13 +393,46 @@ func RpcChainGetAccount(account string) (*StAccount, error) {
 	return &resp.StAccount, nil
 }
 
+// RpcChainGetCurrencyBalance 获取代币余额
+func RpcChainGetCurrencyBalance(code string, account string, symbol string) ([]string, error) {
+	return defaultClient.RpcChainGetCurrencyBalance(code, account, symbol)
+}
+
+// RpcChainGetCurrencyBalance 获取代币余额
+func (client *Client) RpcChainGetCurrencyBalance(code string, account string, symbol string) ([]string, error) {
+	var balances []string
+	_, body, errs := gorequest.New().Timeout(time.Minute * 5).Post(client.rpcURI + "/v1/chain/get_currency_balance").Send(gin.H{
+		"code":    code,
+		"account": account,
+		"symbol":  symbol,
+	}).EndBytes()
+	if errs != nil {
+		return nil, errs[0]
+	}
+	err := json.Unmarshal(body, &balances)
+	if err != nil {
+		// 出错时返回的是错误信息
+		var resp StRpcRespError
+		if json.Unmarshal(body, &resp) == nil && resp.Code != 0 {
+			return nil, &resp
+		}
+		return nil, err
+	}
+	return balances, nil
+}
+
 // RpcChainGetBlock 获取链信息
 func RpcChainGetBlock(blockNum int64) (*StBlock, error) {
+	return defaultClient.RpcChainGetBlock(blockNum)
+}
+
+// RpcChainGetBlock 获取链信息
+func (client *Client) RpcChainGetBlock(blockNum int64) (*StBlock, error) {
 	resp := struct {
 		StRpcRespError
 		StBlock
 	}{}
-	err := doReq(
+	err := client.doReq(
 		"/v1/chain/get_block",
 		gin.H{
 			"block_num_or_id": blockNum,
@@ -310,13 +448,46 @@ func RpcChainGetBlock(blockNum int64) (*StBlock, error) {
 	return &resp.StBlock, nil
 }
 
+// RpcTraceGetBlock 获取block的action trace 包含inline action
+func RpcTraceGetBlock(blockNum int64) (*StTraceBlock, error) {
+	return defaultClient.RpcTraceGetBlock(blockNum)
+}
+
+// RpcTraceGetBlock 获取block的action trace 包含inline action
+func (client *Client) RpcTraceGetBlock(blockNum int64) (*StTraceBlock, error) {
+	resp := struct {
+		StRpcRespError
+		StTraceBlock
+	}{}
+	err := doReqURI(
+		client.traceURI,
+		"/v1/trace_api/get_block",
+		gin.H{
+			"block_num": blockNum,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, &(resp.StRpcRespError)
+	}
+	return &resp.StTraceBlock, nil
+}
+
 // RpcChainPushTransaction 推送交易
 func RpcChainPushTransaction(arg StPushTransactionArg) (*StPushTransaction, error) {
+	return defaultClient.RpcChainPushTransaction(arg)
+}
+
+// RpcChainPushTransaction 推送交易
+func (client *Client) RpcChainPushTransaction(arg StPushTransactionArg) (*StPushTransaction, error) {
 	resp := struct {
 		StRpcRespError
 		StPushTransaction
 	}{}
-	err := doReq(
+	err := client.doReq(
 		"/v1/chain/push_transaction",
 		arg,
 		&resp,
@@ -330,13 +501,18 @@ func RpcChainPushTransaction(arg StPushTransactionArg) (*StPushTransaction, erro
 	return &resp.StPushTransaction, nil
 }
 
-// RpcChainPushTransaction 推送交易
-func RpcHistoryGetTransaction(id string) (*StPushTransaction, error) {
+// RpcHistoryGetTransaction 获取已上链的交易
+func RpcHistoryGetTransaction(id string) (*StHistoryTransaction, error) {
+	return defaultClient.RpcHistoryGetTransaction(id)
+}
+
+// RpcHistoryGetTransaction 获取已上链的交易
+func (client *Client) RpcHistoryGetTransaction(id string) (*StHistoryTransaction, error) {
 	resp := struct {
 		StRpcRespError
-		StPushTransaction
+		StHistoryTransaction
 	}{}
-	err := doReq(
+	err := client.doReq(
 		"/v1/history/get_transaction",
 		gin.H{
 			"id": id,
@@ -349,5 +525,5 @@ func RpcHistoryGetTransaction(id string) (*StPushTransaction, error) {
 	if resp.Code != 0 {
 		return nil, &(resp.StRpcRespError)
 	}
-	return &resp.StPushTransaction, nil
+	return &resp.StHistoryTransaction, nil
 }
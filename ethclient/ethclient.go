@@ -35,6 +35,8 @@ import (
 // Client defines typed wrappers for the Ethereum RPC API.
 type Client struct {
 	c *rpc.Client
+
+	networkID int64
 }
 
 // Dial connects a client to the given URL.
@@ -52,7 +54,7 @@ func DialContext(ctx context.Context, rawurl string) (*Client, error) {
 
 // NewClient creates a client that uses the given RPC client.
 func NewClient(c *rpc.Client) *Client {
-	return &Client{c}
+	return &Client{c: c}
 }
 
 func (ec *Client) Close() {
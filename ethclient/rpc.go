@@ -17,7 +17,9 @@ import (
 )
 
 var client *Client
-var networkID int64
+
+// chainClientMap 其他evm链的接口对象 map[链标识]
+var chainClientMap = make(map[string]*Client)
 
 // InitClient 初始化接口对象
 func InitClient(uri string) {
@@ -28,9 +30,33 @@ func InitClient(uri string) {
 	}
 }
 
+// InitChainClient 初始化其他evm链的接口对象
+func InitChainClient(chainSymbol string, uri string) {
+	chainClient, err := Dial(uri)
+	if err != nil {
+		mcommon.Log.Fatalf("%s client dial error: [%T] %s", chainSymbol, err, err.Error())
+	}
+	chainClientMap[chainSymbol] = chainClient
+}
+
+// DefaultClient 默认接口对象
+func DefaultClient() *Client {
+	return client
+}
+
+// ChainClient 获取evm链的接口对象
+func ChainClient(chainSymbol string) *Client {
+	return chainClientMap[chainSymbol]
+}
+
 // RpcBlockNumber 获取最新的block number
 func RpcBlockNumber(ctx context.Context) (int64, error) {
-	blockNum, err := client.GetBlockNumber(ctx)
+	return client.RpcBlockNumber(ctx)
+}
+
+// RpcBlockNumber 获取最新的block number
+func (ec *Client) RpcBlockNumber(ctx context.Context) (int64, error) {
+	blockNum, err := ec.GetBlockNumber(ctx)
 	if nil != err {
 		return 0, err
 	}
@@ -39,7 +65,12 @@ func RpcBlockNumber(ctx context.Context) (int64, error) {
 
 // RpcBlockByNum 获取block信息
 func RpcBlockByNum(ctx context.Context, blockNum int64) (*types.Block, error) {
-	resp, err := client.BlockByNumber(ctx, big.NewInt(blockNum))
+	return client.RpcBlockByNum(ctx, blockNum)
+}
+
+// RpcBlockByNum 获取block信息
+func (ec *Client) RpcBlockByNum(ctx context.Context, blockNum int64) (*types.Block, error) {
+	resp, err := ec.BlockByNumber(ctx, big.NewInt(blockNum))
 	if nil != err {
 		return nil, err
 	}
@@ -48,7 +79,12 @@ func RpcBlockByNum(ctx context.Context, blockNum int64) (*types.Block, error) {
 
 // RpcNonceAt 获取nonce
 func RpcNonceAt(ctx context.Context, address string) (int64, error) {
-	count, err := client.NonceAt(
+	return client.RpcNonceAt(ctx, address)
+}
+
+// RpcNonceAt 获取nonce
+func (ec *Client) RpcNonceAt(ctx context.Context, address string) (int64, error) {
+	count, err := ec.NonceAt(
 		ctx,
 		common.HexToAddress(address),
 		nil,
@@ -61,20 +97,39 @@ func RpcNonceAt(ctx context.Context, address string) (int64, error) {
 
 // RpcNetworkID 获取block信息
 func RpcNetworkID(ctx context.Context) (int64, error) {
-	if networkID != 0 {
-		return networkID, nil
+	return client.RpcNetworkID(ctx)
+}
+
+// RpcNetworkID 获取block信息
+func (ec *Client) RpcNetworkID(ctx context.Context) (int64, error) {
+	if ec.networkID != 0 {
+		return ec.networkID, nil
 	}
-	resp, err := client.NetworkID(ctx)
+	resp, err := ec.NetworkID(ctx)
+	if nil != err {
+		return 0, err
+	}
+	ec.networkID = resp.Int64()
+	return resp.Int64(), nil
+}
+
+// RpcSuggestGasPrice 获取节点建议的gas price
+func (ec *Client) RpcSuggestGasPrice(ctx context.Context) (int64, error) {
+	resp, err := ec.SuggestGasPrice(ctx)
 	if nil != err {
 		return 0, err
 	}
-	networkID = resp.Int64()
 	return resp.Int64(), nil
 }
 
 // RpcSendTransaction 发送交易
 func RpcSendTransaction(ctx context.Context, tx *types.Transaction) error {
-	err := client.SendTransaction(
+	return client.RpcSendTransaction(ctx, tx)
+}
+
+// RpcSendTransaction 发送交易
+func (ec *Client) RpcSendTransaction(ctx context.Context, tx *types.Transaction) error {
+	err := ec.SendTransaction(
 		ctx,
 		tx,
 	)
@@ -86,8 +141,13 @@ func RpcSendTransaction(ctx context.Context, tx *types.Transaction) error {
 
 // RpcTransactionByHash 确认交易是否打包完成
 func RpcTransactionByHash(ctx context.Context, txHashStr string) (*types.Transaction, error) {
+	return client.RpcTransactionByHash(ctx, txHashStr)
+}
+
+// RpcTransactionByHash 确认交易是否打包完成
+func (ec *Client) RpcTransactionByHash(ctx context.Context, txHashStr string) (*types.Transaction, error) {
 	txHash := common.HexToHash(txHashStr)
-	tx, isPending, err := client.TransactionByHash(ctx, txHash)
+	tx, isPending, err := ec.TransactionByHash(ctx, txHash)
 	if err != nil {
 		return nil, err
 	}
@@ -99,8 +159,13 @@ func RpcTransactionByHash(ctx context.Context, txHashStr string) (*types.Transac
 
 // RpcTransactionReceipt 确认交易是否打包完成
 func RpcTransactionReceipt(ctx context.Context, txHashStr string) (*types.Receipt, error) {
+	return client.RpcTransactionReceipt(ctx, txHashStr)
+}
+
+// RpcTransactionReceipt 确认交易是否打包完成
+func (ec *Client) RpcTransactionReceipt(ctx context.Context, txHashStr string) (*types.Receipt, error) {
 	txHash := common.HexToHash(txHashStr)
-	tx, err := client.TransactionReceipt(ctx, txHash)
+	tx, err := ec.TransactionReceipt(ctx, txHash)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +174,12 @@ func RpcTransactionReceipt(ctx context.Context, txHashStr string) (*types.Receip
 
 // RpcBalanceAt 获取余额
 func RpcBalanceAt(ctx context.Context, address string) (*big.Int, error) {
-	balance, err := client.BalanceAt(ctx, common.HexToAddress(address), nil)
+	return client.RpcBalanceAt(ctx, address)
+}
+
+// RpcBalanceAt 获取余额
+func (ec *Client) RpcBalanceAt(ctx context.Context, address string) (*big.Int, error) {
+	balance, err := ec.BalanceAt(ctx, common.HexToAddress(address), nil)
 	if nil != err {
 		return nil, err
 	}
@@ -118,6 +188,11 @@ func RpcBalanceAt(ctx context.Context, address string) (*big.Int, error) {
 
 // RpcFilterLogs 获取日志
 func RpcFilterLogs(ctx context.Context, startBlock int64, endBlock int64, contractAddresses []string, event abi.Event) ([]types.Log, error) {
+	return client.RpcFilterLogs(ctx, startBlock, endBlock, contractAddresses, event)
+}
+
+// RpcFilterLogs 获取日志
+func (ec *Client) RpcFilterLogs(ctx context.Context, startBlock int64, endBlock int64, contractAddresses []string, event abi.Event) ([]types.Log, error) {
 	var warpAddresses []common.Address
 	for _, contractAddress := range contractAddresses {
 		warpAddresses = append(warpAddresses, common.HexToAddress(contractAddress))
@@ -130,7 +205,7 @@ func RpcFilterLogs(ctx context.Context, startBlock int64, endBlock int64, contra
 			{event.ID},
 		},
 	}
-	logs, err := client.FilterLogs(ctx, query)
+	logs, err := ec.FilterLogs(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -139,8 +214,13 @@ func RpcFilterLogs(ctx context.Context, startBlock int64, endBlock int64, contra
 
 // RpcTokenBalance 获取token余额
 func RpcTokenBalance(ctx context.Context, tokenAddress string, address string) (*big.Int, error) {
+	return client.RpcTokenBalance(ctx, tokenAddress, address)
+}
+
+// RpcTokenBalance 获取token余额
+func (ec *Client) RpcTokenBalance(ctx context.Context, tokenAddress string, address string) (*big.Int, error) {
 	tokenAddressHash := common.HexToAddress(tokenAddress)
-	instance, err := NewEth(tokenAddressHash, client)
+	instance, err := NewEth(tokenAddressHash, ec)
 	if err != nil {
 		return nil, err
 	}
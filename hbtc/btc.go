@@ -39,12 +39,12 @@ const (
 
 //var gloalGenIndex = 0
 
-func genAddressAndAesKey() (string, string, error) {
+func (chain *Chain) genAddressAndAesKey() (string, string, error) {
 	//defer func() {
 	//	gloalGenIndex++
 	//}()
 	// 生成私钥
-	wif, err := GetNetwork(xenv.Cfg.BtcNetworkType).CreatePrivateKey()
+	wif, err := chain.Network().CreatePrivateKey()
 	if err != nil {
 		return "", "", err
 	}
@@ -54,14 +54,22 @@ func genAddressAndAesKey() (string, string, error) {
 		return "", "", err
 	}
 	//// 获取地址
-	//address, err := GetNetwork(xenv.Cfg.BtcNetworkType).GetAddress(wif)
+	//address, err := chain.Network().GetAddress(wif)
 	//if err != nil {
 	//	return "", "", err
 	//}
 	//addressStr := address.EncodeAddress()
 	//if gloalGenIndex%2 == 0 {
+	network := chain.Network()
+	if !chain.IsSegwit {
+		addressPubKeyHash, err := network.GetAddressPubKeyHash(wif)
+		if err != nil {
+			return "", "", err
+		}
+		return network.EncodeAddress(addressPubKeyHash), wifStrEn, nil
+	}
 	// segwitNested
-	addressScriptHash, err := GetNetwork(xenv.Cfg.BtcNetworkType).GetAddressSegwitNested(wif)
+	addressScriptHash, err := network.GetAddressSegwitNested(wif)
 	if err != nil {
 		return "", "", err
 	}
@@ -72,17 +80,22 @@ func genAddressAndAesKey() (string, string, error) {
 
 // CreateHotAddress 创建自用地址
 func CreateHotAddress(num int64) ([]string, error) {
+	return Btc.CreateHotAddress(num)
+}
+
+// CreateHotAddress 创建自用地址
+func (chain *Chain) CreateHotAddress(num int64) ([]string, error) {
 	var rows []*model.DBTAddressKey
 	var addresses []string
 	// 遍历差值次数
 	for i := int64(0); i < num; i++ {
-		address, wifStrEn, err := genAddressAndAesKey()
+		address, wifStrEn, err := chain.genAddressAndAesKey()
 		if err != nil {
 			return nil, err
 		}
 		// 存入待添加队列
 		rows = append(rows, &model.DBTAddressKey{
-			Symbol:  CoinSymbol,
+			Symbol:  chain.Symbol,
 			Address: address,
 			Pwd:     wifStrEn,
 			UseTag:  -1,
@@ -104,7 +117,12 @@ func CreateHotAddress(num int64) ([]string, error) {
 
 // CheckAddressFree 检测剩余地址数
 func CheckAddressFree() {
-	lockKey := "BtcCheckAddressFree"
+	Btc.CheckAddressFree()
+}
+
+// CheckAddressFree 检测剩余地址数
+func (chain *Chain) CheckAddressFree() {
+	lockKey := chain.LockPrefix + "CheckAddressFree"
 	app.LockWrap(lockKey, func() {
 		// 获取配置 允许的最小剩余地址数
 		minFreeValue, err := app.SQLGetTAppConfigIntValueByK(
@@ -120,7 +138,7 @@ func CheckAddressFree() {
 		freeCount, err := app.SQLGetTAddressKeyFreeCount(
 			context.Background(),
 			xenv.DbCon,
-			CoinSymbol,
+			chain.Symbol,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -131,14 +149,14 @@ func CheckAddressFree() {
 			var rows []*model.DBTAddressKey
 			// 遍历差值次数
 			for i := int64(0); i < minFreeValue-freeCount; i++ {
-				address, wifStrEn, err := genAddressAndAesKey()
+				address, wifStrEn, err := chain.genAddressAndAesKey()
 				if err != nil {
 					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 					return
 				}
 				// 存入待添加队列
 				rows = append(rows, &model.DBTAddressKey{
-					Symbol:  CoinSymbol,
+					Symbol:  chain.Symbol,
 					Address: address,
 					Pwd:     wifStrEn,
 					UseTag:  0,
@@ -161,13 +179,18 @@ func CheckAddressFree() {
 
 // CheckBlockSeek 检测到账
 func CheckBlockSeek() {
-	lockKey := "BtcCheckBlockSeek"
+	Btc.CheckBlockSeek()
+}
+
+// CheckBlockSeek 检测到账
+func (chain *Chain) CheckBlockSeek() {
+	lockKey := chain.LockPrefix + "CheckBlockSeek"
 	app.LockWrap(lockKey, func() {
 		// 获取配置 延迟确认数
 		confirmValue, err := app.SQLGetTAppConfigIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"btc_block_confirm_num",
+			chain.prefixKey("block_confirm_num"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -177,13 +200,13 @@ func CheckBlockSeek() {
 		seekValue, err := app.SQLGetTAppStatusIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"btc_seek_num",
+			chain.prefixKey("seek_num"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
-		rpcBlockNum, err := omniclient.RpcGetBlockCount()
+		rpcBlockNum, err := chain.GetClient().RpcGetBlockCount()
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
@@ -194,18 +217,21 @@ func CheckBlockSeek() {
 			// 获取所有token
 			var tokenHotAddresses []string
 			var tokenFeeAddresses []string
-			tokenRows, err := app.SQLSelectTAppConfigTokenBtcColAll(
-				context.Background(),
-				xenv.DbCon,
-				[]string{
-					model.DBColTAppConfigTokenBtcID,
-					model.DBColTAppConfigTokenBtcHotAddress,
-					model.DBColTAppConfigTokenBtcFeeAddress,
-				},
-			)
-			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-				return
+			var tokenRows []*model.DBTAppConfigTokenBtc
+			if chain.IsOmni {
+				tokenRows, err = app.SQLSelectTAppConfigTokenBtcColAll(
+					context.Background(),
+					xenv.DbCon,
+					[]string{
+						model.DBColTAppConfigTokenBtcID,
+						model.DBColTAppConfigTokenBtcHotAddress,
+						model.DBColTAppConfigTokenBtcFeeAddress,
+					},
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
 			}
 			for _, tokenRow := range tokenRows {
 				if !mcommon.IsStringInSlice(tokenHotAddresses, tokenRow.HotAddress) {
@@ -215,276 +241,328 @@ func CheckBlockSeek() {
 					tokenFeeAddresses = append(tokenFeeAddresses, tokenRow.FeeAddress)
 				}
 			}
-			// 遍历获取需要查询的block信息
-			for i := startI; i < endI; i++ {
-				//mcommon.Log.Debugf("btc check block: %d", i)
-				blockHash, err := omniclient.RpcGetBlockHash(i)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
-				}
-				// 一个block
-				rpcBlock, err := omniclient.RpcGetBlockVerbose(blockHash)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
-				}
-				// 目标地址
-				var toAddresses []string
-				type StTxWithIndex struct {
-					RPCTx    *omniclient.StTxResult
-					Index    int64
-					IsOmniTx bool
-				}
-				toAddressTxMap := make(map[string][]*StTxWithIndex)
-				// 来源hash
-				var fromTxHashes []string
-				type StVinWithIndex struct {
-					TxHash string
-					VoutN  int64
-
-					SpendTxHash string
-					SpendN      int64
-				}
-				vinMap := make(map[string]*StVinWithIndex)
-				// 所有tx
-				for _, rpcTx := range rpcBlock.Tx {
-					for i, vin := range rpcTx.Vin {
-						fromTxHash := vin.Txid
-						if !mcommon.IsStringInSlice(fromTxHashes, fromTxHash) {
-							fromTxHashes = append(fromTxHashes, fromTxHash)
-						}
-						key := fmt.Sprintf("%s-%d", vin.Txid, vin.Vout)
-						vinMap[key] = &StVinWithIndex{
-							TxHash:      vin.Txid,
-							VoutN:       vin.Vout,
-							SpendTxHash: rpcTx.Txid,
-							SpendN:      int64(i),
-						}
-					}
-					omniScript := omniWithReturnHex
-					isOmniTx := false
-					for _, vout := range rpcTx.Vout {
-						if strings.HasPrefix(vout.ScriptPubKey.Hex, omniScript) {
-							isOmniTx = true
-						}
+			// 增量更新地址索引
+			err = chain.seekIndex.refresh(chain.Symbol)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 分批并发预取block, 按块高顺序处理
+			for batchStart := startI; batchStart < endI; batchStart += blockPrefetchSize {
+				batchEnd := batchStart + blockPrefetchSize
+				if batchEnd > endI {
+					batchEnd = endI
+				}
+				rpcBlocks, fetchErr := chain.prefetchBlocks(batchStart, batchEnd)
+				for j, rpcBlock := range rpcBlocks {
+					blockNum := batchStart + int64(j)
+					//mcommon.Log.Debugf("btc check block: %d", blockNum)
+					err = chain.seekBlock(rpcBlock, tokenHotAddresses, tokenFeeAddresses)
+					if err != nil {
+						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						return
 					}
-					for _, vout := range rpcTx.Vout {
-						if len(vout.ScriptPubKey.Addresses) == 1 {
-							toAddress := vout.ScriptPubKey.Addresses[0]
-							if !mcommon.IsStringInSlice(toAddresses, toAddress) {
-								toAddresses = append(toAddresses, toAddress)
-							}
-							toAddressTxMap[toAddress] = append(toAddressTxMap[toAddress], &StTxWithIndex{
-								RPCTx:    rpcTx,
-								Index:    vout.N,
-								IsOmniTx: isOmniTx,
-							})
-						}
+					// 更新block num
+					_, err = app.SQLUpdateTAppStatusIntByKGreater(
+						context.Background(),
+						xenv.DbCon,
+						&model.DBTAppStatusInt{
+							K: chain.prefixKey("seek_num"),
+							V: blockNum,
+						},
+					)
+					if err != nil {
+						mcommon.Log.Errorf("SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
+						return
 					}
 				}
-				//mcommon.Log.Debugf("rpc get block: %d to addresses: %d", i, len(toAddresses))
-
-				// 从db中查询这些地址是否是冲币地址中的地址
-				dbAddressRows, err := app.SQLSelectTAddressKeyColByAddress(
-					context.Background(),
-					xenv.DbCon,
-					[]string{
-						model.DBColTAddressKeyAddress,
-						model.DBColTAddressKeyUseTag,
-					},
-					toAddresses,
-				)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				if fetchErr != nil {
+					mcommon.Log.Errorf("err: [%T] %s", fetchErr, fetchErr.Error())
 					return
 				}
-				// 待插入数据
-				var txBtcRows []*model.DBTTxBtc
-				var txBtcUxtoRows []*model.DBTTxBtcUxto
-				now := time.Now().Unix()
-				// 遍历数据库地址
-				for _, dbAddressRow := range dbAddressRows {
-					toAddress := dbAddressRow.Address
-					rpcTxWithIndexes := toAddressTxMap[toAddress]
-					for _, rpcTxWithIndex := range rpcTxWithIndexes {
-						rpcTx := rpcTxWithIndex.RPCTx
-						voutIndex := rpcTxWithIndex.Index
-						checkVout := rpcTx.Vout[voutIndex]
+			}
+		}
+	})
+}
 
-						voutAddress := checkVout.ScriptPubKey.Addresses[0]
-						voutScript := checkVout.ScriptPubKey.Hex
-						omniVinAddress := ""
-						if rpcTxWithIndex.IsOmniTx {
-							for _, vin := range rpcTx.Vin {
-								vinAddresses, err := GetAddressesOfVin(
-									GetNetwork(xenv.Cfg.BtcNetworkType).Params,
-									vin,
-								)
-								if err != nil {
-									mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-									return
-								}
-								if len(vinAddresses) > 0 {
-									omniVinAddress = strings.Join(vinAddresses, ",")
-									break
-								}
-							}
-						}
-						value := decimal.NewFromFloat(checkVout.Value).String()
-						if dbAddressRow.UseTag > 0 &&
-							!rpcTxWithIndex.IsOmniTx {
-							// 记录数据 只记录已经获取，并且输入没有输出的记录
-							txBtcRows = append(
-								txBtcRows,
-								&model.DBTTxBtc{
-									ProductID:    dbAddressRow.UseTag,
-									BlockHash:    rpcBlock.Hash,
-									TxID:         rpcTx.Txid,
-									VoutN:        voutIndex,
-									VoutAddress:  voutAddress,
-									VoutValue:    value,
-									CreateTime:   now,
-									HandleStatus: 0,
-									HandleMsg:    "",
-									HandleTime:   now,
-								},
-							)
-						}
-						uxtoType := int64(app.UxtoTypeTx)
-						if dbAddressRow.UseTag < 0 {
-							uxtoType = app.UxtoTypeHot
-						}
-						if mcommon.IsStringInSlice(tokenHotAddresses, voutAddress) {
-							uxtoType = app.UxtoTypeOmniHot
-						}
-						if mcommon.IsStringInSlice(tokenFeeAddresses, voutAddress) {
-							uxtoType = app.UxtoTypeOmniOrgFee
-						}
-						if rpcTxWithIndex.IsOmniTx {
-							omniOutAddress := ""
-							isExchanged := false
-							for i := len(rpcTx.Vout) - 1; i >= 0; i-- {
-								vout := rpcTx.Vout[i]
-								if len(vout.ScriptPubKey.Addresses) > 0 {
-									toAddress := strings.Join(vout.ScriptPubKey.Addresses, ",")
-									if !isExchanged && toAddress == omniVinAddress {
-										isExchanged = true
-										continue
-									}
-									omniOutAddress = toAddress
-									break
-								}
-							}
-							if omniOutAddress == voutAddress {
-								uxtoType = app.UxtoTypeOmni
-							}
-						}
-						txBtcUxtoRows = append(
-							txBtcUxtoRows,
-							&model.DBTTxBtcUxto{
-								UxtoType:     uxtoType,
-								BlockHash:    rpcBlock.Hash,
-								TxID:         rpcTx.Txid,
-								VoutN:        voutIndex,
-								VoutAddress:  voutAddress,
-								VoutValue:    value,
-								VoutScript:   voutScript,
-								CreateTime:   now,
-								SpendTxID:    "",
-								SpendN:       0,
-								HandleStatus: 0,
-								HandleMsg:    "",
-								HandleTime:   now,
-							},
-						)
-					}
-				}
+// seekBlock 处理一个block中的充币和uxto花费
+// 只有命中地址索引的输出和花费了本系统uxto的输入才会查询数据库
+func (chain *Chain) seekBlock(rpcBlock *omniclient.StBlockResult, tokenHotAddresses []string, tokenFeeAddresses []string) error {
+	// 命中的输出 按照block中的顺序排列
+	type StTxWithIndex struct {
+		RPCTx    *omniclient.StTxResult
+		Index    int64
+		IsOmniTx bool
+	}
+	var matchTxes []*StTxWithIndex
+	// 命中的目标地址
+	var toAddresses []string
+	// 来源hash
+	var fromTxHashes []string
+	type StVinWithIndex struct {
+		TxHash string
+		VoutN  int64
 
-				// 从uxto中查询txhash
-				var updateUxtoRows []*model.DBTTxBtcUxto
-				uxtoRows, err := app.SQLSelectTTxBtcUxtoColByTxIDs(
-					context.Background(),
-					xenv.DbCon,
-					[]string{
-						model.DBColTTxBtcUxtoID,
-						model.DBColTTxBtcUxtoTxID,
-						model.DBColTTxBtcUxtoVoutN,
-					},
-					fromTxHashes,
-				)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
-				}
-				for _, uxtoRow := range uxtoRows {
-					key := fmt.Sprintf("%s-%d", uxtoRow.TxID, uxtoRow.VoutN)
-					rpcVin, ok := vinMap[key]
-					if ok {
-						updateUxtoRows = append(updateUxtoRows, &model.DBTTxBtcUxto{
-							ID:           uxtoRow.ID,
-							TxID:         uxtoRow.TxID,
-							VoutN:        uxtoRow.VoutN,
-							SpendTxID:    rpcVin.SpendTxHash,
-							SpendN:       rpcVin.SpendN,
-							HandleStatus: app.UxtoHandleStatusConfirm,
-							HandleMsg:    "confirmed",
-							HandleTime:   now,
-						})
-					}
+		SpendTxHash string
+		SpendN      int64
+	}
+	vinMap := make(map[string]*StVinWithIndex)
+	// 所有tx
+	for _, rpcTx := range rpcBlock.Tx {
+		for i, vin := range rpcTx.Vin {
+			fromTxHash := vin.Txid
+			if !chain.seekIndex.hasUxtoTx(fromTxHash) {
+				continue
+			}
+			if !mcommon.IsStringInSlice(fromTxHashes, fromTxHash) {
+				fromTxHashes = append(fromTxHashes, fromTxHash)
+			}
+			key := fmt.Sprintf("%s-%d", vin.Txid, vin.Vout)
+			vinMap[key] = &StVinWithIndex{
+				TxHash:      vin.Txid,
+				VoutN:       vin.Vout,
+				SpendTxHash: rpcTx.Txid,
+				SpendN:      int64(i),
+			}
+		}
+		omniScript := omniWithReturnHex
+		isOmniTx := false
+		for _, vout := range rpcTx.Vout {
+			if chain.IsOmni && strings.HasPrefix(vout.ScriptPubKey.Hex, omniScript) {
+				isOmniTx = true
+			}
+		}
+		for _, vout := range rpcTx.Vout {
+			if len(vout.ScriptPubKey.Addresses) == 1 {
+				toAddress := vout.ScriptPubKey.Addresses[0]
+				if !chain.seekIndex.hasAddress(toAddress) {
+					continue
 				}
-				// 插入数据库
-				_, err = model.SQLCreateManyTTxBtc(
-					context.Background(),
-					xenv.DbCon,
-					txBtcRows,
-					true,
-				)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
+				if !mcommon.IsStringInSlice(toAddresses, toAddress) {
+					toAddresses = append(toAddresses, toAddress)
 				}
-				_, err = model.SQLCreateManyTTxBtcUxto(
-					context.Background(),
-					xenv.DbCon,
-					txBtcUxtoRows,
-					true,
+				matchTxes = append(matchTxes, &StTxWithIndex{
+					RPCTx:    rpcTx,
+					Index:    vout.N,
+					IsOmniTx: isOmniTx,
+				})
+			}
+		}
+	}
+
+	// 从db中查询命中地址的占用状态
+	dbAddressRows, err := app.SQLSelectTAddressKeyColByAddressAndSymbol(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTAddressKeyAddress,
+			model.DBColTAddressKeyUseTag,
+		},
+		chain.Symbol,
+		toAddresses,
+	)
+	if err != nil {
+		return err
+	}
+	dbAddressMap := make(map[string]*model.DBTAddressKey)
+	for _, dbAddressRow := range dbAddressRows {
+		dbAddressMap[dbAddressRow.Address] = dbAddressRow
+	}
+	// 待插入数据
+	var txBtcRows []*model.DBTTxBtc
+	var txBtcUxtoRows []*model.DBTTxBtcUxto
+	now := time.Now().Unix()
+	for _, matchTx := range matchTxes {
+		rpcTx := matchTx.RPCTx
+		voutIndex := matchTx.Index
+		checkVout := rpcTx.Vout[voutIndex]
+
+		voutAddress := checkVout.ScriptPubKey.Addresses[0]
+		voutScript := checkVout.ScriptPubKey.Hex
+		dbAddressRow, ok := dbAddressMap[voutAddress]
+		if !ok {
+			continue
+		}
+		omniVinAddress := ""
+		if matchTx.IsOmniTx {
+			for _, vin := range rpcTx.Vin {
+				vinAddresses, err := GetAddressesOfVin(
+					chain.Network().Params,
+					vin,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
+					return err
 				}
-				// 更新uxto状态
-				_, err = app.SQLCreateManyTTxBtcUxtoUpdate(
-					context.Background(),
-					xenv.DbCon,
-					updateUxtoRows,
-				)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
+				if len(vinAddresses) > 0 {
+					omniVinAddress = strings.Join(vinAddresses, ",")
+					break
 				}
-				// 更新block num
-				_, err = app.SQLUpdateTAppStatusIntByKGreater(
-					context.Background(),
-					xenv.DbCon,
-					&model.DBTAppStatusInt{
-						K: "btc_seek_num",
-						V: i,
-					},
-				)
-				if err != nil {
-					mcommon.Log.Errorf("SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
-					return
+			}
+		}
+		value := decimal.NewFromFloat(checkVout.Value).String()
+		if dbAddressRow.UseTag > 0 &&
+			!matchTx.IsOmniTx {
+			// 记录数据 只记录已经获取，并且输入没有输出的记录
+			txBtcRows = append(
+				txBtcRows,
+				&model.DBTTxBtc{
+					Symbol:       chain.Symbol,
+					ProductID:    dbAddressRow.UseTag,
+					BlockHash:    rpcBlock.Hash,
+					BlockNum:     rpcBlock.Height,
+					TxID:         rpcTx.Txid,
+					VoutN:        voutIndex,
+					VoutAddress:  voutAddress,
+					VoutValue:    value,
+					CreateTime:   now,
+					HandleStatus: 0,
+					HandleMsg:    "",
+					HandleTime:   now,
+				},
+			)
+		}
+		uxtoType := int64(app.UxtoTypeTx)
+		if dbAddressRow.UseTag < 0 {
+			uxtoType = app.UxtoTypeHot
+		}
+		if mcommon.IsStringInSlice(tokenHotAddresses, voutAddress) {
+			uxtoType = app.UxtoTypeOmniHot
+		}
+		if mcommon.IsStringInSlice(tokenFeeAddresses, voutAddress) {
+			uxtoType = app.UxtoTypeOmniOrgFee
+		}
+		if matchTx.IsOmniTx {
+			omniOutAddress := ""
+			isExchanged := false
+			for i := len(rpcTx.Vout) - 1; i >= 0; i-- {
+				vout := rpcTx.Vout[i]
+				if len(vout.ScriptPubKey.Addresses) > 0 {
+					toAddress := strings.Join(vout.ScriptPubKey.Addresses, ",")
+					if !isExchanged && toAddress == omniVinAddress {
+						isExchanged = true
+						continue
+					}
+					omniOutAddress = toAddress
+					break
 				}
 			}
+			if omniOutAddress == voutAddress {
+				uxtoType = app.UxtoTypeOmni
+			}
 		}
-	})
+		txBtcUxtoRows = append(
+			txBtcUxtoRows,
+			&model.DBTTxBtcUxto{
+				Symbol:       chain.Symbol,
+				UxtoType:     uxtoType,
+				BlockHash:    rpcBlock.Hash,
+				TxID:         rpcTx.Txid,
+				VoutN:        voutIndex,
+				VoutAddress:  voutAddress,
+				VoutValue:    value,
+				VoutScript:   voutScript,
+				CreateTime:   now,
+				SpendTxID:    "",
+				SpendN:       0,
+				HandleStatus: 0,
+				HandleMsg:    "",
+				HandleTime:   now,
+			},
+		)
+	}
+
+	// 从uxto中查询txhash
+	var updateUxtoRows []*model.DBTTxBtcUxto
+	// map[tx id] => 所有输出是否都已花费
+	spentTxIDMap := make(map[string]bool)
+	if len(fromTxHashes) > 0 {
+		uxtoRows, err := app.SQLSelectTTxBtcUxtoColByTxIDs(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTTxBtcUxtoID,
+				model.DBColTTxBtcUxtoTxID,
+				model.DBColTTxBtcUxtoVoutN,
+				model.DBColTTxBtcUxtoHandleStatus,
+			},
+			chain.Symbol,
+			fromTxHashes,
+		)
+		if err != nil {
+			return err
+		}
+		for _, uxtoRow := range uxtoRows {
+			if _, ok := spentTxIDMap[uxtoRow.TxID]; !ok {
+				spentTxIDMap[uxtoRow.TxID] = true
+			}
+			key := fmt.Sprintf("%s-%d", uxtoRow.TxID, uxtoRow.VoutN)
+			rpcVin, ok := vinMap[key]
+			if !ok && uxtoRow.HandleStatus != app.UxtoHandleStatusConfirm {
+				spentTxIDMap[uxtoRow.TxID] = false
+			}
+			if ok {
+				updateUxtoRows = append(updateUxtoRows, &model.DBTTxBtcUxto{
+					ID:           uxtoRow.ID,
+					TxID:         uxtoRow.TxID,
+					VoutN:        uxtoRow.VoutN,
+					SpendTxID:    rpcVin.SpendTxHash,
+					SpendN:       rpcVin.SpendN,
+					HandleStatus: app.UxtoHandleStatusConfirm,
+					HandleMsg:    "confirmed",
+					HandleTime:   now,
+				})
+			}
+		}
+	}
+	// 插入数据库
+	_, err = model.SQLCreateManyTTxBtc(
+		context.Background(),
+		xenv.DbCon,
+		txBtcRows,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	_, err = model.SQLCreateManyTTxBtcUxto(
+		context.Background(),
+		xenv.DbCon,
+		txBtcUxtoRows,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	// 后续block可能会花费本block中的uxto
+	for _, txBtcUxtoRow := range txBtcUxtoRows {
+		chain.seekIndex.addUxtoTx(txBtcUxtoRow.TxID)
+	}
+	// 更新uxto状态
+	_, err = app.SQLCreateManyTTxBtcUxtoUpdate(
+		context.Background(),
+		xenv.DbCon,
+		updateUxtoRows,
+	)
+	if err != nil {
+		return err
+	}
+	// 所有输出都已花费的tx不会再被引用 从索引中移除
+	for txID, isSpent := range spentTxIDMap {
+		if isSpent {
+			chain.seekIndex.removeUxtoTx(txID)
+		}
+	}
+	return nil
 }
 
 // CheckTxOrg 检测零钱整理
 func CheckTxOrg() {
-	lockKey := "BtcCheckTxOrg"
+	Btc.CheckTxOrg()
+}
+
+// CheckTxOrg 检测零钱整理
+func (chain *Chain) CheckTxOrg() {
+	lockKey := chain.LockPrefix + "CheckTxOrg"
 	app.LockWrap(lockKey, func() {
 		// 开始事物
 		isComment := false
@@ -510,6 +588,7 @@ func CheckTxOrg() {
 				model.DBColTTxBtcUxtoVoutValue,
 				model.DBColTTxBtcUxtoVoutScript,
 			},
+			chain.Symbol,
 			app.UxtoTypeTx,
 		)
 		if err != nil {
@@ -523,7 +602,7 @@ func CheckTxOrg() {
 		coldAddressValue, err := app.SQLGetTAppConfigStrValueByK(
 			context.Background(),
 			dbTx,
-			"cold_wallet_address_btc",
+			chain.suffixKey("cold_wallet_address"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -533,7 +612,7 @@ func CheckTxOrg() {
 		feePriceValue, err := app.SQLGetTAppStatusIntValueByK(
 			context.Background(),
 			dbTx,
-			"to_cold_gas_price_btc",
+			chain.suffixKey("to_cold_gas_price"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -585,7 +664,7 @@ func CheckTxOrg() {
 					Wif:       wif,
 				})
 			}
-			tx, err := BtcMakeTx(GetNetwork(xenv.Cfg.BtcNetworkType).Params, inItems, outItems, feePriceValue, coldAddressValue)
+			tx, err := chain.MakeTx(inItems, outItems, feePriceValue, coldAddressValue)
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 				return
@@ -615,6 +694,7 @@ func CheckTxOrg() {
 					gasPrice = feePriceValue
 				}
 				sendRows = append(sendRows, &model.DBTSendBtc{
+					Symbol:       chain.Symbol,
 					RelatedType:  app.SendRelationTypeUXTOOrg,
 					RelatedID:    uxtoRow.ID,
 					TokenID:      0,
@@ -673,7 +753,12 @@ func CheckTxOrg() {
 
 // CheckRawTxSend 发送交易
 func CheckRawTxSend() {
-	lockKey := "BtcCheckRawTxSend"
+	Btc.CheckRawTxSend()
+}
+
+// CheckRawTxSend 发送交易
+func (chain *Chain) CheckRawTxSend() {
+	lockKey := chain.LockPrefix + "CheckRawTxSend"
 	app.LockWrap(lockKey, func() {
 		// 发送的数组
 		var sendHexes []string
@@ -688,6 +773,7 @@ func CheckRawTxSend() {
 				model.DBColTSendBtcRelatedType,
 				model.DBColTSendBtcRelatedID,
 			},
+			chain.Symbol,
 			app.SendStatusInit,
 		)
 		if err != nil {
@@ -802,7 +888,7 @@ func CheckRawTxSend() {
 			if sendRow.Hex == "" {
 				continue
 			}
-			_, err := omniclient.RpcSendRawTransaction(sendRow.Hex)
+			_, err := chain.GetClient().RpcSendRawTransaction(sendRow.Hex)
 			if err != nil && !strings.Contains(err.Error(), "already in block chain") {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 				continue
@@ -871,7 +957,7 @@ func CheckRawTxSend() {
 			return
 		}
 		// 检测发送是否生成新的uxto
-		err = checkSendUxto(sendHexes)
+		err = chain.checkSendUxto(sendHexes)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
@@ -894,16 +980,15 @@ func CheckRawTxSend() {
 	})
 }
 
-func checkSendUxto(hexes []string) error {
+func (chain *Chain) checkSendUxto(hexes []string) error {
 	if len(hexes) > 0 {
 		// 需要添加的uxto
 		var txBtcUxtoRows []*model.DBTTxBtcUxto
 		now := time.Now().Unix()
 		// 获取btc热钱包地址
-		hotAddress, err := app.SQLGetTAppConfigStrValueByK(
+		hotAddressMap, err := chain.getHotAddressMap(
 			context.Background(),
 			xenv.DbCon,
-			"hot_wallet_address_btc",
 		)
 		if err != nil {
 			return err
@@ -911,17 +996,20 @@ func checkSendUxto(hexes []string) error {
 		// 获取所有token
 		var tokenHotAddresses []string
 		var tokenFeeAddresses []string
-		tokenRows, err := app.SQLSelectTAppConfigTokenBtcColAll(
-			context.Background(),
-			xenv.DbCon,
-			[]string{
-				model.DBColTAppConfigTokenBtcID,
-				model.DBColTAppConfigTokenBtcHotAddress,
-				model.DBColTAppConfigTokenBtcFeeAddress,
-			},
-		)
-		if err != nil {
-			return err
+		var tokenRows []*model.DBTAppConfigTokenBtc
+		if chain.IsOmni {
+			tokenRows, err = app.SQLSelectTAppConfigTokenBtcColAll(
+				context.Background(),
+				xenv.DbCon,
+				[]string{
+					model.DBColTAppConfigTokenBtcID,
+					model.DBColTAppConfigTokenBtcHotAddress,
+					model.DBColTAppConfigTokenBtcFeeAddress,
+				},
+			)
+			if err != nil {
+				return err
+			}
 		}
 		for _, tokenRow := range tokenRows {
 			if !mcommon.IsStringInSlice(tokenHotAddresses, tokenRow.HotAddress) {
@@ -946,7 +1034,7 @@ func checkSendUxto(hexes []string) error {
 			omniInAddress := ""
 			// 检测是否是omni交易
 			for _, txOut := range msgTx.TxOut {
-				if strings.HasPrefix(hex.EncodeToString(txOut.PkScript), omniWithReturnHex) {
+				if chain.IsOmni && strings.HasPrefix(hex.EncodeToString(txOut.PkScript), omniWithReturnHex) {
 					isOmniTx = true
 					break
 				}
@@ -955,7 +1043,7 @@ func checkSendUxto(hexes []string) error {
 			if isOmniTx {
 				for _, txIn := range msgTx.TxIn {
 					vinAddresses, err := GetAddressesOfVinMsg(
-						GetNetwork(xenv.Cfg.BtcNetworkType).Params,
+						chain.Network().Params,
 						txIn,
 					)
 					if err != nil {
@@ -971,7 +1059,7 @@ func checkSendUxto(hexes []string) error {
 					txOut := msgTx.TxOut[i]
 					_, outAdds, _, err := txscript.ExtractPkScriptAddrs(
 						txOut.PkScript,
-						GetNetwork(xenv.Cfg.BtcNetworkType).Params,
+						chain.Network().Params,
 					)
 					if err != nil {
 						return err
@@ -993,17 +1081,17 @@ func checkSendUxto(hexes []string) error {
 			for i, txOut := range msgTx.TxOut {
 				_, outAdds, _, err := txscript.ExtractPkScriptAddrs(
 					txOut.PkScript,
-					GetNetwork(xenv.Cfg.BtcNetworkType).Params,
+					chain.Network().Params,
 				)
 				if err != nil {
 					return err
 				}
 				if len(outAdds) == 1 {
 					// 地址结构正确
-					toAddress := outAdds[0].EncodeAddress()
+					toAddress := chain.Network().EncodeAddress(outAdds[0])
 					// 输出地址
 					uxtoType := -1
-					if toAddress == hotAddress {
+					if hotAddressMap[toAddress] {
 						// btc 热钱包地址
 						uxtoType = app.UxtoTypeHot
 					} else if mcommon.IsStringInSlice(tokenFeeAddresses, toAddress) ||
@@ -1027,6 +1115,7 @@ func checkSendUxto(hexes []string) error {
 						txBtcUxtoRows = append(
 							txBtcUxtoRows,
 							&model.DBTTxBtcUxto{
+								Symbol:       chain.Symbol,
 								UxtoType:     int64(uxtoType),
 								BlockHash:    "",
 								TxID:         msgTx.TxHash().String(),
@@ -1063,7 +1152,12 @@ func checkSendUxto(hexes []string) error {
 
 // CheckRawTxConfirm 确认tx是否打包完成
 func CheckRawTxConfirm() {
-	lockKey := "BtcCheckRawTxConfirm"
+	Btc.CheckRawTxConfirm()
+}
+
+// CheckRawTxConfirm 确认tx是否打包完成
+func (chain *Chain) CheckRawTxConfirm() {
+	lockKey := chain.LockPrefix + "CheckRawTxConfirm"
 	app.LockWrap(lockKey, func() {
 		sendRows, err := app.SQLSelectTSendBtcColByStatus(
 			context.Background(),
@@ -1075,6 +1169,7 @@ func CheckRawTxConfirm() {
 				model.DBColTSendBtcRelatedType,
 				model.DBColTSendBtcRelatedID,
 			},
+			chain.Symbol,
 			app.SendStatusSend,
 		)
 		if err != nil {
@@ -1193,7 +1288,7 @@ func CheckRawTxConfirm() {
 		var confirmHashes []string
 		for _, sendRow := range sendRows {
 			if !mcommon.IsStringInSlice(confirmHashes, sendRow.TxID) {
-				rpcTx, err := omniclient.RpcGetRawTransactionVerbose(sendRow.TxID)
+				rpcTx, err := chain.GetClient().RpcGetRawTransactionVerbose(sendRow.TxID)
 				if err != nil {
 					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 					continue
@@ -1201,6 +1296,24 @@ func CheckRawTxConfirm() {
 				if rpcTx.Confirmations <= 0 {
 					continue
 				}
+				// 记录所在块高 供提币查询确认数使用
+				rpcBlock, err := chain.GetClient().RpcGetBlockTxIDs(rpcTx.Blockhash)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					continue
+				}
+				_, err = app.SQLUpdateTSendBtcBlockNumByTxID(
+					context.Background(),
+					xenv.DbCon,
+					model.DBTSendBtc{
+						TxID:     sendRow.TxID,
+						BlockNum: rpcBlock.Height,
+					},
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					continue
+				}
 				confirmHashes = append(confirmHashes, sendRow.TxID)
 			}
 			err = addWithdrawNotify(sendRow)
@@ -1271,7 +1384,12 @@ func CheckRawTxConfirm() {
 
 // CheckWithdraw 检测提现
 func CheckWithdraw() {
-	lockKey := "BtcCheckWithdraw"
+	Btc.CheckWithdraw()
+}
+
+// CheckWithdraw 检测提现
+func (chain *Chain) CheckWithdraw() {
+	lockKey := chain.LockPrefix + "CheckWithdraw"
 	app.LockWrap(lockKey, func() {
 		// 开始事物
 		isComment := false
@@ -1285,6 +1403,13 @@ func CheckWithdraw() {
 				_ = dbTx.Rollback()
 			}
 		}()
+		// 找零到新的热钱包地址
+		// 地址池不足时会在事务外创建地址, 需要在锁定提币和uxto之前分配
+		changeAddress, err := chain.getChangeAddress(context.Background(), dbTx)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
 		// 获取提币信息
 		withdrawRows, err := app.SQLSelectTWithdrawColByStatusForUpdate(
 			context.Background(),
@@ -1297,7 +1422,7 @@ func CheckWithdraw() {
 				model.DBColTWithdrawBalanceReal,
 			},
 			app.WithdrawStatusInit,
-			[]string{CoinSymbol},
+			[]string{chain.GetCoinSymbol()},
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -1310,25 +1435,14 @@ func CheckWithdraw() {
 		feePriceValue, err := app.SQLGetTAppStatusIntValueByK(
 			context.Background(),
 			dbTx,
-			"to_user_gas_price_btc",
-		)
-		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-			return
-		}
-		// 获取热钱包地址
-		hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
-			context.Background(),
-			dbTx,
-			"hot_wallet_address_btc",
+			chain.suffixKey("to_user_gas_price"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
-		hotAddress := hotAddressValue
-		// 获取热钱包uxto
-		uxtoRows, err := app.SQLSelectTTxBtcUxtoColByAddressAndTypeForUpdate(
+		// 获取所有热钱包地址的uxto
+		uxtoRows, err := app.SQLSelectTTxBtcUxtoColToOrgForUpdate(
 			context.Background(),
 			dbTx,
 			[]string{
@@ -1339,7 +1453,7 @@ func CheckWithdraw() {
 				model.DBColTTxBtcUxtoVoutValue,
 				model.DBColTTxBtcUxtoVoutScript,
 			},
-			hotAddress,
+			chain.Symbol,
 			app.UxtoTypeHot,
 		)
 		if err != nil {
@@ -1390,7 +1504,7 @@ func CheckWithdraw() {
 				if len(tmpInUxtoRows) > 0 {
 					feeInUxtoRows = append(feeInUxtoRows, tmpInUxtoRows...)
 				}
-				txSize, err := BtcTxWithdrawSize(GetNetwork(xenv.Cfg.BtcNetworkType).Params, feeInUxtoRows, feeOutWithdrawRows, addressWifMap)
+				txSize, err := chain.TxWithdrawSize(feeInUxtoRows, feeOutWithdrawRows, addressWifMap)
 				if err != nil {
 					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 					return
@@ -1470,7 +1584,7 @@ func CheckWithdraw() {
 				Balance:     balance.Mul(decimal.NewFromInt(1e8)).IntPart(),
 			})
 		}
-		tx, err := BtcMakeTx(GetNetwork(xenv.Cfg.BtcNetworkType).Params, argVins, argVouts, feePriceValue, hotAddress)
+		tx, err := chain.MakeTx(argVins, argVouts, feePriceValue, changeAddress)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
@@ -1483,6 +1597,16 @@ func CheckWithdraw() {
 			return
 		}
 		//mcommon.Log.Debugf("raw tx: %s", hex.EncodeToString(b.Bytes()))
+		// 输入来自多个热钱包地址时 无法用一个地址表示 发送地址留空
+		fromAddress := ""
+		for i, uxtoRow := range inUxtoRows {
+			if i == 0 {
+				fromAddress = uxtoRow.VoutAddress
+			} else if uxtoRow.VoutAddress != fromAddress {
+				fromAddress = ""
+				break
+			}
+		}
 		now := time.Now().Unix()
 		var sendRows []*model.DBTSendBtc
 		var updateUxtoRows []*model.DBTTxBtcUxto
@@ -1497,11 +1621,12 @@ func CheckWithdraw() {
 				gasPrice = feePriceValue
 			}
 			sendRows = append(sendRows, &model.DBTSendBtc{
+				Symbol:       chain.Symbol,
 				RelatedType:  app.SendRelationTypeWithdraw,
 				RelatedID:    outWithdrawRow.ID,
 				TokenID:      0,
 				TxID:         tx.TxHash().String(),
-				FromAddress:  hotAddress,
+				FromAddress:  fromAddress,
 				ToAddress:    outWithdrawRow.ToAddress,
 				BalanceReal:  outWithdrawRow.BalanceReal,
 				Gas:          gas,
@@ -1575,7 +1700,12 @@ func CheckWithdraw() {
 
 // CheckTxNotify 创建btc冲币通知
 func CheckTxNotify() {
-	lockKey := "BtcCheckTxNotify"
+	Btc.CheckTxNotify()
+}
+
+// CheckTxNotify 创建btc冲币通知
+func (chain *Chain) CheckTxNotify() {
+	lockKey := chain.LockPrefix + "CheckTxNotify"
 	app.LockWrap(lockKey, func() {
 		txRows, err := app.SQLSelectTTxBtcColByStatus(
 			context.Background(),
@@ -1588,6 +1718,7 @@ func CheckTxNotify() {
 				model.DBColTTxBtcVoutN,
 				model.DBColTTxBtcVoutValue,
 			},
+			chain.Symbol,
 			app.TxStatusInit,
 		)
 		if err != nil {
@@ -1595,10 +1726,14 @@ func CheckTxNotify() {
 			return
 		}
 		var productIDs []int64
+		var addresses []string
 		for _, txRow := range txRows {
 			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
 				productIDs = append(productIDs, txRow.ProductID)
 			}
+			if !mcommon.IsStringInSlice(addresses, txRow.VoutAddress) {
+				addresses = append(addresses, txRow.VoutAddress)
+			}
 		}
 		productMap, err := app.SQLGetProductMap(
 			context.Background(),
@@ -1615,6 +1750,20 @@ func CheckTxNotify() {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
+		addressUserMap, err := app.SQLGetAddressUserMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressUserProductID,
+				model.DBColTAddressUserUserID,
+			},
+			[]string{chain.Symbol},
+			addresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
 		var notifyTxIDs []int64
 		var notifyRows []*model.DBTProductNotify
 		now := time.Now().Unix()
@@ -1631,9 +1780,12 @@ func CheckTxNotify() {
 				"app_name":    productRow.AppName,
 				"address":     txRow.VoutAddress,
 				"balance":     txRow.VoutValue,
-				"symbol":      CoinSymbol,
+				"symbol":      chain.GetCoinSymbol(),
 				"notify_type": app.NotifyTypeTx,
 			}
+			if userID := app.GetAddressUserID(addressUserMap, txRow.ProductID, txRow.VoutAddress); userID != "" {
+				reqObj["user_id"] = userID
+			}
 			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
 			req, err := json.Marshal(reqObj)
 			if err != nil {
@@ -1646,7 +1798,7 @@ func CheckTxNotify() {
 				ItemType:     app.SendRelationTypeTx,
 				ItemID:       txRow.ID,
 				NotifyType:   app.NotifyTypeTx,
-				TokenSymbol:  CoinSymbol,
+				TokenSymbol:  chain.GetCoinSymbol(),
 				URL:          productRow.CbURL,
 				Msg:          string(req),
 				HandleStatus: app.NotifyStatusInit,
@@ -1931,6 +2083,7 @@ func OmniCheckBlockSeek() {
 										TokenIndex:   rpcTx.Propertyid,
 										TokenSymbol:  tokenRow.TokenSymbol,
 										BlockHash:    rpcTx.Blockhash,
+										BlockNum:     rpcTx.Block,
 										TxID:         rpcTx.Txid,
 										FromAddress:  rpcTx.Sendingaddress,
 										ToAddress:    rpcTx.Referenceaddress,
@@ -2267,6 +2420,7 @@ func OmniCheckTxOrg() {
 					}
 					// 发送数据
 					sendRows = append(sendRows, &model.DBTSendBtc{
+						Symbol:       CoinSymbol,
 						RelatedType:  app.SendRelationTypeOmniOrg,
 						RelatedID:    txRow.ID,
 						TokenID:      txRow.TokenIndex,
@@ -2382,8 +2536,8 @@ func OmniCheckWithdraw() {
 			return
 		}
 		for _, tokenRow := range tokenBtcRows {
-			symbols = append(symbols, tokenRow.TokenSymbol)
-			tokenMap[tokenRow.TokenSymbol] = tokenRow
+			symbols = append(symbols, OmniGetTokenSymbol(tokenRow.TokenSymbol))
+			tokenMap[OmniGetTokenSymbol(tokenRow.TokenSymbol)] = tokenRow
 			if !mcommon.IsStringInSlice(tokenHotAddresses, tokenRow.HotAddress) {
 				tokenHotAddresses = append(tokenHotAddresses, tokenRow.HotAddress)
 			}
@@ -2600,6 +2754,7 @@ func OmniCheckWithdraw() {
 			// 准备数据
 			// 发送数据
 			sendRows = append(sendRows, &model.DBTSendBtc{
+				Symbol:       CoinSymbol,
 				RelatedType:  app.SendRelationTypeWithdraw,
 				RelatedID:    withdrawRow.ID,
 				TokenID:      tokenRow.TokenIndex,
@@ -2704,10 +2859,14 @@ func OmniCheckTxNotify() {
 			return
 		}
 		var productIDs []int64
+		var addresses []string
 		for _, txRow := range txRows {
 			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
 				productIDs = append(productIDs, txRow.ProductID)
 			}
+			if !mcommon.IsStringInSlice(addresses, txRow.ToAddress) {
+				addresses = append(addresses, txRow.ToAddress)
+			}
 		}
 		productMap, err := app.SQLGetProductMap(
 			context.Background(),
@@ -2724,6 +2883,20 @@ func OmniCheckTxNotify() {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
+		addressUserMap, err := app.SQLGetAddressUserMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressUserProductID,
+				model.DBColTAddressUserUserID,
+			},
+			[]string{CoinSymbol},
+			addresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
 		var notifyTxIDs []int64
 		var notifyRows []*model.DBTProductNotify
 		now := time.Now().Unix()
@@ -2740,9 +2913,12 @@ func OmniCheckTxNotify() {
 				"app_name":    productRow.AppName,
 				"address":     txRow.ToAddress,
 				"balance":     txRow.Value,
-				"symbol":      txRow.TokenSymbol,
+				"symbol":      OmniGetTokenSymbol(txRow.TokenSymbol),
 				"notify_type": app.NotifyTypeTx,
 			}
+			if userID := app.GetAddressUserID(addressUserMap, txRow.ProductID, txRow.ToAddress); userID != "" {
+				reqObj["user_id"] = userID
+			}
 			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
 			req, err := json.Marshal(reqObj)
 			if err != nil {
@@ -2755,7 +2931,7 @@ func OmniCheckTxNotify() {
 				ItemType:     app.SendRelationTypeTx,
 				ItemID:       txRow.ID,
 				NotifyType:   app.NotifyTypeTx,
-				TokenSymbol:  txRow.TokenSymbol,
+				TokenSymbol:  OmniGetTokenSymbol(txRow.TokenSymbol),
 				URL:          productRow.CbURL,
 				Msg:          string(req),
 				HandleStatus: app.NotifyStatusInit,
@@ -2795,17 +2971,22 @@ func OmniCheckTxNotify() {
 
 // CheckBlockSeekHotAndFee 检测到账
 func CheckBlockSeekHotAndFee() {
-	lockKey := "BtcCheckBlockSeekHotAndFee"
+	Btc.CheckBlockSeekHotAndFee()
+}
+
+// CheckBlockSeekHotAndFee 检测到账
+func (chain *Chain) CheckBlockSeekHotAndFee() {
+	lockKey := chain.LockPrefix + "CheckBlockSeekHotAndFee"
 	app.LockWrap(lockKey, func() {
 		// 获取状态 当前处理完成的最新的block number
 		seekValue, err := app.SQLGetTAppStatusIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"btc_hot_fee_seek_num",
+			chain.prefixKey("hot_fee_seek_num"),
 		)
 		if err != nil {
 			if strings.Contains(err.Error(), "no app status int of") {
-				rpcBlockNum, err := omniclient.RpcGetBlockCount()
+				rpcBlockNum, err := chain.GetClient().RpcGetBlockCount()
 				if err != nil {
 					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 					return
@@ -2814,7 +2995,7 @@ func CheckBlockSeekHotAndFee() {
 					context.Background(),
 					xenv.DbCon,
 					&model.DBTAppStatusInt{
-						K: "btc_hot_fee_seek_num",
+						K: chain.prefixKey("hot_fee_seek_num"),
 						V: rpcBlockNum,
 					},
 					true,
@@ -2824,7 +3005,7 @@ func CheckBlockSeekHotAndFee() {
 				return
 			}
 		}
-		rpcBlockNum, err := omniclient.RpcGetBlockCount()
+		rpcBlockNum, err := chain.GetClient().RpcGetBlockCount()
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
@@ -2833,10 +3014,9 @@ func CheckBlockSeekHotAndFee() {
 		endI := rpcBlockNum + 1
 		if startI < endI {
 			// 获取btc热钱包地址
-			hotAddress, err := app.SQLGetTAppConfigStrValueByK(
+			hotAddressMap, err := chain.getHotAddressMap(
 				context.Background(),
 				xenv.DbCon,
-				"hot_wallet_address_btc",
 			)
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -2845,18 +3025,21 @@ func CheckBlockSeekHotAndFee() {
 			// 获取所有token
 			var tokenHotAddresses []string
 			var tokenFeeAddresses []string
-			tokenRows, err := app.SQLSelectTAppConfigTokenBtcColAll(
-				context.Background(),
-				xenv.DbCon,
-				[]string{
-					model.DBColTAppConfigTokenBtcID,
-					model.DBColTAppConfigTokenBtcHotAddress,
-					model.DBColTAppConfigTokenBtcFeeAddress,
-				},
-			)
-			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-				return
+			var tokenRows []*model.DBTAppConfigTokenBtc
+			if chain.IsOmni {
+				tokenRows, err = app.SQLSelectTAppConfigTokenBtcColAll(
+					context.Background(),
+					xenv.DbCon,
+					[]string{
+						model.DBColTAppConfigTokenBtcID,
+						model.DBColTAppConfigTokenBtcHotAddress,
+						model.DBColTAppConfigTokenBtcFeeAddress,
+					},
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
 			}
 			for _, tokenRow := range tokenRows {
 				if !mcommon.IsStringInSlice(tokenHotAddresses, tokenRow.HotAddress) {
@@ -2868,13 +3051,13 @@ func CheckBlockSeekHotAndFee() {
 			}
 			// 遍历获取需要查询的block信息
 			for curBlockNum := startI; curBlockNum < endI; curBlockNum++ {
-				blockHash, err := omniclient.RpcGetBlockHash(curBlockNum)
+				blockHash, err := chain.GetClient().RpcGetBlockHash(curBlockNum)
 				if err != nil {
 					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 					return
 				}
 				// 一个block
-				rpcBlock, err := omniclient.RpcGetBlockVerbose(blockHash)
+				rpcBlock, err := chain.getBlockVerbose(blockHash)
 				if err != nil {
 					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 					return
@@ -2915,7 +3098,7 @@ func CheckBlockSeekHotAndFee() {
 						if len(vout.ScriptPubKey.Addresses) == 1 {
 							// 输出地址
 							toAddress := vout.ScriptPubKey.Addresses[0]
-							if toAddress == hotAddress {
+							if hotAddressMap[toAddress] {
 								// btc 热钱包地址
 								isIgnoreTx = false
 								break
@@ -2937,7 +3120,7 @@ func CheckBlockSeekHotAndFee() {
 					omniInAddress := ""
 					// 检测是否是omni交易
 					for _, vout := range rpcTx.Vout {
-						if strings.HasPrefix(vout.ScriptPubKey.Hex, omniScript) {
+						if chain.IsOmni && strings.HasPrefix(vout.ScriptPubKey.Hex, omniScript) {
 							isOmniTx = true
 							break
 						}
@@ -2946,7 +3129,7 @@ func CheckBlockSeekHotAndFee() {
 					if isOmniTx {
 						for _, vin := range rpcTx.Vin {
 							vinAddresses, err := GetAddressesOfVin(
-								GetNetwork(xenv.Cfg.BtcNetworkType).Params,
+								chain.Network().Params,
 								vin,
 							)
 							if err != nil {
@@ -2976,7 +3159,7 @@ func CheckBlockSeekHotAndFee() {
 							// 输出地址
 							uxtoType := -1
 							toAddress := vout.ScriptPubKey.Addresses[0]
-							if toAddress == hotAddress {
+							if hotAddressMap[toAddress] {
 								// btc 热钱包地址
 								uxtoType = app.UxtoTypeHot
 							} else if mcommon.IsStringInSlice(tokenFeeAddresses, toAddress) ||
@@ -3000,6 +3183,7 @@ func CheckBlockSeekHotAndFee() {
 								txBtcUxtoRows = append(
 									txBtcUxtoRows,
 									&model.DBTTxBtcUxto{
+										Symbol:       chain.Symbol,
 										UxtoType:     int64(uxtoType),
 										BlockHash:    rpcBlock.Hash,
 										TxID:         rpcTx.Txid,
@@ -3029,6 +3213,7 @@ func CheckBlockSeekHotAndFee() {
 						model.DBColTTxBtcUxtoTxID,
 						model.DBColTTxBtcUxtoVoutN,
 					},
+					chain.Symbol,
 					vinTxHashes,
 				)
 				if err != nil {
@@ -3077,7 +3262,7 @@ func CheckBlockSeekHotAndFee() {
 					context.Background(),
 					xenv.DbCon,
 					&model.DBTAppStatusInt{
-						K: "btc_hot_fee_seek_num",
+						K: chain.prefixKey("hot_fee_seek_num"),
 						V: curBlockNum,
 					},
 				)
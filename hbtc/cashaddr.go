@@ -0,0 +1,126 @@
+package hbtc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+// cashaddr 字符集
+const cashAddrCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// cashaddr 地址类型
+const (
+	cashAddrTypeP2PKH = 0
+	cashAddrTypeP2SH  = 1
+)
+
+// cashAddrPolyMod 校验和计算
+func cashAddrPolyMod(values []byte) uint64 {
+	generators := []uint64{
+		0x98f2bc8e61,
+		0x79b76d99e2,
+		0xf33e5fb3c4,
+		0xae2eabe2a8,
+		0x1e4f43e470,
+	}
+	c := uint64(1)
+	for _, d := range values {
+		c0 := c >> 35
+		c = ((c & 0x07ffffffff) << 5) ^ uint64(d)
+		for i, generator := range generators {
+			if (c0>>uint(i))&1 == 1 {
+				c ^= generator
+			}
+		}
+	}
+	return c ^ 1
+}
+
+// cashAddrPrefixExpand 前缀展开
+func cashAddrPrefixExpand(prefix string) []byte {
+	var values []byte
+	for _, c := range prefix {
+		values = append(values, byte(c)&0x1f)
+	}
+	return append(values, 0)
+}
+
+// EncodeCashAddress 生成 cashaddr 格式地址
+func EncodeCashAddress(addr btcutil.Address, prefix string) (string, error) {
+	var addrType byte
+	switch addr.(type) {
+	case *btcutil.AddressPubKeyHash:
+		addrType = cashAddrTypeP2PKH
+	case *btcutil.AddressScriptHash:
+		addrType = cashAddrTypeP2SH
+	default:
+		return "", fmt.Errorf("cashaddr not support address type: %T", addr)
+	}
+	// 版本字节 只支持 160 bit hash
+	payload := append([]byte{addrType << 3}, addr.ScriptAddress()...)
+	data, err := bech32.ConvertBits(payload, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	checkValues := append(cashAddrPrefixExpand(prefix), data...)
+	checkValues = append(checkValues, make([]byte, 8)...)
+	polyMod := cashAddrPolyMod(checkValues)
+	for i := 0; i < 8; i++ {
+		data = append(data, byte((polyMod>>uint(5*(7-i)))&0x1f))
+	}
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	sb.WriteString(":")
+	for _, d := range data {
+		sb.WriteByte(cashAddrCharset[d])
+	}
+	return sb.String(), nil
+}
+
+// DecodeCashAddress 解析 cashaddr 格式地址
+func DecodeCashAddress(address string, prefix string, chainParams *chaincfg.Params) (btcutil.Address, error) {
+	if strings.ToLower(address) != address && strings.ToUpper(address) != address {
+		return nil, errors.New("cashaddr mixed case")
+	}
+	address = strings.ToLower(address)
+	if strings.Contains(address, ":") {
+		parts := strings.SplitN(address, ":", 2)
+		if parts[0] != prefix {
+			return nil, fmt.Errorf("cashaddr prefix error: %s", parts[0])
+		}
+		address = parts[1]
+	}
+	var data []byte
+	for _, c := range address {
+		index := strings.IndexRune(cashAddrCharset, c)
+		if index < 0 {
+			return nil, fmt.Errorf("cashaddr invalid char: %c", c)
+		}
+		data = append(data, byte(index))
+	}
+	if len(data) <= 8 {
+		return nil, errors.New("cashaddr too short")
+	}
+	if cashAddrPolyMod(append(cashAddrPrefixExpand(prefix), data...)) != 0 {
+		return nil, errors.New("cashaddr checksum error")
+	}
+	payload, err := bech32.ConvertBits(data[:len(data)-8], 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) != 21 {
+		return nil, errors.New("cashaddr hash size error")
+	}
+	switch payload[0] {
+	case cashAddrTypeP2PKH << 3:
+		return btcutil.NewAddressPubKeyHash(payload[1:], chainParams)
+	case cashAddrTypeP2SH << 3:
+		return btcutil.NewAddressScriptHashFromHash(payload[1:], chainParams)
+	}
+	return nil, fmt.Errorf("cashaddr version error: %d", payload[0])
+}
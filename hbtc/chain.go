@@ -0,0 +1,310 @@
+package hbtc
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/omniclient"
+	"go-dc-wallet/xenv"
+	"strings"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/moremorefun/mcommon"
+	"github.com/shopspring/decimal"
+)
+
+// Chain utxo 链配置
+type Chain struct {
+	Symbol           string // 币种
+	LockPrefix       string // 任务锁前缀
+	IsSegwit         bool   // 充币地址是否使用隔离见证
+	IsForkID         bool   // 签名是否使用 SIGHASH_FORKID
+	IsOmni           bool   // 是否处理omni代币
+	IsBlockTxIDsOnly bool   // 节点 getblock 是否只能返回txid
+	MinNondustOutput int64  // 最小输出金额
+
+	GetEnable      func() bool
+	GetNetworkType func() string
+	GetClient      func() *omniclient.Client
+
+	mempoolTxIDs map[string]bool // 已经处理过的内存池txid
+	seekIndex    addressIndex    // 扫块使用的地址索引
+}
+
+// Btc btc
+var Btc = &Chain{
+	Symbol:           CoinSymbol,
+	LockPrefix:       "Btc",
+	IsSegwit:         true,
+	IsOmni:           true,
+	MinNondustOutput: MinNondustOutput,
+	GetEnable: func() bool {
+		return true
+	},
+	GetNetworkType: func() string {
+		return xenv.Cfg.BtcNetworkType
+	},
+	GetClient: omniclient.DefaultClient,
+}
+
+// Ltc litecoin
+var Ltc = &Chain{
+	Symbol:           "ltc",
+	LockPrefix:       "Ltc",
+	IsSegwit:         true,
+	MinNondustOutput: 5460,
+	GetEnable: func() bool {
+		return xenv.Cfg.LtcEnable
+	},
+	GetNetworkType: func() string {
+		return xenv.Cfg.LtcNetworkType
+	},
+	GetClient: func() *omniclient.Client {
+		return omniclient.NewClient(xenv.Cfg.LtcRPCHost, xenv.Cfg.LtcRPCUser, xenv.Cfg.LtcRPCPwd)
+	},
+}
+
+// Doge dogecoin
+var Doge = &Chain{
+	Symbol:           "doge",
+	LockPrefix:       "Doge",
+	IsBlockTxIDsOnly: true,
+	MinNondustOutput: 1000000,
+	GetEnable: func() bool {
+		return xenv.Cfg.DogeEnable
+	},
+	GetNetworkType: func() string {
+		return xenv.Cfg.DogeNetworkType
+	},
+	GetClient: func() *omniclient.Client {
+		return omniclient.NewClient(xenv.Cfg.DogeRPCHost, xenv.Cfg.DogeRPCUser, xenv.Cfg.DogeRPCPwd)
+	},
+}
+
+// Bch bitcoin cash
+var Bch = &Chain{
+	Symbol:           "bch",
+	LockPrefix:       "Bch",
+	IsForkID:         true,
+	MinNondustOutput: MinNondustOutput,
+	GetEnable: func() bool {
+		return xenv.Cfg.BchEnable
+	},
+	GetNetworkType: func() string {
+		return xenv.Cfg.BchNetworkType
+	},
+	GetClient: func() *omniclient.Client {
+		return omniclient.NewClient(xenv.Cfg.BchRPCHost, xenv.Cfg.BchRPCUser, xenv.Cfg.BchRPCPwd)
+	},
+}
+
+// Chains 所有utxo链
+var Chains = []*Chain{Btc, Ltc, Doge, Bch}
+
+// GetChain 根据币种获取链
+func GetChain(symbol string) *Chain {
+	for _, chain := range Chains {
+		if chain.Symbol == symbol {
+			return chain
+		}
+	}
+	return nil
+}
+
+// GetChainByCoinSymbol 根据对外使用的币种获取链 omni代币返回nil
+func GetChainByCoinSymbol(coinSymbol string) *Chain {
+	for _, chain := range Chains {
+		if chain.GetCoinSymbol() == coinSymbol {
+			return chain
+		}
+	}
+	return nil
+}
+
+// GetEnableChains 获取开启的链
+func GetEnableChains() []*Chain {
+	var chains []*Chain
+	for _, chain := range Chains {
+		if chain.GetEnable() {
+			chains = append(chains, chain)
+		}
+	}
+	return chains
+}
+
+// CheckChains 检测开启的链的网络类型配置
+func CheckChains() error {
+	for _, chain := range GetEnableChains() {
+		networkType := chain.GetNetworkType()
+		if networkType != chain.Symbol && !strings.HasPrefix(networkType, chain.Symbol+"-") {
+			return fmt.Errorf("%s network type error: %s", chain.Symbol, networkType)
+		}
+		err := CheckNetwork(networkType)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Network 获取网络
+func (chain *Chain) Network() Network {
+	return GetNetwork(chain.GetNetworkType())
+}
+
+// OmniNetwork omni代币资产标识中的链
+const OmniNetwork = "omni"
+
+// GetCoinSymbol 对外使用的币种 例如 btc:btc
+func (chain *Chain) GetCoinSymbol() string {
+	return app.GetAssetID(chain.Symbol, chain.Symbol)
+}
+
+// OmniGetTokenSymbol omni代币对外使用的币种 例如 omni:usdt
+func OmniGetTokenSymbol(tokenSymbol string) string {
+	return app.GetAssetID(OmniNetwork, tokenSymbol)
+}
+
+// prefixKey 配置键 例如 btc_seek_num
+func (chain *Chain) prefixKey(k string) string {
+	return fmt.Sprintf("%s_%s", chain.Symbol, k)
+}
+
+// suffixKey 配置键 例如 hot_wallet_address_btc
+func (chain *Chain) suffixKey(k string) string {
+	return fmt.Sprintf("%s_%s", k, chain.Symbol)
+}
+
+// getBlockVerbose 获取block 内容
+// 输出地址统一按照本链的地址格式重新解析
+func (chain *Chain) getBlockVerbose(blockHash string) (*omniclient.StBlockResult, error) {
+	client := chain.GetClient()
+	var rpcBlock *omniclient.StBlockResult
+	if chain.IsBlockTxIDsOnly {
+		rpcBlockTxIDs, err := client.RpcGetBlockTxIDs(blockHash)
+		if err != nil {
+			return nil, err
+		}
+		rpcBlock = &omniclient.StBlockResult{
+			Hash:              rpcBlockTxIDs.Hash,
+			Confirmations:     rpcBlockTxIDs.Confirmations,
+			Height:            rpcBlockTxIDs.Height,
+			Time:              rpcBlockTxIDs.Time,
+			Previousblockhash: rpcBlockTxIDs.Previousblockhash,
+			Nextblockhash:     rpcBlockTxIDs.Nextblockhash,
+		}
+		for _, txID := range rpcBlockTxIDs.Tx {
+			rpcTx, err := client.RpcGetRawTransactionVerbose(txID)
+			if err != nil {
+				return nil, err
+			}
+			rpcBlock.Tx = append(rpcBlock.Tx, rpcTx)
+		}
+	} else {
+		var err error
+		rpcBlock, err = client.RpcGetBlockVerbose(blockHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, rpcTx := range rpcBlock.Tx {
+		err := chain.fillTxAddresses(rpcTx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rpcBlock, nil
+}
+
+// getRawTransactionVerbose 获取tx
+// 输出地址统一按照本链的地址格式重新解析
+func (chain *Chain) getRawTransactionVerbose(txHash string) (*omniclient.StTxResult, error) {
+	rpcTx, err := chain.GetClient().RpcGetRawTransactionVerbose(txHash)
+	if err != nil {
+		return nil, err
+	}
+	err = chain.fillTxAddresses(rpcTx)
+	if err != nil {
+		return nil, err
+	}
+	return rpcTx, nil
+}
+
+// fillTxAddresses 根据输出脚本重新解析地址
+func (chain *Chain) fillTxAddresses(rpcTx *omniclient.StTxResult) error {
+	network := chain.Network()
+	for i := range rpcTx.Vout {
+		addresses, err := network.GetAddressesOfScript(rpcTx.Vout[i].ScriptPubKey.Hex)
+		if err != nil {
+			return err
+		}
+		rpcTx.Vout[i].ScriptPubKey.Addresses = addresses
+	}
+	return nil
+}
+
+// SigVins 对vin进行签名
+func (chain *Chain) SigVins(tx *wire.MsgTx, vins []*StBtxTxIn) error {
+	if chain.IsForkID {
+		return SigVinsForkID(chain.Network().Params, tx, vins)
+	}
+	return SigVins(chain.Network().Params, tx, vins)
+}
+
+// AddTxOut 添加一个输出
+func (chain *Chain) AddTxOut(tx *wire.MsgTx, toAddress string, balance int64) error {
+	addrTo, err := chain.Network().DecodeAddress(toAddress)
+	if err != nil {
+		return err
+	}
+	pkScriptf, err := txscript.PayToAddrScript(addrTo)
+	if err != nil {
+		return err
+	}
+	tx.AddTxOut(wire.NewTxOut(balance, pkScriptf))
+	return nil
+}
+
+// GetConfirmNum 获取充币确认数
+func (chain *Chain) GetConfirmNum(ctx context.Context, tx mcommon.DbExeAble) (int64, error) {
+	return app.SQLGetTAppConfigIntValueByK(
+		ctx,
+		tx,
+		chain.prefixKey("block_confirm_num"),
+	)
+}
+
+// GetWithdrawFee 获取当前提币预计的手续费 按1个输入2个输出估算
+func (chain *Chain) GetWithdrawFee(ctx context.Context, tx mcommon.DbExeAble) (string, error) {
+	feePriceValue, err := app.SQLGetTAppStatusIntValueByK(
+		ctx,
+		tx,
+		chain.suffixKey("to_user_gas_price"),
+	)
+	if err != nil {
+		return "", err
+	}
+	txSize, err := GetEstimateTxSize(1, 2, false)
+	if err != nil {
+		return "", err
+	}
+	return decimal.NewFromInt(txSize * feePriceValue).Div(decimal.NewFromInt(1e8)).String(), nil
+}
+
+// OmniGetWithdrawFee 获取当前omni代币提币预计的btc手续费 按2个输入2个输出估算
+func OmniGetWithdrawFee(ctx context.Context, tx mcommon.DbExeAble) (string, error) {
+	feePriceValue, err := app.SQLGetTAppStatusIntValueByK(
+		ctx,
+		tx,
+		"to_user_gas_price_btc",
+	)
+	if err != nil {
+		return "", err
+	}
+	txSize, err := GetEstimateTxSize(2, 2, true)
+	if err != nil {
+		return "", err
+	}
+	return decimal.NewFromInt(txSize * feePriceValue).Div(decimal.NewFromInt(1e8)).String(), nil
+}
@@ -10,6 +10,7 @@ import (
 	"go-dc-wallet/omniclient"
 	"go-dc-wallet/xenv"
 	"math"
+	"strings"
 
 	"github.com/moremorefun/mcommon"
 	"github.com/shopspring/decimal"
@@ -23,6 +24,7 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/bech32"
 )
 
 // StBtxTxIn 输入信息
@@ -42,20 +44,40 @@ type StBtxTxOut struct {
 
 // Network 类型
 type Network struct {
-	Params *chaincfg.Params
+	Params         *chaincfg.Params
+	CashAddrPrefix string // bch cashaddr 地址前缀
 }
 
 var network = map[string]Network{
-	"btc":      {Params: &chaincfg.MainNetParams},
-	"btc-test": {Params: &chaincfg.TestNet3Params},
+	"btc":          {Params: &chaincfg.MainNetParams},
+	"btc-test":     {Params: &chaincfg.TestNet3Params},
+	"btc-regtest":  {Params: &chaincfg.RegressionNetParams},
+	"btc-signet":   {Params: &SigNetParams},
+	"ltc":          {Params: &LtcMainNetParams},
+	"ltc-test":     {Params: &LtcTestNetParams},
+	"ltc-regtest":  {Params: &LtcRegTestParams},
+	"doge":         {Params: &DogeMainNetParams},
+	"doge-test":    {Params: &DogeTestNetParams},
+	"doge-regtest": {Params: &DogeRegTestParams},
+	"bch":          {Params: &BchMainNetParams, CashAddrPrefix: "bitcoincash"},
+	"bch-test":     {Params: &BchTestNetParams, CashAddrPrefix: "bchtest"},
+	"bch-regtest":  {Params: &BchRegTestParams, CashAddrPrefix: "bchreg"},
+}
+
+// CheckNetwork 检测网络类型配置
+func CheckNetwork(coinType string) error {
+	_, ok := network[coinType]
+	if !ok {
+		return fmt.Errorf("no btc network: %s", coinType)
+	}
+	return nil
 }
 
 // GetNetwork 获取对象
 func GetNetwork(coinType string) Network {
 	n, ok := network[coinType]
 	if !ok {
-		mcommon.Log.Errorf("no network: %s get btc for replace", coinType)
-		return network[CoinSymbol]
+		mcommon.Log.Fatalf("no btc network: %s", coinType)
 	}
 	return n
 }
@@ -109,25 +131,97 @@ func (network Network) GetAddressSegwitNested(wif *btcutil.WIF) (*btcutil.Addres
 	return addressScriptHash, nil
 }
 
-// BtcAddTxOut 添加一个输出
-func BtcAddTxOut(tx *wire.MsgTx, toAddress string, balance int64) error {
-	addrTo, err := btcutil.DecodeAddress(
-		toAddress,
-		GetNetwork(xenv.Cfg.BtcNetworkType).Params,
-	)
+// GetAddressPubKeyHash 获取普通地址
+func (network Network) GetAddressPubKeyHash(wif *btcutil.WIF) (*btcutil.AddressPubKeyHash, error) {
+	return btcutil.NewAddressPubKeyHash(btcutil.Hash160(wif.PrivKey.PubKey().SerializeCompressed()), network.GetNetworkParams())
+}
+
+// EncodeAddress 地址编码, bch 使用 cashaddr
+func (network Network) EncodeAddress(addr btcutil.Address) string {
+	if network.CashAddrPrefix != "" {
+		address, err := EncodeCashAddress(addr, network.CashAddrPrefix)
+		if err == nil {
+			return address
+		}
+	}
+	return addr.EncodeAddress()
+}
+
+// DecodeAddress 解析地址, 并检测地址是否属于该网络
+func (network Network) DecodeAddress(address string) (btcutil.Address, error) {
+	var addr btcutil.Address
+	var err error
+	hrp := network.Params.Bech32HRPSegwit
+	if network.CashAddrPrefix != "" {
+		addr, err = DecodeCashAddress(address, network.CashAddrPrefix, network.Params)
+		if err != nil && !strings.Contains(address, ":") {
+			// 兼容旧格式地址
+			addr, err = btcutil.DecodeAddress(address, network.Params)
+		}
+	} else if hrp != "" && strings.HasPrefix(strings.ToLower(address), hrp+"1") {
+		addr, err = decodeSegwitAddress(address, network.Params)
+	} else {
+		addr, err = btcutil.DecodeAddress(address, network.Params)
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
-	pkScriptf, err := txscript.PayToAddrScript(addrTo)
+	if !addr.IsForNet(network.Params) {
+		return nil, fmt.Errorf("address %s not for network %s", address, network.Params.Name)
+	}
+	return addr, nil
+}
+
+// decodeSegwitAddress 解析隔离见证地址
+// 分叉链的 bech32 前缀没有注册到 chaincfg 中, 这里单独解析
+func decodeSegwitAddress(address string, chainParams *chaincfg.Params) (btcutil.Address, error) {
+	hrp, data, err := bech32.Decode(address)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tx.AddTxOut(wire.NewTxOut(balance, pkScriptf))
-	return nil
+	if hrp != chainParams.Bech32HRPSegwit {
+		return nil, fmt.Errorf("segwit address hrp error: %s", hrp)
+	}
+	if len(data) < 1 || data[0] != 0 {
+		return nil, errors.New("segwit address version not support")
+	}
+	witnessProg, err := bech32.ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+	switch len(witnessProg) {
+	case 20:
+		return btcutil.NewAddressWitnessPubKeyHash(witnessProg, chainParams)
+	case 32:
+		return btcutil.NewAddressWitnessScriptHash(witnessProg, chainParams)
+	}
+	return nil, errors.New("segwit address program size error")
+}
+
+// GetAddressesOfScript 获取输出script的地址
+func (network Network) GetAddressesOfScript(scriptHex string) ([]string, error) {
+	script, err := hex.DecodeString(scriptHex)
+	if err != nil {
+		return nil, err
+	}
+	_, adds, _, err := txscript.ExtractPkScriptAddrs(script, network.Params)
+	if err != nil {
+		return nil, err
+	}
+	var addresses []string
+	for _, add := range adds {
+		addresses = append(addresses, network.EncodeAddress(add))
+	}
+	return addresses, nil
+}
+
+// BtcAddTxOut 添加一个输出
+func BtcAddTxOut(tx *wire.MsgTx, toAddress string, balance int64) error {
+	return Btc.AddTxOut(tx, toAddress, balance)
 }
 
-// BtcMakeTx 创建交易
-func BtcMakeTx(chainParams *chaincfg.Params, vins []*StBtxTxIn, vouts []*StBtxTxOut, gasPrice int64, changeAddress string) (*wire.MsgTx, error) {
+// MakeTx 创建交易
+func (chain *Chain) MakeTx(vins []*StBtxTxIn, vouts []*StBtxTxOut, gasPrice int64, changeAddress string) (*wire.MsgTx, error) {
 	inAmount := int64(0)
 	outAmount := int64(0)
 	tx := wire.NewMsgTx(wire.TxVersion)
@@ -142,19 +236,19 @@ func BtcMakeTx(chainParams *chaincfg.Params, vins []*StBtxTxIn, vouts []*StBtxTx
 		inAmount += vin.Balance
 	}
 	for _, vout := range vouts {
-		err := BtcAddTxOut(tx, vout.VoutAddress, vout.Balance)
+		err := chain.AddTxOut(tx, vout.VoutAddress, vout.Balance)
 		if err != nil {
 			return nil, err
 		}
 		outAmount += vout.Balance
 	}
 	// 添加预找零信息
-	err := BtcAddTxOut(tx, changeAddress, BtcInitChange)
+	err := chain.AddTxOut(tx, changeAddress, BtcInitChange)
 	if err != nil {
 		return nil, err
 	}
 	// 计算手续费
-	err = SigVins(chainParams, tx, vins)
+	err = chain.SigVins(tx, vins)
 	if err != nil {
 		return nil, err
 	}
@@ -163,9 +257,9 @@ func BtcMakeTx(chainParams *chaincfg.Params, vins []*StBtxTxIn, vouts []*StBtxTx
 	change := inAmount - outAmount - txFee
 	if change < 0 {
 		// 数额不足
-		return nil, errors.New("btc tx input amount not ok")
+		return nil, errors.New("tx input amount not ok")
 	}
-	if change >= MinNondustOutput {
+	if change >= chain.MinNondustOutput {
 		// 设置预找零数额
 		tx.TxOut[len(tx.TxOut)-1].Value = change
 	} else {
@@ -174,22 +268,22 @@ func BtcMakeTx(chainParams *chaincfg.Params, vins []*StBtxTxIn, vouts []*StBtxTx
 	}
 	if len(tx.TxOut) <= 0 {
 		// 数额不足
-		return nil, errors.New("btc tx input amount not ok")
+		return nil, errors.New("tx input amount not ok")
 	}
 	if tx.SerializeSize() > MaxTxSize {
 		// 长度过大
-		return nil, errors.New("btc tx size too big")
+		return nil, errors.New("tx size too big")
 	}
 	// 重新签名
-	err = SigVins(chainParams, tx, vins)
+	err = chain.SigVins(tx, vins)
 	if err != nil {
 		return nil, err
 	}
 	return tx, nil
 }
 
-// BtcTxSize 交易大小
-func BtcTxSize(chainParams *chaincfg.Params, vins []*StBtxTxIn, vouts []*StBtxTxOut) (int64, error) {
+// TxSize 交易大小
+func (chain *Chain) TxSize(vins []*StBtxTxIn, vouts []*StBtxTxOut) (int64, error) {
 	tx := wire.NewMsgTx(wire.TxVersion)
 	for _, vin := range vins {
 		hash, err := chainhash.NewHashFromStr(vin.VinTxHash)
@@ -201,13 +295,13 @@ func BtcTxSize(chainParams *chaincfg.Params, vins []*StBtxTxIn, vouts []*StBtxTx
 		tx.AddTxIn(txIn)
 	}
 	for _, vout := range vouts {
-		err := BtcAddTxOut(tx, vout.VoutAddress, vout.Balance)
+		err := chain.AddTxOut(tx, vout.VoutAddress, vout.Balance)
 		if err != nil {
 			return 0, err
 		}
 	}
 	// 计算手续费
-	err := SigVins(chainParams, tx, vins)
+	err := chain.SigVins(tx, vins)
 	if err != nil {
 		return 0, err
 	}
@@ -215,8 +309,8 @@ func BtcTxSize(chainParams *chaincfg.Params, vins []*StBtxTxIn, vouts []*StBtxTx
 	return txSize, nil
 }
 
-// BtcTxWithdrawSize 提币tx大小
-func BtcTxWithdrawSize(chainParams *chaincfg.Params, vins []*model.DBTTxBtcUxto, vouts []*model.DBTWithdraw, keyMap map[string]*btcutil.WIF) (int64, error) {
+// TxWithdrawSize 提币tx大小
+func (chain *Chain) TxWithdrawSize(vins []*model.DBTTxBtcUxto, vouts []*model.DBTWithdraw, keyMap map[string]*btcutil.WIF) (int64, error) {
 	var argVins []*StBtxTxIn
 	var argVouts []*StBtxTxOut
 	firstAddress := ""
@@ -257,7 +351,7 @@ func BtcTxWithdrawSize(chainParams *chaincfg.Params, vins []*model.DBTTxBtcUxto,
 		VoutAddress: firstAddress,
 		Balance:     0,
 	})
-	return BtcTxSize(chainParams, argVins, argVouts)
+	return chain.TxSize(argVins, argVouts)
 }
 
 // OmniTxMake 生成交易
@@ -619,6 +713,57 @@ func SigVins(chainParams *chaincfg.Params, tx *wire.MsgTx, vins []*StBtxTxIn) er
 	return nil
 }
 
+// SigHashForkID bch 签名类型
+const SigHashForkID txscript.SigHashType = 0x40
+
+// SigVinsForkID 使用 SIGHASH_FORKID 对vin进行签名
+// 签名摘要使用 BIP143 的算法, 只支持 P2PKH 输入
+func SigVinsForkID(chainParams *chaincfg.Params, tx *wire.MsgTx, vins []*StBtxTxIn) error {
+	txSigHash := txscript.NewTxSigHashes(tx)
+	hashType := txscript.SigHashAll | SigHashForkID
+	for i, vin := range vins {
+		// 重置sig
+		tx.TxIn[i].SignatureScript = nil
+		// 解析vin的script字符串
+		txInPkScript, err := hex.DecodeString(vin.VinScript)
+		if err != nil {
+			return err
+		}
+		// 获取vin的script的类型
+		scriptClass, _, _, err := txscript.ExtractPkScriptAddrs(txInPkScript, chainParams)
+		if err != nil {
+			return err
+		}
+		if scriptClass != txscript.PubKeyHashTy {
+			return fmt.Errorf("error script type: %s", scriptClass.String())
+		}
+		hash, err := txscript.CalcWitnessSigHash(
+			txInPkScript,
+			txSigHash,
+			hashType,
+			tx,
+			i,
+			vin.Balance,
+		)
+		if err != nil {
+			return err
+		}
+		signature, err := vin.Wif.PrivKey.Sign(hash)
+		if err != nil {
+			return err
+		}
+		script, err := txscript.NewScriptBuilder().
+			AddData(append(signature.Serialize(), byte(hashType))).
+			AddData(vin.Wif.SerializePubKey()).
+			Script()
+		if err != nil {
+			return err
+		}
+		tx.TxIn[i].SignatureScript = script
+	}
+	return nil
+}
+
 // GetTxVsize 获取tx vsize
 func GetTxVsize(tx *wire.MsgTx) int64 {
 	s := math.Ceil(float64(1.0*3*tx.SerializeSizeStripped()+tx.SerializeSize()) / 4)
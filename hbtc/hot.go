@@ -0,0 +1,164 @@
+package hbtc
+
+import (
+	"context"
+	"errors"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"strings"
+
+	"github.com/moremorefun/mcommon"
+)
+
+// hotAddressCreateNum 热钱包地址不足时一次创建的数量
+const hotAddressCreateNum = 10
+
+// getTokenAddresses 获取omni token使用的热钱包和手续费地址
+func (chain *Chain) getTokenAddresses(ctx context.Context, tx mcommon.DbExeAble) ([]string, error) {
+	if !chain.IsOmni {
+		return nil, nil
+	}
+	tokenRows, err := app.SQLSelectTAppConfigTokenBtcColAll(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppConfigTokenBtcID,
+			model.DBColTAppConfigTokenBtcHotAddress,
+			model.DBColTAppConfigTokenBtcFeeAddress,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	var tokenAddresses []string
+	for _, tokenRow := range tokenRows {
+		tokenAddresses = append(tokenAddresses, tokenRow.HotAddress, tokenRow.FeeAddress)
+	}
+	return tokenAddresses, nil
+}
+
+// getHotAddressRows 获取热钱包地址集合
+// 包含 use_tag=-1 的所有地址, 不包含 omni token 使用的地址
+func (chain *Chain) getHotAddressRows(ctx context.Context, tx mcommon.DbExeAble) ([]*model.DBTAddressKey, error) {
+	tokenAddresses, err := chain.getTokenAddresses(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	addressRows, err := app.SQLSelectTAddressKeyColByTagAndSymbol(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAddressKeyID,
+			model.DBColTAddressKeyAddress,
+		},
+		-1,
+		chain.Symbol,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var hotAddressRows []*model.DBTAddressKey
+	for _, addressRow := range addressRows {
+		if mcommon.IsStringInSlice(tokenAddresses, addressRow.Address) {
+			continue
+		}
+		hotAddressRows = append(hotAddressRows, addressRow)
+	}
+	return hotAddressRows, nil
+}
+
+// getHotAddressMap 获取热钱包地址集合
+func (chain *Chain) getHotAddressMap(ctx context.Context, tx mcommon.DbExeAble) (map[string]bool, error) {
+	hotAddressRows, err := chain.getHotAddressRows(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	hotAddressMap := make(map[string]bool)
+	for _, hotAddressRow := range hotAddressRows {
+		hotAddressMap[hotAddressRow.Address] = true
+	}
+	// 配置的热钱包地址
+	hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+		ctx,
+		tx,
+		chain.suffixKey("hot_wallet_address"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	hotAddressMap[hotAddressValue] = true
+	return hotAddressMap, nil
+}
+
+// getChangeAddress 获取一个新的找零地址
+// 按照id顺序轮换 use_tag=-1 的地址, 已经使用过的地址不再作为找零地址
+func (chain *Chain) getChangeAddress(ctx context.Context, tx mcommon.DbExeAble) (string, error) {
+	lastIDKey := chain.suffixKey("hot_change_address_id")
+	lastID, err := app.SQLGetTAppStatusIntValueByK(
+		ctx,
+		tx,
+		lastIDKey,
+	)
+	if err != nil {
+		if !strings.Contains(err.Error(), "no app status int of") {
+			return "", err
+		}
+		_, err = model.SQLCreateTAppStatusInt(
+			ctx,
+			tx,
+			&model.DBTAppStatusInt{
+				K: lastIDKey,
+				V: 0,
+			},
+			true,
+		)
+		if err != nil {
+			return "", err
+		}
+	}
+	hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+		ctx,
+		tx,
+		chain.suffixKey("hot_wallet_address"),
+	)
+	if err != nil {
+		return "", err
+	}
+	var changeAddressRow *model.DBTAddressKey
+	for i := 0; i < 2 && changeAddressRow == nil; i++ {
+		if i > 0 {
+			// 没有可用的地址 创建新的热钱包地址
+			_, err = chain.CreateHotAddress(hotAddressCreateNum)
+			if err != nil {
+				return "", err
+			}
+		}
+		// 新创建的地址在事务外写入, 这里不使用事务查询
+		hotAddressRows, err := chain.getHotAddressRows(ctx, xenv.DbCon)
+		if err != nil {
+			return "", err
+		}
+		for _, hotAddressRow := range hotAddressRows {
+			if hotAddressRow.ID > lastID && hotAddressRow.Address != hotAddressValue {
+				changeAddressRow = hotAddressRow
+				break
+			}
+		}
+	}
+	if changeAddressRow == nil {
+		return "", errors.New("no change address")
+	}
+	_, err = app.SQLUpdateTAppStatusIntByK(
+		ctx,
+		tx,
+		&model.DBTAppStatusInt{
+			K: lastIDKey,
+			V: changeAddressRow.ID,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return changeAddressRow.Address, nil
+}
@@ -0,0 +1,143 @@
+package hbtc
+
+import (
+	"context"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"go-dc-wallet/omniclient"
+	"go-dc-wallet/xenv"
+	"sync"
+)
+
+// 扫块预取配置
+const (
+	blockPrefetchWorkers = 4  // 同时请求的block数
+	blockPrefetchSize    = 16 // 每批预取的block数
+
+	// indexReloadOverlap 增量加载时回看的id数量
+	// 自增id的提交顺序和大小不一定一致, 较小id的记录可能在较大id之后才提交
+	indexReloadOverlap = 1000
+)
+
+// addressIndex 内存中的地址和uxto索引
+// 扫块时只有命中索引的输出和输入才会查询数据库
+type addressIndex struct {
+	addressMaxID int64
+	addresses    map[string]bool // t_address_key 中的地址, 包含热钱包和手续费地址
+	uxtoMaxID    int64
+	uxtoTxIDs    map[string]bool // t_tx_btc_uxto 中未花费记录的txid
+}
+
+// refresh 增量加载新增的地址和uxto
+// 每次从 最大id-indexReloadOverlap 开始加载, 避免漏掉晚提交的记录
+func (index *addressIndex) refresh(symbol string) error {
+	if index.addresses == nil {
+		index.addresses = make(map[string]bool)
+	}
+	if index.uxtoTxIDs == nil {
+		index.uxtoTxIDs = make(map[string]bool)
+	}
+	addressRows, err := app.SQLSelectTAddressKeyColByIDGreater(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTAddressKeyID,
+			model.DBColTAddressKeyAddress,
+		},
+		symbol,
+		reloadFromID(index.addressMaxID),
+	)
+	if err != nil {
+		return err
+	}
+	for _, addressRow := range addressRows {
+		index.addresses[addressRow.Address] = true
+		if addressRow.ID > index.addressMaxID {
+			index.addressMaxID = addressRow.ID
+		}
+	}
+	uxtoRows, err := app.SQLSelectTTxBtcUxtoColByIDGreater(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTTxBtcUxtoID,
+			model.DBColTTxBtcUxtoTxID,
+			model.DBColTTxBtcUxtoHandleStatus,
+		},
+		symbol,
+		reloadFromID(index.uxtoMaxID),
+	)
+	if err != nil {
+		return err
+	}
+	for _, uxtoRow := range uxtoRows {
+		if uxtoRow.HandleStatus != app.UxtoHandleStatusConfirm {
+			index.uxtoTxIDs[uxtoRow.TxID] = true
+		}
+		if uxtoRow.ID > index.uxtoMaxID {
+			index.uxtoMaxID = uxtoRow.ID
+		}
+	}
+	return nil
+}
+
+// reloadFromID 增量加载的起始id
+func reloadFromID(maxID int64) int64 {
+	if maxID <= indexReloadOverlap {
+		return 0
+	}
+	return maxID - indexReloadOverlap
+}
+
+// hasAddress 是否是本系统的地址
+func (index *addressIndex) hasAddress(address string) bool {
+	return index.addresses[address]
+}
+
+// hasUxtoTx 是否包含本系统的uxto
+func (index *addressIndex) hasUxtoTx(txID string) bool {
+	return index.uxtoTxIDs[txID]
+}
+
+// addUxtoTx 添加本次扫块产生的uxto
+func (index *addressIndex) addUxtoTx(txID string) {
+	index.uxtoTxIDs[txID] = true
+}
+
+// removeUxtoTx 移除所有输出都已花费的uxto
+func (index *addressIndex) removeUxtoTx(txID string) {
+	delete(index.uxtoTxIDs, txID)
+}
+
+// prefetchBlocks 并发获取 [startNum, endNum) 的block
+// 返回结果按照块高排序, 出错时只返回出错块之前的block
+func (chain *Chain) prefetchBlocks(startNum, endNum int64) ([]*omniclient.StBlockResult, error) {
+	count := endNum - startNum
+	rpcBlocks := make([]*omniclient.StBlockResult, count)
+	errs := make([]error, count)
+	sem := make(chan struct{}, blockPrefetchWorkers)
+	var wg sync.WaitGroup
+	for i := int64(0); i < count; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int64) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			blockHash, err := chain.GetClient().RpcGetBlockHash(startNum + i)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			rpcBlocks[i], errs[i] = chain.getBlockVerbose(blockHash)
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return rpcBlocks[:i], err
+		}
+	}
+	return rpcBlocks, nil
+}
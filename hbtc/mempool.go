@@ -0,0 +1,371 @@
+package hbtc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"go-dc-wallet/omniclient"
+	"go-dc-wallet/xenv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moremorefun/mcommon"
+	"github.com/shopspring/decimal"
+)
+
+// mempoolBatchSize 每次最多处理的内存池tx数
+const mempoolBatchSize = 2000
+
+// isRpcNotFound 是否是tx不存在的错误
+func isRpcNotFound(err error) bool {
+	rpcErr, ok := err.(*omniclient.StRpcRespError)
+	return ok && rpcErr.Code == omniclient.RpcErrorCodeInvalidAddressOrKey
+}
+
+// CheckMempool 检测内存池中的未确认充币
+func CheckMempool() {
+	Btc.CheckMempool()
+}
+
+// CheckMempool 检测内存池中的未确认充币
+func (chain *Chain) CheckMempool() {
+	lockKey := chain.LockPrefix + "CheckMempool"
+	app.LockWrap(lockKey, func() {
+		mempoolTxIDs, err := chain.GetClient().RpcGetRawMempool()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		mempoolTxIDMap := make(map[string]bool)
+		for _, txID := range mempoolTxIDs {
+			mempoolTxIDMap[txID] = true
+		}
+		// 只处理新进入内存池的tx
+		seenTxIDMap := make(map[string]bool)
+		var newTxIDs []string
+		for _, txID := range mempoolTxIDs {
+			if chain.mempoolTxIDs[txID] {
+				seenTxIDMap[txID] = true
+				continue
+			}
+			if len(newTxIDs) >= mempoolBatchSize {
+				continue
+			}
+			newTxIDs = append(newTxIDs, txID)
+		}
+		// 目标地址
+		var toAddresses []string
+		type StTxWithIndex struct {
+			RPCTx *omniclient.StTxResult
+			Index int64
+		}
+		toAddressTxMap := make(map[string][]*StTxWithIndex)
+		for _, txID := range newTxIDs {
+			rpcTx, err := chain.getRawTransactionVerbose(txID)
+			if err != nil {
+				if isRpcNotFound(err) {
+					// 已经离开内存池
+					continue
+				}
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			isOmniTx := false
+			for _, vout := range rpcTx.Vout {
+				if chain.IsOmni && strings.HasPrefix(vout.ScriptPubKey.Hex, omniWithReturnHex) {
+					isOmniTx = true
+				}
+			}
+			if isOmniTx {
+				// omni 交易在 OmniCheckBlockSeek 中处理
+				continue
+			}
+			for _, vout := range rpcTx.Vout {
+				if len(vout.ScriptPubKey.Addresses) == 1 {
+					toAddress := vout.ScriptPubKey.Addresses[0]
+					if !mcommon.IsStringInSlice(toAddresses, toAddress) {
+						toAddresses = append(toAddresses, toAddress)
+					}
+					toAddressTxMap[toAddress] = append(toAddressTxMap[toAddress], &StTxWithIndex{
+						RPCTx: rpcTx,
+						Index: vout.N,
+					})
+				}
+			}
+		}
+		// 从db中查询这些地址是否是冲币地址中的地址
+		dbAddressRows, err := app.SQLSelectTAddressKeyColByAddressAndSymbol(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressKeyAddress,
+				model.DBColTAddressKeyUseTag,
+			},
+			chain.Symbol,
+			toAddresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var mempoolRows []*model.DBTTxBtcMempool
+		now := time.Now().Unix()
+		for _, dbAddressRow := range dbAddressRows {
+			if dbAddressRow.UseTag <= 0 {
+				continue
+			}
+			for _, rpcTxWithIndex := range toAddressTxMap[dbAddressRow.Address] {
+				checkVout := rpcTxWithIndex.RPCTx.Vout[rpcTxWithIndex.Index]
+				mempoolRows = append(mempoolRows, &model.DBTTxBtcMempool{
+					Symbol:       chain.Symbol,
+					ProductID:    dbAddressRow.UseTag,
+					TxID:         rpcTxWithIndex.RPCTx.Txid,
+					VoutN:        rpcTxWithIndex.Index,
+					VoutAddress:  dbAddressRow.Address,
+					VoutValue:    decimal.NewFromFloat(checkVout.Value).String(),
+					CreateTime:   now,
+					HandleStatus: app.MempoolStatusInit,
+					HandleMsg:    "",
+					HandleTime:   now,
+				})
+			}
+		}
+		_, err = model.SQLCreateManyTTxBtcMempool(
+			context.Background(),
+			xenv.DbCon,
+			mempoolRows,
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 记录已处理的tx, 已经离开内存池的tx不再记录
+		for _, txID := range newTxIDs {
+			seenTxIDMap[txID] = true
+		}
+		chain.mempoolTxIDs = seenTxIDMap
+
+		// 检测离开内存池的记录
+		pendingRows, err := app.SQLSelectTTxBtcMempoolColByStatuses(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTTxBtcMempoolID,
+				model.DBColTTxBtcMempoolTxID,
+			},
+			chain.Symbol,
+			[]int64{
+				app.MempoolStatusInit,
+				app.MempoolStatusNotify,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 同一个tx只请求一次
+		txStatusMap := make(map[string]int64)
+		var confirmIDs []int64
+		var dropIDs []int64
+		for _, pendingRow := range pendingRows {
+			if mempoolTxIDMap[pendingRow.TxID] {
+				continue
+			}
+			status, ok := txStatusMap[pendingRow.TxID]
+			if !ok {
+				rpcTx, err := chain.GetClient().RpcGetRawTransactionVerbose(pendingRow.TxID)
+				if err != nil {
+					if !isRpcNotFound(err) {
+						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						return
+					}
+					// 被替换或者双花
+					status = app.MempoolStatusDrop
+				} else if rpcTx.Confirmations > 0 {
+					status = app.MempoolStatusConfirm
+				} else {
+					// 获取内存池后才进入的tx
+					status = app.MempoolStatusInit
+				}
+				txStatusMap[pendingRow.TxID] = status
+			}
+			switch status {
+			case app.MempoolStatusConfirm:
+				confirmIDs = append(confirmIDs, pendingRow.ID)
+			case app.MempoolStatusDrop:
+				dropIDs = append(dropIDs, pendingRow.ID)
+			}
+		}
+		_, err = app.SQLUpdateTTxBtcMempoolStatusByIDs(
+			context.Background(),
+			xenv.DbCon,
+			confirmIDs,
+			model.DBTTxBtcMempool{
+				HandleStatus: app.MempoolStatusConfirm,
+				HandleMsg:    "confirmed",
+				HandleTime:   now,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = app.SQLUpdateTTxBtcMempoolStatusByIDs(
+			context.Background(),
+			xenv.DbCon,
+			dropIDs,
+			model.DBTTxBtcMempool{
+				HandleStatus: app.MempoolStatusDrop,
+				HandleMsg:    "dropped",
+				HandleTime:   now,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
+
+// CheckMempoolNotify 创建未确认充币通知
+func CheckMempoolNotify() {
+	Btc.CheckMempoolNotify()
+}
+
+// CheckMempoolNotify 创建未确认充币通知
+func (chain *Chain) CheckMempoolNotify() {
+	lockKey := chain.LockPrefix + "CheckMempoolNotify"
+	app.LockWrap(lockKey, func() {
+		mempoolRows, err := app.SQLSelectTTxBtcMempoolColByStatuses(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTTxBtcMempoolID,
+				model.DBColTTxBtcMempoolProductID,
+				model.DBColTTxBtcMempoolTxID,
+				model.DBColTTxBtcMempoolVoutAddress,
+				model.DBColTTxBtcMempoolVoutN,
+				model.DBColTTxBtcMempoolVoutValue,
+			},
+			chain.Symbol,
+			[]int64{
+				app.MempoolStatusInit,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var productIDs []int64
+		var addresses []string
+		for _, mempoolRow := range mempoolRows {
+			if !mcommon.IsIntInSlice(productIDs, mempoolRow.ProductID) {
+				productIDs = append(productIDs, mempoolRow.ProductID)
+			}
+			if !mcommon.IsStringInSlice(addresses, mempoolRow.VoutAddress) {
+				addresses = append(addresses, mempoolRow.VoutAddress)
+			}
+		}
+		productMap, err := app.SQLGetProductMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTProductID,
+				model.DBColTProductAppName,
+				model.DBColTProductCbURL,
+				model.DBColTProductAppSk,
+			},
+			productIDs,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		addressUserMap, err := app.SQLGetAddressUserMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressUserProductID,
+				model.DBColTAddressUserUserID,
+			},
+			[]string{chain.Symbol},
+			addresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var notifyMempoolIDs []int64
+		var notifyRows []*model.DBTProductNotify
+		now := time.Now().Unix()
+		for _, mempoolRow := range mempoolRows {
+			productRow, ok := productMap[mempoolRow.ProductID]
+			if !ok {
+				mcommon.Log.Warnf("no productMap: %d", mempoolRow.ProductID)
+				notifyMempoolIDs = append(notifyMempoolIDs, mempoolRow.ID)
+				continue
+			}
+			nonce := mcommon.GetUUIDStr()
+			reqObj := gin.H{
+				"tx_hash":     fmt.Sprintf("%s_%d", mempoolRow.TxID, mempoolRow.VoutN),
+				"app_name":    productRow.AppName,
+				"address":     mempoolRow.VoutAddress,
+				"balance":     mempoolRow.VoutValue,
+				"symbol":      chain.GetCoinSymbol(),
+				"notify_type": app.NotifyTypeTxPending,
+			}
+			if userID := app.GetAddressUserID(addressUserMap, mempoolRow.ProductID, mempoolRow.VoutAddress); userID != "" {
+				reqObj["user_id"] = userID
+			}
+			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
+			req, err := json.Marshal(reqObj)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+			notifyRows = append(notifyRows, &model.DBTProductNotify{
+				Nonce:        nonce,
+				ProductID:    mempoolRow.ProductID,
+				ItemType:     app.SendRelationTypeTxMempool,
+				ItemID:       mempoolRow.ID,
+				NotifyType:   app.NotifyTypeTxPending,
+				TokenSymbol:  chain.GetCoinSymbol(),
+				URL:          productRow.CbURL,
+				Msg:          string(req),
+				HandleStatus: app.NotifyStatusInit,
+				HandleMsg:    "",
+				CreateTime:   now,
+				UpdateTime:   now,
+			})
+			notifyMempoolIDs = append(notifyMempoolIDs, mempoolRow.ID)
+		}
+		_, err = model.SQLCreateManyTProductNotify(
+			context.Background(),
+			xenv.DbCon,
+			notifyRows,
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = app.SQLUpdateTTxBtcMempoolStatusByIDs(
+			context.Background(),
+			xenv.DbCon,
+			notifyMempoolIDs,
+			model.DBTTxBtcMempool{
+				HandleStatus: app.MempoolStatusNotify,
+				HandleMsg:    "notify",
+				HandleTime:   now,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
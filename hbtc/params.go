@@ -0,0 +1,88 @@
+package hbtc
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/moremorefun/mcommon"
+)
+
+// 网络参数只用于地址编码和交易签名, 不做共识校验
+
+// SigNetParams signet 网络参数
+// btcd 暂未内置 signet, 地址编码与 testnet3 一致
+var SigNetParams = newSigNetParams()
+
+// LtcMainNetParams ltc 主网
+var LtcMainNetParams = newForkParams(&chaincfg.MainNetParams, "ltc-mainnet", 0xdbb6c0fb, "9333", 0x30, 0x32, 0xb0, "ltc", 2)
+
+// LtcTestNetParams ltc 测试网 testnet4
+var LtcTestNetParams = newForkParams(&chaincfg.TestNet3Params, "ltc-testnet4", 0xf1c8d2fd, "19335", 0x6f, 0x3a, 0xef, "tltc", 1)
+
+// LtcRegTestParams ltc regtest
+var LtcRegTestParams = newForkParams(&chaincfg.RegressionNetParams, "ltc-regtest", 0xdab5bffa, "19444", 0x6f, 0x3a, 0xef, "rltc", 1)
+
+// DogeMainNetParams doge 主网
+var DogeMainNetParams = newForkParams(&chaincfg.MainNetParams, "doge-mainnet", 0xc0c0c0c0, "22556", 0x1e, 0x16, 0x9e, "", 3)
+
+// DogeTestNetParams doge 测试网
+var DogeTestNetParams = newForkParams(&chaincfg.TestNet3Params, "doge-testnet", 0xdcb7c1fc, "44556", 0x71, 0xc4, 0xf1, "", 1)
+
+// DogeRegTestParams doge regtest
+var DogeRegTestParams = newForkParams(&chaincfg.RegressionNetParams, "doge-regtest", 0xdab5bffa, "18444", 0x6f, 0xc4, 0xef, "", 1)
+
+// BchMainNetParams bch 主网 地址使用 cashaddr 编码
+var BchMainNetParams = newForkParams(&chaincfg.MainNetParams, "bch-mainnet", 0xe8f3e1e3, "8333", 0x00, 0x05, 0x80, "", 145)
+
+// BchTestNetParams bch 测试网
+var BchTestNetParams = newForkParams(&chaincfg.TestNet3Params, "bch-testnet", 0xf4f3e5f4, "18333", 0x6f, 0xc4, 0xef, "", 1)
+
+// BchRegTestParams bch regtest
+var BchRegTestParams = newForkParams(&chaincfg.RegressionNetParams, "bch-regtest", 0xdab5bffa, "18444", 0x6f, 0xc4, 0xef, "", 1)
+
+func init() {
+	err := chaincfg.Register(&SigNetParams)
+	if err != nil {
+		mcommon.Log.Fatalf("register signet params err: [%T] %s", err, err.Error())
+	}
+}
+
+// newSigNetParams 生成 signet 网络参数
+func newSigNetParams() chaincfg.Params {
+	genesisBlock := *chaincfg.TestNet3Params.GenesisBlock
+	genesisBlock.Header.Timestamp = time.Unix(1598918400, 0)
+	genesisBlock.Header.Bits = 0x1e0377ae
+	genesisBlock.Header.Nonce = 52613770
+	genesisHash := genesisBlock.BlockHash()
+
+	params := chaincfg.TestNet3Params
+	params.Name = "signet"
+	params.Net = wire.BitcoinNet(0x40cf030a)
+	params.DefaultPort = "38333"
+	params.DNSSeeds = []chaincfg.DNSSeed{
+		{Host: "seed.signet.bitcoin.sprovoost.nl", HasFiltering: false},
+	}
+	params.GenesisBlock = &genesisBlock
+	params.GenesisHash = &genesisHash
+	params.Checkpoints = nil
+	return params
+}
+
+// newForkParams 生成分叉链网络参数
+func newForkParams(base *chaincfg.Params, name string, net uint32, port string, pubKeyHashAddrID, scriptHashAddrID, privateKeyID byte, bech32HRPSegwit string, hdCoinType uint32) chaincfg.Params {
+	params := *base
+	params.Name = name
+	params.Net = wire.BitcoinNet(net)
+	params.DefaultPort = port
+	params.DNSSeeds = nil
+	params.GenesisBlock = nil
+	params.GenesisHash = nil
+	params.Checkpoints = nil
+	params.Bech32HRPSegwit = bech32HRPSegwit
+	params.PubKeyHashAddrID = pubKeyHashAddrID
+	params.ScriptHashAddrID = scriptHashAddrID
+	params.PrivateKeyID = privateKeyID
+	params.HDCoinType = hdCoinType
+	return params
+}
@@ -0,0 +1,115 @@
+package heos
+
+import (
+	"fmt"
+	"go-dc-wallet/eosclient"
+	"go-dc-wallet/xenv"
+)
+
+// Chain antelope 链配置
+type Chain struct {
+	Symbol        string // 币种 同时是原生代币的币种
+	LockPrefix    string // 任务锁前缀
+	TokenAddress  string // 原生代币合约
+	TokenSymbol   string // 原生代币符号
+	TokenDecimals int64  // 原生代币精度
+
+	GetEnable  func() bool
+	GetChainID func() string // 配置的链id 为空时不检测
+	GetClient  func() *eosclient.Client
+}
+
+// Eos eos
+var Eos = &Chain{
+	Symbol:        CoinSymbol,
+	LockPrefix:    "Eos",
+	TokenAddress:  EosTokenAddress,
+	TokenSymbol:   EosTokenSymbol,
+	TokenDecimals: EosTokenDecimals,
+	GetEnable: func() bool {
+		return xenv.Cfg.EosEnable
+	},
+	GetChainID: func() string {
+		return xenv.Cfg.EosChainID
+	},
+	GetClient: eosclient.DefaultClient,
+}
+
+// Wax wax
+var Wax = &Chain{
+	Symbol:        "wax",
+	LockPrefix:    "Wax",
+	TokenAddress:  "eosio.token",
+	TokenSymbol:   "WAX",
+	TokenDecimals: 8,
+	GetEnable: func() bool {
+		return xenv.Cfg.WaxEnable
+	},
+	GetChainID: func() string {
+		return xenv.Cfg.WaxChainID
+	},
+	GetClient: func() *eosclient.Client {
+		return eosclient.NewClient(xenv.Cfg.WaxRPC, xenv.Cfg.WaxTraceRPC)
+	},
+}
+
+// Tlos telos
+var Tlos = &Chain{
+	Symbol:        "tlos",
+	LockPrefix:    "Tlos",
+	TokenAddress:  "eosio.token",
+	TokenSymbol:   "TLOS",
+	TokenDecimals: 4,
+	GetEnable: func() bool {
+		return xenv.Cfg.TlosEnable
+	},
+	GetChainID: func() string {
+		return xenv.Cfg.TlosChainID
+	},
+	GetClient: func() *eosclient.Client {
+		return eosclient.NewClient(xenv.Cfg.TlosRPC, xenv.Cfg.TlosTraceRPC)
+	},
+}
+
+// Chains 所有antelope链
+var Chains = []*Chain{Eos, Wax, Tlos}
+
+// GetChain 根据币种获取链
+func GetChain(symbol string) *Chain {
+	for _, chain := range Chains {
+		if chain.Symbol == symbol {
+			return chain
+		}
+	}
+	return nil
+}
+
+// GetEnableChains 获取开启的链
+func GetEnableChains() []*Chain {
+	var chains []*Chain
+	for _, chain := range Chains {
+		if chain.GetEnable() {
+			chains = append(chains, chain)
+		}
+	}
+	return chains
+}
+
+// checkChainID 检测节点的链id是否和配置一致
+func (chain *Chain) checkChainID(rpcChainInfo *eosclient.StChainGetInfo) error {
+	chainID := chain.GetChainID()
+	if chainID != "" && chainID != rpcChainInfo.ChainID {
+		return fmt.Errorf("%s chain id error: %s", chain.Symbol, rpcChainInfo.ChainID)
+	}
+	return nil
+}
+
+// prefixKey 配置键 例如 eos_seek_num
+func (chain *Chain) prefixKey(k string) string {
+	return fmt.Sprintf("%s_%s", chain.Symbol, k)
+}
+
+// suffixKey 配置键 例如 hot_wallet_address_eos
+func (chain *Chain) suffixKey(k string) string {
+	return fmt.Sprintf("%s_%s", k, chain.Symbol)
+}
@@ -2,7 +2,6 @@ package heos
 
 import (
 	"context"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"go-dc-wallet/app"
@@ -21,7 +20,12 @@ import (
 
 // CheckAddressFree 检测剩余地址数
 func CheckAddressFree() {
-	lockKey := "EosCheckAddressFree"
+	Eos.CheckAddressFree()
+}
+
+// CheckAddressFree 检测剩余地址数
+func (chain *Chain) CheckAddressFree() {
+	lockKey := chain.LockPrefix + "CheckAddressFree"
 	app.LockWrap(lockKey, func() {
 		// 获取配置 允许的最小剩余地址数
 		minFreeValue, err := app.SQLGetTAppConfigIntValueByK(
@@ -37,7 +41,7 @@ func CheckAddressFree() {
 		freeCount, err := app.SQLGetTAddressKeyFreeCount(
 			context.Background(),
 			xenv.DbCon,
-			CoinSymbol,
+			chain.Symbol,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -50,6 +54,7 @@ func CheckAddressFree() {
 			maxAddress, err := app.SQLGetTAddressMaxIntOfEos(
 				context.Background(),
 				xenv.DbCon,
+				chain.Symbol,
 			)
 			if maxAddress < MiniAddress {
 				maxAddress = MiniAddress
@@ -59,7 +64,7 @@ func CheckAddressFree() {
 			for i := int64(0); i < minFreeValue-freeCount; i++ {
 				// 存入待添加队列
 				rows = append(rows, &model.DBTAddressKey{
-					Symbol:  CoinSymbol,
+					Symbol:  chain.Symbol,
 					Address: fmt.Sprintf("%d", maxAddress+i),
 					Pwd:     "",
 					UseTag:  0,
@@ -82,291 +87,433 @@ func CheckAddressFree() {
 
 // CheckBlockSeek 检测到账
 func CheckBlockSeek() {
-	lockKey := "EosCheckBlockSeek"
+	Eos.CheckBlockSeek()
+}
+
+// CheckBlockSeek 检测到账
+// 不可逆块中的充币为已确认, 不可逆块之后的充币为未确认
+// 未确认的充币在块不可逆后确认, 所在块被回滚时标记为回滚
+func (chain *Chain) CheckBlockSeek() {
+	lockKey := chain.LockPrefix + "CheckBlockSeek"
 	app.LockWrap(lockKey, func() {
 		// 获取状态 当前处理完成的最新的block number
 		seekValue, err := app.SQLGetTAppStatusIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"eos_seek_num",
+			chain.prefixKey("seek_num"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
-		rpcChainInfo, err := eosclient.RpcChainGetInfo()
+		rpcChainInfo, err := chain.GetClient().RpcChainGetInfo()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		err = chain.checkChainID(rpcChainInfo)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
+		// 获取冷钱包地址
+		eosColdAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+			context.Background(),
+			xenv.DbCon,
+			chain.suffixKey("cold_wallet_address"),
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 获取所有代币 合约:符号 -> 币种
+		tokenRows, err := chain.GetTokenRows(
+			context.Background(),
+			xenv.DbCon,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var tokenSymbols []string
+		tokenSymbolMap := make(map[string]string)
+		for _, tokenRow := range tokenRows {
+			tokenSymbols = append(tokenSymbols, chain.GetTokenSymbol(tokenRow))
+			tokenSymbolMap[tokenRow.TokenAddress+":"+tokenRow.TokenSymbol] = chain.GetTokenSymbol(tokenRow)
+		}
+		// 不可逆块
 		startI := seekValue + 1
 		endI := rpcChainInfo.LastIrreversibleBlockNum + 1
-		mcommon.Log.Debugf("eos check block: %d->%d", startI, endI)
-		if startI < endI {
-			// 获取冷钱包地址
-			eosColdAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+		mcommon.Log.Debugf("%s check block: %d->%d", chain.Symbol, startI, endI)
+		for i := startI; i < endI; i++ {
+			mcommon.Log.Debugf("%s check block: %d", chain.Symbol, i)
+			rpcBlock, txRows, err := chain.getBlockTxRows(i, eosColdAddressValue, tokenSymbolMap)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			err = chain.confirmBlockTxRows(rpcBlock, txRows, tokenSymbols)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 更新block num
+			_, err = app.SQLUpdateTAppStatusIntByKGreater(
 				context.Background(),
 				xenv.DbCon,
-				"cold_wallet_address_eos",
+				&model.DBTAppStatusInt{
+					K: chain.prefixKey("seek_num"),
+					V: i,
+				},
 			)
+			if err != nil {
+				mcommon.Log.Errorf("SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		// 不可逆块之后的块
+		headSeekValue, err := getStatusIntDefault(chain.prefixKey("head_seek_num"), 0)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if headSeekValue < rpcChainInfo.LastIrreversibleBlockNum {
+			headSeekValue = rpcChainInfo.LastIrreversibleBlockNum
+		}
+		headStartI := headSeekValue + 1
+		headEndI := rpcChainInfo.HeadBlockNum + 1
+		mcommon.Log.Debugf("%s check head block: %d->%d", chain.Symbol, headStartI, headEndI)
+		for i := headStartI; i < headEndI; i++ {
+			_, txRows, err := chain.getBlockTxRows(i, eosColdAddressValue, tokenSymbolMap)
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 				return
 			}
-			// 遍历获取需要查询的block信息
-			now := time.Now().Unix()
-			for i := startI; i < endI; i++ {
-				mcommon.Log.Debugf("eos check block: %d", i)
-				rpcBlock, err := eosclient.RpcChainGetBlock(i)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
-				}
-				var memos []string
-				type stAction struct {
-					rpcTrx        eosclient.StTransactionTrx
-					rpcActionData eosclient.StActionData
-					actionIndex   int64
-				}
-				memosMap := make(map[string][]stAction)
-				for _, rpcTransaction := range rpcBlock.Transactions {
-					if rpcTransaction.Status != "executed" {
-						continue
-					}
-					var rpcTrx eosclient.StTransactionTrx
-					err := json.Unmarshal(rpcTransaction.Trx, &rpcTrx)
+			err = chain.saveHeadTxRows(txRows)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			err = setStatusInt(chain.prefixKey("head_seek_num"), i)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+	})
+}
+
+// getBlockTxRows 获取块中的充币
+func (chain *Chain) getBlockTxRows(blockNum int64, eosColdAddressValue string, tokenSymbolMap map[string]string) (*eosclient.StTraceBlock, []*model.DBTTxEos, error) {
+	// 通过 trace 获取block, 包含合约调用产生的 inline action
+	rpcBlock, err := chain.GetClient().RpcTraceGetBlock(blockNum)
+	if err != nil {
+		return nil, nil, err
+	}
+	var memos []string
+	type stAction struct {
+		txHash        string
+		symbol        string
+		rpcActionData eosclient.StActionData
+		actionIndex   int64
+	}
+	memosMap := make(map[string][]stAction)
+	for _, rpcTrx := range rpcBlock.Transactions {
+		if rpcTrx.Status != "executed" {
+			continue
+		}
+		// action 按照执行顺序排列, 跳过通知后的序号在没有 inline action 时和顶层 action 的序号一致
+		actionIndex := int64(-1)
+		for _, rpcAction := range rpcTrx.Actions {
+			if rpcAction.Receiver != rpcAction.Account {
+				// from 和 to 收到的通知, 和原始 action 重复
+				continue
+			}
+			actionIndex++
+			if rpcAction.Action != "transfer" {
+				continue
+			}
+			if len(rpcAction.Params) == 0 {
+				// 没有abi的合约 参数无法解析
+				continue
+			}
+			var rpcActionData eosclient.StActionData
+			err := json.Unmarshal(rpcAction.Params, &rpcActionData)
+			if err != nil {
+				// 其他合约的同名action 参数格式不同 不是转账
+				mcommon.Log.Debugf("%s:%d %s transfer params err: %s", rpcTrx.ID[:5], actionIndex, rpcAction.Account, err.Error())
+				continue
+			}
+			if rpcActionData.Quantity != "" {
+				if eosColdAddressValue != "" && rpcActionData.To == eosColdAddressValue {
+					mcommon.Log.Debugf(
+						"%s:%d %s->%s: memo:%s value:%s",
+						rpcTrx.ID[:5],
+						actionIndex,
+						rpcActionData.From,
+						rpcActionData.To,
+						rpcActionData.Memo,
+						rpcActionData.Quantity,
+					)
+					quantity, quantitySymbol, err := SplitQuantity(rpcActionData.Quantity)
 					if err != nil {
-						_, ok := err.(*json.UnmarshalTypeError)
-						if !ok {
-							mcommon.Log.Debugf("err: [%T] %s", err, err.Error())
-							return
-						} else {
-							continue
-						}
-					}
-					if rpcTrx.Transaction.DelaySec != 0 {
+						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 						continue
 					}
-					for i, rpcAction := range rpcTrx.Transaction.Actions {
-						if rpcAction.Account != "eosio.token" {
-							continue
-						}
-						if rpcAction.Name != "transfer" {
-							continue
-						}
-						var rpcActionData eosclient.StActionData
-						err := json.Unmarshal(rpcAction.Data, &rpcActionData)
-						if err != nil {
-							_, ok := err.(*json.UnmarshalTypeError)
-							if !ok {
-								mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-								return
-							} else {
-								continue
-							}
-						}
-						if rpcActionData.Quantity != "" {
-							if eosColdAddressValue != "" && rpcActionData.To == eosColdAddressValue {
-								mcommon.Log.Debugf(
-									"%s:%d %s->%s: memo:%s value:%s",
-									rpcTrx.ID[:5],
-									i,
-									rpcActionData.From,
-									rpcActionData.To,
-									rpcActionData.Memo,
-									rpcActionData.Quantity,
-								)
-								// 打款到冷钱包
-								if !mcommon.IsStringInSlice(memos, rpcActionData.Memo) {
-									memos = append(memos, rpcActionData.Memo)
-								}
-								rpcActionData.Quantity, err = EosValueToStr(rpcActionData.Quantity)
-								if err != nil {
-									mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-									return
-								}
-								memosMap[rpcActionData.Memo] = append(
-									memosMap[rpcActionData.Memo],
-									stAction{
-										rpcTrx:        rpcTrx,
-										rpcActionData: rpcActionData,
-										actionIndex:   int64(i),
-									},
-								)
-							}
-						}
+					// 只处理配置过的合约和代币, 防止同名假币
+					symbol, ok := tokenSymbolMap[rpcAction.Account+":"+quantitySymbol]
+					if !ok {
+						continue
 					}
-				}
-				// 从db中查询这些地址是否是冲币地址中的地址
-				dbAddressRows, err := app.SQLSelectTAddressKeyColByAddress(
-					context.Background(),
-					xenv.DbCon,
-					[]string{
-						model.DBColTAddressKeyAddress,
-						model.DBColTAddressKeyUseTag,
-					},
-					memos,
-				)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
-				}
-				var txRows []*model.DBTTxEos
-				for _, dbAddressRow := range dbAddressRows {
-					tActions := memosMap[dbAddressRow.Address]
-					for _, tAction := range tActions {
-						txRows = append(
-							txRows,
-							&model.DBTTxEos{
-								ProductID:    dbAddressRow.UseTag,
-								TxHash:       tAction.rpcTrx.ID,
-								LogIndex:     tAction.actionIndex,
-								FromAddress:  tAction.rpcActionData.From,
-								ToAddress:    tAction.rpcActionData.To,
-								Memo:         tAction.rpcActionData.Memo,
-								BalanceReal:  tAction.rpcActionData.Quantity,
-								CreateAt:     now,
-								HandleStatus: 0,
-								HandleMsg:    "",
-								HandleAt:     now,
-							},
-						)
+					// 打款到冷钱包
+					if !mcommon.IsStringInSlice(memos, rpcActionData.Memo) {
+						memos = append(memos, rpcActionData.Memo)
 					}
-				}
-				_, err = model.SQLCreateManyTTxEos(
-					context.Background(),
-					xenv.DbCon,
-					txRows,
-					true,
-				)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
-				}
-				// 更新block num
-				_, err = app.SQLUpdateTAppStatusIntByKGreater(
-					context.Background(),
-					xenv.DbCon,
-					&model.DBTAppStatusInt{
-						K: "eos_seek_num",
-						V: i,
-					},
-				)
-				if err != nil {
-					mcommon.Log.Errorf("SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
-					return
+					rpcActionData.Quantity = quantity
+					memosMap[rpcActionData.Memo] = append(
+						memosMap[rpcActionData.Memo],
+						stAction{
+							txHash:        rpcTrx.ID,
+							symbol:        symbol,
+							rpcActionData: rpcActionData,
+							actionIndex:   actionIndex,
+						},
+					)
 				}
 			}
 		}
-	})
+	}
+	// 从db中查询这些地址是否是冲币地址中的地址
+	dbAddressRows, err := app.SQLSelectTAddressKeyColByAddressAndSymbol(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTAddressKeyAddress,
+			model.DBColTAddressKeyUseTag,
+		},
+		chain.Symbol,
+		memos,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now().Unix()
+	var txRows []*model.DBTTxEos
+	for _, dbAddressRow := range dbAddressRows {
+		tActions := memosMap[dbAddressRow.Address]
+		for _, tAction := range tActions {
+			txRows = append(
+				txRows,
+				&model.DBTTxEos{
+					ProductID:    dbAddressRow.UseTag,
+					Symbol:       tAction.symbol,
+					TxHash:       tAction.txHash,
+					LogIndex:     tAction.actionIndex,
+					FromAddress:  tAction.rpcActionData.From,
+					ToAddress:    tAction.rpcActionData.To,
+					Memo:         tAction.rpcActionData.Memo,
+					BalanceReal:  tAction.rpcActionData.Quantity,
+					CreateAt:     now,
+					HandleStatus: app.TxStatusInit,
+					HandleMsg:    "",
+					HandleAt:     now,
+					BlockNum:     blockNum,
+					BlockID:      rpcBlock.ID,
+				},
+			)
+		}
+	}
+	return rpcBlock, txRows, nil
 }
 
-// CheckTxNotify 创建冲币通知
-func CheckTxNotify() {
-	lockKey := "EosCheckTxNotify"
-	app.LockWrap(lockKey, func() {
-		txRows, err := app.SQLSelectTTxEosColByStatus(
+// saveHeadTxRows 保存不可逆块之后的充币
+// 交易因为分叉被打包到其他块时 更新记录的块信息, 避免在原块高被误判为回滚
+func (chain *Chain) saveHeadTxRows(txRows []*model.DBTTxEos) error {
+	if len(txRows) == 0 {
+		return nil
+	}
+	var txHashes []string
+	for _, txRow := range txRows {
+		if !mcommon.IsStringInSlice(txHashes, txRow.TxHash) {
+			txHashes = append(txHashes, txRow.TxHash)
+		}
+	}
+	existRows, err := app.SQLSelectTTxEosColByTxHashes(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTTxEosID,
+			model.DBColTTxEosTxHash,
+			model.DBColTTxEosLogIndex,
+			model.DBColTTxEosBlockID,
+			model.DBColTTxEosHandleStatus,
+		},
+		txHashes,
+	)
+	if err != nil {
+		return err
+	}
+	existMap := make(map[string]*model.DBTTxEos)
+	for _, existRow := range existRows {
+		existMap[fmt.Sprintf("%s_%d", existRow.TxHash, existRow.LogIndex)] = existRow
+	}
+	now := time.Now().Unix()
+	var createRows []*model.DBTTxEos
+	for _, txRow := range txRows {
+		txRow.HandleStatus = app.TxStatusPending
+		existRow, ok := existMap[fmt.Sprintf("%s_%d", txRow.TxHash, txRow.LogIndex)]
+		if !ok {
+			createRows = append(createRows, txRow)
+			continue
+		}
+		if existRow.BlockID == txRow.BlockID {
+			continue
+		}
+		handleStatus := existRow.HandleStatus
+		if handleStatus == app.TxStatusInit || handleStatus == app.TxStatusNotify {
+			// 已经确认
+			continue
+		}
+		if handleStatus == app.TxStatusRevert || handleStatus == app.TxStatusRevertNotify {
+			// 回滚后重新打包
+			handleStatus = app.TxStatusPending
+		}
+		mcommon.Log.Warnf("%s tx %s moved from block %s to %d %s", chain.Symbol, txRow.TxHash, existRow.BlockID, txRow.BlockNum, txRow.BlockID)
+		_, err = app.SQLUpdateTTxEosBlockByID(
 			context.Background(),
 			xenv.DbCon,
-			[]string{
-				model.DBColTTxEosID,
-				model.DBColTTxEosProductID,
-				model.DBColTTxEosTxHash,
-				model.DBColTTxEosLogIndex,
-				model.DBColTTxEosFromAddress,
-				model.DBColTTxEosToAddress,
-				model.DBColTTxEosMemo,
-				model.DBColTTxEosBalanceReal,
+			&model.DBTTxEos{
+				ID:           existRow.ID,
+				BlockNum:     txRow.BlockNum,
+				BlockID:      txRow.BlockID,
+				HandleStatus: handleStatus,
+				HandleMsg:    "",
+				HandleAt:     now,
 			},
-			app.TxStatusInit,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-			return
+			return err
 		}
-		var productIDs []int64
-		for _, txRow := range txRows {
-			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
-				productIDs = append(productIDs, txRow.ProductID)
-			}
+	}
+	_, err = model.SQLCreateManyTTxEos(
+		context.Background(),
+		xenv.DbCon,
+		createRows,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// confirmBlockTxRows 保存不可逆块中的充币
+// 已经记录的未确认充币更新为确认, 块高度不超过当前块仍未确认的充币标记为回滚
+func (chain *Chain) confirmBlockTxRows(rpcBlock *eosclient.StTraceBlock, txRows []*model.DBTTxEos, tokenSymbols []string) error {
+	var txHashes []string
+	for _, txRow := range txRows {
+		if !mcommon.IsStringInSlice(txHashes, txRow.TxHash) {
+			txHashes = append(txHashes, txRow.TxHash)
 		}
-		productMap, err := app.SQLGetProductMap(
+	}
+	existRows, err := app.SQLSelectTTxEosColByTxHashes(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTTxEosID,
+			model.DBColTTxEosTxHash,
+			model.DBColTTxEosLogIndex,
+			model.DBColTTxEosBlockID,
+			model.DBColTTxEosHandleStatus,
+		},
+		txHashes,
+	)
+	if err != nil {
+		return err
+	}
+	existMap := make(map[string]*model.DBTTxEos)
+	for _, existRow := range existRows {
+		existMap[fmt.Sprintf("%s_%d", existRow.TxHash, existRow.LogIndex)] = existRow
+	}
+	now := time.Now().Unix()
+	var createRows []*model.DBTTxEos
+	for _, txRow := range txRows {
+		existRow, ok := existMap[fmt.Sprintf("%s_%d", txRow.TxHash, txRow.LogIndex)]
+		if !ok {
+			createRows = append(createRows, txRow)
+			continue
+		}
+		if existRow.HandleStatus == app.TxStatusInit || existRow.HandleStatus == app.TxStatusNotify {
+			// 已经确认
+			continue
+		}
+		if existRow.BlockID != rpcBlock.ID {
+			// 检测到时所在的块已被分叉替换 交易被打包到了不可逆块中
+			mcommon.Log.Warnf("%s tx %s confirmed in block %d %s, found in %s", chain.Symbol, txRow.TxHash, rpcBlock.Number, rpcBlock.ID, existRow.BlockID)
+		}
+		_, err = app.SQLUpdateTTxEosBlockByID(
 			context.Background(),
 			xenv.DbCon,
-			[]string{
-				model.DBColTProductID,
-				model.DBColTProductAppName,
-				model.DBColTProductCbURL,
-				model.DBColTProductAppSk,
+			&model.DBTTxEos{
+				ID:           existRow.ID,
+				BlockNum:     rpcBlock.Number,
+				BlockID:      rpcBlock.ID,
+				HandleStatus: app.TxStatusInit,
+				HandleMsg:    "",
+				HandleAt:     now,
 			},
-			productIDs,
 		)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = model.SQLCreateManyTTxEos(
+		context.Background(),
+		xenv.DbCon,
+		createRows,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	// 所在块被回滚
+	count, err := app.SQLUpdateTTxEosRevertByBlockNum(
+		context.Background(),
+		xenv.DbCon,
+		tokenSymbols,
+		rpcBlock.Number,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		mcommon.Log.Warnf("%s revert tx count: %d at block: %d", chain.Symbol, count, rpcBlock.Number)
+	}
+	return nil
+}
+
+// CheckTxNotify 创建冲币通知
+func CheckTxNotify() {
+	Eos.CheckTxNotify()
+}
+
+// CheckTxNotify 创建冲币通知
+// 未确认的充币创建检测到充币通知, 确认的充币创建到账通知, 回滚的充币创建回滚通知
+func (chain *Chain) CheckTxNotify() {
+	lockKey := chain.LockPrefix + "CheckTxNotify"
+	app.LockWrap(lockKey, func() {
+		err := chain.createTxNotify(app.TxStatusPending, app.TxStatusPendingNotify, app.NotifyTypeTxPending)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
-		var notifyTxIDs []int64
-		var notifyRows []*model.DBTProductNotify
-		now := time.Now().Unix()
-		for _, txRow := range txRows {
-			productRow, ok := productMap[txRow.ProductID]
-			if !ok {
-				mcommon.Log.Warnf("no productMap: %d", txRow.ProductID)
-				notifyTxIDs = append(notifyTxIDs, txRow.ID)
-				continue
-			}
-			nonce := mcommon.GetUUIDStr()
-			reqObj := gin.H{
-				"tx_hash":     fmt.Sprintf("%s_%d", txRow.TxHash, txRow.LogIndex),
-				"app_name":    productRow.AppName,
-				"address":     txRow.ToAddress,
-				"memo":        txRow.Memo,
-				"balance":     txRow.BalanceReal,
-				"symbol":      CoinSymbol,
-				"notify_type": app.NotifyTypeTx,
-			}
-			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
-			req, err := json.Marshal(reqObj)
-			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-				continue
-			}
-			notifyRows = append(notifyRows, &model.DBTProductNotify{
-				Nonce:        nonce,
-				ProductID:    txRow.ProductID,
-				ItemType:     app.SendRelationTypeTx,
-				ItemID:       txRow.ID,
-				NotifyType:   app.NotifyTypeTx,
-				TokenSymbol:  CoinSymbol,
-				URL:          productRow.CbURL,
-				Msg:          string(req),
-				HandleStatus: app.NotifyStatusInit,
-				HandleMsg:    "",
-				CreateTime:   now,
-				UpdateTime:   now,
-			})
-			notifyTxIDs = append(notifyTxIDs, txRow.ID)
-		}
-		_, err = model.SQLCreateManyTProductNotify(
-			context.Background(),
-			xenv.DbCon,
-			notifyRows,
-			true,
-		)
+		err = chain.createTxNotify(app.TxStatusInit, app.TxStatusNotify, app.NotifyTypeTx)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
-		_, err = app.SQLUpdateTTxEosStatusByIDs(
-			context.Background(),
-			xenv.DbCon,
-			notifyTxIDs,
-			model.DBTTxEos{
-				HandleStatus: app.TxStatusNotify,
-				HandleMsg:    "notify",
-				HandleAt:     now,
-			},
-		)
+		err = chain.createTxNotify(app.TxStatusRevert, app.TxStatusRevertNotify, app.NotifyTypeTxRevert)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
@@ -374,19 +521,178 @@ func CheckTxNotify() {
 	})
 }
 
+// createTxNotify 为指定状态的充币创建通知并更新状态
+func (chain *Chain) createTxNotify(status int64, notifyStatus int64, notifyType int64) error {
+	// 获取所有代币币种
+	tokenSymbols, err := chain.GetTokenSymbols(
+		context.Background(),
+		xenv.DbCon,
+	)
+	if err != nil {
+		return err
+	}
+	txRows, err := app.SQLSelectTTxEosColByStatus(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTTxEosID,
+			model.DBColTTxEosProductID,
+			model.DBColTTxEosSymbol,
+			model.DBColTTxEosTxHash,
+			model.DBColTTxEosLogIndex,
+			model.DBColTTxEosFromAddress,
+			model.DBColTTxEosToAddress,
+			model.DBColTTxEosMemo,
+			model.DBColTTxEosBalanceReal,
+		},
+		status,
+		tokenSymbols,
+	)
+	if err != nil {
+		return err
+	}
+	var productIDs []int64
+	var addresses []string
+	for _, txRow := range txRows {
+		if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
+			productIDs = append(productIDs, txRow.ProductID)
+		}
+		if !mcommon.IsStringInSlice(addresses, txRow.Memo) {
+			addresses = append(addresses, txRow.Memo)
+		}
+	}
+	productMap, err := app.SQLGetProductMap(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTProductID,
+			model.DBColTProductAppName,
+			model.DBColTProductCbURL,
+			model.DBColTProductAppSk,
+		},
+		productIDs,
+	)
+	if err != nil {
+		return err
+	}
+	addressUserMap, err := app.SQLGetAddressUserMap(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTAddressUserProductID,
+			model.DBColTAddressUserUserID,
+		},
+		[]string{chain.Symbol},
+		addresses,
+	)
+	if err != nil {
+		return err
+	}
+	var notifyTxIDs []int64
+	var notifyRows []*model.DBTProductNotify
+	now := time.Now().Unix()
+	for _, txRow := range txRows {
+		productRow, ok := productMap[txRow.ProductID]
+		if !ok {
+			mcommon.Log.Warnf("no productMap: %d", txRow.ProductID)
+			notifyTxIDs = append(notifyTxIDs, txRow.ID)
+			continue
+		}
+		nonce := mcommon.GetUUIDStr()
+		reqObj := gin.H{
+			"tx_hash":     fmt.Sprintf("%s_%d", txRow.TxHash, txRow.LogIndex),
+			"app_name":    productRow.AppName,
+			"address":     txRow.ToAddress,
+			"memo":        txRow.Memo,
+			"balance":     txRow.BalanceReal,
+			"symbol":      txRow.Symbol,
+			"notify_type": notifyType,
+		}
+		if userID := app.GetAddressUserID(addressUserMap, txRow.ProductID, txRow.Memo); userID != "" {
+			reqObj["user_id"] = userID
+		}
+		reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
+		req, err := json.Marshal(reqObj)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			continue
+		}
+		notifyRows = append(notifyRows, &model.DBTProductNotify{
+			Nonce:        nonce,
+			ProductID:    txRow.ProductID,
+			ItemType:     app.SendRelationTypeTx,
+			ItemID:       txRow.ID,
+			NotifyType:   notifyType,
+			TokenSymbol:  txRow.Symbol,
+			URL:          productRow.CbURL,
+			Msg:          string(req),
+			HandleStatus: app.NotifyStatusInit,
+			HandleMsg:    "",
+			CreateTime:   now,
+			UpdateTime:   now,
+		})
+		notifyTxIDs = append(notifyTxIDs, txRow.ID)
+	}
+	_, err = model.SQLCreateManyTProductNotify(
+		context.Background(),
+		xenv.DbCon,
+		notifyRows,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	_, err = app.SQLUpdateTTxEosStatusByIDsAndStatus(
+		context.Background(),
+		xenv.DbCon,
+		notifyTxIDs,
+		status,
+		model.DBTTxEos{
+			HandleStatus: notifyStatus,
+			HandleMsg:    "notify",
+			HandleAt:     now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // CheckWithdraw 检测提现
 func CheckWithdraw() {
-	lockKey := "EosCheckWithdraw"
+	Eos.CheckWithdraw()
+}
+
+// CheckWithdraw 检测提现
+func (chain *Chain) CheckWithdraw() {
+	lockKey := chain.LockPrefix + "CheckWithdraw"
 	app.LockWrap(lockKey, func() {
+		// 获取所有代币
+		tokenRows, err := chain.GetTokenRows(
+			context.Background(),
+			xenv.DbCon,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var tokenSymbols []string
+		tokenMap := make(map[string]*model.DBTAppConfigTokenEos)
+		for _, tokenRow := range tokenRows {
+			tokenSymbols = append(tokenSymbols, chain.GetTokenSymbol(tokenRow))
+			tokenMap[chain.GetTokenSymbol(tokenRow)] = tokenRow
+		}
 		// 获取需要处理的提币数据
 		withdrawRows, err := app.SQLSelectTWithdrawColByStatus(
 			context.Background(),
 			xenv.DbCon,
 			[]string{
 				model.DBColTWithdrawID,
+				model.DBColTWithdrawSymbol,
 			},
 			app.WithdrawStatusInit,
-			[]string{CoinSymbol},
+			tokenSymbols,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -400,67 +706,56 @@ func CheckWithdraw() {
 		hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"hot_wallet_address_eos",
+			chain.suffixKey("hot_wallet_address"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
-		// 获取热钱包私钥
-		hotKeyValue, err := app.SQLGetTAppConfigStrValueByK(
-			context.Background(),
-			xenv.DbCon,
-			"hot_wallet_key_eos",
-		)
-		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-			return
-		}
-		key, err := mcommon.AesDecrypt(hotKeyValue, xenv.Cfg.AESKey)
-		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-			return
-		}
-		if len(key) == 0 {
-			mcommon.Log.Errorf("error key of eos")
+		// 资源不足时暂停提币
+		if !chain.isHotResourceEnough(hotAddressValue) {
 			return
 		}
-		// 获取热钱包余额
-		rpcAccount, err := eosclient.RpcChainGetAccount(
-			hotAddressValue,
-		)
+		// 获取热钱包私钥
+		key, err := chain.getHotKey()
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
-		rpcHotBalance, err := EosValueToDecimal(rpcAccount.CoreLiquidBalance)
+		// 获取热钱包签名权限
+		permission, err := chain.getHotPermission()
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
-		pendingBalanceRealStr, err := app.SQLGetTSendEosPendingBalanceReal(
-			context.Background(),
-			xenv.DbCon,
-			hotAddressValue,
-		)
-		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-			return
+		// 获取热钱包各代币余额
+		hotBalanceMap := make(map[string]*decimal.Decimal)
+		for _, withdrawRow := range withdrawRows {
+			_, ok := hotBalanceMap[withdrawRow.Symbol]
+			if ok {
+				continue
+			}
+			tokenRow := tokenMap[withdrawRow.Symbol]
+			rpcHotBalance, err := chain.getHotBalance(hotAddressValue, tokenRow)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			hotBalanceMap[withdrawRow.Symbol] = &rpcHotBalance
 		}
-		pendingBalanceReal, err := StrToEosDecimal(pendingBalanceRealStr)
+		// 获取链信息
+		rpcChainInfo, err := chain.GetClient().RpcChainGetInfo()
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
-		rpcHotBalance = rpcHotBalance.Sub(pendingBalanceReal)
-		// 获取链信息
-		rpcChainInfo, err := eosclient.RpcChainGetInfo()
+		err = chain.checkChainID(rpcChainInfo)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
 		for _, withdrawRow := range withdrawRows {
-			err = handleWithdraw(rpcChainInfo, withdrawRow.ID, hotAddressValue, key, &rpcHotBalance)
+			err = chain.handleWithdraw(rpcChainInfo, withdrawRow.ID, hotAddressValue, key, permission, tokenMap[withdrawRow.Symbol], hotBalanceMap[withdrawRow.Symbol])
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 				continue
@@ -469,7 +764,44 @@ func CheckWithdraw() {
 	})
 }
 
-func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, hotAddressValue string, hotKey string, hotBalance *decimal.Decimal) error {
+// getHotBalance 获取热钱包代币余额 扣除未确认的提币
+func (chain *Chain) getHotBalance(hotAddressValue string, tokenRow *model.DBTAppConfigTokenEos) (decimal.Decimal, error) {
+	rpcBalances, err := chain.GetClient().RpcChainGetCurrencyBalance(
+		tokenRow.TokenAddress,
+		hotAddressValue,
+		tokenRow.TokenSymbol,
+	)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	hotBalance := decimal.Zero
+	if len(rpcBalances) > 0 {
+		quantity, _, err := SplitQuantity(rpcBalances[0])
+		if err != nil {
+			return decimal.Zero, err
+		}
+		hotBalance, err = decimal.NewFromString(quantity)
+		if err != nil {
+			return decimal.Zero, err
+		}
+	}
+	pendingBalanceRealStr, err := app.SQLGetTSendEosPendingBalanceReal(
+		context.Background(),
+		xenv.DbCon,
+		hotAddressValue,
+		chain.GetTokenSymbol(tokenRow),
+	)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	pendingBalanceReal, err := decimal.NewFromString(pendingBalanceRealStr)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return hotBalance.Sub(pendingBalanceReal), nil
+}
+
+func (chain *Chain) handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, hotAddressValue string, hotKey string, hotPermission string, tokenRow *model.DBTAppConfigTokenEos, hotBalance *decimal.Decimal) error {
 	isComment := false
 	dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
 	if err != nil {
@@ -489,6 +821,7 @@ func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, ho
 			model.DBColTWithdrawBalanceReal,
 			model.DBColTWithdrawToAddress,
 			model.DBColTWithdrawMemo,
+			model.DBColTWithdrawSymbol,
 		},
 		withdrawID,
 		app.WithdrawStatusInit,
@@ -499,19 +832,26 @@ func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, ho
 	if withdrawRow == nil {
 		return nil
 	}
-	withdrawBalance, err := StrToEosDecimal(withdrawRow.BalanceReal)
+	withdrawBalance, err := decimal.NewFromString(withdrawRow.BalanceReal)
 	if err != nil {
 		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 		return nil
 	}
+	withdrawBalance = withdrawBalance.RoundBank(int32(tokenRow.TokenDecimals))
 	*hotBalance = (*hotBalance).Sub(withdrawBalance)
 	if (*hotBalance).Cmp(decimal.NewFromInt(0)) < 0 {
 		// 金额不够
-		mcommon.Log.Errorf("eos hot balance limit")
+		mcommon.Log.Errorf("%s hot balance limit: %s", chain.Symbol, withdrawRow.Symbol)
 		*hotBalance = (*hotBalance).Add(withdrawBalance)
 		return nil
 	}
-	eosAesset, err := eos.NewEOSAssetFromString(withdrawRow.BalanceReal)
+	eosAesset, err := eos.NewFixedSymbolAssetFromString(
+		eos.Symbol{
+			Precision: uint8(tokenRow.TokenDecimals),
+			Symbol:    tokenRow.TokenSymbol,
+		},
+		withdrawBalance.StringFixedBank(int32(tokenRow.TokenDecimals)),
+	)
 	if err != nil {
 		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 		return err
@@ -522,55 +862,12 @@ func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, ho
 		eosAesset,
 		withdrawRow.Memo,
 	)
-	action.Account = "eosio.token"
-	actions := []*eos.Action{action}
-	// 设置tx属性
-	chainID, err := hex.DecodeString(rpcChainInfo.ChainID)
-	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-		return err
-	}
-	headBlockID, err := hex.DecodeString(rpcChainInfo.HeadBlockID)
-	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-		return err
-	}
-	opts := &eos.TxOptions{
-		ChainID:     chainID,
-		HeadBlockID: headBlockID,
-	}
-	// 创建tx
-	tx := eos.NewTransaction(actions, opts)
-	tx.SetExpiration(time.Second * 3000)
-	tx.ContextFreeActions = append(
-		tx.ContextFreeActions,
-		&eos.Action{
-			Account:    "eosio.null",
-			Name:       "nonce",
-			ActionData: eos.NewActionDataFromHexData([]byte(fmt.Sprintf("%d", withdrawRow.ID))),
-		},
-	)
-	// 生成待签名tx
-	signTx := eos.NewSignedTransaction(tx)
-	// 创建密钥对
-	kb := eos.NewKeyBag()
-	err = kb.Add(hotKey)
-	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-		return err
+	action.Account = eos.AccountName(tokenRow.TokenAddress)
+	action.Authorization = []eos.PermissionLevel{
+		{Actor: eos.AccountName(hotAddressValue), Permission: eos.PN(hotPermission)},
 	}
-	keys, err := kb.AvailableKeys()
-	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-		return err
-	}
-	_, err = kb.Sign(signTx, chainID, keys[0])
-	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-		return err
-	}
-	// 打包tx
-	packedTx, err := signTx.Pack(eos.CompressionNone)
+	actions := []*eos.Action{action}
+	packedTx, err := signActions(rpcChainInfo, hotKey, actions, fmt.Sprintf("%d", withdrawRow.ID))
 	if err != nil {
 		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 		return err
@@ -606,6 +903,7 @@ func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, ho
 		dbTx,
 		&model.DBTSendEos{
 			WithdrawID:   withdrawID,
+			Symbol:       withdrawRow.Symbol,
 			TxHash:       txHash,
 			FromAddress:  hotAddressValue,
 			ToAddress:    withdrawRow.ToAddress,
@@ -633,8 +931,22 @@ func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, ho
 
 // CheckRawTxSend 发送交易
 func CheckRawTxSend() {
-	lockKey := "EosCheckRawTxSend"
+	Eos.CheckRawTxSend()
+}
+
+// CheckRawTxSend 发送交易
+func (chain *Chain) CheckRawTxSend() {
+	lockKey := chain.LockPrefix + "CheckRawTxSend"
 	app.LockWrap(lockKey, func() {
+		// 获取所有代币币种
+		tokenSymbols, err := chain.GetTokenSymbols(
+			context.Background(),
+			xenv.DbCon,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
 		// 获取待发送的数据
 		sendRows, err := app.SQLSelectTSendEosColByStatus(
 			context.Background(),
@@ -646,6 +958,7 @@ func CheckRawTxSend() {
 				model.DBColTSendEosWithdrawID,
 			},
 			app.SendStatusInit,
+			tokenSymbols,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -744,10 +1057,15 @@ func CheckRawTxSend() {
 			withdrawIDs = append(withdrawIDs, withdrawRow.ID)
 			return nil
 		}
+		// 过期未上链的交易
+		var expiredSendIDs []int64
+		var expiredWithdrawIDs []int64
+		isResourceChecked := false
+		isResourceEnough := false
 		for _, sendRow := range sendRows {
 			// 判定是否已经发送过
 			isSend := false
-			_, err := eosclient.RpcHistoryGetTransaction(sendRow.TxHash)
+			_, err := chain.GetClient().RpcHistoryGetTransaction(sendRow.TxHash)
 			if err != nil {
 				rpcErr, ok := err.(*eosclient.StRpcRespError)
 				if !ok {
@@ -771,13 +1089,30 @@ func CheckRawTxSend() {
 			}
 			// 发送数据中需要排除占位数据
 			if !isSend && sendRow.Hex != "" {
+				// 发送前检测资源 资源不足时暂停发送
+				if !isResourceChecked {
+					isResourceChecked = true
+					hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+						context.Background(),
+						xenv.DbCon,
+						chain.suffixKey("hot_wallet_address"),
+					)
+					if err != nil {
+						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						break
+					}
+					isResourceEnough = chain.isHotResourceEnough(hotAddressValue)
+				}
+				if !isResourceEnough {
+					break
+				}
 				var args eosclient.StPushTransactionArg
 				err := json.Unmarshal([]byte(sendRow.Hex), &args)
 				if err != nil {
 					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 					continue
 				}
-				_, err = eosclient.RpcChainPushTransaction(
+				_, err = chain.GetClient().RpcChainPushTransaction(
 					args,
 				)
 				if err != nil {
@@ -790,21 +1125,30 @@ func CheckRawTxSend() {
 					case 3080001:
 						// account using more than allotted RAM usage
 						// rom 不足
-						mcommon.Log.Errorf("eos hot rom limit")
+						mcommon.Log.Errorf("%s hot rom limit", chain.Symbol)
 						return
 					case 3080002:
 						// Transaction exceeded the current network usage limit imposed on the transaction
 						// net 不足
-						mcommon.Log.Errorf("eos hot net limit")
+						mcommon.Log.Errorf("%s hot net limit", chain.Symbol)
 						return
 					case 3080004:
 						// Transaction exceeded the current CPU usage limit imposed on the transaction
 						// cpu 不足
-						mcommon.Log.Errorf("eos hot cpu limit")
+						mcommon.Log.Errorf("%s hot cpu limit", chain.Symbol)
 						return
 					case 3040008:
 						// Duplicate transaction
 						// 已经发送
+					case 3040005:
+						// expired_tx_exception
+						// 交易已过期 提币重置为待处理 重新生成交易
+						mcommon.Log.Warnf("%s tx expired: %s", chain.Symbol, sendRow.TxHash)
+						expiredSendIDs = append(expiredSendIDs, sendRow.ID)
+						if !mcommon.IsIntInSlice(expiredWithdrawIDs, sendRow.WithdrawID) {
+							expiredWithdrawIDs = append(expiredWithdrawIDs, sendRow.WithdrawID)
+						}
+						continue
 					default:
 						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 						continue
@@ -817,6 +1161,35 @@ func CheckRawTxSend() {
 				}
 			}
 		}
+		// 更新过期的交易
+		_, err = app.SQLUpdateTSendEosStatusByIDs(
+			context.Background(),
+			xenv.DbCon,
+			expiredSendIDs,
+			model.DBTSendEos{
+				HandleStatus: app.SendStatusExpired,
+				HandleMsg:    "expired",
+				HandleAt:     now,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = app.SQLUpdateTWithdrawStatusByIDs(
+			context.Background(),
+			xenv.DbCon,
+			expiredWithdrawIDs,
+			&model.DBTWithdraw{
+				HandleStatus: app.WithdrawStatusInit,
+				HandleMsg:    "expired",
+				HandleTime:   now,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
 		// 插入通知
 		_, err = model.SQLCreateManyTProductNotify(
 			context.Background(),
@@ -863,8 +1236,22 @@ func CheckRawTxSend() {
 
 // CheckRawTxConfirm 确认tx是否打包完成
 func CheckRawTxConfirm() {
-	lockKey := "EosCheckRawTxConfirm"
+	Eos.CheckRawTxConfirm()
+}
+
+// CheckRawTxConfirm 确认tx是否打包完成
+func (chain *Chain) CheckRawTxConfirm() {
+	lockKey := chain.LockPrefix + "CheckRawTxConfirm"
 	app.LockWrap(lockKey, func() {
+		// 获取所有代币币种
+		tokenSymbols, err := chain.GetTokenSymbols(
+			context.Background(),
+			xenv.DbCon,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
 		// 获取待发送的数据
 		sendRows, err := app.SQLSelectTSendEosColByStatus(
 			context.Background(),
@@ -876,6 +1263,7 @@ func CheckRawTxConfirm() {
 				model.DBColTSendEosWithdrawID,
 			},
 			app.SendStatusSend,
+			tokenSymbols,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -932,13 +1320,26 @@ func CheckRawTxConfirm() {
 		var sendIDs []int64
 		withdrawIDs = []int64{}
 		for _, sendRow := range sendRows {
-			_, err := eosclient.RpcHistoryGetTransaction(
+			rpcTx, err := chain.GetClient().RpcHistoryGetTransaction(
 				sendRow.TxHash,
 			)
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 				continue
 			}
+			// 记录所在块高 供提币查询确认数使用
+			_, err = app.SQLUpdateTSendEosBlockNumByTxHash(
+				context.Background(),
+				xenv.DbCon,
+				model.DBTSendEos{
+					TxHash:   sendRow.TxHash,
+					BlockNum: rpcTx.BlockNum,
+				},
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
 			// 提币
 			withdrawRow, ok := withdrawMap[sendRow.WithdrawID]
 			if !ok {
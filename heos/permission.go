@@ -0,0 +1,146 @@
+package heos
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/eosclient"
+	"go-dc-wallet/xenv"
+	"strings"
+	"time"
+
+	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/ecc"
+	"github.com/eoscanada/eos-go/system"
+)
+
+// SetupHotPermission 为热钱包账号创建自定义权限
+// 权限的公钥为 hot_wallet_key 对应的公钥, 权限只关联代币合约的 transfer
+// isLinkResource 为 true 时同时关联 powerup 用于资源自动补充
+// delegatebw 可以设置 transfer 将抵押的代币转给其他账号, 不关联到自定义权限
+func (chain *Chain) SetupHotPermission(activeKey string, permission string, isLinkResource bool) (string, error) {
+	permission = strings.TrimSpace(permission)
+	if permission == "" || permission == "active" || permission == "owner" {
+		return "", fmt.Errorf("error permission: %s", permission)
+	}
+	// 获取热钱包地址
+	hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+		context.Background(),
+		xenv.DbCon,
+		chain.suffixKey("hot_wallet_address"),
+	)
+	if err != nil {
+		return "", err
+	}
+	// 获取热钱包私钥对应的公钥
+	hotKey, err := chain.getHotKey()
+	if err != nil {
+		return "", err
+	}
+	privateKey, err := ecc.NewPrivateKey(hotKey)
+	if err != nil {
+		return "", err
+	}
+	// 自定义权限需要使用单独的热钱包私钥 和 active 私钥相同时没有限制作用
+	activePrivateKey, err := ecc.NewPrivateKey(activeKey)
+	if err != nil {
+		return "", err
+	}
+	if activePrivateKey.PublicKey().String() == privateKey.PublicKey().String() {
+		return "", fmt.Errorf("hot wallet key is same as active key, set a separate hot key first")
+	}
+	account := eos.AccountName(hotAddressValue)
+	var actions []*eos.Action
+	// 创建权限
+	actions = append(
+		actions,
+		system.NewUpdateAuth(
+			account,
+			eos.PN(permission),
+			eos.PN("active"),
+			eos.Authority{
+				Threshold: 1,
+				Keys: []eos.KeyWeight{
+					{
+						PublicKey: privateKey.PublicKey(),
+						Weight:    1,
+					},
+				},
+			},
+			eos.PN("active"),
+		),
+	)
+	// 关联代币合约的 transfer
+	tokenRows, err := chain.GetTokenRows(
+		context.Background(),
+		xenv.DbCon,
+	)
+	if err != nil {
+		return "", err
+	}
+	tokenAddressMap := make(map[string]bool)
+	for _, tokenRow := range tokenRows {
+		if tokenAddressMap[tokenRow.TokenAddress] {
+			continue
+		}
+		tokenAddressMap[tokenRow.TokenAddress] = true
+		actions = append(
+			actions,
+			system.NewLinkAuth(
+				account,
+				eos.AccountName(tokenRow.TokenAddress),
+				eos.ActN("transfer"),
+				eos.PN(permission),
+			),
+		)
+	}
+	// 关联资源操作
+	if isLinkResource {
+		actions = append(
+			actions,
+			system.NewLinkAuth(
+				account,
+				eos.AccountName("eosio"),
+				eos.ActN("powerup"),
+				eos.PN(permission),
+			),
+		)
+	}
+	// 获取链信息
+	rpcChainInfo, err := chain.GetClient().RpcChainGetInfo()
+	if err != nil {
+		return "", err
+	}
+	err = chain.checkChainID(rpcChainInfo)
+	if err != nil {
+		return "", err
+	}
+	rpcPush, err := chain.pushActions(rpcChainInfo, activeKey, actions, fmt.Sprintf("permission_%d", time.Now().Unix()))
+	if err != nil {
+		return "", err
+	}
+	return rpcPush.TransactionID, nil
+}
+
+// isActionLinked 权限是否可以执行合约的action
+// active 可以执行所有action, 自定义权限需要通过 linkauth 关联
+func isActionLinked(rpcAccount *eosclient.StAccount, permission string, contract string, action string) bool {
+	if permission == "active" {
+		return true
+	}
+	for _, rpcPermission := range rpcAccount.Permissions {
+		if rpcPermission.PermName != permission {
+			continue
+		}
+		for _, linkedAction := range rpcPermission.LinkedActions {
+			if linkedAction.Account != contract {
+				continue
+			}
+			// action 为空时关联合约的所有action
+			if linkedAction.Action == "" || linkedAction.Action == action {
+				return true
+			}
+		}
+	}
+	return false
+}
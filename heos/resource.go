@@ -0,0 +1,395 @@
+package heos
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/eosclient"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/system"
+	"github.com/moremorefun/mcommon"
+	"github.com/shopspring/decimal"
+)
+
+// 资源补充方式
+const (
+	ResourceModeStake   = "stake"   // 抵押
+	ResourceModePowerUp = "powerup" // 租用
+)
+
+// PowerUp eosio::powerup 参数
+type PowerUp struct {
+	Payer      eos.AccountName `json:"payer"`
+	Receiver   eos.AccountName `json:"receiver"`
+	Days       uint32          `json:"days"`
+	NetFrac    int64           `json:"net_frac"`
+	CPUFrac    int64           `json:"cpu_frac"`
+	MaxPayment eos.Asset       `json:"max_payment"`
+}
+
+// getConfigIntDefault 获取int配置 没有配置时使用默认值
+func getConfigIntDefault(k string, defaultValue int64) (int64, error) {
+	v, err := app.SQLGetTAppConfigIntValueByK(
+		context.Background(),
+		xenv.DbCon,
+		k,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "no app config int of") {
+			return defaultValue, nil
+		}
+		return 0, err
+	}
+	return v, nil
+}
+
+// getConfigStrDefault 获取str配置 没有配置时使用默认值
+func getConfigStrDefault(k string, defaultValue string) (string, error) {
+	v, err := app.SQLGetTAppConfigStrValueByK(
+		context.Background(),
+		xenv.DbCon,
+		k,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "no app config str of") {
+			return defaultValue, nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(v), nil
+}
+
+// getStatusIntDefault 获取状态 没有记录时使用默认值
+func getStatusIntDefault(k string, defaultValue int64) (int64, error) {
+	v, err := app.SQLGetTAppStatusIntValueByK(
+		context.Background(),
+		xenv.DbCon,
+		k,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "no app status int of") {
+			return defaultValue, nil
+		}
+		return 0, err
+	}
+	return v, nil
+}
+
+// setStatusInt 更新状态 没有记录时创建
+func setStatusInt(k string, v int64) error {
+	_, err := model.SQLCreateTAppStatusInt(
+		context.Background(),
+		xenv.DbCon,
+		&model.DBTAppStatusInt{
+			K: k,
+			V: v,
+		},
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	_, err = app.SQLUpdateTAppStatusIntByK(
+		context.Background(),
+		xenv.DbCon,
+		&model.DBTAppStatusInt{
+			K: k,
+			V: v,
+		},
+	)
+	return err
+}
+
+// resourcePercent 剩余资源百分比
+func resourcePercent(available int64, max int64) int64 {
+	if max <= 0 {
+		return 0
+	}
+	return available * 100 / max
+}
+
+// checkHotResource 检测热钱包资源是否足够发送交易
+func (chain *Chain) checkHotResource(rpcAccount *eosclient.StAccount) error {
+	minCPU, err := getConfigIntDefault(chain.prefixKey("resource_min_cpu"), 0)
+	if err != nil {
+		return err
+	}
+	minNet, err := getConfigIntDefault(chain.prefixKey("resource_min_net"), 0)
+	if err != nil {
+		return err
+	}
+	if rpcAccount.CPULimit.Available < minCPU {
+		return fmt.Errorf("%s hot cpu limit: %d < %d", chain.Symbol, rpcAccount.CPULimit.Available, minCPU)
+	}
+	if rpcAccount.NetLimit.Available < minNet {
+		return fmt.Errorf("%s hot net limit: %d < %d", chain.Symbol, rpcAccount.NetLimit.Available, minNet)
+	}
+	return nil
+}
+
+// isHotResourceEnough 热钱包资源是否足够 不足时暂停提币
+func (chain *Chain) isHotResourceEnough(hotAddressValue string) bool {
+	rpcAccount, err := chain.GetClient().RpcChainGetAccount(hotAddressValue)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		return false
+	}
+	err = chain.checkHotResource(rpcAccount)
+	if err != nil {
+		mcommon.Log.Warnf("pause withdraw: %s", err.Error())
+		return false
+	}
+	return true
+}
+
+// toAsset 原生代币金额
+func (chain *Chain) toAsset(units int64) eos.Asset {
+	return eos.Asset{
+		Amount: eos.Int64(units),
+		Symbol: eos.Symbol{
+			Precision: uint8(chain.TokenDecimals),
+			Symbol:    chain.TokenSymbol,
+		},
+	}
+}
+
+// toUnits 原生代币金额转为最小单位
+func (chain *Chain) toUnits(balanceReal string) (int64, error) {
+	if balanceReal == "" {
+		return 0, nil
+	}
+	v, err := decimal.NewFromString(balanceReal)
+	if err != nil {
+		return 0, err
+	}
+	return v.Shift(int32(chain.TokenDecimals)).IntPart(), nil
+}
+
+// CheckResource 检测热钱包cpu net资源
+func CheckResource() {
+	Eos.CheckResource()
+}
+
+// CheckResource 检测热钱包cpu net资源
+// 低于报警百分比时报警, 并按照配置抵押或者租用资源
+func (chain *Chain) CheckResource() {
+	lockKey := chain.LockPrefix + "CheckResource"
+	app.LockWrap(lockKey, func() {
+		hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+			context.Background(),
+			xenv.DbCon,
+			chain.suffixKey("hot_wallet_address"),
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		rpcAccount, err := chain.GetClient().RpcChainGetAccount(hotAddressValue)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		alertPercent, err := getConfigIntDefault(chain.prefixKey("resource_alert_percent"), 20)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		cpuPercent := resourcePercent(rpcAccount.CPULimit.Available, rpcAccount.CPULimit.Max)
+		netPercent := resourcePercent(rpcAccount.NetLimit.Available, rpcAccount.NetLimit.Max)
+		isCPULow := cpuPercent < alertPercent
+		isNetLow := netPercent < alertPercent
+		if isCPULow {
+			mcommon.Log.Errorf("%s hot cpu low: %d%% %d/%d", chain.Symbol, cpuPercent, rpcAccount.CPULimit.Available, rpcAccount.CPULimit.Max)
+		}
+		if isNetLow {
+			mcommon.Log.Errorf("%s hot net low: %d%% %d/%d", chain.Symbol, netPercent, rpcAccount.NetLimit.Available, rpcAccount.NetLimit.Max)
+		}
+		if !isCPULow && !isNetLow {
+			return
+		}
+		// 补充资源
+		mode, err := getConfigStrDefault(chain.prefixKey("resource_mode"), "")
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		hotPermission, err := chain.getHotPermission()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var action *eos.Action
+		var cost int64
+		switch mode {
+		case "":
+			// 没有开启自动补充
+			return
+		case ResourceModeStake:
+			var stakeCPU, stakeNet int64
+			if isCPULow {
+				stakeCPUValue, err := getConfigStrDefault(chain.prefixKey("resource_stake_cpu"), "")
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				stakeCPU, err = chain.toUnits(stakeCPUValue)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			}
+			if isNetLow {
+				stakeNetValue, err := getConfigStrDefault(chain.prefixKey("resource_stake_net"), "")
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				stakeNet, err = chain.toUnits(stakeNetValue)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			}
+			cost = stakeCPU + stakeNet
+			action = system.NewDelegateBW(
+				eos.AccountName(hotAddressValue),
+				eos.AccountName(hotAddressValue),
+				chain.toAsset(stakeCPU),
+				chain.toAsset(stakeNet),
+				false,
+			)
+			action.Authorization = []eos.PermissionLevel{
+				{Actor: eos.AccountName(hotAddressValue), Permission: eos.PN(hotPermission)},
+			}
+		case ResourceModePowerUp:
+			var cpuFrac, netFrac int64
+			if isCPULow {
+				cpuFrac, err = getConfigIntDefault(chain.prefixKey("resource_powerup_cpu_frac"), 0)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			}
+			if isNetLow {
+				netFrac, err = getConfigIntDefault(chain.prefixKey("resource_powerup_net_frac"), 0)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			}
+			maxPaymentValue, err := getConfigStrDefault(chain.prefixKey("resource_max_payment"), "")
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			cost, err = chain.toUnits(maxPaymentValue)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			if cpuFrac+netFrac <= 0 {
+				mcommon.Log.Errorf("%s powerup frac not set", chain.Symbol)
+				return
+			}
+			action = &eos.Action{
+				Account: "eosio",
+				Name:    eos.ActN("powerup"),
+				Authorization: []eos.PermissionLevel{
+					{Actor: eos.AccountName(hotAddressValue), Permission: eos.PN(hotPermission)},
+				},
+				ActionData: eos.NewActionData(PowerUp{
+					Payer:      eos.AccountName(hotAddressValue),
+					Receiver:   eos.AccountName(hotAddressValue),
+					Days:       1,
+					NetFrac:    netFrac,
+					CPUFrac:    cpuFrac,
+					MaxPayment: chain.toAsset(cost),
+				}),
+			}
+		default:
+			mcommon.Log.Errorf("%s resource mode error: %s", chain.Symbol, mode)
+			return
+		}
+		if !isActionLinked(rpcAccount, hotPermission, string(action.Account), string(action.Name)) {
+			// 热钱包私钥只有自定义权限 没有关联时无法签名
+			mcommon.Log.Errorf("%s resource %s not linked to permission: %s", chain.Symbol, action.Name, hotPermission)
+			return
+		}
+		if cost <= 0 {
+			mcommon.Log.Errorf("%s resource cost not set", chain.Symbol)
+			return
+		}
+		// 每日花费限制
+		dayLimitValue, err := getConfigStrDefault(chain.prefixKey("resource_day_limit"), "")
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		dayLimit, err := chain.toUnits(dayLimitValue)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		today, err := strconv.ParseInt(time.Now().Format("20060102"), 10, 64)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		spentDay, err := getStatusIntDefault(chain.prefixKey("resource_day"), 0)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		spent := int64(0)
+		if spentDay == today {
+			spent, err = getStatusIntDefault(chain.prefixKey("resource_day_spent"), 0)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		if spent+cost > dayLimit {
+			mcommon.Log.Errorf("%s resource day limit: %s + %s > %s", chain.Symbol, chain.toAsset(spent), chain.toAsset(cost), chain.toAsset(dayLimit))
+			return
+		}
+		// 发送交易
+		hotKey, err := chain.getHotKey()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		rpcChainInfo, err := chain.GetClient().RpcChainGetInfo()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		err = chain.checkChainID(rpcChainInfo)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		rpcPush, err := chain.pushActions(rpcChainInfo, hotKey, []*eos.Action{action}, fmt.Sprintf("resource_%d", time.Now().Unix()))
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		mcommon.Log.Infof("%s resource %s: %s cost: %s", chain.Symbol, mode, rpcPush.TransactionID, chain.toAsset(cost))
+		// 记录花费
+		err = setStatusInt(chain.prefixKey("resource_day"), today)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		err = setStatusInt(chain.prefixKey("resource_day_spent"), spent+cost)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
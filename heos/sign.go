@@ -0,0 +1,116 @@
+package heos
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/eosclient"
+	"go-dc-wallet/xenv"
+	"time"
+
+	"github.com/eoscanada/eos-go"
+	"github.com/moremorefun/mcommon"
+)
+
+// getHotKey 获取热钱包私钥
+func (chain *Chain) getHotKey() (string, error) {
+	hotKeyValue, err := app.SQLGetTAppConfigStrValueByK(
+		context.Background(),
+		xenv.DbCon,
+		chain.suffixKey("hot_wallet_key"),
+	)
+	if err != nil {
+		return "", err
+	}
+	key, err := mcommon.AesDecrypt(hotKeyValue, xenv.Cfg.AESKey)
+	if err != nil {
+		return "", err
+	}
+	if len(key) == 0 {
+		return "", fmt.Errorf("error key of %s", chain.Symbol)
+	}
+	return key, nil
+}
+
+// getHotPermission 获取热钱包签名使用的权限 默认为 active
+func (chain *Chain) getHotPermission() (string, error) {
+	hotPermission, err := getConfigStrDefault(chain.suffixKey("hot_wallet_permission"), "")
+	if err != nil {
+		return "", err
+	}
+	if hotPermission == "" {
+		hotPermission = "active"
+	}
+	return hotPermission, nil
+}
+
+// signActions 创建并签名交易
+// nonce 用于区分相同内容的交易
+func signActions(rpcChainInfo *eosclient.StChainGetInfo, hotKey string, actions []*eos.Action, nonce string) (*eos.PackedTransaction, error) {
+	// 设置tx属性
+	chainID, err := hex.DecodeString(rpcChainInfo.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	headBlockID, err := hex.DecodeString(rpcChainInfo.HeadBlockID)
+	if err != nil {
+		return nil, err
+	}
+	opts := &eos.TxOptions{
+		ChainID:     chainID,
+		HeadBlockID: headBlockID,
+	}
+	// 创建tx
+	tx := eos.NewTransaction(actions, opts)
+	tx.SetExpiration(time.Second * 3000)
+	tx.ContextFreeActions = append(
+		tx.ContextFreeActions,
+		&eos.Action{
+			Account:    "eosio.null",
+			Name:       "nonce",
+			ActionData: eos.NewActionDataFromHexData([]byte(nonce)),
+		},
+	)
+	// 生成待签名tx
+	signTx := eos.NewSignedTransaction(tx)
+	// 创建密钥对
+	kb := eos.NewKeyBag()
+	err = kb.Add(hotKey)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := kb.AvailableKeys()
+	if err != nil {
+		return nil, err
+	}
+	_, err = kb.Sign(signTx, chainID, keys[0])
+	if err != nil {
+		return nil, err
+	}
+	// 打包tx
+	packedTx, err := signTx.Pack(eos.CompressionNone)
+	if err != nil {
+		return nil, err
+	}
+	return packedTx, nil
+}
+
+// pushActions 签名并发送交易
+func (chain *Chain) pushActions(rpcChainInfo *eosclient.StChainGetInfo, key string, actions []*eos.Action, nonce string) (*eosclient.StPushTransaction, error) {
+	packedTx, err := signActions(rpcChainInfo, key, actions, nonce)
+	if err != nil {
+		return nil, err
+	}
+	packedTxBs, err := json.Marshal(packedTx)
+	if err != nil {
+		return nil, err
+	}
+	var args eosclient.StPushTransactionArg
+	err = json.Unmarshal(packedTxBs, &args)
+	if err != nil {
+		return nil, err
+	}
+	return chain.GetClient().RpcChainPushTransaction(args)
+}
@@ -0,0 +1,82 @@
+package heos
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"strings"
+
+	"github.com/moremorefun/mcommon"
+)
+
+// eos 原生代币
+const (
+	EosTokenAddress  = "eosio.token"
+	EosTokenSymbol   = "EOS"
+	EosTokenDecimals = 4
+)
+
+// GetTokenRows 获取链上所有代币配置
+// 没有配置原生代币时使用默认配置
+func (chain *Chain) GetTokenRows(ctx context.Context, tx mcommon.DbExeAble) ([]*model.DBTAppConfigTokenEos, error) {
+	tokenRows, err := app.SQLSelectTAppConfigTokenEosColByChainSymbol(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppConfigTokenEosID,
+			model.DBColTAppConfigTokenEosChainSymbol,
+			model.DBColTAppConfigTokenEosTokenAddress,
+			model.DBColTAppConfigTokenEosTokenSymbol,
+			model.DBColTAppConfigTokenEosTokenDecimals,
+		},
+		chain.Symbol,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, tokenRow := range tokenRows {
+		if strings.ToLower(tokenRow.TokenSymbol) == chain.Symbol {
+			return tokenRows, nil
+		}
+	}
+	tokenRows = append(tokenRows, &model.DBTAppConfigTokenEos{
+		ChainSymbol:   chain.Symbol,
+		TokenAddress:  chain.TokenAddress,
+		TokenSymbol:   chain.TokenSymbol,
+		TokenDecimals: chain.TokenDecimals,
+	})
+	return tokenRows, nil
+}
+
+// GetTokenSymbols 获取链上所有代币的币种
+func (chain *Chain) GetTokenSymbols(ctx context.Context, tx mcommon.DbExeAble) ([]string, error) {
+	tokenRows, err := chain.GetTokenRows(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	var tokenSymbols []string
+	for _, tokenRow := range tokenRows {
+		tokenSymbols = append(tokenSymbols, chain.GetTokenSymbol(tokenRow))
+	}
+	return tokenSymbols, nil
+}
+
+// GetCoinSymbol 获取原生代币对外使用的币种 例如 wax:wax
+func (chain *Chain) GetCoinSymbol() string {
+	return app.GetAssetID(chain.Symbol, chain.TokenSymbol)
+}
+
+// GetTokenSymbol 获取代币对外使用的币种 例如 eos:usdt
+func (chain *Chain) GetTokenSymbol(tokenRow *model.DBTAppConfigTokenEos) string {
+	return app.GetAssetID(chain.Symbol, tokenRow.TokenSymbol)
+}
+
+// SplitQuantity 拆分金额和代币符号
+func SplitQuantity(quantity string) (string, string, error) {
+	quantitys := strings.Split(quantity, " ")
+	if len(quantitys) != 2 {
+		return "0", "", fmt.Errorf("error value: %s", quantity)
+	}
+	return quantitys[0], quantitys[1], nil
+}
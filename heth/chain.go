@@ -0,0 +1,267 @@
+package heth
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"strings"
+
+	"github.com/moremorefun/mcommon"
+)
+
+// Chain evm 链配置
+type Chain struct {
+	Symbol       string // 链标识 同时是数据表的 chain_symbol
+	NativeSymbol string // 原生币符号
+	LockPrefix   string // 任务锁前缀
+	KeyPrefix    string // 配置键前缀 eth为空 兼容已有配置
+	MaxGasPrice  int64  // 默认最高 gas price 未配置时使用
+	GasUse       int64  // 默认原生币转账 gas 未配置时使用
+
+	GetEnable       func() bool
+	GetChainID      func() int64 // 配置的链id 为0时不检测
+	GetShareAddress func() bool  // 是否使用eth的充币地址
+	GetClient       func() *ethclient.Client
+}
+
+// Eth eth
+var Eth = &Chain{
+	Symbol:       CoinSymbol,
+	NativeSymbol: CoinSymbol,
+	LockPrefix:   "Eth",
+	KeyPrefix:    "",
+	MaxGasPrice:  80000000000,
+	GasUse:       21000,
+	GetEnable: func() bool {
+		return true
+	},
+	GetChainID: func() int64 {
+		return xenv.Cfg.EthChainID
+	},
+	GetShareAddress: func() bool {
+		return false
+	},
+	GetClient: ethclient.DefaultClient,
+}
+
+// Bsc binance smart chain
+var Bsc = &Chain{
+	Symbol:       "bsc",
+	NativeSymbol: "bnb",
+	LockPrefix:   "Bsc",
+	KeyPrefix:    "bsc",
+	MaxGasPrice:  20000000000,
+	GasUse:       21000,
+	GetEnable: func() bool {
+		return xenv.Cfg.BscEnable
+	},
+	GetChainID: func() int64 {
+		return xenv.Cfg.BscChainID
+	},
+	GetShareAddress: func() bool {
+		return xenv.Cfg.BscShareAddress
+	},
+	GetClient: func() *ethclient.Client {
+		return ethclient.ChainClient("bsc")
+	},
+}
+
+// Polygon polygon
+var Polygon = &Chain{
+	Symbol:       "polygon",
+	NativeSymbol: "matic",
+	LockPrefix:   "Polygon",
+	KeyPrefix:    "polygon",
+	MaxGasPrice:  1000000000000,
+	GasUse:       21000,
+	GetEnable: func() bool {
+		return xenv.Cfg.PolygonEnable
+	},
+	GetChainID: func() int64 {
+		return xenv.Cfg.PolygonChainID
+	},
+	GetShareAddress: func() bool {
+		return xenv.Cfg.PolygonShareAddress
+	},
+	GetClient: func() *ethclient.Client {
+		return ethclient.ChainClient("polygon")
+	},
+}
+
+// Arbitrum arbitrum one
+var Arbitrum = &Chain{
+	Symbol:       "arbitrum",
+	NativeSymbol: "eth",
+	LockPrefix:   "Arbitrum",
+	KeyPrefix:    "arbitrum",
+	MaxGasPrice:  10000000000,
+	GasUse:       1000000,
+	GetEnable: func() bool {
+		return xenv.Cfg.ArbitrumEnable
+	},
+	GetChainID: func() int64 {
+		return xenv.Cfg.ArbitrumChainID
+	},
+	GetShareAddress: func() bool {
+		return xenv.Cfg.ArbitrumShareAddress
+	},
+	GetClient: func() *ethclient.Client {
+		return ethclient.ChainClient("arbitrum")
+	},
+}
+
+// Chains 所有evm链
+var Chains = []*Chain{Eth, Bsc, Polygon, Arbitrum}
+
+// GetChain 根据链标识获取链
+func GetChain(symbol string) *Chain {
+	for _, chain := range Chains {
+		if chain.Symbol == symbol {
+			return chain
+		}
+	}
+	return nil
+}
+
+// GetEnableChains 获取开启的链
+func GetEnableChains() []*Chain {
+	var chains []*Chain
+	for _, chain := range Chains {
+		if chain.GetEnable() {
+			chains = append(chains, chain)
+		}
+	}
+	return chains
+}
+
+// GetLegacySymbol 旧版本对外使用的币种 eth链为原有币种 其他链加上链标识 例如 usdt_bsc
+func (chain *Chain) GetLegacySymbol(symbol string) string {
+	symbol = strings.ToLower(symbol)
+	if chain == Eth {
+		return symbol
+	}
+	return fmt.Sprintf("%s_%s", symbol, chain.Symbol)
+}
+
+// GetCoinSymbol 原生币对外使用的币种 例如 bsc:bnb
+func (chain *Chain) GetCoinSymbol() string {
+	return app.GetAssetID(chain.Symbol, chain.NativeSymbol)
+}
+
+// GetTokenSymbol 代币对外使用的币种 例如 eth:usdt
+func (chain *Chain) GetTokenSymbol(tokenRow *model.DBTAppConfigToken) string {
+	return app.GetAssetID(chain.Symbol, tokenRow.TokenSymbol)
+}
+
+// GetAddressSymbol 充币地址的币种 共用eth地址时为eth
+func (chain *Chain) GetAddressSymbol() string {
+	if chain.GetShareAddress() {
+		return Eth.Symbol
+	}
+	return chain.Symbol
+}
+
+// GetAddressUserSymbols 绑定用户时记录的币种 共用eth地址的链使用同一个地址池
+func (chain *Chain) GetAddressUserSymbols() []string {
+	return GetAddressUserSymbols(chain.GetAddressSymbol())
+}
+
+// GetAddressUserSymbols 获取使用地址池的所有绑定用户币种 不是evm地址池时为地址池币种
+func GetAddressUserSymbols(addressSymbol string) []string {
+	var symbols []string
+	for _, chain := range Chains {
+		if chain.GetAddressSymbol() == addressSymbol {
+			symbols = append(symbols, chain.GetLegacySymbol(chain.NativeSymbol))
+		}
+	}
+	if len(symbols) == 0 {
+		symbols = append(symbols, addressSymbol)
+	}
+	return symbols
+}
+
+// key 配置键 例如 bsc_seek_num
+func (chain *Chain) key(k string) string {
+	if chain.KeyPrefix == "" {
+		return k
+	}
+	return fmt.Sprintf("%s_%s", chain.KeyPrefix, k)
+}
+
+// maxGasPriceKey 最高 gas price 配置键 eth沿用 max_gas_price_eth
+func (chain *Chain) maxGasPriceKey() string {
+	if chain == Eth {
+		return "max_gas_price_eth"
+	}
+	return chain.key("max_gas_price")
+}
+
+// getGasUse 原生币转账的 gas limit
+// arbitrum 的 gas 包含 l1 数据费用 不能使用固定的 21000
+func (chain *Chain) getGasUse(ctx context.Context, tx mcommon.DbExeAble) (int64, error) {
+	gasUse, err := app.SQLGetTAppConfigIntValueByK(
+		ctx,
+		tx,
+		chain.key("gas_use"),
+	)
+	if err != nil {
+		if !strings.Contains(err.Error(), "no app config int of") {
+			return 0, err
+		}
+		gasUse = chain.GasUse
+	}
+	return gasUse, nil
+}
+
+// getChainID 获取链id 并检测节点的链id是否和配置一致
+func (chain *Chain) getChainID(ctx context.Context) (int64, error) {
+	chainID, err := chain.GetClient().RpcNetworkID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	configChainID := chain.GetChainID()
+	if configChainID != 0 && configChainID != chainID {
+		return 0, fmt.Errorf("%s chain id error: %d", chain.Symbol, chainID)
+	}
+	return chainID, nil
+}
+
+// GetConfirmNum 获取充币确认数
+func (chain *Chain) GetConfirmNum(ctx context.Context, tx mcommon.DbExeAble) (int64, error) {
+	return app.SQLGetTAppConfigIntValueByK(
+		ctx,
+		tx,
+		chain.key("block_confirm_num"),
+	)
+}
+
+// GetWithdrawFee 获取当前提币预计的手续费 单位为链的原生币
+func (chain *Chain) GetWithdrawFee(ctx context.Context, tx mcommon.DbExeAble, isToken bool) (string, error) {
+	gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
+		ctx,
+		tx,
+		chain.key("to_user_gas_price"),
+	)
+	if err != nil {
+		return "", err
+	}
+	gasLimit, err := chain.getGasUse(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+	if isToken {
+		gasLimit, err = app.SQLGetTAppConfigIntValueByK(
+			ctx,
+			tx,
+			chain.key("erc20_gas_use"),
+		)
+		if err != nil {
+			return "", err
+		}
+	}
+	return WeiBigIntToEthStr(big.NewInt(gasLimit * gasPriceValue))
+}
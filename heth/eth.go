@@ -61,6 +61,11 @@ func genAddressAndAesKey() (string, string, error) {
 
 // CreateHotAddress 创建自用地址
 func CreateHotAddress(num int64) ([]string, error) {
+	return Eth.CreateHotAddress(num)
+}
+
+// CreateHotAddress 创建自用地址
+func (chain *Chain) CreateHotAddress(num int64) ([]string, error) {
 	var rows []*model.DBTAddressKey
 	var addresses []string
 	// 遍历差值次数
@@ -71,7 +76,7 @@ func CreateHotAddress(num int64) ([]string, error) {
 		}
 		// 存入待添加队列
 		rows = append(rows, &model.DBTAddressKey{
-			Symbol:  CoinSymbol,
+			Symbol:  chain.GetAddressSymbol(),
 			Address: address,
 			Pwd:     privateKeyStrEn,
 			UseTag:  -1,
@@ -93,7 +98,16 @@ func CreateHotAddress(num int64) ([]string, error) {
 
 // CheckAddressFree 检测是否有充足的备用地址
 func CheckAddressFree() {
-	lockKey := "EthCheckAddressFree"
+	Eth.CheckAddressFree()
+}
+
+// CheckAddressFree 检测是否有充足的备用地址
+func (chain *Chain) CheckAddressFree() {
+	if chain.GetShareAddress() {
+		// 共用eth的充币地址
+		return
+	}
+	lockKey := chain.LockPrefix + "CheckAddressFree"
 	app.LockWrap(lockKey, func() {
 		// 获取配置 允许的最小剩余地址数
 		minFreeCount, err := app.SQLGetTAppConfigIntValueByK(
@@ -109,7 +123,7 @@ func CheckAddressFree() {
 		freeCount, err := app.SQLGetTAddressKeyFreeCount(
 			context.Background(),
 			xenv.DbCon,
-			CoinSymbol,
+			chain.GetAddressSymbol(),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -127,7 +141,7 @@ func CheckAddressFree() {
 				}
 				// 存入待添加队列
 				rows = append(rows, &model.DBTAddressKey{
-					Symbol:  CoinSymbol,
+					Symbol:  chain.GetAddressSymbol(),
 					Address: address,
 					Pwd:     privateKeyStrEn,
 					UseTag:  0,
@@ -150,13 +164,18 @@ func CheckAddressFree() {
 
 // CheckBlockSeek 检测到账
 func CheckBlockSeek() {
-	lockKey := "EthCheckBlockSeek"
+	Eth.CheckBlockSeek()
+}
+
+// CheckBlockSeek 检测到账
+func (chain *Chain) CheckBlockSeek() {
+	lockKey := chain.LockPrefix + "CheckBlockSeek"
 	app.LockWrap(lockKey, func() {
 		// 获取配置 延迟确认数
 		confirmValue, err := app.SQLGetTAppConfigIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"block_confirm_num",
+			chain.key("block_confirm_num"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -166,14 +185,14 @@ func CheckBlockSeek() {
 		seekValue, err := app.SQLGetTAppStatusIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"seek_num",
+			chain.key("seek_num"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
 		// rpc 获取当前最新区块数
-		rpcBlockNum, err := ethclient.RpcBlockNumber(context.Background())
+		rpcBlockNum, err := chain.GetClient().RpcBlockNumber(context.Background())
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
@@ -185,7 +204,7 @@ func CheckBlockSeek() {
 			feeAddressValue, err := app.SQLGetTAppConfigStrValueByK(
 				context.Background(),
 				xenv.DbCon,
-				"fee_wallet_address_list",
+				chain.key("fee_wallet_address_list"),
 			)
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -203,7 +222,7 @@ func CheckBlockSeek() {
 			for i := startI; i < endI; i++ {
 				// rpc获取block信息
 				//mcommon.Log.Debugf("eth check block: %d", i)
-				rpcBlock, err := ethclient.RpcBlockByNum(context.Background(), i)
+				rpcBlock, err := chain.GetClient().RpcBlockByNum(context.Background(), i)
 				if err != nil {
 					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 					return
@@ -233,13 +252,14 @@ func CheckBlockSeek() {
 					}
 				}
 				// 从db中查询这些地址是否是冲币地址中的地址
-				dbAddressRows, err := app.SQLSelectTAddressKeyColByAddress(
+				dbAddressRows, err := app.SQLSelectTAddressKeyColByAddressAndSymbol(
 					context.Background(),
 					xenv.DbCon,
 					[]string{
 						model.DBColTAddressKeyAddress,
 						model.DBColTAddressKeyUseTag,
 					},
+					chain.GetAddressSymbol(),
 					toAddresses,
 				)
 				if err != nil {
@@ -276,8 +296,10 @@ func CheckBlockSeek() {
 							return
 						}
 						dbTxRows = append(dbTxRows, &model.DBTTx{
+							ChainSymbol:  chain.Symbol,
 							ProductID:    addressProductMap[toAddress],
 							TxID:         tx.Hash().String(),
+							BlockNum:     i,
 							FromAddress:  fromAddress,
 							ToAddress:    toAddress,
 							BalanceReal:  balanceReal,
@@ -307,7 +329,7 @@ func CheckBlockSeek() {
 					context.Background(),
 					xenv.DbCon,
 					&model.DBTAppStatusInt{
-						K: "seek_num",
+						K: chain.key("seek_num"),
 						V: i,
 					},
 				)
@@ -322,13 +344,18 @@ func CheckBlockSeek() {
 
 // CheckAddressOrg 零钱整理到冷钱包
 func CheckAddressOrg() {
-	lockKey := "EthCheckAddressOrg"
+	Eth.CheckAddressOrg()
+}
+
+// CheckAddressOrg 零钱整理到冷钱包
+func (chain *Chain) CheckAddressOrg() {
+	lockKey := chain.LockPrefix + "CheckAddressOrg"
 	app.LockWrap(lockKey, func() {
 		// 获取冷钱包地址
 		coldAddressValue, err := app.SQLGetTAppConfigStrValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"cold_wallet_address",
+			chain.key("cold_wallet_address"),
 		)
 		if err != nil {
 			mcommon.Log.Warnf("SQLGetTAppConfigInt err: [%T] %s", err, err.Error())
@@ -336,7 +363,7 @@ func CheckAddressOrg() {
 		}
 		coldAddress, err := StrToAddressBytes(coldAddressValue)
 		if err != nil {
-			mcommon.Log.Errorf("eth organize cold address err: [%T] %s", err, err.Error())
+			mcommon.Log.Errorf("%s organize cold address err: [%T] %s", chain.Symbol, err, err.Error())
 			return
 		}
 		// 开启事物
@@ -361,6 +388,7 @@ func CheckAddressOrg() {
 				model.DBColTTxBalanceReal,
 			},
 			app.TxOrgStatusInit,
+			chain.Symbol,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -374,17 +402,21 @@ func CheckAddressOrg() {
 		gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
 			context.Background(),
 			dbTx,
-			"to_cold_gas_price",
+			chain.key("to_cold_gas_price"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
 		gasPrice := gasPriceValue
-		gasLimit := int64(21000)
+		gasLimit, err := chain.getGasUse(context.Background(), dbTx)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
 		feeValue := big.NewInt(gasLimit * gasPrice)
 		// chain id
-		chainID, err := ethclient.RpcNetworkID(context.Background())
+		chainID, err := chain.getChainID(context.Background())
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
@@ -439,7 +471,7 @@ func CheckAddressOrg() {
 				continue
 			}
 			// 获取nonce值
-			nonce, err := GetNonce(dbTx, address)
+			nonce, err := chain.GetNonce(dbTx, address)
 			if err != nil {
 				mcommon.Log.Errorf("GetNonce err: [%T] %s", err, err.Error())
 				return
@@ -482,6 +514,7 @@ func CheckAddressOrg() {
 				if rowIndex == 0 {
 					// 只有第一条数据需要发送，其余数据为占位数据
 					sendRows = append(sendRows, &model.DBTSend{
+						ChainSymbol:  chain.Symbol,
 						RelatedType:  app.SendRelationTypeTx,
 						RelatedID:    rowID,
 						TxID:         txHash,
@@ -500,6 +533,7 @@ func CheckAddressOrg() {
 				} else {
 					// 占位数据
 					sendRows = append(sendRows, &model.DBTSend{
+						ChainSymbol:  chain.Symbol,
 						RelatedType:  app.SendRelationTypeTx,
 						RelatedID:    rowID,
 						TxID:         txHash,
@@ -556,7 +590,12 @@ func CheckAddressOrg() {
 
 // CheckRawTxSend 发送交易
 func CheckRawTxSend() {
-	lockKey := "EthCheckRawTxSend"
+	Eth.CheckRawTxSend()
+}
+
+// CheckRawTxSend 发送交易
+func (chain *Chain) CheckRawTxSend() {
+	lockKey := chain.LockPrefix + "CheckRawTxSend"
 	app.LockWrap(lockKey, func() {
 		// 获取待发送的数据
 		sendRows, err := app.SQLSelectTSendColByStatus(
@@ -570,6 +609,7 @@ func CheckRawTxSend() {
 				model.DBColTSendRelatedID,
 			},
 			app.SendStatusInit,
+			chain.Symbol,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -708,7 +748,7 @@ func CheckRawTxSend() {
 					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 					continue
 				}
-				err = ethclient.RpcSendTransaction(
+				err = chain.GetClient().RpcSendTransaction(
 					context.Background(),
 					tx,
 				)
@@ -824,7 +864,12 @@ func CheckRawTxSend() {
 
 // CheckRawTxConfirm 确认tx是否打包完成
 func CheckRawTxConfirm() {
-	lockKey := "EthCheckRawTxConfirm"
+	Eth.CheckRawTxConfirm()
+}
+
+// CheckRawTxConfirm 确认tx是否打包完成
+func (chain *Chain) CheckRawTxConfirm() {
+	lockKey := chain.LockPrefix + "CheckRawTxConfirm"
 	app.LockWrap(lockKey, func() {
 		sendRows, err := app.SQLSelectTSendColByStatus(
 			context.Background(),
@@ -837,6 +882,7 @@ func CheckRawTxConfirm() {
 				model.DBColTSendTxID,
 			},
 			app.SendStatusSend,
+			chain.Symbol,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -900,7 +946,7 @@ func CheckRawTxConfirm() {
 		var sendHashes []string
 		for _, sendRow := range sendRows {
 			if !mcommon.IsStringInSlice(sendHashes, sendRow.TxID) {
-				rpcTx, err := ethclient.RpcTransactionByHash(
+				rpcTx, err := chain.GetClient().RpcTransactionByHash(
 					context.Background(),
 					sendRow.TxID,
 				)
@@ -1067,7 +1113,12 @@ func CheckRawTxConfirm() {
 
 // CheckWithdraw 检测提现
 func CheckWithdraw() {
-	lockKey := "EthCheckWithdraw"
+	Eth.CheckWithdraw()
+}
+
+// CheckWithdraw 检测提现
+func (chain *Chain) CheckWithdraw() {
+	lockKey := chain.LockPrefix + "CheckWithdraw"
 	app.LockWrap(lockKey, func() {
 		// 获取需要处理的提币数据
 		withdrawRows, err := app.SQLSelectTWithdrawColByStatus(
@@ -1077,7 +1128,7 @@ func CheckWithdraw() {
 				model.DBColTWithdrawID,
 			},
 			app.WithdrawStatusInit,
-			[]string{CoinSymbol},
+			[]string{chain.GetCoinSymbol()},
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -1091,7 +1142,7 @@ func CheckWithdraw() {
 		hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"hot_wallet_address",
+			chain.key("hot_wallet_address"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -1099,7 +1150,7 @@ func CheckWithdraw() {
 		}
 		_, err = StrToAddressBytes(hotAddressValue)
 		if err != nil {
-			mcommon.Log.Errorf("eth hot address err: [%T] %s", err, err.Error())
+			mcommon.Log.Errorf("%s hot address err: [%T] %s", chain.Symbol, err, err.Error())
 			return
 		}
 		// 获取私钥
@@ -1113,7 +1164,7 @@ func CheckWithdraw() {
 			return
 		}
 		// 获取热钱包余额
-		hotAddressBalance, err := ethclient.RpcBalanceAt(
+		hotAddressBalance, err := chain.GetClient().RpcBalanceAt(
 			context.Background(),
 			hotAddressValue,
 		)
@@ -1125,6 +1176,7 @@ func CheckWithdraw() {
 			context.Background(),
 			xenv.DbCon,
 			hotAddressValue,
+			chain.Symbol,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -1140,22 +1192,26 @@ func CheckWithdraw() {
 		gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"to_user_gas_price",
+			chain.key("to_user_gas_price"),
 		)
 		if err != nil {
 			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
 			return
 		}
 		gasPrice := gasPriceValue
-		gasLimit := int64(21000)
+		gasLimit, err := chain.getGasUse(context.Background(), xenv.DbCon)
+		if err != nil {
+			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
+			return
+		}
 		feeValue := gasLimit * gasPrice
-		chainID, err := ethclient.RpcNetworkID(context.Background())
+		chainID, err := chain.getChainID(context.Background())
 		if err != nil {
 			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
 			return
 		}
 		for _, withdrawRow := range withdrawRows {
-			err = handleWithdraw(withdrawRow.ID, chainID, hotAddressValue, privateKey, hotAddressBalance, gasLimit, gasPrice, feeValue)
+			err = chain.handleWithdraw(withdrawRow.ID, chainID, hotAddressValue, privateKey, hotAddressBalance, gasLimit, gasPrice, feeValue)
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 				continue
@@ -1164,7 +1220,7 @@ func CheckWithdraw() {
 	})
 }
 
-func handleWithdraw(withdrawID int64, chainID int64, hotAddress string, privateKey *ecdsa.PrivateKey, hotAddressBalance *big.Int, gasLimit, gasPrice, feeValue int64) error {
+func (chain *Chain) handleWithdraw(withdrawID int64, chainID int64, hotAddress string, privateKey *ecdsa.PrivateKey, hotAddressBalance *big.Int, gasLimit, gasPrice, feeValue int64) error {
 	isComment := false
 	dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
 	if err != nil {
@@ -1206,7 +1262,7 @@ func handleWithdraw(withdrawID int64, chainID int64, hotAddress string, privateK
 		return nil
 	}
 	// nonce
-	nonce, err := GetNonce(
+	nonce, err := chain.GetNonce(
 		dbTx,
 		hotAddress,
 	)
@@ -1254,6 +1310,7 @@ func handleWithdraw(withdrawID int64, chainID int64, hotAddress string, privateK
 		context.Background(),
 		dbTx,
 		&model.DBTSend{
+			ChainSymbol:  chain.Symbol,
 			RelatedType:  app.SendRelationTypeWithdraw,
 			RelatedID:    withdrawID,
 			TxID:         txHash,
@@ -1284,7 +1341,12 @@ func handleWithdraw(withdrawID int64, chainID int64, hotAddress string, privateK
 
 // CheckTxNotify 创建eth冲币通知
 func CheckTxNotify() {
-	lockKey := "EthCheckTxNotify"
+	Eth.CheckTxNotify()
+}
+
+// CheckTxNotify 创建冲币通知
+func (chain *Chain) CheckTxNotify() {
+	lockKey := chain.LockPrefix + "CheckTxNotify"
 	app.LockWrap(lockKey, func() {
 		txRows, err := app.SQLSelectTTxColByStatus(
 			context.Background(),
@@ -1297,16 +1359,21 @@ func CheckTxNotify() {
 				model.DBColTTxBalanceReal,
 			},
 			app.TxStatusInit,
+			chain.Symbol,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
 		var productIDs []int64
+		var addresses []string
 		for _, txRow := range txRows {
 			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
 				productIDs = append(productIDs, txRow.ProductID)
 			}
+			if !mcommon.IsStringInSlice(addresses, txRow.ToAddress) {
+				addresses = append(addresses, txRow.ToAddress)
+			}
 		}
 		productMap, err := app.SQLGetProductMap(
 			context.Background(),
@@ -1323,6 +1390,20 @@ func CheckTxNotify() {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
+		addressUserMap, err := app.SQLGetAddressUserMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressUserProductID,
+				model.DBColTAddressUserUserID,
+			},
+			chain.GetAddressUserSymbols(),
+			addresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
 
 		var notifyTxIDs []int64
 		var notifyRows []*model.DBTProductNotify
@@ -1340,9 +1421,12 @@ func CheckTxNotify() {
 				"app_name":    productRow.AppName,
 				"address":     txRow.ToAddress,
 				"balance":     txRow.BalanceReal,
-				"symbol":      CoinSymbol,
+				"symbol":      chain.GetCoinSymbol(),
 				"notify_type": app.NotifyTypeTx,
 			}
+			if userID := app.GetAddressUserID(addressUserMap, txRow.ProductID, txRow.ToAddress); userID != "" {
+				reqObj["user_id"] = userID
+			}
 			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
 			req, err := json.Marshal(reqObj)
 			if err != nil {
@@ -1355,7 +1439,7 @@ func CheckTxNotify() {
 				ItemType:     app.SendRelationTypeTx,
 				ItemID:       txRow.ID,
 				NotifyType:   app.NotifyTypeTx,
-				TokenSymbol:  CoinSymbol,
+				TokenSymbol:  chain.GetCoinSymbol(),
 				URL:          productRow.CbURL,
 				Msg:          string(req),
 				HandleStatus: app.NotifyStatusInit,
@@ -1394,13 +1478,18 @@ func CheckTxNotify() {
 
 // CheckErc20BlockSeek 检测erc20到账
 func CheckErc20BlockSeek() {
-	lockKey := "Erc20CheckBlockSeek"
+	Eth.CheckErc20BlockSeek()
+}
+
+// CheckErc20BlockSeek 检测erc20到账
+func (chain *Chain) CheckErc20BlockSeek() {
+	lockKey := chain.LockPrefix + "Erc20CheckBlockSeek"
 	app.LockWrap(lockKey, func() {
 		// 获取配置 延迟确认数
 		confirmValue, err := app.SQLGetTAppConfigIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"block_confirm_num",
+			chain.key("block_confirm_num"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -1410,14 +1499,14 @@ func CheckErc20BlockSeek() {
 		seekValue, err := app.SQLGetTAppStatusIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"erc20_seek_num",
+			chain.key("erc20_seek_num"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
 		// rpc 获取当前最新区块数
-		rpcBlockNum, err := ethclient.RpcBlockNumber(context.Background())
+		rpcBlockNum, err := chain.GetClient().RpcBlockNumber(context.Background())
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
@@ -1439,7 +1528,7 @@ func CheckErc20BlockSeek() {
 			// 获取所有token
 			var configTokenRowAddresses []string
 			configTokenRowMap := make(map[string]*model.DBTAppConfigToken)
-			configTokenRows, err := app.SQLSelectTAppConfigTokenColAll(
+			configTokenRows, err := app.SQLSelectTAppConfigTokenColByChainSymbol(
 				context.Background(),
 				xenv.DbCon,
 				[]string{
@@ -1448,6 +1537,7 @@ func CheckErc20BlockSeek() {
 					model.DBColTAppConfigTokenTokenDecimals,
 					model.DBColTAppConfigTokenTokenSymbol,
 				},
+				chain.Symbol,
 			)
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -1462,7 +1552,7 @@ func CheckErc20BlockSeek() {
 				//mcommon.Log.Debugf("erc20 check block: %d", i)
 				if len(configTokenRowAddresses) > 0 {
 					// rpc获取block信息
-					logs, err := ethclient.RpcFilterLogs(
+					logs, err := chain.GetClient().RpcFilterLogs(
 						context.Background(),
 						i,
 						i,
@@ -1488,13 +1578,14 @@ func CheckErc20BlockSeek() {
 						toAddressLogMap[toAddress] = append(toAddressLogMap[toAddress], log)
 					}
 					// 从db中查询这些地址是否是冲币地址中的地址
-					dbAddressRows, err := app.SQLSelectTAddressKeyColByAddress(
+					dbAddressRows, err := app.SQLSelectTAddressKeyColByAddressAndSymbol(
 						context.Background(),
 						xenv.DbCon,
 						[]string{
 							model.DBColTAddressKeyAddress,
 							model.DBColTAddressKeyUseTag,
 						},
+						chain.GetAddressSymbol(),
 						toAddresses,
 					)
 					if err != nil {
@@ -1536,7 +1627,7 @@ func CheckErc20BlockSeek() {
 								mcommon.Log.Errorf("no configTokenRowMap of: %s", contractAddress)
 								return
 							}
-							rpcTxReceipt, err := ethclient.RpcTransactionReceipt(
+							rpcTxReceipt, err := chain.GetClient().RpcTransactionReceipt(
 								context.Background(),
 								log.TxHash.Hex(),
 							)
@@ -1547,7 +1638,7 @@ func CheckErc20BlockSeek() {
 							if rpcTxReceipt.Status <= 0 {
 								continue
 							}
-							rpcTx, err := ethclient.RpcTransactionByHash(
+							rpcTx, err := chain.GetClient().RpcTransactionByHash(
 								context.Background(),
 								log.TxHash.Hex(),
 							)
@@ -1580,9 +1671,11 @@ func CheckErc20BlockSeek() {
 							}
 							// 放入待插入数组
 							txErc20Rows = append(txErc20Rows, &model.DBTTxErc20{
+								ChainSymbol:  chain.Symbol,
 								TokenID:      configTokenRow.ID,
 								ProductID:    addressProductMap[transferEvent.To],
 								TxID:         log.TxHash.Hex(),
+								BlockNum:     int64(log.BlockNumber),
 								FromAddress:  transferEvent.From,
 								ToAddress:    transferEvent.To,
 								BalanceReal:  balanceReal,
@@ -1612,7 +1705,7 @@ func CheckErc20BlockSeek() {
 					context.Background(),
 					xenv.DbCon,
 					&model.DBTAppStatusInt{
-						K: "erc20_seek_num",
+						K: chain.key("erc20_seek_num"),
 						V: i,
 					},
 				)
@@ -1627,7 +1720,12 @@ func CheckErc20BlockSeek() {
 
 // CheckErc20TxNotify 创建erc20冲币通知
 func CheckErc20TxNotify() {
-	lockKey := "Erc20CheckTxNotify"
+	Eth.CheckErc20TxNotify()
+}
+
+// CheckErc20TxNotify 创建erc20冲币通知
+func (chain *Chain) CheckErc20TxNotify() {
+	lockKey := chain.LockPrefix + "Erc20CheckTxNotify"
 	app.LockWrap(lockKey, func() {
 		txRows, err := app.SQLSelectTTxErc20ColByStatus(
 			context.Background(),
@@ -1641,17 +1739,22 @@ func CheckErc20TxNotify() {
 				model.DBColTTxErc20BalanceReal,
 			},
 			app.TxStatusInit,
+			chain.Symbol,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
 		var productIDs []int64
+		var addresses []string
 		var tokenIDs []int64
 		for _, txRow := range txRows {
 			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
 				productIDs = append(productIDs, txRow.ProductID)
 			}
+			if !mcommon.IsStringInSlice(addresses, txRow.ToAddress) {
+				addresses = append(addresses, txRow.ToAddress)
+			}
 			if !mcommon.IsIntInSlice(tokenIDs, txRow.TokenID) {
 				tokenIDs = append(tokenIDs, txRow.TokenID)
 			}
@@ -1671,6 +1774,20 @@ func CheckErc20TxNotify() {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
+		addressUserMap, err := app.SQLGetAddressUserMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressUserProductID,
+				model.DBColTAddressUserUserID,
+			},
+			chain.GetAddressUserSymbols(),
+			addresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
 		tokenMap, err := app.SQLGetAppConfigTokenMap(
 			context.Background(),
 			xenv.DbCon,
@@ -1706,9 +1823,12 @@ func CheckErc20TxNotify() {
 				"app_name":    productRow.AppName,
 				"address":     txRow.ToAddress,
 				"balance":     txRow.BalanceReal,
-				"symbol":      tokenRow.TokenSymbol,
+				"symbol":      chain.GetTokenSymbol(tokenRow),
 				"notify_type": app.NotifyTypeTx,
 			}
+			if userID := app.GetAddressUserID(addressUserMap, txRow.ProductID, txRow.ToAddress); userID != "" {
+				reqObj["user_id"] = userID
+			}
 			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
 			req, err := json.Marshal(reqObj)
 			if err != nil {
@@ -1721,7 +1841,7 @@ func CheckErc20TxNotify() {
 				ItemType:     app.SendRelationTypeTx,
 				ItemID:       txRow.ID,
 				NotifyType:   app.NotifyTypeTx,
-				TokenSymbol:  tokenRow.TokenSymbol,
+				TokenSymbol:  chain.GetTokenSymbol(tokenRow),
 				URL:          productRow.CbURL,
 				Msg:          string(req),
 				HandleStatus: app.NotifyStatusInit,
@@ -1760,13 +1880,18 @@ func CheckErc20TxNotify() {
 
 // CheckErc20TxOrg erc20零钱整理
 func CheckErc20TxOrg() {
-	lockKey := "Erc20CheckTxOrg"
+	Eth.CheckErc20TxOrg()
+}
+
+// CheckErc20TxOrg erc20零钱整理
+func (chain *Chain) CheckErc20TxOrg() {
+	lockKey := chain.LockPrefix + "Erc20CheckTxOrg"
 	app.LockWrap(lockKey, func() {
 		// 计算转账token所需的手续费
 		erc20GasUseValue, err := app.SQLGetTAppConfigIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"erc20_gas_use",
+			chain.key("erc20_gas_use"),
 		)
 		if err != nil {
 			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
@@ -1775,17 +1900,21 @@ func CheckErc20TxOrg() {
 		gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"to_cold_gas_price",
+			chain.key("to_cold_gas_price"),
 		)
 		if err != nil {
 			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
 			return
 		}
 		erc20Fee := big.NewInt(erc20GasUseValue * gasPriceValue)
-		ethGasUse := int64(21000)
+		ethGasUse, err := chain.getGasUse(context.Background(), xenv.DbCon)
+		if err != nil {
+			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
+			return
+		}
 		ethFee := big.NewInt(ethGasUse * gasPriceValue)
 		// chainID
-		chainID, err := ethclient.RpcNetworkID(context.Background())
+		chainID, err := chain.getChainID(context.Background())
 		if err != nil {
 			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
 			return
@@ -1815,6 +1944,7 @@ func CheckErc20TxOrg() {
 				model.DBColTTxErc20BalanceReal,
 			},
 			[]int64{app.TxOrgStatusInit, app.TxOrgStatusFeeConfirm},
+			chain.Symbol,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -1873,7 +2003,7 @@ func CheckErc20TxOrg() {
 			// 读取eth余额
 			_, ok = addressEthBalanceMap[txRow.ToAddress]
 			if !ok {
-				balance, err := ethclient.RpcBalanceAt(
+				balance, err := chain.GetClient().RpcBalanceAt(
 					context.Background(),
 					txRow.ToAddress,
 				)
@@ -1951,7 +2081,7 @@ func CheckErc20TxOrg() {
 				continue
 			}
 			// 获取nonce值
-			nonce, err := GetNonce(dbTx, toAddress)
+			nonce, err := chain.GetNonce(dbTx, toAddress)
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 				continue
@@ -1999,6 +2129,7 @@ func CheckErc20TxOrg() {
 			for rowIndex, txID := range orgInfo.TxIDs {
 				if rowIndex == 0 {
 					sendRows = append(sendRows, &model.DBTSend{
+						ChainSymbol:  chain.Symbol,
 						RelatedType:  app.SendRelationTypeTxErc20,
 						RelatedID:    txID,
 						TokenID:      orgInfo.TokenID,
@@ -2017,6 +2148,7 @@ func CheckErc20TxOrg() {
 					})
 				} else {
 					sendRows = append(sendRows, &model.DBTSend{
+						ChainSymbol:  chain.Symbol,
 						RelatedType:  app.SendRelationTypeTxErc20,
 						RelatedID:    txID,
 						TokenID:      orgInfo.TokenID,
@@ -2068,7 +2200,7 @@ func CheckErc20TxOrg() {
 			feeAddressValue, err := app.SQLGetTAppConfigStrValueByK(
 				context.Background(),
 				dbTx,
-				"fee_wallet_address",
+				chain.key("fee_wallet_address"),
 			)
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -2089,7 +2221,7 @@ func CheckErc20TxOrg() {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 				return
 			}
-			feeAddressBalance, err := ethclient.RpcBalanceAt(
+			feeAddressBalance, err := chain.GetClient().RpcBalanceAt(
 				context.Background(),
 				feeAddressValue,
 			)
@@ -2101,6 +2233,7 @@ func CheckErc20TxOrg() {
 				context.Background(),
 				dbTx,
 				feeAddressValue,
+				chain.Symbol,
 			)
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -2117,11 +2250,11 @@ func CheckErc20TxOrg() {
 				feeAddressBalance.Sub(feeAddressBalance, ethFee)
 				feeAddressBalance.Sub(feeAddressBalance, erc20Fee)
 				if feeAddressBalance.Cmp(new(big.Int)) < 0 {
-					mcommon.Log.Errorf("eth fee balance limit")
+					mcommon.Log.Errorf("%s fee balance limit", chain.Symbol)
 					return
 				}
 				// nonce
-				nonce, err := GetNonce(
+				nonce, err := chain.GetNonce(
 					dbTx,
 					feeAddressValue,
 				)
@@ -2159,6 +2292,7 @@ func CheckErc20TxOrg() {
 				for rowIndex, txID := range orgInfo.TxIDs {
 					if rowIndex == 0 {
 						sendRows = append(sendRows, &model.DBTSend{
+							ChainSymbol:  chain.Symbol,
 							RelatedType:  app.SendRelationTypeTxErc20Fee,
 							RelatedID:    txID,
 							TokenID:      0,
@@ -2177,6 +2311,7 @@ func CheckErc20TxOrg() {
 						})
 					} else {
 						sendRows = append(sendRows, &model.DBTSend{
+							ChainSymbol:  chain.Symbol,
 							RelatedType:  app.SendRelationTypeTxErc20Fee,
 							RelatedID:    txID,
 							TokenID:      0,
@@ -2235,14 +2370,19 @@ func CheckErc20TxOrg() {
 
 // CheckErc20Withdraw erc20提币
 func CheckErc20Withdraw() {
-	lockKey := "Erc20CheckWithdraw"
+	Eth.CheckErc20Withdraw()
+}
+
+// CheckErc20Withdraw erc20提币
+func (chain *Chain) CheckErc20Withdraw() {
+	lockKey := chain.LockPrefix + "Erc20CheckWithdraw"
 	app.LockWrap(lockKey, func() {
 		var tokenSymbols []string
 		tokenMap := make(map[string]*model.DBTAppConfigToken)
 		addressKeyMap := make(map[string]*ecdsa.PrivateKey)
 		addressEthBalanceMap := make(map[string]*big.Int)
 		addressTokenBalanceMap := make(map[string]*big.Int)
-		tokenRows, err := app.SQLSelectTAppConfigTokenColAll(
+		tokenRows, err := app.SQLSelectTAppConfigTokenColByChainSymbol(
 			context.Background(),
 			xenv.DbCon,
 			[]string{
@@ -2252,15 +2392,17 @@ func CheckErc20Withdraw() {
 				model.DBColTAppConfigTokenTokenSymbol,
 				model.DBColTAppConfigTokenHotAddress,
 			},
+			chain.Symbol,
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
 		for _, tokenRow := range tokenRows {
-			tokenMap[tokenRow.TokenSymbol] = tokenRow
-			if !mcommon.IsStringInSlice(tokenSymbols, tokenRow.TokenSymbol) {
-				tokenSymbols = append(tokenSymbols, tokenRow.TokenSymbol)
+			tokenSymbol := chain.GetTokenSymbol(tokenRow)
+			tokenMap[tokenSymbol] = tokenRow
+			if !mcommon.IsStringInSlice(tokenSymbols, tokenSymbol) {
+				tokenSymbols = append(tokenSymbols, tokenSymbol)
 			}
 		}
 		withdrawRows, err := app.SQLSelectTWithdrawColByStatus(
@@ -2328,7 +2470,7 @@ func CheckErc20Withdraw() {
 			}
 			_, ok = addressEthBalanceMap[hotAddress]
 			if !ok {
-				hotAddressBalance, err := ethclient.RpcBalanceAt(
+				hotAddressBalance, err := chain.GetClient().RpcBalanceAt(
 					context.Background(),
 					hotAddress,
 				)
@@ -2340,6 +2482,7 @@ func CheckErc20Withdraw() {
 					context.Background(),
 					xenv.DbCon,
 					hotAddress,
+					chain.Symbol,
 				)
 				if err != nil {
 					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -2356,7 +2499,7 @@ func CheckErc20Withdraw() {
 			tokenBalanceKey := fmt.Sprintf("%s-%s", tokenRow.HotAddress, tokenRow.TokenSymbol)
 			_, ok = addressTokenBalanceMap[tokenBalanceKey]
 			if !ok {
-				tokenBalance, err := ethclient.RpcTokenBalance(
+				tokenBalance, err := chain.GetClient().RpcTokenBalance(
 					context.Background(),
 					tokenRow.TokenAddress,
 					tokenRow.HotAddress,
@@ -2372,7 +2515,7 @@ func CheckErc20Withdraw() {
 		gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"to_user_gas_price",
+			chain.key("to_user_gas_price"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -2382,7 +2525,7 @@ func CheckErc20Withdraw() {
 		erc20GasUseValue, err := app.SQLGetTAppConfigIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"erc20_gas_use",
+			chain.key("erc20_gas_use"),
 		)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
@@ -2391,13 +2534,13 @@ func CheckErc20Withdraw() {
 		gasLimit := erc20GasUseValue
 		// eth fee
 		feeValue := big.NewInt(gasLimit * gasPrice)
-		chainID, err := ethclient.RpcNetworkID(context.Background())
+		chainID, err := chain.getChainID(context.Background())
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
 		for _, withdrawRow := range withdrawRows {
-			err = handleErc20Withdraw(withdrawRow.ID, chainID, &tokenMap, &addressKeyMap, &addressEthBalanceMap, &addressTokenBalanceMap, gasLimit, gasPrice, feeValue)
+			err = chain.handleErc20Withdraw(withdrawRow.ID, chainID, &tokenMap, &addressKeyMap, &addressEthBalanceMap, &addressTokenBalanceMap, gasLimit, gasPrice, feeValue)
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 				continue
@@ -2406,7 +2549,7 @@ func CheckErc20Withdraw() {
 	})
 }
 
-func handleErc20Withdraw(withdrawID int64, chainID int64, tokenMap *map[string]*model.DBTAppConfigToken, addressKeyMap *map[string]*ecdsa.PrivateKey, addressEthBalanceMap *map[string]*big.Int, addressTokenBalanceMap *map[string]*big.Int, gasLimit, gasPrice int64, feeValue *big.Int) error {
+func (chain *Chain) handleErc20Withdraw(withdrawID int64, chainID int64, tokenMap *map[string]*model.DBTAppConfigToken, addressKeyMap *map[string]*ecdsa.PrivateKey, addressEthBalanceMap *map[string]*big.Int, addressTokenBalanceMap *map[string]*big.Int, gasLimit, gasPrice int64, feeValue *big.Int) error {
 	isComment := false
 	dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
 	if err != nil {
@@ -2452,7 +2595,7 @@ func handleErc20Withdraw(withdrawID int64, chainID int64, tokenMap *map[string]*
 		feeValue,
 	)
 	if (*addressEthBalanceMap)[hotAddress].Cmp(new(big.Int)) < 0 {
-		mcommon.Log.Errorf("%s eth limit", hotAddress)
+		mcommon.Log.Errorf("%s %s limit", hotAddress, chain.NativeSymbol)
 		return nil
 	}
 	tokenBalanceKey := fmt.Sprintf("%s-%s", tokenRow.HotAddress, tokenRow.TokenSymbol)
@@ -2469,7 +2612,7 @@ func handleErc20Withdraw(withdrawID int64, chainID int64, tokenMap *map[string]*
 		return nil
 	}
 	// 获取nonce值
-	nonce, err := GetNonce(dbTx, hotAddress)
+	nonce, err := chain.GetNonce(dbTx, hotAddress)
 	if err != nil {
 		return err
 	}
@@ -2523,6 +2666,7 @@ func handleErc20Withdraw(withdrawID int64, chainID int64, tokenMap *map[string]*
 		context.Background(),
 		dbTx,
 		&model.DBTSend{
+			ChainSymbol:  chain.Symbol,
 			RelatedType:  app.SendRelationTypeWithdraw,
 			RelatedID:    withdrawID,
 			TxID:         txHash,
@@ -2553,13 +2697,18 @@ func handleErc20Withdraw(withdrawID int64, chainID int64, tokenMap *map[string]*
 
 // CheckGasPrice 检测gas price
 func CheckGasPrice() {
-	lockKey := "EthCheckGasPrice"
+	Eth.CheckGasPrice()
+}
+
+// CheckGasPrice 检测gas price
+func (chain *Chain) CheckGasPrice() {
+	lockKey := chain.LockPrefix + "CheckGasPrice"
 	app.LockWrap(lockKey, func() {
 		// 获取最高单价
 		maxValue, err := app.SQLGetTAppStatusIntValueByK(
 			context.Background(),
 			xenv.DbCon,
-			"max_gas_price_eth",
+			chain.maxGasPriceKey(),
 		)
 		if err != nil {
 			if !strings.Contains(err.Error(), "no app status int of") {
@@ -2568,13 +2717,13 @@ func CheckGasPrice() {
 			}
 		}
 		if maxValue <= 0 {
-			maxValue = 80000000000
+			maxValue = chain.MaxGasPrice
 			// 创建
 			_, err := model.SQLCreateTAppStatusInt(
 				context.Background(),
 				xenv.DbCon,
 				&model.DBTAppStatusInt{
-					K: "max_gas_price_eth",
+					K: chain.maxGasPriceKey(),
 					V: maxValue,
 				},
 				true,
@@ -2584,41 +2733,11 @@ func CheckGasPrice() {
 				return
 			}
 		}
-		type StRespGasPrice struct {
-			Fast        int64   `json:"fast"`
-			Fastest     int64   `json:"fastest"`
-			SafeLow     int64   `json:"safeLow"`
-			Average     int64   `json:"average"`
-			BlockTime   float64 `json:"block_time"`
-			BlockNum    int64   `json:"blockNum"`
-			Speed       float64 `json:"speed"`
-			SafeLowWait float64 `json:"safeLowWait"`
-			AvgWait     float64 `json:"avgWait"`
-			FastWait    float64 `json:"fastWait"`
-			FastestWait float64 `json:"fastestWait"`
-		}
-		gresp, body, errs := gorequest.New().
-			Proxy(xenv.Cfg.Proxy).
-			Get("https://ethgasstation.info/api/ethgasAPI.json").
-			Timeout(time.Second * 120).
-			End()
-		if errs != nil {
-			mcommon.Log.Errorf("err: [%T] %s", errs[0], errs[0].Error())
-			return
-		}
-		if gresp.StatusCode != http.StatusOK {
-			// 状态错误
-			mcommon.Log.Errorf("req status error: %d", gresp.StatusCode)
-			return
-		}
-		var resp StRespGasPrice
-		err = json.Unmarshal([]byte(body), &resp)
+		toUserGasPrice, toColdGasPrice, err := chain.getGasPrice()
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
 		}
-		toUserGasPrice := resp.Fast * int64(math.Pow10(8))
-		toColdGasPrice := resp.Average * int64(math.Pow10(8))
 		if toUserGasPrice > maxValue {
 			toUserGasPrice = maxValue
 		}
@@ -2629,7 +2748,7 @@ func CheckGasPrice() {
 			context.Background(),
 			xenv.DbCon,
 			&model.DBTAppStatusInt{
-				K: "to_user_gas_price",
+				K: chain.key("to_user_gas_price"),
 				V: toUserGasPrice,
 			},
 		)
@@ -2641,7 +2760,7 @@ func CheckGasPrice() {
 			context.Background(),
 			xenv.DbCon,
 			&model.DBTAppStatusInt{
-				K: "to_cold_gas_price",
+				K: chain.key("to_cold_gas_price"),
 				V: toColdGasPrice,
 			},
 		)
@@ -2651,3 +2770,48 @@ func CheckGasPrice() {
 		}
 	})
 }
+
+// getGasPrice 获取到用户和到冷钱包的gas price
+// eth 使用 ethgasstation, 其他链使用节点建议的gas price
+func (chain *Chain) getGasPrice() (int64, int64, error) {
+	if chain != Eth {
+		gasPrice, err := chain.GetClient().RpcSuggestGasPrice(context.Background())
+		if err != nil {
+			return 0, 0, err
+		}
+		return gasPrice, gasPrice, nil
+	}
+	type StRespGasPrice struct {
+		Fast        int64   `json:"fast"`
+		Fastest     int64   `json:"fastest"`
+		SafeLow     int64   `json:"safeLow"`
+		Average     int64   `json:"average"`
+		BlockTime   float64 `json:"block_time"`
+		BlockNum    int64   `json:"blockNum"`
+		Speed       float64 `json:"speed"`
+		SafeLowWait float64 `json:"safeLowWait"`
+		AvgWait     float64 `json:"avgWait"`
+		FastWait    float64 `json:"fastWait"`
+		FastestWait float64 `json:"fastestWait"`
+	}
+	gresp, body, errs := gorequest.New().
+		Proxy(xenv.Cfg.Proxy).
+		Get("https://ethgasstation.info/api/ethgasAPI.json").
+		Timeout(time.Second * 120).
+		End()
+	if errs != nil {
+		return 0, 0, errs[0]
+	}
+	if gresp.StatusCode != http.StatusOK {
+		// 状态错误
+		return 0, 0, fmt.Errorf("req status error: %d", gresp.StatusCode)
+	}
+	var resp StRespGasPrice
+	err := json.Unmarshal([]byte(body), &resp)
+	if err != nil {
+		return 0, 0, err
+	}
+	toUserGasPrice := resp.Fast * int64(math.Pow10(8))
+	toColdGasPrice := resp.Average * int64(math.Pow10(8))
+	return toUserGasPrice, toColdGasPrice, nil
+}
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"go-dc-wallet/app"
-	"go-dc-wallet/ethclient"
 	"go-dc-wallet/model"
 	"go-dc-wallet/xenv"
 	"math/big"
@@ -36,9 +35,9 @@ func init() {
 }
 
 // GetNonce 获取nonce值
-func GetNonce(tx mcommon.DbExeAble, address string) (int64, error) {
+func (chain *Chain) GetNonce(tx mcommon.DbExeAble, address string) (int64, error) {
 	// 通过rpc获取
-	rpcNonce, err := ethclient.RpcNonceAt(
+	rpcNonce, err := chain.GetClient().RpcNonceAt(
 		context.Background(),
 		address,
 	)
@@ -50,6 +49,7 @@ func GetNonce(tx mcommon.DbExeAble, address string) (int64, error) {
 		context.Background(),
 		tx,
 		address,
+		chain.Symbol,
 	)
 	if nil != err {
 		return 0, err
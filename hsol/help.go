@@ -0,0 +1,253 @@
+package hsol
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"go-dc-wallet/solclient"
+	"go-dc-wallet/xenv"
+	"math/big"
+
+	"github.com/moremorefun/mcommon"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// SolToLamports 数据单位
+	SolToLamports = 1e9
+	// SolDecimals 精度
+	SolDecimals = 9
+	// CoinSymbol 单位标志
+	CoinSymbol = "sol"
+	// SignatureFee 每个签名的手续费 lamports
+	SignatureFee = 5000
+	// TokenAccountSize 代币账户数据大小
+	TokenAccountSize = 165
+)
+
+// solToLamportsDecimal 转换单位
+var solToLamportsDecimal = decimal.NewFromInt(SolToLamports)
+
+// genAddressAndAesKey 生成地址和加密后的私钥
+func genAddressAndAesKey() (string, string, error) {
+	// 生成私钥
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+	privateKeyStr := hex.EncodeToString(privateKey)
+	// 加密密钥
+	privateKeyStrEn, err := mcommon.AesEncrypt(privateKeyStr, xenv.Cfg.AESKey)
+	if err != nil {
+		return "", "", err
+	}
+	// 获取地址
+	var address PublicKey
+	copy(address[:], publicKey)
+	return address.String(), privateKeyStrEn, nil
+}
+
+// PrivateKeyToPublicKey 私钥获取公钥
+func PrivateKeyToPublicKey(privateKey ed25519.PrivateKey) PublicKey {
+	var publicKey PublicKey
+	copy(publicKey[:], privateKey.Public().(ed25519.PublicKey))
+	return publicKey
+}
+
+// IsValidAddress 检测地址
+func IsValidAddress(address string) bool {
+	_, err := PublicKeyFromBase58(address)
+	return err == nil
+}
+
+// GetAssociatedTokenAddress 获取地址的关联代币账户
+func GetAssociatedTokenAddress(address string, mintAddress string) (string, error) {
+	owner, err := PublicKeyFromBase58(address)
+	if err != nil {
+		return "", err
+	}
+	mint, err := PublicKeyFromBase58(mintAddress)
+	if err != nil {
+		return "", err
+	}
+	tokenAccount, err := FindAssociatedTokenAddress(owner, mint)
+	if err != nil {
+		return "", err
+	}
+	return tokenAccount.String(), nil
+}
+
+// SolStrToLamports 转换金额 sol to lamports
+func SolStrToLamports(balanceRealStr string) (int64, error) {
+	balanceReal, err := decimal.NewFromString(balanceRealStr)
+	if err != nil {
+		return 0, err
+	}
+	return balanceReal.Mul(solToLamportsDecimal).IntPart(), nil
+}
+
+// LamportsToSolStr 转换金额 lamports to sol
+func LamportsToSolStr(lamports int64) string {
+	return decimal.NewFromInt(lamports).Div(solToLamportsDecimal).StringFixed(SolDecimals)
+}
+
+// TokenStrToBigInt 转换代币金额
+func TokenStrToBigInt(balanceRealStr string, tokenDecimals int64) (*big.Int, error) {
+	balanceReal, err := decimal.NewFromString(balanceRealStr)
+	if err != nil {
+		return nil, err
+	}
+	balanceStr := balanceReal.Mul(decimal.NewFromInt(10).Pow(decimal.NewFromInt(tokenDecimals))).StringFixed(0)
+	b := new(big.Int)
+	_, ok := b.SetString(balanceStr, 10)
+	if !ok {
+		return nil, errors.New("error str to bigint")
+	}
+	return b, nil
+}
+
+// TokenBigIntToStr 转换代币金额
+func TokenBigIntToStr(balance *big.Int, tokenDecimals int64) (string, error) {
+	balanceDecimal, err := decimal.NewFromString(balance.String())
+	if err != nil {
+		return "0", err
+	}
+	balanceStr := balanceDecimal.Div(decimal.NewFromInt(10).Pow(decimal.NewFromInt(tokenDecimals))).StringFixed(int32(tokenDecimals))
+	return balanceStr, nil
+}
+
+// GetCoinSymbol 获取原生币对外使用的币种
+func GetCoinSymbol() string {
+	return app.GetAssetID(CoinSymbol, CoinSymbol)
+}
+
+// GetTokenSymbol 获取代币对外使用的币种
+func GetTokenSymbol(tokenRow *model.DBTAppConfigTokenSol) string {
+	return app.GetAssetID(CoinSymbol, tokenRow.TokenSymbol)
+}
+
+// getAvailableBalance 获取地址可用的sol余额 扣除待发送的金额
+func getAvailableBalance(tx mcommon.DbExeAble, address string) (int64, error) {
+	balance, err := solclient.RpcGetBalance(address)
+	if err != nil {
+		return 0, err
+	}
+	pendingBalanceReal, err := app.SQLGetTSendSolPendingBalanceReal(
+		context.Background(),
+		tx,
+		address,
+		0,
+	)
+	if err != nil {
+		return 0, err
+	}
+	pendingBalance, err := SolStrToLamports(pendingBalanceReal)
+	if err != nil {
+		return 0, err
+	}
+	return balance - pendingBalance, nil
+}
+
+// getTokenBalance 获取代币账户余额 账户不存在时为0
+func getTokenBalance(tokenAccount string) (*big.Int, error) {
+	rpcAccount, err := solclient.RpcGetAccountInfo(tokenAccount)
+	if err != nil {
+		return nil, err
+	}
+	if rpcAccount == nil {
+		return new(big.Int), nil
+	}
+	rpcAmount, err := solclient.RpcGetTokenAccountBalance(tokenAccount)
+	if err != nil {
+		return nil, err
+	}
+	balance, ok := new(big.Int).SetString(rpcAmount.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("error token balance of: %s", tokenAccount)
+	}
+	return balance, nil
+}
+
+// getTokenAccountRent 获取创建代币账户需要的租金 账户已存在时为0
+func getTokenAccountRent(tokenAccount string, rentMin int64) (int64, error) {
+	rpcAccount, err := solclient.RpcGetAccountInfo(tokenAccount)
+	if err != nil {
+		return 0, err
+	}
+	if rpcAccount != nil {
+		return 0, nil
+	}
+	return rentMin, nil
+}
+
+// GetPKMapOfAddresses 获取地址私钥
+func GetPKMapOfAddresses(ctx context.Context, db mcommon.DbExeAble, addresses []string) (map[string]ed25519.PrivateKey, error) {
+	addressPKMap := make(map[string]ed25519.PrivateKey)
+	addressKeyMap, err := app.SQLGetAddressKeyMap(
+		ctx,
+		db,
+		[]string{
+			model.DBColTAddressKeyID,
+			model.DBColTAddressKeyAddress,
+			model.DBColTAddressKeyPwd,
+		},
+		addresses,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range addressKeyMap {
+		privateKey, err := decryptKey(v.Pwd)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			continue
+		}
+		addressPKMap[k] = privateKey
+	}
+	return addressPKMap, nil
+}
+
+// GetPkOfAddress 获取地址私钥
+func GetPkOfAddress(ctx context.Context, db mcommon.DbExeAble, address string) (ed25519.PrivateKey, error) {
+	keyRow, err := app.SQLGetTAddressKeyColByAddress(
+		ctx,
+		db,
+		[]string{
+			model.DBColTAddressKeyPwd,
+		},
+		address,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if keyRow == nil {
+		return nil, fmt.Errorf("no key of: %s", address)
+	}
+	return decryptKey(keyRow.Pwd)
+}
+
+// decryptKey 解密私钥
+func decryptKey(pwd string) (ed25519.PrivateKey, error) {
+	key, err := mcommon.AesDecrypt(pwd, xenv.Cfg.AESKey)
+	if err != nil {
+		return nil, err
+	}
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(keyBytes) != ed25519.PrivateKeySize {
+		return nil, errors.New("error key")
+	}
+	return ed25519.PrivateKey(keyBytes), nil
+}
+
+// GetWithdrawFee 获取提币的sol手续费 提币交易只有热钱包一个签名
+func GetWithdrawFee() string {
+	return LamportsToSolStr(SignatureFee)
+}
@@ -0,0 +1,1356 @@
+package hsol
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"go-dc-wallet/solclient"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moremorefun/mcommon"
+)
+
+// signatureStatusLimit getSignatureStatuses 每次最多查询的数量
+const signatureStatusLimit = 256
+
+// CreateHotAddress 创建自用地址
+func CreateHotAddress(num int64) ([]string, error) {
+	var rows []*model.DBTAddressKey
+	var addresses []string
+	// 遍历差值次数
+	for i := int64(0); i < num; i++ {
+		address, privateKeyStrEn, err := genAddressAndAesKey()
+		if err != nil {
+			return nil, err
+		}
+		// 存入待添加队列
+		rows = append(rows, &model.DBTAddressKey{
+			Symbol:  CoinSymbol,
+			Address: address,
+			Pwd:     privateKeyStrEn,
+			UseTag:  -1,
+		})
+		addresses = append(addresses, address)
+	}
+	// 一次性将生成的地址存入数据库
+	_, err := model.SQLCreateManyTAddressKey(
+		context.Background(),
+		xenv.DbCon,
+		rows,
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// CheckAddressFree 检测是否有充足的备用地址
+func CheckAddressFree() {
+	lockKey := "SolCheckAddressFree"
+	app.LockWrap(lockKey, func() {
+		// 获取配置 允许的最小剩余地址数
+		minFreeCount, err := app.SQLGetTAppConfigIntValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"min_free_address",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 获取当前剩余可用地址数
+		freeCount, err := app.SQLGetTAddressKeyFreeCount(
+			context.Background(),
+			xenv.DbCon,
+			CoinSymbol,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 如果数据库中剩余可用地址小于最小允许可用地址
+		if freeCount < minFreeCount {
+			var rows []*model.DBTAddressKey
+			// 遍历差值次数
+			for i := int64(0); i < minFreeCount-freeCount; i++ {
+				address, privateKeyStrEn, err := genAddressAndAesKey()
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				// 存入待添加队列
+				rows = append(rows, &model.DBTAddressKey{
+					Symbol:  CoinSymbol,
+					Address: address,
+					Pwd:     privateKeyStrEn,
+					UseTag:  0,
+				})
+			}
+			// 一次性将生成的地址存入数据库
+			_, err = model.SQLCreateManyTAddressKey(
+				context.Background(),
+				xenv.DbCon,
+				rows,
+				true,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+	})
+}
+
+// CheckBlockSeek 检测sol和spl代币到账 只处理finalized的slot
+func CheckBlockSeek() {
+	lockKey := "SolCheckBlockSeek"
+	app.LockWrap(lockKey, func() {
+		// 获取状态 当前处理完成的最新的slot
+		seekValue, err := app.SQLGetTAppStatusIntValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"sol_seek_num",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// rpc 获取当前最新的finalized slot
+		rpcSlot, err := solclient.RpcGetSlot(solclient.CommitmentFinalized)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		startI := seekValue + 1
+		endI := rpcSlot + 1
+		if startI >= endI {
+			return
+		}
+		// 获取代币配置
+		tokenRows, err := app.SQLSelectTAppConfigTokenSolColAll(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigTokenSolID,
+				model.DBColTAppConfigTokenSolTokenAddress,
+				model.DBColTAppConfigTokenSolTokenDecimals,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// map[mint地址] => 代币配置
+		tokenAddressMap := make(map[string]*model.DBTAppConfigTokenSol)
+		for _, tokenRow := range tokenRows {
+			tokenAddressMap[tokenRow.TokenAddress] = tokenRow
+		}
+		// 遍历获取需要查询的slot信息
+		for i := startI; i < endI; i++ {
+			rpcBlock, err := solclient.RpcGetBlock(i)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 待处理交易
+			var blockTxRows []*model.DBTTxSol
+			if rpcBlock != nil {
+				// 跳过的slot没有block
+				for _, rpcTx := range rpcBlock.Transactions {
+					txRows, err := parseTransfers(rpcTx, tokenAddressMap)
+					if err != nil {
+						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						return
+					}
+					for _, txRow := range txRows {
+						txRow.BlockNum = i
+					}
+					blockTxRows = append(blockTxRows, txRows...)
+				}
+			}
+			// 从db中查询这些地址是否是冲币地址中的地址
+			var toAddresses []string
+			for _, blockTxRow := range blockTxRows {
+				if !mcommon.IsStringInSlice(toAddresses, blockTxRow.ToAddress) {
+					toAddresses = append(toAddresses, blockTxRow.ToAddress)
+				}
+			}
+			var dbAddressRows []*model.DBTAddressKey
+			if len(toAddresses) > 0 {
+				dbAddressRows, err = app.SQLSelectTAddressKeyColByAddressAndSymbol(
+					context.Background(),
+					xenv.DbCon,
+					[]string{
+						model.DBColTAddressKeyAddress,
+						model.DBColTAddressKeyUseTag,
+					},
+					CoinSymbol,
+					toAddresses,
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			}
+			// map[接收地址] => 产品id
+			addressProductMap := make(map[string]int64)
+			for _, dbAddressRow := range dbAddressRows {
+				if dbAddressRow.UseTag < 0 {
+					// 热钱包地址
+					continue
+				}
+				addressProductMap[dbAddressRow.Address] = dbAddressRow.UseTag
+			}
+			// 待插入数据
+			var dbTxRows []*model.DBTTxSol
+			now := time.Now().Unix()
+			for _, blockTxRow := range blockTxRows {
+				productID, ok := addressProductMap[blockTxRow.ToAddress]
+				if !ok {
+					continue
+				}
+				blockTxRow.ProductID = productID
+				blockTxRow.CreateTime = now
+				blockTxRow.HandleStatus = app.TxStatusInit
+				blockTxRow.HandleTime = now
+				blockTxRow.OrgStatus = app.TxOrgStatusInit
+				blockTxRow.OrgTime = now
+				dbTxRows = append(dbTxRows, blockTxRow)
+			}
+			// 插入交易数据
+			_, err = model.SQLCreateManyTTxSol(
+				context.Background(),
+				xenv.DbCon,
+				dbTxRows,
+				true,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 更新检查到的最新slot
+			_, err = app.SQLUpdateTAppStatusIntByKGreater(
+				context.Background(),
+				xenv.DbCon,
+				&model.DBTAppStatusInt{
+					K: "sol_seek_num",
+					V: i,
+				},
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+	})
+}
+
+// isRpcErr 接口返回的err是否为失败
+func isRpcErr(rpcErr json.RawMessage) bool {
+	return len(rpcErr) > 0 && string(rpcErr) != "null"
+}
+
+// parseTransfers 解析交易中的sol和spl代币转账 指令序号按外层指令及其内部指令的执行顺序计算
+func parseTransfers(rpcTx *solclient.StTransaction, tokenAddressMap map[string]*model.DBTAppConfigTokenSol) ([]*model.DBTTxSol, error) {
+	if rpcTx.Meta == nil || isRpcErr(rpcTx.Meta.Err) || len(rpcTx.Transaction.Signatures) == 0 {
+		return nil, nil
+	}
+	txID := rpcTx.Transaction.Signatures[0]
+	// map[代币账户] => 代币余额信息 用于获取接收账户的mint和所有者
+	accountKeys := rpcTx.Transaction.Message.AccountKeys
+	tokenAccountMap := make(map[string]*solclient.StTokenBalance)
+	for _, tokenBalances := range [][]*solclient.StTokenBalance{rpcTx.Meta.PreTokenBalances, rpcTx.Meta.PostTokenBalances} {
+		for _, tokenBalance := range tokenBalances {
+			if tokenBalance.AccountIndex < 0 || tokenBalance.AccountIndex >= int64(len(accountKeys)) {
+				continue
+			}
+			tokenAccountMap[accountKeys[tokenBalance.AccountIndex].Pubkey] = tokenBalance
+		}
+	}
+	// 按执行顺序排列外层指令和内部指令
+	innerMap := make(map[int64][]*solclient.StInstruction)
+	for _, inner := range rpcTx.Meta.InnerInstructions {
+		innerMap[inner.Index] = append(innerMap[inner.Index], inner.Instructions...)
+	}
+	var instructions []*solclient.StInstruction
+	for i, instruction := range rpcTx.Transaction.Message.Instructions {
+		instructions = append(instructions, instruction)
+		instructions = append(instructions, innerMap[int64(i)]...)
+	}
+	var txRows []*model.DBTTxSol
+	for ixIndex, instruction := range instructions {
+		if len(instruction.Parsed) == 0 || instruction.Parsed[0] != '{' {
+			// 未解析或者parsed为字符串的指令 例如memo
+			continue
+		}
+		var parsed solclient.StParsedInstruction
+		err := json.Unmarshal(instruction.Parsed, &parsed)
+		if err != nil {
+			return nil, err
+		}
+		switch instruction.Program {
+		case "system":
+			if parsed.Type != "transfer" {
+				continue
+			}
+			var info solclient.StTransferInfo
+			err := json.Unmarshal(parsed.Info, &info)
+			if err != nil {
+				return nil, err
+			}
+			if info.Lamports <= 0 {
+				continue
+			}
+			txRows = append(txRows, &model.DBTTxSol{
+				TokenID:     0,
+				TxID:        txID,
+				IxIndex:     int64(ixIndex),
+				FromAddress: info.Source,
+				ToAddress:   info.Destination,
+				BalanceReal: LamportsToSolStr(info.Lamports),
+			})
+		case "spl-token":
+			if parsed.Type != "transfer" && parsed.Type != "transferChecked" {
+				continue
+			}
+			var info solclient.StTokenTransferInfo
+			err := json.Unmarshal(parsed.Info, &info)
+			if err != nil {
+				return nil, err
+			}
+			destination, ok := tokenAccountMap[info.Destination]
+			if !ok || destination.Owner == "" {
+				continue
+			}
+			tokenRow, ok := tokenAddressMap[destination.Mint]
+			if !ok {
+				continue
+			}
+			amountStr := info.Amount
+			if parsed.Type == "transferChecked" {
+				amountStr = info.TokenAmount.Amount
+			}
+			amount, ok := new(big.Int).SetString(amountStr, 10)
+			if !ok {
+				return nil, fmt.Errorf("error token amount: %s", amountStr)
+			}
+			if amount.Sign() <= 0 {
+				continue
+			}
+			balanceReal, err := TokenBigIntToStr(amount, tokenRow.TokenDecimals)
+			if err != nil {
+				return nil, err
+			}
+			fromAddress := info.Authority
+			if source, ok := tokenAccountMap[info.Source]; ok && source.Owner != "" {
+				fromAddress = source.Owner
+			}
+			txRows = append(txRows, &model.DBTTxSol{
+				TokenID:      tokenRow.ID,
+				TxID:         txID,
+				IxIndex:      int64(ixIndex),
+				TokenAccount: info.Destination,
+				FromAddress:  fromAddress,
+				ToAddress:    destination.Owner,
+				BalanceReal:  balanceReal,
+			})
+		}
+	}
+	return txRows, nil
+}
+
+// CheckTxNotify 创建sol和spl代币冲币通知
+func CheckTxNotify() {
+	lockKey := "SolCheckTxNotify"
+	app.LockWrap(lockKey, func() {
+		txRows, err := app.SQLSelectTTxSolColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTTxSolID,
+				model.DBColTTxSolTokenID,
+				model.DBColTTxSolProductID,
+				model.DBColTTxSolTxID,
+				model.DBColTTxSolIxIndex,
+				model.DBColTTxSolToAddress,
+				model.DBColTTxSolBalanceReal,
+			},
+			app.TxStatusInit,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(txRows) == 0 {
+			return
+		}
+		var productIDs []int64
+		var addresses []string
+		var tokenIDs []int64
+		for _, txRow := range txRows {
+			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
+				productIDs = append(productIDs, txRow.ProductID)
+			}
+			if !mcommon.IsStringInSlice(addresses, txRow.ToAddress) {
+				addresses = append(addresses, txRow.ToAddress)
+			}
+			if txRow.TokenID > 0 && !mcommon.IsIntInSlice(tokenIDs, txRow.TokenID) {
+				tokenIDs = append(tokenIDs, txRow.TokenID)
+			}
+		}
+		productMap, err := app.SQLGetProductMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTProductID,
+				model.DBColTProductAppName,
+				model.DBColTProductCbURL,
+				model.DBColTProductAppSk,
+			},
+			productIDs,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		addressUserMap, err := app.SQLGetAddressUserMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressUserProductID,
+				model.DBColTAddressUserUserID,
+			},
+			[]string{CoinSymbol},
+			addresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		tokenMap, err := app.SQLGetAppConfigTokenSolMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigTokenSolID,
+				model.DBColTAppConfigTokenSolTokenSymbol,
+			},
+			tokenIDs,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+
+		var notifyTxIDs []int64
+		var notifyRows []*model.DBTProductNotify
+		now := time.Now().Unix()
+		for _, txRow := range txRows {
+			productRow, ok := productMap[txRow.ProductID]
+			if !ok {
+				mcommon.Log.Warnf("no productMap: %d", txRow.ProductID)
+				notifyTxIDs = append(notifyTxIDs, txRow.ID)
+				continue
+			}
+			symbol := GetCoinSymbol()
+			if txRow.TokenID > 0 {
+				tokenRow, ok := tokenMap[txRow.TokenID]
+				if !ok {
+					mcommon.Log.Errorf("no tokenMap: %d", txRow.TokenID)
+					continue
+				}
+				symbol = GetTokenSymbol(tokenRow)
+			}
+			// 同一交易中可能有多笔转账
+			txHash := fmt.Sprintf("%s_%d", txRow.TxID, txRow.IxIndex)
+			nonce := mcommon.GetUUIDStr()
+			reqObj := gin.H{
+				"tx_hash":     txHash,
+				"app_name":    productRow.AppName,
+				"address":     txRow.ToAddress,
+				"balance":     txRow.BalanceReal,
+				"symbol":      symbol,
+				"notify_type": app.NotifyTypeTx,
+			}
+			if userID := app.GetAddressUserID(addressUserMap, txRow.ProductID, txRow.ToAddress); userID != "" {
+				reqObj["user_id"] = userID
+			}
+			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
+			req, err := json.Marshal(reqObj)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+			notifyRows = append(notifyRows, &model.DBTProductNotify{
+				Nonce:        nonce,
+				ProductID:    txRow.ProductID,
+				ItemType:     app.SendRelationTypeTx,
+				ItemID:       txRow.ID,
+				NotifyType:   app.NotifyTypeTx,
+				TokenSymbol:  symbol,
+				URL:          productRow.CbURL,
+				Msg:          string(req),
+				HandleStatus: app.NotifyStatusInit,
+				HandleMsg:    "",
+				CreateTime:   now,
+				UpdateTime:   now,
+			})
+			notifyTxIDs = append(notifyTxIDs, txRow.ID)
+		}
+		_, err = model.SQLCreateManyTProductNotify(
+			context.Background(),
+			xenv.DbCon,
+			notifyRows,
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = app.SQLUpdateTTxSolStatusByIDs(
+			context.Background(),
+			xenv.DbCon,
+			notifyTxIDs,
+			model.DBTTxSol{
+				HandleStatus: app.TxStatusNotify,
+				HandleMsg:    "notify",
+				HandleTime:   now,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
+
+// CheckTxOrg sol零钱整理到冷钱包
+func CheckTxOrg() {
+	lockKey := "SolCheckTxOrg"
+	app.LockWrap(lockKey, func() {
+		// 获取冷钱包地址
+		coldAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"cold_wallet_address_sol",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if !IsValidAddress(coldAddressValue) {
+			mcommon.Log.Errorf("sol organize cold address err: %s", coldAddressValue)
+			return
+		}
+		// 免租金的最小余额 剩余金额低于该值时一起转出
+		rentMin, err := solclient.RpcGetMinimumBalanceForRentExemption(0)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		blockhash, err := GetLatestBlockhash()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 开启事物
+		isComment := false
+		dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		defer func() {
+			if !isComment {
+				_ = dbTx.Rollback()
+			}
+		}()
+		// 获取待整理的交易列表
+		txRows, err := app.SQLSelectTTxSolColByOrgForUpdate(
+			context.Background(),
+			dbTx,
+			[]string{
+				model.DBColTTxSolID,
+				model.DBColTTxSolTokenID,
+				model.DBColTTxSolToAddress,
+				model.DBColTTxSolBalanceReal,
+			},
+			[]int64{app.TxOrgStatusInit},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 将待整理地址按地址做归并处理
+		type OrgInfo struct {
+			RowIDs  []int64 // db t_tx_sol.id
+			Balance int64   // 金额
+		}
+		// addressMap map[地址] = []整理信息
+		addressMap := make(map[string]*OrgInfo)
+		// addresses 需要整理的地址列表
+		var addresses []string
+		for _, txRow := range txRows {
+			if txRow.TokenID > 0 {
+				// 代币由 CheckSplTxOrg 处理
+				continue
+			}
+			info := addressMap[txRow.ToAddress]
+			if info == nil {
+				info = &OrgInfo{}
+				addressMap[txRow.ToAddress] = info
+			}
+			info.RowIDs = append(info.RowIDs, txRow.ID)
+			txLamports, err := SolStrToLamports(txRow.BalanceReal)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			info.Balance += txLamports
+
+			if !mcommon.IsStringInSlice(addresses, txRow.ToAddress) {
+				addresses = append(addresses, txRow.ToAddress)
+			}
+		}
+		if len(addresses) == 0 {
+			// 没有要处理的信息
+			return
+		}
+		// 获取地址私钥
+		addressPKMap, err := GetPKMapOfAddresses(
+			context.Background(),
+			dbTx,
+			addresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		now := time.Now().Unix()
+		for address, info := range addressMap {
+			// 获取私钥
+			privateKey, ok := addressPKMap[address]
+			if !ok {
+				mcommon.Log.Errorf("no key of: %s", address)
+				continue
+			}
+			// 获取地址可用余额
+			balance, err := getAvailableBalance(dbTx, address)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 发送数量 不超过充币金额
+			sendBalance := balance - SignatureFee
+			if sendBalance > info.Balance {
+				sendBalance = info.Balance
+			}
+			// 剩余金额不足免租金的最小余额时会被拒绝 需要一起转出
+			left := balance - SignatureFee - sendBalance
+			if left > 0 && left < rentMin {
+				sendBalance += left
+			}
+			if sendBalance <= 0 {
+				// 数额不足
+				continue
+			}
+			signedTx, err := SignTransfer(blockhash, privateKey, coldAddressValue, sendBalance)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 创建存入数据
+			sendRows := genSendRows(
+				app.SendRelationTypeTx,
+				info.RowIDs,
+				0,
+				signedTx,
+				address,
+				coldAddressValue,
+				LamportsToSolStr(sendBalance),
+				now,
+			)
+			// 插入发送数据
+			_, err = model.SQLCreateManyTSendSol(
+				context.Background(),
+				dbTx,
+				sendRows,
+				true,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 更改tx整理状态
+			_, err = app.SQLUpdateTTxSolOrgStatusByIDs(
+				context.Background(),
+				dbTx,
+				info.RowIDs,
+				model.DBTTxSol{
+					OrgStatus: app.TxOrgStatusHex,
+					OrgMsg:    "hex",
+					OrgTime:   now,
+				},
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		// 提交事物
+		err = dbTx.Commit()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		isComment = true
+	})
+}
+
+// genSendRows 生成发送数据 只有第一条数据需要发送，其余数据为占位数据
+func genSendRows(relatedType int64, relatedIDs []int64, tokenID int64, signedTx *StTx, fromAddress, toAddress, balanceReal string, now int64) []*model.DBTSendSol {
+	var sendRows []*model.DBTSendSol
+	for rowIndex, relatedID := range relatedIDs {
+		sendRow := &model.DBTSendSol{
+			RelatedType:     relatedType,
+			RelatedID:       relatedID,
+			TokenID:         tokenID,
+			TxID:            signedTx.TxID,
+			FromAddress:     fromAddress,
+			ToAddress:       toAddress,
+			BalanceReal:     balanceReal,
+			LastValidHeight: signedTx.LastValidHeight,
+			Hex:             signedTx.Raw,
+			CreateTime:      now,
+			HandleStatus:    app.SendStatusInit,
+			HandleMsg:       "",
+			HandleTime:      now,
+		}
+		if rowIndex > 0 {
+			// 占位数据
+			sendRow.BalanceReal = "0"
+			sendRow.Hex = ""
+		}
+		sendRows = append(sendRows, sendRow)
+	}
+	return sendRows
+}
+
+// CheckWithdraw 检测sol提现
+func CheckWithdraw() {
+	lockKey := "SolCheckWithdraw"
+	app.LockWrap(lockKey, func() {
+		// 获取需要处理的提币数据
+		withdrawRows, err := app.SQLSelectTWithdrawColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTWithdrawID,
+			},
+			app.WithdrawStatusInit,
+			[]string{GetCoinSymbol()},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(withdrawRows) == 0 {
+			// 没有要处理的提币
+			return
+		}
+		// 获取热钱包地址
+		hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"hot_wallet_address_sol",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if !IsValidAddress(hotAddressValue) {
+			mcommon.Log.Errorf("sol hot address err: %s", hotAddressValue)
+			return
+		}
+		// 获取私钥
+		privateKey, err := GetPkOfAddress(
+			context.Background(),
+			xenv.DbCon,
+			hotAddressValue,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 获取热钱包可用余额
+		hotAddressBalance, err := getAvailableBalance(xenv.DbCon, hotAddressValue)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 免租金的最小余额
+		rentMin, err := solclient.RpcGetMinimumBalanceForRentExemption(0)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		blockhash, err := GetLatestBlockhash()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		for _, withdrawRow := range withdrawRows {
+			err = handleWithdraw(withdrawRow.ID, blockhash, hotAddressValue, privateKey, &hotAddressBalance, rentMin)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+		}
+	})
+}
+
+func handleWithdraw(withdrawID int64, blockhash *StBlockhash, hotAddress string, privateKey ed25519.PrivateKey, hotAddressBalance *int64, rentMin int64) error {
+	isComment := false
+	dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if !isComment {
+			_ = dbTx.Rollback()
+		}
+	}()
+	// 处理业务
+	withdrawRow, err := app.SQLGetTWithdrawColForUpdate(
+		context.Background(),
+		dbTx,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawBalanceReal,
+			model.DBColTWithdrawToAddress,
+		},
+		withdrawID,
+		app.WithdrawStatusInit,
+	)
+	if err != nil {
+		return err
+	}
+	if withdrawRow == nil {
+		return nil
+	}
+	balance, err := SolStrToLamports(withdrawRow.BalanceReal)
+	if err != nil {
+		return err
+	}
+	// 热钱包需要保留免租金的最小余额
+	if *hotAddressBalance-balance-SignatureFee < rentMin {
+		mcommon.Log.Errorf("hot balance limit")
+		return nil
+	}
+	if balance < rentMin {
+		// 转入空账户的金额低于免租金的最小余额时交易会失败
+		toBalance, err := solclient.RpcGetBalance(withdrawRow.ToAddress)
+		if err != nil {
+			return err
+		}
+		if toBalance == 0 {
+			mcommon.Log.Errorf("sol withdraw %d balance less than rent: %s", withdrawID, withdrawRow.BalanceReal)
+			return nil
+		}
+	}
+	*hotAddressBalance -= balance + SignatureFee
+	// 创建交易
+	signedTx, err := SignTransfer(blockhash, privateKey, withdrawRow.ToAddress, balance)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	_, err = app.SQLUpdateTWithdrawGenTx(
+		context.Background(),
+		dbTx,
+		&model.DBTWithdraw{
+			ID:           withdrawID,
+			TxHash:       signedTx.TxID,
+			HandleStatus: app.WithdrawStatusHex,
+			HandleMsg:    "hex",
+			HandleTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = model.SQLCreateTSendSol(
+		context.Background(),
+		dbTx,
+		&model.DBTSendSol{
+			RelatedType:     app.SendRelationTypeWithdraw,
+			RelatedID:       withdrawID,
+			TokenID:         0,
+			TxID:            signedTx.TxID,
+			FromAddress:     hotAddress,
+			ToAddress:       withdrawRow.ToAddress,
+			BalanceReal:     withdrawRow.BalanceReal,
+			LastValidHeight: signedTx.LastValidHeight,
+			Hex:             signedTx.Raw,
+			CreateTime:      now,
+			HandleStatus:    app.SendStatusInit,
+			HandleMsg:       "",
+			HandleTime:      now,
+		},
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	// 处理完成
+	err = dbTx.Commit()
+	if err != nil {
+		return err
+	}
+	isComment = true
+	return nil
+}
+
+// genWithdrawNotifyRow 生成提币通知
+func genWithdrawNotifyRow(withdrawRow *model.DBTWithdraw, productRow *model.DBTProduct, txID string, notifyType int64, now int64) (*model.DBTProductNotify, error) {
+	reqObj := gin.H{
+		"tx_hash":     txID,
+		"balance":     withdrawRow.BalanceReal,
+		"app_name":    productRow.AppName,
+		"out_serial":  withdrawRow.OutSerial,
+		"address":     withdrawRow.ToAddress,
+		"symbol":      withdrawRow.Symbol,
+		"notify_type": notifyType,
+	}
+	reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
+	req, err := json.Marshal(reqObj)
+	if err != nil {
+		return nil, err
+	}
+	return &model.DBTProductNotify{
+		Nonce:        mcommon.GetUUIDStr(),
+		ProductID:    withdrawRow.ProductID,
+		ItemType:     app.SendRelationTypeWithdraw,
+		ItemID:       withdrawRow.ID,
+		NotifyType:   notifyType,
+		TokenSymbol:  withdrawRow.Symbol,
+		URL:          productRow.CbURL,
+		Msg:          string(req),
+		HandleStatus: app.NotifyStatusInit,
+		HandleMsg:    "",
+		CreateTime:   now,
+		UpdateTime:   now,
+	}, nil
+}
+
+// getWithdrawAndProductMap 获取发送数据关联的提币和产品
+func getWithdrawAndProductMap(sendRows []*model.DBTSendSol) (map[int64]*model.DBTWithdraw, map[int64]*model.DBTProduct, error) {
+	var withdrawIDs []int64
+	for _, sendRow := range sendRows {
+		if sendRow.RelatedType == app.SendRelationTypeWithdraw {
+			if !mcommon.IsIntInSlice(withdrawIDs, sendRow.RelatedID) {
+				withdrawIDs = append(withdrawIDs, sendRow.RelatedID)
+			}
+		}
+	}
+	withdrawMap, err := app.SQLGetWithdrawMap(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawProductID,
+			model.DBColTWithdrawOutSerial,
+			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawSymbol,
+			model.DBColTWithdrawBalanceReal,
+		},
+		withdrawIDs,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	var productIDs []int64
+	for _, withdrawRow := range withdrawMap {
+		if !mcommon.IsIntInSlice(productIDs, withdrawRow.ProductID) {
+			productIDs = append(productIDs, withdrawRow.ProductID)
+		}
+	}
+	productMap, err := app.SQLGetProductMap(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTProductID,
+			model.DBColTProductAppName,
+			model.DBColTProductCbURL,
+			model.DBColTProductAppSk,
+		},
+		productIDs,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return withdrawMap, productMap, nil
+}
+
+// StSendResult 发送或确认后需要更新状态的数据
+type StSendResult struct {
+	SendIDs     []int64
+	TxIDs       []int64
+	TokenTxIDs  []int64
+	WithdrawIDs []int64
+	NotifyRows  []*model.DBTProductNotify
+}
+
+// add 按关联类型记录数据
+func (r *StSendResult) add(sendRow *model.DBTSendSol) {
+	if !mcommon.IsIntInSlice(r.SendIDs, sendRow.ID) {
+		r.SendIDs = append(r.SendIDs, sendRow.ID)
+	}
+	var ids *[]int64
+	switch sendRow.RelatedType {
+	case app.SendRelationTypeTx:
+		ids = &r.TxIDs
+	case app.SendRelationTypeTxErc20:
+		ids = &r.TokenTxIDs
+	case app.SendRelationTypeWithdraw:
+		ids = &r.WithdrawIDs
+	default:
+		return
+	}
+	if !mcommon.IsIntInSlice(*ids, sendRow.RelatedID) {
+		*ids = append(*ids, sendRow.RelatedID)
+	}
+}
+
+// save 更新状态
+func (r *StSendResult) save(withdrawStatus, orgStatus, sendStatus int64, msg string, now int64) error {
+	// 插入通知
+	_, err := model.SQLCreateManyTProductNotify(
+		context.Background(),
+		xenv.DbCon,
+		r.NotifyRows,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	// 更新提币状态
+	_, err = app.SQLUpdateTWithdrawStatusByIDs(
+		context.Background(),
+		xenv.DbCon,
+		r.WithdrawIDs,
+		&model.DBTWithdraw{
+			HandleStatus: withdrawStatus,
+			HandleMsg:    msg,
+			HandleTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	// 更新零钱整理状态
+	_, err = app.SQLUpdateTTxSolOrgStatusByIDs(
+		context.Background(),
+		xenv.DbCon,
+		append(r.TxIDs, r.TokenTxIDs...),
+		model.DBTTxSol{
+			OrgStatus: orgStatus,
+			OrgMsg:    msg,
+			OrgTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	// 更新发送状态
+	_, err = app.SQLUpdateTSendSolStatusByIDs(
+		context.Background(),
+		xenv.DbCon,
+		r.SendIDs,
+		model.DBTSendSol{
+			HandleStatus: sendStatus,
+			HandleMsg:    msg,
+			HandleTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// saveExpired blockhash过期的交易 提币和零钱整理重置为待处理 重新生成交易
+func (r *StSendResult) saveExpired(now int64) error {
+	return r.save(
+		app.WithdrawStatusInit,
+		app.TxOrgStatusInit,
+		app.SendStatusExpired,
+		"expired",
+		now,
+	)
+}
+
+// CheckRawTxSend 发送交易
+func CheckRawTxSend() {
+	lockKey := "SolCheckRawTxSend"
+	app.LockWrap(lockKey, func() {
+		// 获取待发送的数据
+		sendRows, err := app.SQLSelectTSendSolColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTSendSolID,
+				model.DBColTSendSolTxID,
+				model.DBColTSendSolHex,
+				model.DBColTSendSolRelatedType,
+				model.DBColTSendSolRelatedID,
+				model.DBColTSendSolLastValidHeight,
+			},
+			app.SendStatusInit,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(sendRows) == 0 {
+			return
+		}
+		withdrawMap, productMap, err := getWithdrawAndProductMap(sendRows)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 当前finalized的区块高度 超过交易的最后有效高度时交易已过期
+		blockHeight, err := solclient.RpcGetBlockHeight(solclient.CommitmentFinalized)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		now := time.Now().Unix()
+		var result StSendResult
+		var expiredResult StSendResult
+		var sendTxHashes []string
+		var expiredTxHashes []string
+		onSendOk := func(sendRow *model.DBTSendSol) error {
+			result.add(sendRow)
+			// 如果是提币，创建通知信息
+			if sendRow.RelatedType == app.SendRelationTypeWithdraw {
+				withdrawRow, ok := withdrawMap[sendRow.RelatedID]
+				if !ok {
+					mcommon.Log.Errorf("withdrawMap no: %d", sendRow.RelatedID)
+					return nil
+				}
+				productRow, ok := productMap[withdrawRow.ProductID]
+				if !ok {
+					mcommon.Log.Errorf("productMap no: %d", withdrawRow.ProductID)
+					return nil
+				}
+				notifyRow, err := genWithdrawNotifyRow(withdrawRow, productRow, sendRow.TxID, app.NotifyTypeWithdrawSend, now)
+				if err != nil {
+					return err
+				}
+				result.NotifyRows = append(result.NotifyRows, notifyRow)
+			}
+			return nil
+		}
+		for _, sendRow := range sendRows {
+			// 发送数据中需要排除占位数据
+			if sendRow.Hex != "" {
+				if blockHeight > sendRow.LastValidHeight {
+					// blockhash已过期 需要重新生成交易
+					expiredTxHashes = append(expiredTxHashes, sendRow.TxID)
+					expiredResult.add(sendRow)
+					continue
+				}
+				_, err := solclient.RpcSendTransaction(sendRow.Hex)
+				if err != nil && !strings.Contains(err.Error(), "already been processed") {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					continue
+				}
+				sendTxHashes = append(sendTxHashes, sendRow.TxID)
+
+				err = onSendOk(sendRow)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			} else if mcommon.IsStringInSlice(sendTxHashes, sendRow.TxID) {
+				err = onSendOk(sendRow)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			} else if mcommon.IsStringInSlice(expiredTxHashes, sendRow.TxID) {
+				expiredResult.add(sendRow)
+			}
+		}
+		err = result.save(
+			app.WithdrawStatusSend,
+			app.TxOrgStatusSend,
+			app.SendStatusSend,
+			"send",
+			now,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		err = expiredResult.saveExpired(now)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
+
+// CheckRawTxConfirm 确认tx是否finalized
+func CheckRawTxConfirm() {
+	lockKey := "SolCheckRawTxConfirm"
+	app.LockWrap(lockKey, func() {
+		sendRows, err := app.SQLSelectTSendSolColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTSendSolID,
+				model.DBColTSendSolRelatedType,
+				model.DBColTSendSolRelatedID,
+				model.DBColTSendSolTxID,
+				model.DBColTSendSolLastValidHeight,
+			},
+			app.SendStatusSend,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(sendRows) == 0 {
+			return
+		}
+		withdrawMap, productMap, err := getWithdrawAndProductMap(sendRows)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 先获取区块高度再查询交易状态 避免交易在两次查询之间上链被误判为过期
+		blockHeight, err := solclient.RpcGetBlockHeight(solclient.CommitmentFinalized)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var txIDs []string
+		for _, sendRow := range sendRows {
+			if !mcommon.IsStringInSlice(txIDs, sendRow.TxID) {
+				txIDs = append(txIDs, sendRow.TxID)
+			}
+		}
+		// map[tx id] => 交易状态
+		statusMap := make(map[string]*solclient.StSignatureStatus)
+		for start := 0; start < len(txIDs); start += signatureStatusLimit {
+			end := start + signatureStatusLimit
+			if end > len(txIDs) {
+				end = len(txIDs)
+			}
+			rpcStatuses, err := solclient.RpcGetSignatureStatuses(txIDs[start:end])
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			for i, rpcStatus := range rpcStatuses {
+				if rpcStatus != nil {
+					statusMap[txIDs[start+i]] = rpcStatus
+				}
+			}
+		}
+		now := time.Now().Unix()
+		var result StSendResult
+		var expiredResult StSendResult
+		// 已记录手续费的交易
+		var feeTxIDs []string
+		for _, sendRow := range sendRows {
+			rpcStatus, ok := statusMap[sendRow.TxID]
+			if !ok {
+				if blockHeight > sendRow.LastValidHeight {
+					// 交易未上链且blockhash已过期 需要重新生成交易
+					expiredResult.add(sendRow)
+				}
+				continue
+			}
+			if isRpcErr(rpcStatus.Err) {
+				// 交易执行失败 需要人工处理
+				mcommon.Log.Errorf("sol tx %s failed: %s", sendRow.TxID, string(rpcStatus.Err))
+				continue
+			}
+			if rpcStatus.ConfirmationStatus != solclient.CommitmentFinalized {
+				continue
+			}
+			if !mcommon.IsStringInSlice(feeTxIDs, sendRow.TxID) {
+				// 记录所在slot和实际手续费 供提币查询使用
+				rpcTx, err := solclient.RpcGetTransaction(sendRow.TxID)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					continue
+				}
+				if rpcTx == nil || rpcTx.Meta == nil {
+					continue
+				}
+				_, err = app.SQLUpdateTSendSolBlockNumFeeByTxID(
+					context.Background(),
+					xenv.DbCon,
+					model.DBTSendSol{
+						TxID:     sendRow.TxID,
+						BlockNum: rpcStatus.Slot,
+						Fee:      LamportsToSolStr(rpcTx.Meta.Fee),
+					},
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					continue
+				}
+				feeTxIDs = append(feeTxIDs, sendRow.TxID)
+			}
+			if sendRow.RelatedType == app.SendRelationTypeWithdraw {
+				// 提币
+				withdrawRow, ok := withdrawMap[sendRow.RelatedID]
+				if !ok {
+					mcommon.Log.Errorf("no withdrawMap: %d", sendRow.RelatedID)
+					return
+				}
+				productRow, ok := productMap[withdrawRow.ProductID]
+				if !ok {
+					mcommon.Log.Errorf("no productMap: %d", withdrawRow.ProductID)
+					return
+				}
+				notifyRow, err := genWithdrawNotifyRow(withdrawRow, productRow, sendRow.TxID, app.NotifyTypeWithdrawConfirm, now)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				result.NotifyRows = append(result.NotifyRows, notifyRow)
+			}
+			result.add(sendRow)
+		}
+		err = result.save(
+			app.WithdrawStatusConfirm,
+			app.TxOrgStatusConfirm,
+			app.SendStatusConfirm,
+			"confirmed",
+			now,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		err = expiredResult.saveExpired(now)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
@@ -0,0 +1,547 @@
+package hsol
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"go-dc-wallet/solclient"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"time"
+
+	"github.com/moremorefun/mcommon"
+)
+
+// CheckSplTxOrg spl代币零钱整理到冷钱包 手续费和冷钱包代币账户的租金由手续费钱包支付
+func CheckSplTxOrg() {
+	lockKey := "SplCheckTxOrg"
+	app.LockWrap(lockKey, func() {
+		// 获取手续费钱包地址
+		feeAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"fee_wallet_address_sol",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if !IsValidAddress(feeAddressValue) {
+			mcommon.Log.Errorf("sol fee address err: %s", feeAddressValue)
+			return
+		}
+		feePrivateKey, err := GetPkOfAddress(
+			context.Background(),
+			xenv.DbCon,
+			feeAddressValue,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 代币账户免租金的最小余额
+		tokenAccountRent, err := solclient.RpcGetMinimumBalanceForRentExemption(TokenAccountSize)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		blockhash, err := GetLatestBlockhash()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 开始事物
+		isComment := false
+		dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		defer func() {
+			if !isComment {
+				_ = dbTx.Rollback()
+			}
+		}()
+		// 查询需要处理的交易
+		txRows, err := app.SQLSelectTTxSolColByOrgForUpdate(
+			context.Background(),
+			dbTx,
+			[]string{
+				model.DBColTTxSolID,
+				model.DBColTTxSolTokenID,
+				model.DBColTTxSolTokenAccount,
+				model.DBColTTxSolToAddress,
+				model.DBColTTxSolBalanceReal,
+			},
+			[]int64{app.TxOrgStatusInit},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 整理信息
+		type StOrgInfo struct {
+			TxIDs        []int64
+			ToAddress    string
+			TokenAccount string
+			TokenID      int64
+			TokenBalance *big.Int
+		}
+		var tokenIDs []int64
+		for _, txRow := range txRows {
+			if txRow.TokenID == 0 {
+				// sol由 CheckTxOrg 处理
+				continue
+			}
+			if !mcommon.IsIntInSlice(tokenIDs, txRow.TokenID) {
+				tokenIDs = append(tokenIDs, txRow.TokenID)
+			}
+		}
+		if len(tokenIDs) == 0 {
+			return
+		}
+		tokenMap, err := app.SQLGetAppConfigTokenSolMap(
+			context.Background(),
+			dbTx,
+			[]string{
+				model.DBColTAppConfigTokenSolID,
+				model.DBColTAppConfigTokenSolTokenAddress,
+				model.DBColTAppConfigTokenSolTokenDecimals,
+				model.DBColTAppConfigTokenSolColdAddress,
+				model.DBColTAppConfigTokenSolOrgMinBalance,
+			},
+			tokenIDs,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 整理信息map 按代币账户归并
+		orgMap := make(map[string]*StOrgInfo)
+		// 整理地址
+		var toAddresses []string
+		for _, txRow := range txRows {
+			if txRow.TokenID == 0 {
+				continue
+			}
+			tokenRow, ok := tokenMap[txRow.TokenID]
+			if !ok {
+				mcommon.Log.Errorf("no token of: %d", txRow.TokenID)
+				return
+			}
+			orgKey := fmt.Sprintf("%s-%d", txRow.TokenAccount, txRow.TokenID)
+			orgInfo, ok := orgMap[orgKey]
+			if !ok {
+				orgInfo = &StOrgInfo{
+					TokenID:      txRow.TokenID,
+					ToAddress:    txRow.ToAddress,
+					TokenAccount: txRow.TokenAccount,
+					TokenBalance: new(big.Int),
+				}
+				orgMap[orgKey] = orgInfo
+			}
+			orgInfo.TxIDs = append(orgInfo.TxIDs, txRow.ID)
+			txBalance, err := TokenStrToBigInt(txRow.BalanceReal, tokenRow.TokenDecimals)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			orgInfo.TokenBalance.Add(orgInfo.TokenBalance, txBalance)
+			if !mcommon.IsStringInSlice(toAddresses, txRow.ToAddress) {
+				toAddresses = append(toAddresses, txRow.ToAddress)
+			}
+		}
+		// 整理地址key
+		addressPKMap, err := GetPKMapOfAddresses(
+			context.Background(),
+			dbTx,
+			toAddresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 手续费钱包可用余额
+		feeAddressBalance, err := getAvailableBalance(dbTx, feeAddressValue)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// map[冷钱包代币账户] => 本次已计算租金
+		coldTokenAccountMap := make(map[string]bool)
+		now := time.Now().Unix()
+		for _, orgInfo := range orgMap {
+			tokenRow := tokenMap[orgInfo.TokenID]
+			orgMinBalance, err := TokenStrToBigInt(tokenRow.OrgMinBalance, tokenRow.TokenDecimals)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			if orgInfo.TokenBalance.Cmp(orgMinBalance) < 0 {
+				mcommon.Log.Warnf("org token balance limit: %s %s", orgInfo.TokenAccount, orgInfo.TokenBalance.String())
+				continue
+			}
+			if !IsValidAddress(tokenRow.ColdAddress) {
+				mcommon.Log.Errorf("spl cold address err: %s", tokenRow.ColdAddress)
+				continue
+			}
+			privateKey, ok := addressPKMap[orgInfo.ToAddress]
+			if !ok {
+				mcommon.Log.Errorf("no key of: %s", orgInfo.ToAddress)
+				continue
+			}
+			// 冷钱包代币账户不存在时需要支付租金创建
+			coldTokenAccount, err := GetAssociatedTokenAddress(tokenRow.ColdAddress, tokenRow.TokenAddress)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+			var rent int64
+			if !coldTokenAccountMap[coldTokenAccount] {
+				rent, err = getTokenAccountRent(coldTokenAccount, tokenAccountRent)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			}
+			// 手续费钱包和充币地址各一个签名
+			needFee := 2*SignatureFee + rent
+			if feeAddressBalance < needFee {
+				mcommon.Log.Errorf("sol fee balance limit")
+				break
+			}
+			feeAddressBalance -= needFee
+			coldTokenAccountMap[coldTokenAccount] = true
+			// 生成交易
+			signedTx, err := SignTokenTransfer(
+				blockhash,
+				feePrivateKey,
+				privateKey,
+				orgInfo.TokenAccount,
+				tokenRow.TokenAddress,
+				tokenRow.TokenDecimals,
+				tokenRow.ColdAddress,
+				orgInfo.TokenBalance,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			balanceReal, err := TokenBigIntToStr(orgInfo.TokenBalance, tokenRow.TokenDecimals)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 创建存入数据
+			sendRows := genSendRows(
+				app.SendRelationTypeTxErc20,
+				orgInfo.TxIDs,
+				orgInfo.TokenID,
+				signedTx,
+				orgInfo.ToAddress,
+				tokenRow.ColdAddress,
+				balanceReal,
+				now,
+			)
+			// 插入发送数据
+			_, err = model.SQLCreateManyTSendSol(
+				context.Background(),
+				dbTx,
+				sendRows,
+				true,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 更改tx整理状态
+			_, err = app.SQLUpdateTTxSolOrgStatusByIDs(
+				context.Background(),
+				dbTx,
+				orgInfo.TxIDs,
+				model.DBTTxSol{
+					OrgStatus: app.TxOrgStatusHex,
+					OrgMsg:    "hex",
+					OrgTime:   now,
+				},
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		// 提交事物
+		err = dbTx.Commit()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		isComment = true
+	})
+}
+
+// CheckSplWithdraw spl代币提币 手续费和接收账户的租金由代币热钱包支付
+func CheckSplWithdraw() {
+	lockKey := "SplCheckWithdraw"
+	app.LockWrap(lockKey, func() {
+		var tokenSymbols []string
+		tokenMap := make(map[string]*model.DBTAppConfigTokenSol)
+		addressKeyMap := make(map[string]ed25519.PrivateKey)
+		addressBalanceMap := make(map[string]int64)
+		addressTokenBalanceMap := make(map[string]*big.Int)
+		tokenRows, err := app.SQLSelectTAppConfigTokenSolColAll(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigTokenSolID,
+				model.DBColTAppConfigTokenSolTokenAddress,
+				model.DBColTAppConfigTokenSolTokenDecimals,
+				model.DBColTAppConfigTokenSolTokenSymbol,
+				model.DBColTAppConfigTokenSolHotAddress,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		for _, tokenRow := range tokenRows {
+			tokenSymbol := GetTokenSymbol(tokenRow)
+			tokenMap[tokenSymbol] = tokenRow
+			if !mcommon.IsStringInSlice(tokenSymbols, tokenSymbol) {
+				tokenSymbols = append(tokenSymbols, tokenSymbol)
+			}
+		}
+		if len(tokenSymbols) == 0 {
+			return
+		}
+		withdrawRows, err := app.SQLSelectTWithdrawColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTWithdrawID,
+				model.DBColTWithdrawSymbol,
+			},
+			app.WithdrawStatusInit,
+			tokenSymbols,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(withdrawRows) == 0 {
+			return
+		}
+		for _, tokenRow := range tokenRows {
+			hotAddress := tokenRow.HotAddress
+			if !IsValidAddress(hotAddress) {
+				mcommon.Log.Errorf("spl hot address err: %s", hotAddress)
+				return
+			}
+			_, ok := addressKeyMap[hotAddress]
+			if !ok {
+				// 获取私钥
+				privateKey, err := GetPkOfAddress(
+					context.Background(),
+					xenv.DbCon,
+					hotAddress,
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				addressKeyMap[hotAddress] = privateKey
+			}
+			_, ok = addressBalanceMap[hotAddress]
+			if !ok {
+				balance, err := getAvailableBalance(xenv.DbCon, hotAddress)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				addressBalanceMap[hotAddress] = balance
+			}
+			tokenBalanceKey := fmt.Sprintf("%s-%d", hotAddress, tokenRow.ID)
+			_, ok = addressTokenBalanceMap[tokenBalanceKey]
+			if !ok {
+				hotTokenAccount, err := GetAssociatedTokenAddress(hotAddress, tokenRow.TokenAddress)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				tokenBalance, err := getTokenBalance(hotTokenAccount)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				pendingBalanceReal, err := app.SQLGetTSendSolPendingBalanceReal(
+					context.Background(),
+					xenv.DbCon,
+					hotAddress,
+					tokenRow.ID,
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				pendingBalance, err := TokenStrToBigInt(pendingBalanceReal, tokenRow.TokenDecimals)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				tokenBalance.Sub(tokenBalance, pendingBalance)
+				addressTokenBalanceMap[tokenBalanceKey] = tokenBalance
+			}
+		}
+		// 代币账户免租金的最小余额
+		tokenAccountRent, err := solclient.RpcGetMinimumBalanceForRentExemption(TokenAccountSize)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		blockhash, err := GetLatestBlockhash()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		for _, withdrawRow := range withdrawRows {
+			err = handleSplWithdraw(withdrawRow.ID, blockhash, tokenMap, addressKeyMap, addressBalanceMap, addressTokenBalanceMap, tokenAccountRent)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+		}
+	})
+}
+
+func handleSplWithdraw(withdrawID int64, blockhash *StBlockhash, tokenMap map[string]*model.DBTAppConfigTokenSol, addressKeyMap map[string]ed25519.PrivateKey, addressBalanceMap map[string]int64, addressTokenBalanceMap map[string]*big.Int, tokenAccountRent int64) error {
+	isComment := false
+	dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if !isComment {
+			_ = dbTx.Rollback()
+		}
+	}()
+	// 处理业务
+	withdrawRow, err := app.SQLGetTWithdrawColForUpdate(
+		context.Background(),
+		dbTx,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawBalanceReal,
+			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawSymbol,
+		},
+		withdrawID,
+		app.WithdrawStatusInit,
+	)
+	if err != nil {
+		return err
+	}
+	if withdrawRow == nil {
+		return nil
+	}
+	tokenRow, ok := tokenMap[withdrawRow.Symbol]
+	if !ok {
+		mcommon.Log.Errorf("no tokenMap: %s", withdrawRow.Symbol)
+		return nil
+	}
+	hotAddress := tokenRow.HotAddress
+	key, ok := addressKeyMap[hotAddress]
+	if !ok {
+		mcommon.Log.Errorf("no addressKeyMap: %s", hotAddress)
+		return nil
+	}
+	tokenBalanceKey := fmt.Sprintf("%s-%d", hotAddress, tokenRow.ID)
+	tokenBalance, err := TokenStrToBigInt(withdrawRow.BalanceReal, tokenRow.TokenDecimals)
+	if err != nil {
+		return err
+	}
+	if addressTokenBalanceMap[tokenBalanceKey].Cmp(tokenBalance) < 0 {
+		mcommon.Log.Errorf("%s token limit", tokenBalanceKey)
+		return nil
+	}
+	// 接收地址的代币账户不存在时需要支付租金创建
+	toTokenAccount, err := GetAssociatedTokenAddress(withdrawRow.ToAddress, tokenRow.TokenAddress)
+	if err != nil {
+		return err
+	}
+	rent, err := getTokenAccountRent(toTokenAccount, tokenAccountRent)
+	if err != nil {
+		return err
+	}
+	needFee := SignatureFee + rent
+	if addressBalanceMap[hotAddress] < needFee {
+		mcommon.Log.Errorf("%s sol fee limit", hotAddress)
+		return nil
+	}
+	hotTokenAccount, err := GetAssociatedTokenAddress(hotAddress, tokenRow.TokenAddress)
+	if err != nil {
+		return err
+	}
+	addressBalanceMap[hotAddress] -= needFee
+	addressTokenBalanceMap[tokenBalanceKey].Sub(addressTokenBalanceMap[tokenBalanceKey], tokenBalance)
+	// 生成交易
+	signedTx, err := SignTokenTransfer(
+		blockhash,
+		key,
+		key,
+		hotTokenAccount,
+		tokenRow.TokenAddress,
+		tokenRow.TokenDecimals,
+		withdrawRow.ToAddress,
+		tokenBalance,
+	)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	_, err = app.SQLUpdateTWithdrawGenTx(
+		context.Background(),
+		dbTx,
+		&model.DBTWithdraw{
+			ID:           withdrawID,
+			TxHash:       signedTx.TxID,
+			HandleStatus: app.WithdrawStatusHex,
+			HandleMsg:    "hex",
+			HandleTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = model.SQLCreateTSendSol(
+		context.Background(),
+		dbTx,
+		&model.DBTSendSol{
+			RelatedType:     app.SendRelationTypeWithdraw,
+			RelatedID:       withdrawID,
+			TokenID:         tokenRow.ID,
+			TxID:            signedTx.TxID,
+			FromAddress:     hotAddress,
+			ToAddress:       withdrawRow.ToAddress,
+			BalanceReal:     withdrawRow.BalanceReal,
+			LastValidHeight: signedTx.LastValidHeight,
+			Hex:             signedTx.Raw,
+			CreateTime:      now,
+			HandleStatus:    app.SendStatusInit,
+			HandleMsg:       "",
+			HandleTime:      now,
+		},
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	// 处理完成
+	err = dbTx.Commit()
+	if err != nil {
+		return err
+	}
+	isComment = true
+	return nil
+}
@@ -0,0 +1,395 @@
+package hsol
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"go-dc-wallet/solclient"
+	"math/big"
+
+	"github.com/btcsuite/btcutil/base58"
+)
+
+// PublicKey 公钥 地址为公钥的base58编码
+type PublicKey [32]byte
+
+// PublicKeyFromBase58 地址转换为公钥
+func PublicKeyFromBase58(address string) (PublicKey, error) {
+	var publicKey PublicKey
+	b := base58.Decode(address)
+	if len(b) != len(publicKey) {
+		return publicKey, fmt.Errorf("error address: %s", address)
+	}
+	copy(publicKey[:], b)
+	return publicKey, nil
+}
+
+// mustPublicKey 程序地址
+func mustPublicKey(address string) PublicKey {
+	publicKey, err := PublicKeyFromBase58(address)
+	if err != nil {
+		panic(err)
+	}
+	return publicKey
+}
+
+// String base58地址
+func (p PublicKey) String() string {
+	return base58.Encode(p[:])
+}
+
+// 程序地址
+var (
+	SystemProgramID                 = mustPublicKey("11111111111111111111111111111111")
+	TokenProgramID                  = mustPublicKey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	AssociatedTokenAccountProgramID = mustPublicKey("ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL")
+)
+
+// ed25519 曲线参数 用于检测派生地址是否在曲线上
+var (
+	curveP = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+	curveD = func() *big.Int {
+		// d = -121665/121666
+		d := new(big.Int).ModInverse(big.NewInt(121666), curveP)
+		d.Mul(d, big.NewInt(-121665))
+		return d.Mod(d, curveP)
+	}()
+)
+
+// isOnCurve 检测32字节是否为ed25519曲线上的点
+func isOnCurve(b []byte) bool {
+	// 小端序 最高位为x的符号位
+	yBytes := make([]byte, 32)
+	for i := 0; i < 32; i++ {
+		yBytes[31-i] = b[i]
+	}
+	yBytes[0] &= 0x7f
+	y := new(big.Int).SetBytes(yBytes)
+	y.Mod(y, curveP)
+	// x^2 = (y^2 - 1) / (d*y^2 + 1)
+	yy := new(big.Int).Mul(y, y)
+	u := new(big.Int).Sub(yy, big.NewInt(1))
+	u.Mod(u, curveP)
+	v := new(big.Int).Mul(curveD, yy)
+	v.Add(v, big.NewInt(1))
+	v.Mod(v, curveP)
+	xx := new(big.Int).ModInverse(v, curveP)
+	xx.Mul(xx, u)
+	xx.Mod(xx, curveP)
+	if xx.Sign() == 0 {
+		return true
+	}
+	// 欧拉判别 x^2 为二次剩余时点在曲线上
+	e := new(big.Int).Rsh(new(big.Int).Sub(curveP, big.NewInt(1)), 1)
+	return new(big.Int).Exp(xx, e, curveP).Cmp(big.NewInt(1)) == 0
+}
+
+// findProgramAddress 计算程序派生地址
+func findProgramAddress(seeds [][]byte, programID PublicKey) (PublicKey, error) {
+	for bump := 255; bump >= 0; bump-- {
+		h := sha256.New()
+		for _, seed := range seeds {
+			h.Write(seed)
+		}
+		h.Write([]byte{byte(bump)})
+		h.Write(programID[:])
+		h.Write([]byte("ProgramDerivedAddress"))
+		sum := h.Sum(nil)
+		if isOnCurve(sum) {
+			continue
+		}
+		var address PublicKey
+		copy(address[:], sum)
+		return address, nil
+	}
+	return PublicKey{}, errors.New("unable to find program address")
+}
+
+// FindAssociatedTokenAddress 获取地址的关联代币账户
+func FindAssociatedTokenAddress(owner PublicKey, mint PublicKey) (PublicKey, error) {
+	return findProgramAddress(
+		[][]byte{
+			owner[:],
+			TokenProgramID[:],
+			mint[:],
+		},
+		AssociatedTokenAccountProgramID,
+	)
+}
+
+// AccountMeta 指令使用的账户
+type AccountMeta struct {
+	PublicKey  PublicKey
+	IsSigner   bool
+	IsWritable bool
+}
+
+// Instruction 指令
+type Instruction struct {
+	ProgramID PublicKey
+	Accounts  []AccountMeta
+	Data      []byte
+}
+
+// NewTransferInstruction system 转账
+func NewTransferInstruction(from PublicKey, to PublicKey, lamports uint64) Instruction {
+	data := make([]byte, 12)
+	binary.LittleEndian.PutUint32(data[0:4], 2)
+	binary.LittleEndian.PutUint64(data[4:12], lamports)
+	return Instruction{
+		ProgramID: SystemProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: from, IsSigner: true, IsWritable: true},
+			{PublicKey: to, IsSigner: false, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+// NewTransferCheckedInstruction spl-token 转账
+func NewTransferCheckedInstruction(source PublicKey, mint PublicKey, destination PublicKey, authority PublicKey, amount uint64, decimals uint8) Instruction {
+	data := make([]byte, 10)
+	data[0] = 12
+	binary.LittleEndian.PutUint64(data[1:9], amount)
+	data[9] = decimals
+	return Instruction{
+		ProgramID: TokenProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: source, IsSigner: false, IsWritable: true},
+			{PublicKey: mint, IsSigner: false, IsWritable: false},
+			{PublicKey: destination, IsSigner: false, IsWritable: true},
+			{PublicKey: authority, IsSigner: true, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+// NewCreateAssociatedTokenAccountIdempotentInstruction 创建关联代币账户 已存在时不处理
+func NewCreateAssociatedTokenAccountIdempotentInstruction(payer PublicKey, associatedAccount PublicKey, owner PublicKey, mint PublicKey) Instruction {
+	return Instruction{
+		ProgramID: AssociatedTokenAccountProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: payer, IsSigner: true, IsWritable: true},
+			{PublicKey: associatedAccount, IsSigner: false, IsWritable: true},
+			{PublicKey: owner, IsSigner: false, IsWritable: false},
+			{PublicKey: mint, IsSigner: false, IsWritable: false},
+			{PublicKey: SystemProgramID, IsSigner: false, IsWritable: false},
+			{PublicKey: TokenProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: []byte{1},
+	}
+}
+
+// appendCompactU16 写入 compact-u16 长度
+func appendCompactU16(b []byte, n int) []byte {
+	for {
+		elem := byte(n & 0x7f)
+		n >>= 7
+		if n == 0 {
+			return append(b, elem)
+		}
+		b = append(b, elem|0x80)
+	}
+}
+
+// compileMessage 生成 legacy 格式的交易消息 返回消息和需要签名的账户
+func compileMessage(feePayer PublicKey, instructions []Instruction, blockhash PublicKey) ([]byte, []PublicKey, error) {
+	// 合并账户 手续费账户在第一位
+	metas := []*AccountMeta{
+		{PublicKey: feePayer, IsSigner: true, IsWritable: true},
+	}
+	addMeta := func(meta AccountMeta) {
+		for _, m := range metas {
+			if m.PublicKey == meta.PublicKey {
+				m.IsSigner = m.IsSigner || meta.IsSigner
+				m.IsWritable = m.IsWritable || meta.IsWritable
+				return
+			}
+		}
+		metas = append(metas, &AccountMeta{
+			PublicKey:  meta.PublicKey,
+			IsSigner:   meta.IsSigner,
+			IsWritable: meta.IsWritable,
+		})
+	}
+	for _, instruction := range instructions {
+		for _, account := range instruction.Accounts {
+			addMeta(account)
+		}
+		addMeta(AccountMeta{PublicKey: instruction.ProgramID})
+	}
+	// 账户排序 可写签名 只读签名 可写 只读
+	var accounts []*AccountMeta
+	var numSigners, numReadonlySigners, numReadonly int
+	for _, group := range [][2]bool{{true, true}, {true, false}, {false, true}, {false, false}} {
+		for _, m := range metas {
+			if m.IsSigner != group[0] || m.IsWritable != group[1] {
+				continue
+			}
+			accounts = append(accounts, m)
+			if m.IsSigner {
+				numSigners++
+				if !m.IsWritable {
+					numReadonlySigners++
+				}
+			} else if !m.IsWritable {
+				numReadonly++
+			}
+		}
+	}
+	if len(accounts) > 256 {
+		return nil, nil, errors.New("too many accounts")
+	}
+	accountIndexMap := make(map[PublicKey]byte)
+	var signers []PublicKey
+	for i, account := range accounts {
+		accountIndexMap[account.PublicKey] = byte(i)
+		if account.IsSigner {
+			signers = append(signers, account.PublicKey)
+		}
+	}
+	// 序列化
+	msg := []byte{byte(numSigners), byte(numReadonlySigners), byte(numReadonly)}
+	msg = appendCompactU16(msg, len(accounts))
+	for _, account := range accounts {
+		msg = append(msg, account.PublicKey[:]...)
+	}
+	msg = append(msg, blockhash[:]...)
+	msg = appendCompactU16(msg, len(instructions))
+	for _, instruction := range instructions {
+		msg = append(msg, accountIndexMap[instruction.ProgramID])
+		msg = appendCompactU16(msg, len(instruction.Accounts))
+		for _, account := range instruction.Accounts {
+			msg = append(msg, accountIndexMap[account.PublicKey])
+		}
+		msg = appendCompactU16(msg, len(instruction.Data))
+		msg = append(msg, instruction.Data...)
+	}
+	return msg, signers, nil
+}
+
+// StBlockhash 交易使用的blockhash
+type StBlockhash struct {
+	Hash            PublicKey
+	LastValidHeight int64 // 超过该块高度后交易失效
+}
+
+// GetLatestBlockhash 获取最新的blockhash
+func GetLatestBlockhash() (*StBlockhash, error) {
+	rpcBlockhash, err := solclient.RpcGetLatestBlockhash()
+	if err != nil {
+		return nil, err
+	}
+	hash, err := PublicKeyFromBase58(rpcBlockhash.Blockhash)
+	if err != nil {
+		return nil, err
+	}
+	return &StBlockhash{
+		Hash:            hash,
+		LastValidHeight: rpcBlockhash.LastValidBlockHeight,
+	}, nil
+}
+
+// StTx 签名后的交易
+type StTx struct {
+	TxID            string // 第一个签名
+	Raw             string // base64
+	LastValidHeight int64
+}
+
+// signInstructions 构造交易并签名 第一个私钥为手续费账户
+func signInstructions(blockhash *StBlockhash, instructions []Instruction, privateKeys ...ed25519.PrivateKey) (*StTx, error) {
+	if len(privateKeys) == 0 {
+		return nil, errors.New("no private key")
+	}
+	keyMap := make(map[PublicKey]ed25519.PrivateKey)
+	for _, privateKey := range privateKeys {
+		keyMap[PrivateKeyToPublicKey(privateKey)] = privateKey
+	}
+	msg, signers, err := compileMessage(PrivateKeyToPublicKey(privateKeys[0]), instructions, blockhash.Hash)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.Write(appendCompactU16(nil, len(signers)))
+	var txID string
+	for i, signer := range signers {
+		privateKey, ok := keyMap[signer]
+		if !ok {
+			return nil, fmt.Errorf("no key of signer: %s", signer)
+		}
+		sig := ed25519.Sign(privateKey, msg)
+		if i == 0 {
+			txID = base58.Encode(sig)
+		}
+		buf.Write(sig)
+	}
+	buf.Write(msg)
+	if buf.Len() > txMaxSize {
+		return nil, fmt.Errorf("tx too large: %d", buf.Len())
+	}
+	return &StTx{
+		TxID:            txID,
+		Raw:             base64.StdEncoding.EncodeToString(buf.Bytes()),
+		LastValidHeight: blockhash.LastValidHeight,
+	}, nil
+}
+
+// txMaxSize 交易最大字节数
+const txMaxSize = 1232
+
+// SignTransfer 构造sol转账
+func SignTransfer(blockhash *StBlockhash, privateKey ed25519.PrivateKey, toAddress string, lamports int64) (*StTx, error) {
+	to, err := PublicKeyFromBase58(toAddress)
+	if err != nil {
+		return nil, err
+	}
+	if lamports <= 0 {
+		return nil, fmt.Errorf("error lamports: %d", lamports)
+	}
+	return signInstructions(
+		blockhash,
+		[]Instruction{
+			NewTransferInstruction(PrivateKeyToPublicKey(privateKey), to, uint64(lamports)),
+		},
+		privateKey,
+	)
+}
+
+// SignTokenTransfer 构造spl代币转账 转入目标地址的关联代币账户 账户不存在时由手续费账户创建
+// feePayerKey 和 ownerKey 可以相同
+func SignTokenTransfer(blockhash *StBlockhash, feePayerKey ed25519.PrivateKey, ownerKey ed25519.PrivateKey, sourceTokenAccount string, mintAddress string, decimals int64, toAddress string, amount *big.Int) (*StTx, error) {
+	source, err := PublicKeyFromBase58(sourceTokenAccount)
+	if err != nil {
+		return nil, err
+	}
+	mint, err := PublicKeyFromBase58(mintAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := PublicKeyFromBase58(toAddress)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := FindAssociatedTokenAddress(to, mint)
+	if err != nil {
+		return nil, err
+	}
+	if amount.Sign() <= 0 || !amount.IsUint64() {
+		return nil, fmt.Errorf("error amount: %s", amount.String())
+	}
+	feePayer := PrivateKeyToPublicKey(feePayerKey)
+	return signInstructions(
+		blockhash,
+		[]Instruction{
+			NewCreateAssociatedTokenAccountIdempotentInstruction(feePayer, destination, to, mint),
+			NewTransferCheckedInstruction(source, mint, destination, PrivateKeyToPublicKey(ownerKey), amount.Uint64(), uint8(decimals)),
+		},
+		feePayerKey,
+		ownerKey,
+	)
+}
@@ -0,0 +1,342 @@
+package htrx
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"go-dc-wallet/tronclient"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/moremorefun/mcommon"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// TrxToSun 数据单位
+	TrxToSun = 1e6
+	// TrxDecimals 精度
+	TrxDecimals = 6
+	// CoinSymbol 单位标志
+	CoinSymbol = "trx"
+	// AddressPrefix 地址前缀
+	AddressPrefix = 0x41
+)
+
+// FeeMode 代币整理的手续费方式
+const (
+	FeeModeTrx    = "trx"    // 手续费钱包转入trx
+	FeeModeEnergy = "energy" // 手续费钱包代理能量
+)
+
+// trxToSunDecimal 转换单位
+var trxToSunDecimal = decimal.NewFromInt(TrxToSun)
+
+// genAddressAndAesKey 生成地址和加密后的私钥
+func genAddressAndAesKey() (string, string, error) {
+	// 生成私钥
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		return "", "", err
+	}
+	privateKeyBytes := crypto.FromECDSA(privateKey)
+	privateKeyStr := hexutil.Encode(privateKeyBytes)
+	// 加密密钥
+	privateKeyStrEn, err := mcommon.AesEncrypt(privateKeyStr, xenv.Cfg.AESKey)
+	if err != nil {
+		return "", "", err
+	}
+	// 获取地址
+	address := PubkeyToAddress(privateKey.PublicKey)
+	return address, privateKeyStrEn, nil
+}
+
+// PubkeyToAddress 公钥转换为地址
+func PubkeyToAddress(publicKey ecdsa.PublicKey) string {
+	return EthAddressToAddress(crypto.PubkeyToAddress(publicKey))
+}
+
+// EthAddressToAddress 20字节地址转换为base58地址
+func EthAddressToAddress(ethAddress common.Address) string {
+	return base58.CheckEncode(ethAddress.Bytes(), AddressPrefix)
+}
+
+// HexToAddress hex地址转换为base58地址 支持带41前缀的21字节和不带前缀的20字节
+func HexToAddress(hexAddress string) (string, error) {
+	hexAddress = strings.TrimPrefix(hexAddress, "0x")
+	addressBytes, err := hex.DecodeString(hexAddress)
+	if err != nil {
+		return "", err
+	}
+	switch len(addressBytes) {
+	case common.AddressLength:
+		return base58.CheckEncode(addressBytes, AddressPrefix), nil
+	case common.AddressLength + 1:
+		if addressBytes[0] != AddressPrefix {
+			return "", fmt.Errorf("error address prefix: %s", hexAddress)
+		}
+		return base58.CheckEncode(addressBytes[1:], AddressPrefix), nil
+	}
+	return "", fmt.Errorf("error address length: %s", hexAddress)
+}
+
+// TopicToAddress log topic转换为base58地址
+func TopicToAddress(topic string) (string, error) {
+	topic = strings.TrimPrefix(topic, "0x")
+	if len(topic) != 64 {
+		return "", fmt.Errorf("error topic: %s", topic)
+	}
+	return HexToAddress(topic[24:])
+}
+
+// AddressToBytes base58地址转换为带41前缀的21字节
+func AddressToBytes(address string) ([]byte, error) {
+	payload, version, err := base58.CheckDecode(address)
+	if err != nil {
+		return nil, err
+	}
+	if version != AddressPrefix || len(payload) != common.AddressLength {
+		return nil, fmt.Errorf("error address: %s", address)
+	}
+	return append([]byte{AddressPrefix}, payload...), nil
+}
+
+// AddressToEthAddress base58地址转换为20字节地址
+func AddressToEthAddress(address string) (common.Address, error) {
+	addressBytes, err := AddressToBytes(address)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(addressBytes[1:]), nil
+}
+
+// IsValidAddress 检测地址
+func IsValidAddress(address string) bool {
+	_, err := AddressToBytes(address)
+	return err == nil
+}
+
+// TrxStrToSun 转换金额 trx to sun
+func TrxStrToSun(balanceRealStr string) (int64, error) {
+	balanceReal, err := decimal.NewFromString(balanceRealStr)
+	if err != nil {
+		return 0, err
+	}
+	return balanceReal.Mul(trxToSunDecimal).IntPart(), nil
+}
+
+// SunToTrxStr 转换金额 sun to trx
+func SunToTrxStr(sun int64) string {
+	return decimal.NewFromInt(sun).Div(trxToSunDecimal).StringFixed(TrxDecimals)
+}
+
+// TokenStrToBigInt 转换代币金额
+func TokenStrToBigInt(balanceRealStr string, tokenDecimals int64) (*big.Int, error) {
+	balanceReal, err := decimal.NewFromString(balanceRealStr)
+	if err != nil {
+		return nil, err
+	}
+	balanceStr := balanceReal.Mul(decimal.NewFromInt(10).Pow(decimal.NewFromInt(tokenDecimals))).StringFixed(0)
+	b := new(big.Int)
+	_, ok := b.SetString(balanceStr, 10)
+	if !ok {
+		return nil, errors.New("error str to bigint")
+	}
+	return b, nil
+}
+
+// TokenBigIntToStr 转换代币金额
+func TokenBigIntToStr(balance *big.Int, tokenDecimals int64) (string, error) {
+	balanceDecimal, err := decimal.NewFromString(balance.String())
+	if err != nil {
+		return "0", err
+	}
+	balanceStr := balanceDecimal.Div(decimal.NewFromInt(10).Pow(decimal.NewFromInt(tokenDecimals))).StringFixed(int32(tokenDecimals))
+	return balanceStr, nil
+}
+
+// GetCoinSymbol 获取原生币对外使用的币种
+func GetCoinSymbol() string {
+	return app.GetAssetID(CoinSymbol, CoinSymbol)
+}
+
+// GetTokenSymbol 获取代币对外使用的币种
+func GetTokenSymbol(tokenRow *model.DBTAppConfigTokenTrx) string {
+	return app.GetAssetID(CoinSymbol, tokenRow.TokenSymbol)
+}
+
+// getConfigIntDefault 获取整数配置 没有配置时使用默认值
+func getConfigIntDefault(k string, defaultValue int64) (int64, error) {
+	v, err := app.SQLGetTAppConfigIntValueByK(
+		context.Background(),
+		xenv.DbCon,
+		k,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "no app config int of") {
+			return defaultValue, nil
+		}
+		return 0, err
+	}
+	return v, nil
+}
+
+// getConfigStrDefault 获取字符串配置 没有配置时使用默认值
+func getConfigStrDefault(k string, defaultValue string) (string, error) {
+	v, err := app.SQLGetTAppConfigStrValueByK(
+		context.Background(),
+		xenv.DbCon,
+		k,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "no app config str of") {
+			return defaultValue, nil
+		}
+		return "", err
+	}
+	return v, nil
+}
+
+// getFeeMode 获取代币整理的手续费方式
+func getFeeMode() (string, error) {
+	feeMode, err := getConfigStrDefault("trx_fee_mode", FeeModeTrx)
+	if err != nil {
+		return "", err
+	}
+	if feeMode != FeeModeEnergy {
+		feeMode = FeeModeTrx
+	}
+	return feeMode, nil
+}
+
+// getAvailableBalance 获取地址可用的trx余额 扣除待发送的金额
+func getAvailableBalance(tx mcommon.DbExeAble, address string) (int64, error) {
+	rpcAccount, err := tronclient.RpcGetAccount(address)
+	if err != nil {
+		return 0, err
+	}
+	pendingBalanceReal, err := app.SQLGetTSendTrxPendingBalanceReal(
+		context.Background(),
+		tx,
+		address,
+		0,
+	)
+	if err != nil {
+		return 0, err
+	}
+	pendingBalance, err := TrxStrToSun(pendingBalanceReal)
+	if err != nil {
+		return 0, err
+	}
+	return rpcAccount.Balance - pendingBalance, nil
+}
+
+// isAccountActive 账户是否已激活 未激活的账户不能接收代理的能量
+func isAccountActive(address string) (bool, error) {
+	rpcAccount, err := tronclient.RpcGetAccount(address)
+	if err != nil {
+		return false, err
+	}
+	return rpcAccount.Address != "", nil
+}
+
+// getAvailableEnergy 获取地址剩余的能量
+func getAvailableEnergy(address string) (int64, error) {
+	rpcResource, err := tronclient.RpcGetAccountResource(address)
+	if err != nil {
+		return 0, err
+	}
+	return rpcResource.EnergyLimit - rpcResource.EnergyUsed, nil
+}
+
+// GetPKMapOfAddresses 获取地址私钥
+func GetPKMapOfAddresses(ctx context.Context, db mcommon.DbExeAble, addresses []string) (map[string]*ecdsa.PrivateKey, error) {
+	addressPKMap := make(map[string]*ecdsa.PrivateKey)
+	addressKeyMap, err := app.SQLGetAddressKeyMap(
+		ctx,
+		db,
+		[]string{
+			model.DBColTAddressKeyID,
+			model.DBColTAddressKeyAddress,
+			model.DBColTAddressKeyPwd,
+		},
+		addresses,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range addressKeyMap {
+		privateKey, err := decryptKey(v.Pwd)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			continue
+		}
+		addressPKMap[k] = privateKey
+	}
+	return addressPKMap, nil
+}
+
+// GetPkOfAddress 获取地址私钥
+func GetPkOfAddress(ctx context.Context, db mcommon.DbExeAble, address string) (*ecdsa.PrivateKey, error) {
+	keyRow, err := app.SQLGetTAddressKeyColByAddress(
+		ctx,
+		db,
+		[]string{
+			model.DBColTAddressKeyPwd,
+		},
+		address,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if keyRow == nil {
+		return nil, fmt.Errorf("no key of: %s", address)
+	}
+	return decryptKey(keyRow.Pwd)
+}
+
+// decryptKey 解密私钥
+func decryptKey(pwd string) (*ecdsa.PrivateKey, error) {
+	key, err := mcommon.AesDecrypt(pwd, xenv.Cfg.AESKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		return nil, errors.New("error key")
+	}
+	return crypto.HexToECDSA(strings.TrimPrefix(key, "0x"))
+}
+
+// GetConfirmNum 获取充币确认数
+func GetConfirmNum(ctx context.Context, tx mcommon.DbExeAble) (int64, error) {
+	return app.SQLGetTAppConfigIntValueByK(
+		ctx,
+		tx,
+		"trx_block_confirm_num",
+	)
+}
+
+// GetWithdrawFee 获取当前提币预计的trx手续费 trc20按需要预留的trx计算
+func GetWithdrawFee(isToken bool) (string, error) {
+	if isToken {
+		fee, err := getTrc20Fee()
+		if err != nil {
+			return "", err
+		}
+		return SunToTrxStr(fee.Fee), nil
+	}
+	feeValue, err := getConfigIntDefault("trx_transfer_fee", 300000)
+	if err != nil {
+		return "", err
+	}
+	return SunToTrxStr(feeValue), nil
+}
@@ -0,0 +1,831 @@
+package htrx
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"go-dc-wallet/tronclient"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/moremorefun/mcommon"
+)
+
+// packTransfer 生成trc20 transfer调用数据
+func packTransfer(toAddress string, amount *big.Int) ([]byte, error) {
+	to, err := AddressToEthAddress(toAddress)
+	if err != nil {
+		return nil, err
+	}
+	contractAbi, err := abi.JSON(strings.NewReader(ethclient.EthABI))
+	if err != nil {
+		return nil, err
+	}
+	return contractAbi.Pack(
+		"transfer",
+		to,
+		amount,
+	)
+}
+
+// RpcTokenBalance 获取trc20余额
+func RpcTokenBalance(tokenAddress string, address string) (*big.Int, error) {
+	ethAddress, err := AddressToEthAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	rpcResp, err := tronclient.RpcTriggerConstantContract(
+		address,
+		tokenAddress,
+		"balanceOf(address)",
+		common.Bytes2Hex(common.LeftPadBytes(ethAddress.Bytes(), 32)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(rpcResp.ConstantResult) == 0 {
+		return nil, fmt.Errorf("no balance of: %s %s", tokenAddress, address)
+	}
+	balance, ok := new(big.Int).SetString(rpcResp.ConstantResult[0], 16)
+	if !ok {
+		return nil, fmt.Errorf("error balance of: %s %s", tokenAddress, address)
+	}
+	return balance, nil
+}
+
+// stTrc20Fee 代币转账手续费配置
+type stTrc20Fee struct {
+	FeeMode         string // 手续费方式
+	FeeLimit        int64  // 合约调用最多消耗的trx sun
+	Fee             int64  // trx方式 需要预留的trx sun
+	Energy          int64  // 一次转账消耗的能量
+	DelegateBalance int64  // 能量方式 每次代理的trx sun
+}
+
+// getTrc20Fee 获取代币转账手续费配置
+func getTrc20Fee() (*stTrc20Fee, error) {
+	var err error
+	fee := &stTrc20Fee{}
+	fee.FeeMode, err = getFeeMode()
+	if err != nil {
+		return nil, err
+	}
+	fee.FeeLimit, err = getConfigIntDefault("trx_trc20_fee_limit", 30000000)
+	if err != nil {
+		return nil, err
+	}
+	fee.Fee, err = getConfigIntDefault("trx_trc20_fee", 15000000)
+	if err != nil {
+		return nil, err
+	}
+	fee.Energy, err = getConfigIntDefault("trx_trc20_energy", 65000)
+	if err != nil {
+		return nil, err
+	}
+	fee.DelegateBalance, err = getConfigIntDefault("trx_energy_delegate_balance", 200000000)
+	if err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
+
+// CheckTrc20TxOrg trc20零钱整理到冷钱包
+// 地址手续费不足时由手续费钱包转入trx或代理能量, 到账后再整理
+func CheckTrc20TxOrg() {
+	lockKey := "Trc20CheckTxOrg"
+	app.LockWrap(lockKey, func() {
+		trc20Fee, err := getTrc20Fee()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		refBlock, err := GetRefBlock()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 开始事物
+		isComment := false
+		dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		defer func() {
+			if !isComment {
+				_ = dbTx.Rollback()
+			}
+		}()
+		// 查询需要处理的交易
+		txRows, err := app.SQLSelectTTxTrxColByOrgForUpdate(
+			context.Background(),
+			dbTx,
+			[]string{
+				model.DBColTTxTrxID,
+				model.DBColTTxTrxTokenID,
+				model.DBColTTxTrxToAddress,
+				model.DBColTTxTrxBalanceReal,
+				model.DBColTTxTrxOrgStatus,
+			},
+			[]int64{app.TxOrgStatusInit, app.TxOrgStatusFeeConfirm},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 整理信息
+		type StOrgInfo struct {
+			TxIDs        []int64
+			ToAddress    string
+			TokenID      int64
+			TokenBalance *big.Int
+		}
+		var tokenIDs []int64
+		// map[地址] => 未整理的trx充值 手续费不能占用
+		addressTrxReserveMap := make(map[string]int64)
+		for _, txRow := range txRows {
+			if txRow.TokenID == 0 {
+				if txRow.OrgStatus == app.TxOrgStatusInit {
+					sun, err := TrxStrToSun(txRow.BalanceReal)
+					if err != nil {
+						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						return
+					}
+					addressTrxReserveMap[txRow.ToAddress] += sun
+				}
+				continue
+			}
+			if !mcommon.IsIntInSlice(tokenIDs, txRow.TokenID) {
+				tokenIDs = append(tokenIDs, txRow.TokenID)
+			}
+		}
+		if len(tokenIDs) == 0 {
+			return
+		}
+		tokenMap, err := app.SQLGetAppConfigTokenTrxMap(
+			context.Background(),
+			dbTx,
+			[]string{
+				model.DBColTAppConfigTokenTrxID,
+				model.DBColTAppConfigTokenTrxTokenAddress,
+				model.DBColTAppConfigTokenTrxTokenDecimals,
+				model.DBColTAppConfigTokenTrxColdAddress,
+				model.DBColTAppConfigTokenTrxOrgMinBalance,
+			},
+			tokenIDs,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 整理信息map
+		orgMap := make(map[string]*StOrgInfo)
+		// 整理地址
+		var toAddresses []string
+		for _, txRow := range txRows {
+			if txRow.TokenID == 0 {
+				continue
+			}
+			tokenRow, ok := tokenMap[txRow.TokenID]
+			if !ok {
+				mcommon.Log.Errorf("no token of: %d", txRow.TokenID)
+				return
+			}
+			orgKey := fmt.Sprintf("%s-%d", txRow.ToAddress, txRow.TokenID)
+			orgInfo, ok := orgMap[orgKey]
+			if !ok {
+				orgInfo = &StOrgInfo{
+					TokenID:      txRow.TokenID,
+					ToAddress:    txRow.ToAddress,
+					TokenBalance: new(big.Int),
+				}
+				orgMap[orgKey] = orgInfo
+			}
+			orgInfo.TxIDs = append(orgInfo.TxIDs, txRow.ID)
+			txBalance, err := TokenStrToBigInt(txRow.BalanceReal, tokenRow.TokenDecimals)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			orgInfo.TokenBalance.Add(orgInfo.TokenBalance, txBalance)
+			if !mcommon.IsStringInSlice(toAddresses, txRow.ToAddress) {
+				toAddresses = append(toAddresses, txRow.ToAddress)
+			}
+		}
+		// 获取地址可用的trx和能量 未激活的地址在能量方式下也会转入trx
+		addressResourceMap := make(map[string]*stHotResource)
+		for _, toAddress := range toAddresses {
+			balance, err := getAvailableBalance(dbTx, toAddress)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			resource := &stHotResource{
+				Balance: balance - addressTrxReserveMap[toAddress],
+			}
+			if trc20Fee.FeeMode == FeeModeEnergy {
+				resource.Energy, err = getAvailableEnergy(toAddress)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			}
+			addressResourceMap[toAddress] = resource
+		}
+		// 整理地址key
+		addressPKMap, err := GetPKMapOfAddresses(
+			context.Background(),
+			dbTx,
+			toAddresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		now := time.Now().Unix()
+		// map[地址] => 需要手续费的交易 同一地址每次只发起一笔手续费交易
+		needFeeMap := make(map[string][]int64)
+		for _, orgInfo := range orgMap {
+			toAddress := orgInfo.ToAddress
+			tokenRow, ok := tokenMap[orgInfo.TokenID]
+			if !ok {
+				mcommon.Log.Errorf("no tokenMap: %d", orgInfo.TokenID)
+				continue
+			}
+			orgMinBalance, err := TokenStrToBigInt(tokenRow.OrgMinBalance, tokenRow.TokenDecimals)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+			if orgInfo.TokenBalance.Cmp(orgMinBalance) < 0 {
+				continue
+			}
+			// 计算手续费
+			if !addressResourceMap[toAddress].use(trc20Fee) {
+				needFeeMap[toAddress] = append(needFeeMap[toAddress], orgInfo.TxIDs...)
+				continue
+			}
+			privateKey, ok := addressPKMap[toAddress]
+			if !ok {
+				mcommon.Log.Errorf("addressMap no: %s", toAddress)
+				continue
+			}
+			// 生成交易
+			data, err := packTransfer(tokenRow.ColdAddress, orgInfo.TokenBalance)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+			signedTx, err := SignTriggerSmartContract(refBlock, privateKey, tokenRow.TokenAddress, data, trc20Fee.FeeLimit)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+			balanceReal, err := TokenBigIntToStr(orgInfo.TokenBalance, tokenRow.TokenDecimals)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+			sendRows := genSendRows(
+				app.SendRelationTypeTxErc20,
+				orgInfo.TxIDs,
+				orgInfo.TokenID,
+				signedTx,
+				toAddress,
+				tokenRow.ColdAddress,
+				balanceReal,
+				trc20Fee.FeeLimit,
+				now,
+			)
+			// 插入发送队列
+			_, err = model.SQLCreateManyTSendTrx(
+				context.Background(),
+				dbTx,
+				sendRows,
+				true,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 更新整理状态
+			_, err = app.SQLUpdateTTxTrxOrgStatusByIDs(
+				context.Background(),
+				dbTx,
+				orgInfo.TxIDs,
+				model.DBTTxTrx{
+					OrgStatus: app.TxOrgStatusHex,
+					OrgMsg:    "hex",
+					OrgTime:   now,
+				},
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		// 生成手续费交易
+		if len(needFeeMap) > 0 {
+			// 获取手续费钱包地址
+			feeAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+				context.Background(),
+				dbTx,
+				"fee_wallet_address_trx",
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			if !IsValidAddress(feeAddressValue) {
+				mcommon.Log.Errorf("trx fee address err: %s", feeAddressValue)
+				return
+			}
+			// 获取私钥
+			privateKey, err := GetPkOfAddress(
+				context.Background(),
+				dbTx,
+				feeAddressValue,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			feeAddressBalance, err := getAvailableBalance(dbTx, feeAddressValue)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			for toAddress, txIDs := range needFeeMap {
+				var signedTx *StTx
+				balanceReal := "0"
+				isDelegate := false
+				if trc20Fee.FeeMode == FeeModeEnergy {
+					// 未激活的账户不能接收代理的能量 转入trx 同时激活账户
+					isDelegate, err = isAccountActive(toAddress)
+					if err != nil {
+						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						return
+					}
+				}
+				if isDelegate {
+					// 代理能量
+					signedTx, err = SignDelegateEnergy(refBlock, privateKey, toAddress, trc20Fee.DelegateBalance, false)
+				} else {
+					// 转入trx
+					feeAddressBalance -= trc20Fee.Fee
+					if feeAddressBalance < 0 {
+						mcommon.Log.Errorf("trx fee balance limit")
+						break
+					}
+					balanceReal = SunToTrxStr(trc20Fee.Fee)
+					signedTx, err = SignTransfer(refBlock, privateKey, toAddress, trc20Fee.Fee)
+				}
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				sendRows := genSendRows(
+					app.SendRelationTypeTxErc20Fee,
+					txIDs,
+					0,
+					signedTx,
+					feeAddressValue,
+					toAddress,
+					balanceReal,
+					0,
+					now,
+				)
+				_, err = model.SQLCreateManyTSendTrx(
+					context.Background(),
+					dbTx,
+					sendRows,
+					true,
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				// 更新整理状态
+				_, err = app.SQLUpdateTTxTrxOrgStatusByIDs(
+					context.Background(),
+					dbTx,
+					txIDs,
+					model.DBTTxTrx{
+						OrgStatus: app.TxOrgStatusFeeHex,
+						OrgMsg:    "fee hex",
+						OrgTime:   now,
+					},
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			}
+		}
+
+		err = dbTx.Commit()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		isComment = true
+	})
+}
+
+// CheckEnergyReclaim 收回已完成整理地址的代理能量
+func CheckEnergyReclaim() {
+	lockKey := "TrxCheckEnergyReclaim"
+	app.LockWrap(lockKey, func() {
+		feeAddressValue, err := getConfigStrDefault("fee_wallet_address_trx", "")
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if !IsValidAddress(feeAddressValue) {
+			return
+		}
+		// 获取代理过能量的地址
+		toAddresses, err := tronclient.RpcGetDelegatedResourceAccountIndex(feeAddressValue)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(toAddresses) == 0 {
+			return
+		}
+		// 只收回充币地址的能量
+		dbAddressRows, err := app.SQLSelectTAddressKeyColByAddressAndSymbol(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressKeyAddress,
+				model.DBColTAddressKeyUseTag,
+			},
+			CoinSymbol,
+			toAddresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		privateKey, err := GetPkOfAddress(
+			context.Background(),
+			xenv.DbCon,
+			feeAddressValue,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		refBlock, err := GetRefBlock()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		for _, dbAddressRow := range dbAddressRows {
+			if dbAddressRow.UseTag < 0 {
+				continue
+			}
+			// 还有未完成的整理时保留能量
+			pendingCount, err := app.SQLGetTTxTrxOrgPendingCountByAddress(
+				context.Background(),
+				xenv.DbCon,
+				dbAddressRow.Address,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			if pendingCount > 0 {
+				continue
+			}
+			// 已经有未完成的收回交易
+			pendingCount, err = app.SQLGetTSendTrxPendingCountByRelatedType(
+				context.Background(),
+				xenv.DbCon,
+				app.SendRelationTypeEnergy,
+				dbAddressRow.Address,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			if pendingCount > 0 {
+				continue
+			}
+			rpcResources, err := tronclient.RpcGetDelegatedResource(feeAddressValue, dbAddressRow.Address)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+			var balance int64
+			for _, rpcResource := range rpcResources {
+				balance += rpcResource.FrozenBalanceForEnergy
+			}
+			if balance <= 0 {
+				continue
+			}
+			signedTx, err := SignDelegateEnergy(refBlock, privateKey, dbAddressRow.Address, balance, true)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 插入发送队列 由发送任务广播 过期后重新生成
+			sendRows := genSendRows(
+				app.SendRelationTypeEnergy,
+				[]int64{0},
+				0,
+				signedTx,
+				feeAddressValue,
+				dbAddressRow.Address,
+				"0",
+				0,
+				time.Now().Unix(),
+			)
+			_, err = model.SQLCreateManyTSendTrx(
+				context.Background(),
+				xenv.DbCon,
+				sendRows,
+				true,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			mcommon.Log.Debugf("undelegate energy %s %d: %s", dbAddressRow.Address, balance, signedTx.TxID)
+		}
+	})
+}
+
+// CheckTrc20Withdraw trc20提币
+func CheckTrc20Withdraw() {
+	lockKey := "Trc20CheckWithdraw"
+	app.LockWrap(lockKey, func() {
+		var tokenSymbols []string
+		tokenMap := make(map[string]*model.DBTAppConfigTokenTrx)
+		addressKeyMap := make(map[string]*ecdsa.PrivateKey)
+		addressResourceMap := make(map[string]*stHotResource)
+		addressTokenBalanceMap := make(map[string]*big.Int)
+		tokenRows, err := app.SQLSelectTAppConfigTokenTrxColAll(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigTokenTrxID,
+				model.DBColTAppConfigTokenTrxTokenAddress,
+				model.DBColTAppConfigTokenTrxTokenDecimals,
+				model.DBColTAppConfigTokenTrxTokenSymbol,
+				model.DBColTAppConfigTokenTrxHotAddress,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		for _, tokenRow := range tokenRows {
+			tokenSymbol := GetTokenSymbol(tokenRow)
+			tokenMap[tokenSymbol] = tokenRow
+			if !mcommon.IsStringInSlice(tokenSymbols, tokenSymbol) {
+				tokenSymbols = append(tokenSymbols, tokenSymbol)
+			}
+		}
+		if len(tokenSymbols) == 0 {
+			return
+		}
+		withdrawRows, err := app.SQLSelectTWithdrawColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTWithdrawID,
+				model.DBColTWithdrawSymbol,
+			},
+			app.WithdrawStatusInit,
+			tokenSymbols,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(withdrawRows) == 0 {
+			return
+		}
+		for _, tokenRow := range tokenRows {
+			hotAddress := tokenRow.HotAddress
+			if !IsValidAddress(hotAddress) {
+				mcommon.Log.Errorf("trc20 hot address err: %s", hotAddress)
+				return
+			}
+			_, ok := addressKeyMap[hotAddress]
+			if !ok {
+				// 获取私钥
+				privateKey, err := GetPkOfAddress(
+					context.Background(),
+					xenv.DbCon,
+					hotAddress,
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				addressKeyMap[hotAddress] = privateKey
+			}
+			_, ok = addressResourceMap[hotAddress]
+			if !ok {
+				balance, err := getAvailableBalance(xenv.DbCon, hotAddress)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				energy, err := getAvailableEnergy(hotAddress)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				addressResourceMap[hotAddress] = &stHotResource{
+					Balance: balance,
+					Energy:  energy,
+				}
+			}
+			tokenBalanceKey := fmt.Sprintf("%s-%d", hotAddress, tokenRow.ID)
+			_, ok = addressTokenBalanceMap[tokenBalanceKey]
+			if !ok {
+				tokenBalance, err := RpcTokenBalance(tokenRow.TokenAddress, hotAddress)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				pendingBalanceReal, err := app.SQLGetTSendTrxPendingBalanceReal(
+					context.Background(),
+					xenv.DbCon,
+					hotAddress,
+					tokenRow.ID,
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				pendingBalance, err := TokenStrToBigInt(pendingBalanceReal, tokenRow.TokenDecimals)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				tokenBalance.Sub(tokenBalance, pendingBalance)
+				addressTokenBalanceMap[tokenBalanceKey] = tokenBalance
+			}
+		}
+		trc20Fee, err := getTrc20Fee()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		refBlock, err := GetRefBlock()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		for _, withdrawRow := range withdrawRows {
+			err = handleTrc20Withdraw(withdrawRow.ID, refBlock, tokenMap, addressKeyMap, addressResourceMap, addressTokenBalanceMap, trc20Fee)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+		}
+	})
+}
+
+// stHotResource 热钱包可用的trx和能量
+type stHotResource struct {
+	Balance int64
+	Energy  int64
+}
+
+// use 扣除一次转账需要的资源 能量不足时使用trx
+func (r *stHotResource) use(trc20Fee *stTrc20Fee) bool {
+	if r.Energy >= trc20Fee.Energy {
+		r.Energy -= trc20Fee.Energy
+		return true
+	}
+	if r.Balance >= trc20Fee.Fee {
+		r.Balance -= trc20Fee.Fee
+		return true
+	}
+	return false
+}
+
+func handleTrc20Withdraw(withdrawID int64, refBlock *StRefBlock, tokenMap map[string]*model.DBTAppConfigTokenTrx, addressKeyMap map[string]*ecdsa.PrivateKey, addressResourceMap map[string]*stHotResource, addressTokenBalanceMap map[string]*big.Int, trc20Fee *stTrc20Fee) error {
+	isComment := false
+	dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if !isComment {
+			_ = dbTx.Rollback()
+		}
+	}()
+	// 处理业务
+	withdrawRow, err := app.SQLGetTWithdrawColForUpdate(
+		context.Background(),
+		dbTx,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawBalanceReal,
+			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawSymbol,
+		},
+		withdrawID,
+		app.WithdrawStatusInit,
+	)
+	if err != nil {
+		return err
+	}
+	if withdrawRow == nil {
+		return nil
+	}
+	tokenRow, ok := tokenMap[withdrawRow.Symbol]
+	if !ok {
+		mcommon.Log.Errorf("no tokenMap: %s", withdrawRow.Symbol)
+		return nil
+	}
+	hotAddress := tokenRow.HotAddress
+	key, ok := addressKeyMap[hotAddress]
+	if !ok {
+		mcommon.Log.Errorf("no addressKeyMap: %s", hotAddress)
+		return nil
+	}
+	tokenBalanceKey := fmt.Sprintf("%s-%d", hotAddress, tokenRow.ID)
+	tokenBalance, err := TokenStrToBigInt(withdrawRow.BalanceReal, tokenRow.TokenDecimals)
+	if err != nil {
+		return err
+	}
+	if addressTokenBalanceMap[tokenBalanceKey].Cmp(tokenBalance) < 0 {
+		mcommon.Log.Errorf("%s token limit", tokenBalanceKey)
+		return nil
+	}
+	if !addressResourceMap[hotAddress].use(trc20Fee) {
+		mcommon.Log.Errorf("%s trx fee limit", hotAddress)
+		return nil
+	}
+	addressTokenBalanceMap[tokenBalanceKey].Sub(addressTokenBalanceMap[tokenBalanceKey], tokenBalance)
+	// 生成交易
+	data, err := packTransfer(withdrawRow.ToAddress, tokenBalance)
+	if err != nil {
+		return err
+	}
+	signedTx, err := SignTriggerSmartContract(refBlock, key, tokenRow.TokenAddress, data, trc20Fee.FeeLimit)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	_, err = app.SQLUpdateTWithdrawGenTx(
+		context.Background(),
+		dbTx,
+		&model.DBTWithdraw{
+			ID:           withdrawID,
+			TxHash:       signedTx.TxID,
+			HandleStatus: app.WithdrawStatusHex,
+			HandleMsg:    "hex",
+			HandleTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = model.SQLCreateTSendTrx(
+		context.Background(),
+		dbTx,
+		&model.DBTSendTrx{
+			RelatedType:  app.SendRelationTypeWithdraw,
+			RelatedID:    withdrawID,
+			TokenID:      tokenRow.ID,
+			TxID:         signedTx.TxID,
+			FromAddress:  hotAddress,
+			ToAddress:    withdrawRow.ToAddress,
+			BalanceReal:  withdrawRow.BalanceReal,
+			FeeLimit:     trc20Fee.FeeLimit,
+			Expiration:   signedTx.Expiration,
+			Hex:          signedTx.Hex,
+			CreateTime:   now,
+			HandleStatus: app.SendStatusInit,
+			HandleMsg:    "",
+			HandleTime:   now,
+		},
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	// 处理完成
+	err = dbTx.Commit()
+	if err != nil {
+		return err
+	}
+	isComment = true
+	return nil
+}
@@ -0,0 +1,1373 @@
+package htrx
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"go-dc-wallet/tronclient"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moremorefun/mcommon"
+)
+
+// transferTopic trc20 Transfer 事件
+const transferTopic = "ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+// CreateHotAddress 创建自用地址
+func CreateHotAddress(num int64) ([]string, error) {
+	var rows []*model.DBTAddressKey
+	var addresses []string
+	// 遍历差值次数
+	for i := int64(0); i < num; i++ {
+		address, privateKeyStrEn, err := genAddressAndAesKey()
+		if err != nil {
+			return nil, err
+		}
+		// 存入待添加队列
+		rows = append(rows, &model.DBTAddressKey{
+			Symbol:  CoinSymbol,
+			Address: address,
+			Pwd:     privateKeyStrEn,
+			UseTag:  -1,
+		})
+		addresses = append(addresses, address)
+	}
+	// 一次性将生成的地址存入数据库
+	_, err := model.SQLCreateManyTAddressKey(
+		context.Background(),
+		xenv.DbCon,
+		rows,
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// CheckAddressFree 检测是否有充足的备用地址
+func CheckAddressFree() {
+	lockKey := "TrxCheckAddressFree"
+	app.LockWrap(lockKey, func() {
+		// 获取配置 允许的最小剩余地址数
+		minFreeCount, err := app.SQLGetTAppConfigIntValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"min_free_address",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 获取当前剩余可用地址数
+		freeCount, err := app.SQLGetTAddressKeyFreeCount(
+			context.Background(),
+			xenv.DbCon,
+			CoinSymbol,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 如果数据库中剩余可用地址小于最小允许可用地址
+		if freeCount < minFreeCount {
+			var rows []*model.DBTAddressKey
+			// 遍历差值次数
+			for i := int64(0); i < minFreeCount-freeCount; i++ {
+				address, privateKeyStrEn, err := genAddressAndAesKey()
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				// 存入待添加队列
+				rows = append(rows, &model.DBTAddressKey{
+					Symbol:  CoinSymbol,
+					Address: address,
+					Pwd:     privateKeyStrEn,
+					UseTag:  0,
+				})
+			}
+			// 一次性将生成的地址存入数据库
+			_, err = model.SQLCreateManyTAddressKey(
+				context.Background(),
+				xenv.DbCon,
+				rows,
+				true,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+	})
+}
+
+// CheckBlockSeek 检测trx和trc20到账
+func CheckBlockSeek() {
+	lockKey := "TrxCheckBlockSeek"
+	app.LockWrap(lockKey, func() {
+		// 获取配置 延迟确认数
+		confirmValue, err := app.SQLGetTAppConfigIntValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"trx_block_confirm_num",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 获取状态 当前处理完成的最新的block number
+		seekValue, err := app.SQLGetTAppStatusIntValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"trx_seek_num",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// rpc 获取当前最新区块数
+		rpcNowBlock, err := tronclient.RpcGetNowBlock()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		startI := seekValue + 1
+		endI := rpcNowBlock.BlockHeader.RawData.Number - confirmValue + 1
+		if startI >= endI {
+			return
+		}
+		// 手续费钱包 打入的手续费不作为充币
+		feeAddressValue, err := getConfigStrDefault("fee_wallet_address_trx", "")
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 获取代币配置
+		tokenRows, err := app.SQLSelectTAppConfigTokenTrxColAll(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigTokenTrxID,
+				model.DBColTAppConfigTokenTrxTokenAddress,
+				model.DBColTAppConfigTokenTrxTokenDecimals,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// map[合约地址] => 代币配置
+		tokenAddressMap := make(map[string]*model.DBTAppConfigTokenTrx)
+		for _, tokenRow := range tokenRows {
+			tokenAddressMap[tokenRow.TokenAddress] = tokenRow
+		}
+		// 遍历获取需要查询的block信息
+		for i := startI; i < endI; i++ {
+			rpcBlock, err := tronclient.RpcGetBlockByNum(i)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 待处理交易
+			var blockTxRows []*model.DBTTxTrx
+			// 是否有合约调用
+			hasTrigger := false
+			for _, rpcTx := range rpcBlock.Transactions {
+				if len(rpcTx.RawData.Contract) != 1 {
+					continue
+				}
+				if len(rpcTx.Ret) > 0 && rpcTx.Ret[0].ContractRet != "" && rpcTx.Ret[0].ContractRet != "SUCCESS" {
+					continue
+				}
+				rpcContract := rpcTx.RawData.Contract[0]
+				switch rpcContract.Type {
+				case "TriggerSmartContract":
+					hasTrigger = true
+				case "TransferContract":
+					var transfer tronclient.StTransferContract
+					err := json.Unmarshal(rpcContract.Parameter.Value, &transfer)
+					if err != nil {
+						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						return
+					}
+					if transfer.Amount <= 0 {
+						continue
+					}
+					if feeAddressValue != "" && transfer.OwnerAddress == feeAddressValue {
+						// 如果打币地址是手续费钱包则不处理
+						continue
+					}
+					blockTxRows = append(blockTxRows, &model.DBTTxTrx{
+						TokenID:     0,
+						TxID:        rpcTx.TxID,
+						BlockNum:    i,
+						LogIndex:    -1,
+						FromAddress: transfer.OwnerAddress,
+						ToAddress:   transfer.ToAddress,
+						BalanceReal: SunToTrxStr(transfer.Amount),
+					})
+				}
+			}
+			// 有合约调用时获取交易日志
+			if hasTrigger && len(tokenAddressMap) > 0 {
+				rpcTxInfos, err := tronclient.RpcGetTransactionInfoByBlockNum(i)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				for _, rpcTxInfo := range rpcTxInfos {
+					if rpcTxInfo.Receipt.Result != "SUCCESS" {
+						continue
+					}
+					for logIndex, rpcLog := range rpcTxInfo.Log {
+						if len(rpcLog.Topics) != 3 || rpcLog.Topics[0] != transferTopic {
+							continue
+						}
+						contractAddress, err := HexToAddress(rpcLog.Address)
+						if err != nil {
+							mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+							continue
+						}
+						tokenRow, ok := tokenAddressMap[contractAddress]
+						if !ok {
+							continue
+						}
+						fromAddress, err := TopicToAddress(rpcLog.Topics[1])
+						if err != nil {
+							mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+							continue
+						}
+						toAddress, err := TopicToAddress(rpcLog.Topics[2])
+						if err != nil {
+							mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+							continue
+						}
+						amount, ok := new(big.Int).SetString(rpcLog.Data, 16)
+						if !ok {
+							mcommon.Log.Errorf("error log data: %s", rpcLog.Data)
+							continue
+						}
+						if amount.Sign() <= 0 {
+							continue
+						}
+						balanceReal, err := TokenBigIntToStr(amount, tokenRow.TokenDecimals)
+						if err != nil {
+							mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+							continue
+						}
+						blockTxRows = append(blockTxRows, &model.DBTTxTrx{
+							TokenID:     tokenRow.ID,
+							TxID:        rpcTxInfo.ID,
+							BlockNum:    i,
+							LogIndex:    int64(logIndex),
+							FromAddress: fromAddress,
+							ToAddress:   toAddress,
+							BalanceReal: balanceReal,
+						})
+					}
+				}
+			}
+			// 从db中查询这些地址是否是冲币地址中的地址
+			var toAddresses []string
+			for _, blockTxRow := range blockTxRows {
+				if !mcommon.IsStringInSlice(toAddresses, blockTxRow.ToAddress) {
+					toAddresses = append(toAddresses, blockTxRow.ToAddress)
+				}
+			}
+			var dbAddressRows []*model.DBTAddressKey
+			if len(toAddresses) > 0 {
+				dbAddressRows, err = app.SQLSelectTAddressKeyColByAddressAndSymbol(
+					context.Background(),
+					xenv.DbCon,
+					[]string{
+						model.DBColTAddressKeyAddress,
+						model.DBColTAddressKeyUseTag,
+					},
+					CoinSymbol,
+					toAddresses,
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			}
+			// map[接收地址] => 产品id
+			addressProductMap := make(map[string]int64)
+			for _, dbAddressRow := range dbAddressRows {
+				if dbAddressRow.UseTag < 0 {
+					// 热钱包地址
+					continue
+				}
+				addressProductMap[dbAddressRow.Address] = dbAddressRow.UseTag
+			}
+			// 待插入数据
+			var dbTxRows []*model.DBTTxTrx
+			now := time.Now().Unix()
+			for _, blockTxRow := range blockTxRows {
+				productID, ok := addressProductMap[blockTxRow.ToAddress]
+				if !ok {
+					continue
+				}
+				blockTxRow.ProductID = productID
+				blockTxRow.CreateTime = now
+				blockTxRow.HandleStatus = app.TxStatusInit
+				blockTxRow.HandleTime = now
+				blockTxRow.OrgStatus = app.TxOrgStatusInit
+				blockTxRow.OrgTime = now
+				dbTxRows = append(dbTxRows, blockTxRow)
+			}
+			// 插入交易数据
+			_, err = model.SQLCreateManyTTxTrx(
+				context.Background(),
+				xenv.DbCon,
+				dbTxRows,
+				true,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 更新检查到的最新区块数
+			_, err = app.SQLUpdateTAppStatusIntByKGreater(
+				context.Background(),
+				xenv.DbCon,
+				&model.DBTAppStatusInt{
+					K: "trx_seek_num",
+					V: i,
+				},
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+	})
+}
+
+// CheckTxNotify 创建trx和trc20冲币通知
+func CheckTxNotify() {
+	lockKey := "TrxCheckTxNotify"
+	app.LockWrap(lockKey, func() {
+		txRows, err := app.SQLSelectTTxTrxColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTTxTrxID,
+				model.DBColTTxTrxTokenID,
+				model.DBColTTxTrxProductID,
+				model.DBColTTxTrxTxID,
+				model.DBColTTxTrxLogIndex,
+				model.DBColTTxTrxToAddress,
+				model.DBColTTxTrxBalanceReal,
+			},
+			app.TxStatusInit,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(txRows) == 0 {
+			return
+		}
+		var productIDs []int64
+		var addresses []string
+		var tokenIDs []int64
+		for _, txRow := range txRows {
+			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
+				productIDs = append(productIDs, txRow.ProductID)
+			}
+			if !mcommon.IsStringInSlice(addresses, txRow.ToAddress) {
+				addresses = append(addresses, txRow.ToAddress)
+			}
+			if txRow.TokenID > 0 && !mcommon.IsIntInSlice(tokenIDs, txRow.TokenID) {
+				tokenIDs = append(tokenIDs, txRow.TokenID)
+			}
+		}
+		productMap, err := app.SQLGetProductMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTProductID,
+				model.DBColTProductAppName,
+				model.DBColTProductCbURL,
+				model.DBColTProductAppSk,
+			},
+			productIDs,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		addressUserMap, err := app.SQLGetAddressUserMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressUserProductID,
+				model.DBColTAddressUserUserID,
+			},
+			[]string{CoinSymbol},
+			addresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		tokenMap, err := app.SQLGetAppConfigTokenTrxMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigTokenTrxID,
+				model.DBColTAppConfigTokenTrxTokenSymbol,
+			},
+			tokenIDs,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+
+		var notifyTxIDs []int64
+		var notifyRows []*model.DBTProductNotify
+		now := time.Now().Unix()
+		for _, txRow := range txRows {
+			productRow, ok := productMap[txRow.ProductID]
+			if !ok {
+				mcommon.Log.Warnf("no productMap: %d", txRow.ProductID)
+				notifyTxIDs = append(notifyTxIDs, txRow.ID)
+				continue
+			}
+			symbol := GetCoinSymbol()
+			txHash := txRow.TxID
+			if txRow.TokenID > 0 {
+				tokenRow, ok := tokenMap[txRow.TokenID]
+				if !ok {
+					mcommon.Log.Errorf("no tokenMap: %d", txRow.TokenID)
+					continue
+				}
+				symbol = GetTokenSymbol(tokenRow)
+				// 同一交易中可能有多笔代币转账
+				txHash = fmt.Sprintf("%s_%d", txRow.TxID, txRow.LogIndex)
+			}
+			nonce := mcommon.GetUUIDStr()
+			reqObj := gin.H{
+				"tx_hash":     txHash,
+				"app_name":    productRow.AppName,
+				"address":     txRow.ToAddress,
+				"balance":     txRow.BalanceReal,
+				"symbol":      symbol,
+				"notify_type": app.NotifyTypeTx,
+			}
+			if userID := app.GetAddressUserID(addressUserMap, txRow.ProductID, txRow.ToAddress); userID != "" {
+				reqObj["user_id"] = userID
+			}
+			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
+			req, err := json.Marshal(reqObj)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+			notifyRows = append(notifyRows, &model.DBTProductNotify{
+				Nonce:        nonce,
+				ProductID:    txRow.ProductID,
+				ItemType:     app.SendRelationTypeTx,
+				ItemID:       txRow.ID,
+				NotifyType:   app.NotifyTypeTx,
+				TokenSymbol:  symbol,
+				URL:          productRow.CbURL,
+				Msg:          string(req),
+				HandleStatus: app.NotifyStatusInit,
+				HandleMsg:    "",
+				CreateTime:   now,
+				UpdateTime:   now,
+			})
+			notifyTxIDs = append(notifyTxIDs, txRow.ID)
+		}
+		_, err = model.SQLCreateManyTProductNotify(
+			context.Background(),
+			xenv.DbCon,
+			notifyRows,
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = app.SQLUpdateTTxTrxStatusByIDs(
+			context.Background(),
+			xenv.DbCon,
+			notifyTxIDs,
+			model.DBTTxTrx{
+				HandleStatus: app.TxStatusNotify,
+				HandleMsg:    "notify",
+				HandleTime:   now,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
+
+// CheckTxOrg trx零钱整理到冷钱包
+func CheckTxOrg() {
+	lockKey := "TrxCheckTxOrg"
+	app.LockWrap(lockKey, func() {
+		// 获取冷钱包地址
+		coldAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"cold_wallet_address_trx",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if !IsValidAddress(coldAddressValue) {
+			mcommon.Log.Errorf("trx organize cold address err: %s", coldAddressValue)
+			return
+		}
+		// 转账预留的带宽手续费
+		feeValue, err := getConfigIntDefault("trx_transfer_fee", 300000)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		refBlock, err := GetRefBlock()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 开启事物
+		isComment := false
+		dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		defer func() {
+			if !isComment {
+				_ = dbTx.Rollback()
+			}
+		}()
+		// 获取待整理的交易列表
+		txRows, err := app.SQLSelectTTxTrxColByOrgForUpdate(
+			context.Background(),
+			dbTx,
+			[]string{
+				model.DBColTTxTrxID,
+				model.DBColTTxTrxTokenID,
+				model.DBColTTxTrxToAddress,
+				model.DBColTTxTrxBalanceReal,
+			},
+			[]int64{app.TxOrgStatusInit},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 将待整理地址按地址做归并处理
+		type OrgInfo struct {
+			RowIDs  []int64 // db t_tx_trx.id
+			Balance int64   // 金额
+		}
+		// addressMap map[地址] = []整理信息
+		addressMap := make(map[string]*OrgInfo)
+		// addresses 需要整理的地址列表
+		var addresses []string
+		for _, txRow := range txRows {
+			if txRow.TokenID > 0 {
+				// 代币由 CheckTrc20TxOrg 处理
+				continue
+			}
+			info := addressMap[txRow.ToAddress]
+			if info == nil {
+				info = &OrgInfo{}
+				addressMap[txRow.ToAddress] = info
+			}
+			info.RowIDs = append(info.RowIDs, txRow.ID)
+			txSun, err := TrxStrToSun(txRow.BalanceReal)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			info.Balance += txSun
+
+			if !mcommon.IsStringInSlice(addresses, txRow.ToAddress) {
+				addresses = append(addresses, txRow.ToAddress)
+			}
+		}
+		if len(addresses) == 0 {
+			// 没有要处理的信息
+			return
+		}
+		// 获取地址私钥
+		addressPKMap, err := GetPKMapOfAddresses(
+			context.Background(),
+			dbTx,
+			addresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		now := time.Now().Unix()
+		for address, info := range addressMap {
+			// 获取私钥
+			privateKey, ok := addressPKMap[address]
+			if !ok {
+				mcommon.Log.Errorf("no key of: %s", address)
+				continue
+			}
+			// 发送数量
+			sendBalance := info.Balance - feeValue
+			if sendBalance <= 0 {
+				// 数额不足
+				continue
+			}
+			signedTx, err := SignTransfer(refBlock, privateKey, coldAddressValue, sendBalance)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 创建存入数据
+			sendRows := genSendRows(
+				app.SendRelationTypeTx,
+				info.RowIDs,
+				0,
+				signedTx,
+				address,
+				coldAddressValue,
+				SunToTrxStr(sendBalance),
+				0,
+				now,
+			)
+			// 插入发送数据
+			_, err = model.SQLCreateManyTSendTrx(
+				context.Background(),
+				dbTx,
+				sendRows,
+				true,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 更改tx整理状态
+			_, err = app.SQLUpdateTTxTrxOrgStatusByIDs(
+				context.Background(),
+				dbTx,
+				info.RowIDs,
+				model.DBTTxTrx{
+					OrgStatus: app.TxOrgStatusHex,
+					OrgMsg:    "hex",
+					OrgTime:   now,
+				},
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		// 提交事物
+		err = dbTx.Commit()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		isComment = true
+	})
+}
+
+// genSendRows 生成发送数据 只有第一条数据需要发送，其余数据为占位数据
+func genSendRows(relatedType int64, relatedIDs []int64, tokenID int64, signedTx *StTx, fromAddress, toAddress, balanceReal string, feeLimit int64, now int64) []*model.DBTSendTrx {
+	var sendRows []*model.DBTSendTrx
+	for rowIndex, relatedID := range relatedIDs {
+		sendRow := &model.DBTSendTrx{
+			RelatedType:  relatedType,
+			RelatedID:    relatedID,
+			TokenID:      tokenID,
+			TxID:         signedTx.TxID,
+			FromAddress:  fromAddress,
+			ToAddress:    toAddress,
+			BalanceReal:  balanceReal,
+			FeeLimit:     feeLimit,
+			Expiration:   signedTx.Expiration,
+			Hex:          signedTx.Hex,
+			CreateTime:   now,
+			HandleStatus: app.SendStatusInit,
+			HandleMsg:    "",
+			HandleTime:   now,
+		}
+		if rowIndex > 0 {
+			// 占位数据
+			sendRow.BalanceReal = "0"
+			sendRow.FeeLimit = 0
+			sendRow.Hex = ""
+		}
+		sendRows = append(sendRows, sendRow)
+	}
+	return sendRows
+}
+
+// CheckWithdraw 检测trx提现
+func CheckWithdraw() {
+	lockKey := "TrxCheckWithdraw"
+	app.LockWrap(lockKey, func() {
+		// 获取需要处理的提币数据
+		withdrawRows, err := app.SQLSelectTWithdrawColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTWithdrawID,
+			},
+			app.WithdrawStatusInit,
+			[]string{GetCoinSymbol()},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(withdrawRows) == 0 {
+			// 没有要处理的提币
+			return
+		}
+		// 获取热钱包地址
+		hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"hot_wallet_address_trx",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if !IsValidAddress(hotAddressValue) {
+			mcommon.Log.Errorf("trx hot address err: %s", hotAddressValue)
+			return
+		}
+		// 获取私钥
+		privateKey, err := GetPkOfAddress(
+			context.Background(),
+			xenv.DbCon,
+			hotAddressValue,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 获取热钱包可用余额
+		hotAddressBalance, err := getAvailableBalance(xenv.DbCon, hotAddressValue)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 转账预留的带宽手续费
+		feeValue, err := getConfigIntDefault("trx_transfer_fee", 300000)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		refBlock, err := GetRefBlock()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		for _, withdrawRow := range withdrawRows {
+			err = handleWithdraw(withdrawRow.ID, refBlock, hotAddressValue, privateKey, &hotAddressBalance, feeValue)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+		}
+	})
+}
+
+func handleWithdraw(withdrawID int64, refBlock *StRefBlock, hotAddress string, privateKey *ecdsa.PrivateKey, hotAddressBalance *int64, feeValue int64) error {
+	isComment := false
+	dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if !isComment {
+			_ = dbTx.Rollback()
+		}
+	}()
+	// 处理业务
+	withdrawRow, err := app.SQLGetTWithdrawColForUpdate(
+		context.Background(),
+		dbTx,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawBalanceReal,
+			model.DBColTWithdrawToAddress,
+		},
+		withdrawID,
+		app.WithdrawStatusInit,
+	)
+	if err != nil {
+		return err
+	}
+	if withdrawRow == nil {
+		return nil
+	}
+	balance, err := TrxStrToSun(withdrawRow.BalanceReal)
+	if err != nil {
+		return err
+	}
+	if *hotAddressBalance-balance-feeValue < 0 {
+		mcommon.Log.Errorf("hot balance limit")
+		return nil
+	}
+	*hotAddressBalance -= balance + feeValue
+	// 创建交易
+	signedTx, err := SignTransfer(refBlock, privateKey, withdrawRow.ToAddress, balance)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	_, err = app.SQLUpdateTWithdrawGenTx(
+		context.Background(),
+		dbTx,
+		&model.DBTWithdraw{
+			ID:           withdrawID,
+			TxHash:       signedTx.TxID,
+			HandleStatus: app.WithdrawStatusHex,
+			HandleMsg:    "hex",
+			HandleTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = model.SQLCreateTSendTrx(
+		context.Background(),
+		dbTx,
+		&model.DBTSendTrx{
+			RelatedType:  app.SendRelationTypeWithdraw,
+			RelatedID:    withdrawID,
+			TokenID:      0,
+			TxID:         signedTx.TxID,
+			FromAddress:  hotAddress,
+			ToAddress:    withdrawRow.ToAddress,
+			BalanceReal:  withdrawRow.BalanceReal,
+			FeeLimit:     0,
+			Expiration:   signedTx.Expiration,
+			Hex:          signedTx.Hex,
+			CreateTime:   now,
+			HandleStatus: app.SendStatusInit,
+			HandleMsg:    "",
+			HandleTime:   now,
+		},
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	// 处理完成
+	err = dbTx.Commit()
+	if err != nil {
+		return err
+	}
+	isComment = true
+	return nil
+}
+
+// genWithdrawNotifyRow 生成提币通知
+func genWithdrawNotifyRow(withdrawRow *model.DBTWithdraw, productRow *model.DBTProduct, txID string, notifyType int64, now int64) (*model.DBTProductNotify, error) {
+	reqObj := gin.H{
+		"tx_hash":     txID,
+		"balance":     withdrawRow.BalanceReal,
+		"app_name":    productRow.AppName,
+		"out_serial":  withdrawRow.OutSerial,
+		"address":     withdrawRow.ToAddress,
+		"symbol":      withdrawRow.Symbol,
+		"notify_type": notifyType,
+	}
+	reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
+	req, err := json.Marshal(reqObj)
+	if err != nil {
+		return nil, err
+	}
+	return &model.DBTProductNotify{
+		Nonce:        mcommon.GetUUIDStr(),
+		ProductID:    withdrawRow.ProductID,
+		ItemType:     app.SendRelationTypeWithdraw,
+		ItemID:       withdrawRow.ID,
+		NotifyType:   notifyType,
+		TokenSymbol:  withdrawRow.Symbol,
+		URL:          productRow.CbURL,
+		Msg:          string(req),
+		HandleStatus: app.NotifyStatusInit,
+		HandleMsg:    "",
+		CreateTime:   now,
+		UpdateTime:   now,
+	}, nil
+}
+
+// getWithdrawAndProductMap 获取发送数据关联的提币和产品
+func getWithdrawAndProductMap(sendRows []*model.DBTSendTrx) (map[int64]*model.DBTWithdraw, map[int64]*model.DBTProduct, error) {
+	var withdrawIDs []int64
+	for _, sendRow := range sendRows {
+		if sendRow.RelatedType == app.SendRelationTypeWithdraw {
+			if !mcommon.IsIntInSlice(withdrawIDs, sendRow.RelatedID) {
+				withdrawIDs = append(withdrawIDs, sendRow.RelatedID)
+			}
+		}
+	}
+	withdrawMap, err := app.SQLGetWithdrawMap(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawProductID,
+			model.DBColTWithdrawOutSerial,
+			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawSymbol,
+			model.DBColTWithdrawBalanceReal,
+		},
+		withdrawIDs,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	var productIDs []int64
+	for _, withdrawRow := range withdrawMap {
+		if !mcommon.IsIntInSlice(productIDs, withdrawRow.ProductID) {
+			productIDs = append(productIDs, withdrawRow.ProductID)
+		}
+	}
+	productMap, err := app.SQLGetProductMap(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTProductID,
+			model.DBColTProductAppName,
+			model.DBColTProductCbURL,
+			model.DBColTProductAppSk,
+		},
+		productIDs,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return withdrawMap, productMap, nil
+}
+
+// StSendResult 发送或确认后需要更新状态的数据
+type StSendResult struct {
+	SendIDs     []int64
+	TxIDs       []int64
+	TokenTxIDs  []int64
+	FeeTxIDs    []int64
+	WithdrawIDs []int64
+	NotifyRows  []*model.DBTProductNotify
+}
+
+// add 按关联类型记录数据
+func (r *StSendResult) add(sendRow *model.DBTSendTrx) {
+	if !mcommon.IsIntInSlice(r.SendIDs, sendRow.ID) {
+		r.SendIDs = append(r.SendIDs, sendRow.ID)
+	}
+	var ids *[]int64
+	switch sendRow.RelatedType {
+	case app.SendRelationTypeTx:
+		ids = &r.TxIDs
+	case app.SendRelationTypeTxErc20:
+		ids = &r.TokenTxIDs
+	case app.SendRelationTypeTxErc20Fee:
+		ids = &r.FeeTxIDs
+	case app.SendRelationTypeWithdraw:
+		ids = &r.WithdrawIDs
+	default:
+		return
+	}
+	if !mcommon.IsIntInSlice(*ids, sendRow.RelatedID) {
+		*ids = append(*ids, sendRow.RelatedID)
+	}
+}
+
+// save 更新状态
+func (r *StSendResult) save(withdrawStatus, orgStatus, feeOrgStatus, sendStatus int64, msg string, now int64) error {
+	// 插入通知
+	_, err := model.SQLCreateManyTProductNotify(
+		context.Background(),
+		xenv.DbCon,
+		r.NotifyRows,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	// 更新提币状态
+	_, err = app.SQLUpdateTWithdrawStatusByIDs(
+		context.Background(),
+		xenv.DbCon,
+		r.WithdrawIDs,
+		&model.DBTWithdraw{
+			HandleStatus: withdrawStatus,
+			HandleMsg:    msg,
+			HandleTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	// 更新零钱整理状态
+	_, err = app.SQLUpdateTTxTrxOrgStatusByIDs(
+		context.Background(),
+		xenv.DbCon,
+		append(r.TxIDs, r.TokenTxIDs...),
+		model.DBTTxTrx{
+			OrgStatus: orgStatus,
+			OrgMsg:    msg,
+			OrgTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	// 更新代币整理手续费状态
+	_, err = app.SQLUpdateTTxTrxOrgStatusByIDs(
+		context.Background(),
+		xenv.DbCon,
+		r.FeeTxIDs,
+		model.DBTTxTrx{
+			OrgStatus: feeOrgStatus,
+			OrgMsg:    "fee " + msg,
+			OrgTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	// 更新发送状态
+	_, err = app.SQLUpdateTSendTrxStatusByIDs(
+		context.Background(),
+		xenv.DbCon,
+		r.SendIDs,
+		model.DBTSendTrx{
+			HandleStatus: sendStatus,
+			HandleMsg:    msg,
+			HandleTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// saveExpired 过期未上链的交易 提币和零钱整理重置为待处理 重新生成交易
+func (r *StSendResult) saveExpired(now int64) error {
+	return r.save(
+		app.WithdrawStatusInit,
+		app.TxOrgStatusInit,
+		app.TxOrgStatusInit,
+		app.SendStatusExpired,
+		"expired",
+		now,
+	)
+}
+
+// saveFail 上链但执行失败的交易 需要人工处理
+func (r *StSendResult) saveFail(now int64) error {
+	return r.save(
+		app.WithdrawStatusFail,
+		app.TxOrgStatusFail,
+		app.TxOrgStatusFail,
+		app.SendStatusFail,
+		"failed",
+		now,
+	)
+}
+
+// getSendExpiration 获取交易的过期时间 毫秒
+// 旧数据没有记录过期时间 使用创建时间计算
+func getSendExpiration(sendRow *model.DBTSendTrx) int64 {
+	if sendRow.Expiration > 0 {
+		return sendRow.Expiration
+	}
+	return sendRow.CreateTime*1000 + int64(txExpiration/time.Millisecond)
+}
+
+// getNowBlockTime 获取最新块的时间 毫秒
+func getNowBlockTime() (int64, error) {
+	rpcBlock, err := tronclient.RpcGetNowBlock()
+	if err != nil {
+		return 0, err
+	}
+	return rpcBlock.BlockHeader.RawData.Timestamp, nil
+}
+
+// CheckRawTxSend 发送交易
+func CheckRawTxSend() {
+	lockKey := "TrxCheckRawTxSend"
+	app.LockWrap(lockKey, func() {
+		// 获取待发送的数据
+		sendRows, err := app.SQLSelectTSendTrxColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTSendTrxID,
+				model.DBColTSendTrxTxID,
+				model.DBColTSendTrxHex,
+				model.DBColTSendTrxRelatedType,
+				model.DBColTSendTrxRelatedID,
+				model.DBColTSendTrxExpiration,
+				model.DBColTSendTrxCreateTime,
+			},
+			app.SendStatusInit,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(sendRows) == 0 {
+			return
+		}
+		withdrawMap, productMap, err := getWithdrawAndProductMap(sendRows)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 最新块的时间 超过交易的过期时间时交易已过期
+		blockTime, err := getNowBlockTime()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		now := time.Now().Unix()
+		var result StSendResult
+		var expiredResult StSendResult
+		var sendTxHashes []string
+		var expiredTxHashes []string
+		onSendOk := func(sendRow *model.DBTSendTrx) error {
+			result.add(sendRow)
+			// 如果是提币，创建通知信息
+			if sendRow.RelatedType == app.SendRelationTypeWithdraw {
+				withdrawRow, ok := withdrawMap[sendRow.RelatedID]
+				if !ok {
+					mcommon.Log.Errorf("withdrawMap no: %d", sendRow.RelatedID)
+					return nil
+				}
+				productRow, ok := productMap[withdrawRow.ProductID]
+				if !ok {
+					mcommon.Log.Errorf("productMap no: %d", withdrawRow.ProductID)
+					return nil
+				}
+				notifyRow, err := genWithdrawNotifyRow(withdrawRow, productRow, sendRow.TxID, app.NotifyTypeWithdrawSend, now)
+				if err != nil {
+					return err
+				}
+				result.NotifyRows = append(result.NotifyRows, notifyRow)
+			}
+			return nil
+		}
+		for _, sendRow := range sendRows {
+			// 发送数据中需要排除占位数据
+			if sendRow.Hex != "" {
+				if blockTime > getSendExpiration(sendRow) {
+					// 交易已过期 需要重新生成交易
+					expiredTxHashes = append(expiredTxHashes, sendRow.TxID)
+					expiredResult.add(sendRow)
+					continue
+				}
+				rpcResp, err := tronclient.RpcBroadcastHex(sendRow.Hex)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					continue
+				}
+				if !rpcResp.Result && rpcResp.Code != "DUP_TRANSACTION_ERROR" {
+					// 广播失败 等待下次重试 过期后重新生成交易
+					mcommon.Log.Errorf("broadcast %s err: %s %s", sendRow.TxID, rpcResp.Code, rpcResp.Message)
+					continue
+				}
+				sendTxHashes = append(sendTxHashes, sendRow.TxID)
+
+				err = onSendOk(sendRow)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			} else if mcommon.IsStringInSlice(sendTxHashes, sendRow.TxID) {
+				err = onSendOk(sendRow)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			} else if mcommon.IsStringInSlice(expiredTxHashes, sendRow.TxID) {
+				expiredResult.add(sendRow)
+			}
+		}
+		err = result.save(
+			app.WithdrawStatusSend,
+			app.TxOrgStatusSend,
+			app.TxOrgStatusFeeSend,
+			app.SendStatusSend,
+			"send",
+			now,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		err = expiredResult.saveExpired(now)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
+
+// 交易上链状态
+const (
+	txStatePending = iota // 未上链
+	txStateConfirm        // 执行成功
+	txStateFail           // 执行失败
+	txStateExpired        // 过期未上链
+)
+
+// CheckRawTxConfirm 确认tx是否打包完成
+func CheckRawTxConfirm() {
+	lockKey := "TrxCheckRawTxConfirm"
+	app.LockWrap(lockKey, func() {
+		sendRows, err := app.SQLSelectTSendTrxColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTSendTrxID,
+				model.DBColTSendTrxRelatedType,
+				model.DBColTSendTrxRelatedID,
+				model.DBColTSendTrxTxID,
+				model.DBColTSendTrxExpiration,
+				model.DBColTSendTrxCreateTime,
+			},
+			app.SendStatusSend,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(sendRows) == 0 {
+			return
+		}
+		withdrawMap, productMap, err := getWithdrawAndProductMap(sendRows)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 先获取块时间再查询交易 避免交易在两次查询之间上链被误判为过期
+		blockTime, err := getNowBlockTime()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		now := time.Now().Unix()
+		var result StSendResult
+		var expiredResult StSendResult
+		var failResult StSendResult
+		// map[tx id] => 交易上链状态
+		stateMap := make(map[string]int)
+		for _, sendRow := range sendRows {
+			state, ok := stateMap[sendRow.TxID]
+			if !ok {
+				rpcTxInfo, err := tronclient.RpcGetTransactionInfoByID(sendRow.TxID)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					continue
+				}
+				state = txStatePending
+				if rpcTxInfo != nil && rpcTxInfo.BlockNumber > 0 {
+					state = txStateConfirm
+					if rpcTxInfo.Result == "FAILED" || (rpcTxInfo.Receipt.Result != "" && rpcTxInfo.Receipt.Result != "SUCCESS") {
+						// 执行失败 需要人工处理
+						mcommon.Log.Errorf("trx tx %s failed: %s %s", sendRow.TxID, rpcTxInfo.Receipt.Result, rpcTxInfo.ResMessage)
+						state = txStateFail
+					}
+					// 记录所在块高和实际手续费 供提币查询使用
+					_, err = app.SQLUpdateTSendTrxBlockNumFeeByTxID(
+						context.Background(),
+						xenv.DbCon,
+						model.DBTSendTrx{
+							TxID:     sendRow.TxID,
+							BlockNum: rpcTxInfo.BlockNumber,
+							Fee:      SunToTrxStr(rpcTxInfo.Fee),
+						},
+					)
+					if err != nil {
+						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						continue
+					}
+				} else if blockTime > getSendExpiration(sendRow) {
+					// 交易未上链且已过期 需要重新生成交易
+					state = txStateExpired
+				}
+				stateMap[sendRow.TxID] = state
+			}
+			if state == txStateExpired {
+				expiredResult.add(sendRow)
+				continue
+			}
+			if state == txStatePending {
+				continue
+			}
+			stateResult := &result
+			notifyType := int64(app.NotifyTypeWithdrawConfirm)
+			if state == txStateFail {
+				stateResult = &failResult
+				notifyType = app.NotifyTypeWithdrawFail
+			}
+			if sendRow.RelatedType == app.SendRelationTypeWithdraw {
+				// 提币
+				withdrawRow, ok := withdrawMap[sendRow.RelatedID]
+				if !ok {
+					mcommon.Log.Errorf("no withdrawMap: %d", sendRow.RelatedID)
+					return
+				}
+				productRow, ok := productMap[withdrawRow.ProductID]
+				if !ok {
+					mcommon.Log.Errorf("no productMap: %d", withdrawRow.ProductID)
+					return
+				}
+				notifyRow, err := genWithdrawNotifyRow(withdrawRow, productRow, sendRow.TxID, notifyType, now)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+				stateResult.NotifyRows = append(stateResult.NotifyRows, notifyRow)
+			}
+			stateResult.add(sendRow)
+		}
+		err = result.save(
+			app.WithdrawStatusConfirm,
+			app.TxOrgStatusConfirm,
+			app.TxOrgStatusFeeConfirm,
+			app.SendStatusConfirm,
+			"confirmed",
+			now,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		err = failResult.saveFail(now)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		err = expiredResult.saveExpired(now)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
@@ -0,0 +1,198 @@
+package htrx
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"go-dc-wallet/tronclient"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// 合约类型
+const (
+	contractTypeTransfer           = 1
+	contractTypeTriggerSmart       = 31
+	contractTypeDelegateResource   = 57
+	contractTypeUnDelegateResource = 58
+)
+
+// resourceEnergy 资源类型 能量
+const resourceEnergy = 1
+
+// txExpiration 交易过期时间
+const txExpiration = time.Hour
+
+// contractTypeURLMap 合约类型对应的 type_url
+var contractTypeURLMap = map[int64]string{
+	contractTypeTransfer:           "type.googleapis.com/protocol.TransferContract",
+	contractTypeTriggerSmart:       "type.googleapis.com/protocol.TriggerSmartContract",
+	contractTypeDelegateResource:   "type.googleapis.com/protocol.DelegateResourceContract",
+	contractTypeUnDelegateResource: "type.googleapis.com/protocol.UnDelegateResourceContract",
+}
+
+// pbBuffer protobuf 编码
+// 只实现了交易用到的 varint 和 bytes 两种类型, 字段需按序号写入, 默认值不写入
+type pbBuffer struct {
+	b []byte
+}
+
+func (p *pbBuffer) varint(v uint64) {
+	for v >= 0x80 {
+		p.b = append(p.b, byte(v)|0x80)
+		v >>= 7
+	}
+	p.b = append(p.b, byte(v))
+}
+
+// Int 写入整数字段
+func (p *pbBuffer) Int(field int, v int64) {
+	if v == 0 {
+		return
+	}
+	p.varint(uint64(field<<3 | 0))
+	p.varint(uint64(v))
+}
+
+// Bytes 写入 bytes string message 字段
+func (p *pbBuffer) Bytes(field int, v []byte) {
+	if len(v) == 0 {
+		return
+	}
+	p.varint(uint64(field<<3 | 2))
+	p.varint(uint64(len(v)))
+	p.b = append(p.b, v...)
+}
+
+// StRefBlock 交易引用的块
+type StRefBlock struct {
+	Bytes     []byte
+	Hash      []byte
+	Timestamp int64
+}
+
+// GetRefBlock 获取最新块作为交易的引用块
+func GetRefBlock() (*StRefBlock, error) {
+	rpcBlock, err := tronclient.RpcGetNowBlock()
+	if err != nil {
+		return nil, err
+	}
+	blockID, err := hex.DecodeString(rpcBlock.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	if len(blockID) != 32 {
+		return nil, fmt.Errorf("error block id: %s", rpcBlock.BlockID)
+	}
+	numBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(numBytes, uint64(rpcBlock.BlockHeader.RawData.Number))
+	return &StRefBlock{
+		Bytes:     numBytes[6:8],
+		Hash:      blockID[8:16],
+		Timestamp: rpcBlock.BlockHeader.RawData.Timestamp,
+	}, nil
+}
+
+// StTx 签名后的交易
+type StTx struct {
+	TxID       string
+	Hex        string
+	Expiration int64 // 过期时间 毫秒
+}
+
+// signContract 构造交易并签名
+func signContract(refBlock *StRefBlock, privateKey *ecdsa.PrivateKey, contractType int64, parameter []byte, feeLimit int64) (*StTx, error) {
+	// google.protobuf.Any
+	var anyMsg pbBuffer
+	anyMsg.Bytes(1, []byte(contractTypeURLMap[contractType]))
+	anyMsg.Bytes(2, parameter)
+	// Transaction.Contract
+	var contract pbBuffer
+	contract.Int(1, contractType)
+	contract.Bytes(2, anyMsg.b)
+	// Transaction.raw
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	expiration := refBlock.Timestamp + int64(txExpiration/time.Millisecond)
+	var raw pbBuffer
+	raw.Bytes(1, refBlock.Bytes)
+	raw.Bytes(4, refBlock.Hash)
+	raw.Int(8, expiration)
+	raw.Bytes(11, contract.b)
+	raw.Int(14, now)
+	raw.Int(18, feeLimit)
+	// 签名
+	txHash := sha256.Sum256(raw.b)
+	sig, err := crypto.Sign(txHash[:], privateKey)
+	if err != nil {
+		return nil, err
+	}
+	// Transaction
+	var signedTx pbBuffer
+	signedTx.Bytes(1, raw.b)
+	signedTx.Bytes(2, sig)
+	return &StTx{
+		TxID:       hex.EncodeToString(txHash[:]),
+		Hex:        hex.EncodeToString(signedTx.b),
+		Expiration: expiration,
+	}, nil
+}
+
+// SignTransfer 构造trx转账
+func SignTransfer(refBlock *StRefBlock, privateKey *ecdsa.PrivateKey, toAddress string, amount int64) (*StTx, error) {
+	ownerBytes, err := AddressToBytes(PubkeyToAddress(privateKey.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+	toBytes, err := AddressToBytes(toAddress)
+	if err != nil {
+		return nil, err
+	}
+	var parameter pbBuffer
+	parameter.Bytes(1, ownerBytes)
+	parameter.Bytes(2, toBytes)
+	parameter.Int(3, amount)
+	return signContract(refBlock, privateKey, contractTypeTransfer, parameter.b, 0)
+}
+
+// SignTriggerSmartContract 构造合约调用
+func SignTriggerSmartContract(refBlock *StRefBlock, privateKey *ecdsa.PrivateKey, contractAddress string, data []byte, feeLimit int64) (*StTx, error) {
+	ownerBytes, err := AddressToBytes(PubkeyToAddress(privateKey.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+	contractBytes, err := AddressToBytes(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	var parameter pbBuffer
+	parameter.Bytes(1, ownerBytes)
+	parameter.Bytes(2, contractBytes)
+	parameter.Bytes(4, data)
+	return signContract(refBlock, privateKey, contractTypeTriggerSmart, parameter.b, feeLimit)
+}
+
+// SignDelegateEnergy 构造能量代理
+// isUnDelegate 为 true 时收回代理
+func SignDelegateEnergy(refBlock *StRefBlock, privateKey *ecdsa.PrivateKey, receiverAddress string, balance int64, isUnDelegate bool) (*StTx, error) {
+	ownerBytes, err := AddressToBytes(PubkeyToAddress(privateKey.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+	receiverBytes, err := AddressToBytes(receiverAddress)
+	if err != nil {
+		return nil, err
+	}
+	var parameter pbBuffer
+	parameter.Bytes(1, ownerBytes)
+	parameter.Int(2, resourceEnergy)
+	parameter.Int(3, balance)
+	parameter.Bytes(4, receiverBytes)
+	contractType := int64(contractTypeDelegateResource)
+	if isUnDelegate {
+		contractType = contractTypeUnDelegateResource
+	}
+	return signContract(refBlock, privateKey, contractType, parameter.b, 0)
+}
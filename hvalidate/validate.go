@@ -0,0 +1,319 @@
+package hvalidate
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/eosclient"
+	"go-dc-wallet/hbtc"
+	"go-dc-wallet/heos"
+	"go-dc-wallet/heth"
+	"go-dc-wallet/hsol"
+	"go-dc-wallet/htrx"
+	"go-dc-wallet/hxrp"
+	"go-dc-wallet/model"
+	"regexp"
+	"strings"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/moremorefun/mcommon"
+)
+
+// 地址不可用的原因
+const (
+	ReasonFormat   = "address format error"
+	ReasonChecksum = "address checksum error"
+	ReasonNetwork  = "address network error"
+	ReasonType     = "address type not support"
+	ReasonBurn     = "burn address"
+	ReasonContract = "token contract address"
+	ReasonAccount  = "account not exist"
+)
+
+// btc 地址类型
+const (
+	AddressTypeP2PKH  = "p2pkh"
+	AddressTypeP2SH   = "p2sh"
+	AddressTypeP2WPKH = "p2wpkh"
+	AddressTypeP2WSH  = "p2wsh"
+)
+
+var (
+	ethAddressRe = regexp.MustCompile("^0x[0-9a-fA-F]{40}$")
+	eosAccountRe = regexp.MustCompile("^[a-z1-5.]{1,12}$")
+
+	// 各链的销毁地址
+	ethBurnAddresses = []string{
+		"0x0000000000000000000000000000000000000000",
+		"0x000000000000000000000000000000000000dead",
+	}
+	btcBurnAddresses = []string{
+		"1BitcoinEaterAddressDontSendf59kuE",
+	}
+	eosBurnAddresses = []string{
+		"eosio.null",
+	}
+	trxBurnAddresses = []string{
+		"T9yD14Nj9j7xAB4dbGeiX9h8unkKHxuWwb",
+	}
+	solBurnAddresses = []string{
+		"11111111111111111111111111111111",
+		"1nc1nerator11111111111111111111111111111111",
+	}
+	xrpBurnAddresses = []string{
+		"rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+		"rrrrrrrrrrrrrrrrrrrrBZbvji",
+	}
+)
+
+// StResult 地址检测结果
+type StResult struct {
+	Address     string // 格式化后的地址
+	IsValid     bool   // 是否可以提币
+	Reason      string // 不可以提币的原因
+	AddressType string // btc地址类型
+	IsInternal  bool   // 是否为本系统的地址
+}
+
+// newInvalid 不可以提币的检测结果
+func newInvalid(address, reason string) *StResult {
+	return &StResult{
+		Address: address,
+		Reason:  reason,
+	}
+}
+
+// CheckEth 检测eth及evm链地址
+// 校验EIP-55大小写 拒绝销毁地址和已配置的代币合约地址
+func CheckEth(ctx context.Context, tx mcommon.DbExeAble, chain *heth.Chain, address string) (*StResult, error) {
+	address = strings.TrimSpace(address)
+	if !ethAddressRe.MatchString(address) {
+		return newInvalid(address, ReasonFormat), nil
+	}
+	hexPart := address[2:]
+	if hexPart != strings.ToLower(hexPart) && hexPart != strings.ToUpper(hexPart) {
+		// 大小写混合时需要符合EIP-55
+		if common.HexToAddress(address).Hex() != address {
+			return newInvalid(address, ReasonChecksum), nil
+		}
+	}
+	address = strings.ToLower(address)
+	if mcommon.IsStringInSlice(ethBurnAddresses, address) {
+		return newInvalid(address, ReasonBurn), nil
+	}
+	tokenRows, err := app.SQLSelectTAppConfigTokenColByChainSymbol(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppConfigTokenTokenAddress,
+		},
+		chain.Symbol,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, tokenRow := range tokenRows {
+		if strings.ToLower(tokenRow.TokenAddress) == address {
+			return newInvalid(address, ReasonContract), nil
+		}
+	}
+	return newValid(ctx, tx, address, nil)
+}
+
+// CheckBtc 检测btc及分叉链地址
+// 校验地址所属网络 omni 只支持 p2pkh p2sh 地址
+func CheckBtc(ctx context.Context, tx mcommon.DbExeAble, chain *hbtc.Chain, address string, isOmni bool) (*StResult, error) {
+	address = strings.TrimSpace(address)
+	addr, err := chain.Network().DecodeAddress(address)
+	if err != nil {
+		if err == btcutil.ErrChecksumMismatch {
+			return newInvalid(address, ReasonChecksum), nil
+		}
+		if strings.Contains(err.Error(), "not for network") {
+			// 其他网络的地址 例如测试网地址
+			return newInvalid(address, ReasonNetwork), nil
+		}
+		return newInvalid(address, ReasonFormat), nil
+	}
+	var addressType string
+	switch addr.(type) {
+	case *btcutil.AddressPubKeyHash:
+		addressType = AddressTypeP2PKH
+	case *btcutil.AddressScriptHash:
+		addressType = AddressTypeP2SH
+	case *btcutil.AddressWitnessPubKeyHash:
+		addressType = AddressTypeP2WPKH
+	case *btcutil.AddressWitnessScriptHash:
+		addressType = AddressTypeP2WSH
+	default:
+		return newInvalid(address, ReasonType), nil
+	}
+	if isOmni && addressType != AddressTypeP2PKH && addressType != AddressTypeP2SH {
+		result := newInvalid(address, ReasonType)
+		result.AddressType = addressType
+		return result, nil
+	}
+	if mcommon.IsStringInSlice(btcBurnAddresses, address) {
+		result := newInvalid(address, ReasonBurn)
+		result.AddressType = addressType
+		return result, nil
+	}
+	result, err := newValid(ctx, tx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	result.AddressType = addressType
+	return result, nil
+}
+
+// CheckEos 检测eos wax tlos 账号
+// 账号需要在链上存在
+func CheckEos(ctx context.Context, tx mcommon.DbExeAble, chain *heos.Chain, address string) (*StResult, error) {
+	address = strings.TrimSpace(address)
+	if !eosAccountRe.MatchString(address) {
+		return newInvalid(address, ReasonFormat), nil
+	}
+	if mcommon.IsStringInSlice(eosBurnAddresses, address) {
+		return newInvalid(address, ReasonBurn), nil
+	}
+	tokenRows, err := chain.GetTokenRows(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	for _, tokenRow := range tokenRows {
+		if tokenRow.TokenAddress == address {
+			return newInvalid(address, ReasonContract), nil
+		}
+	}
+	_, err = chain.GetClient().RpcChainGetAccount(
+		address,
+	)
+	if err != nil {
+		if eosclient.IsUnknownAccountError(err) {
+			return newInvalid(address, ReasonAccount), nil
+		}
+		return nil, err
+	}
+	return newValid(
+		ctx,
+		tx,
+		address,
+		[]string{
+			fmt.Sprintf("cold_wallet_address_%s", chain.Symbol),
+			fmt.Sprintf("hot_wallet_address_%s", chain.Symbol),
+		},
+	)
+}
+
+// CheckTrx 检测trx地址
+func CheckTrx(ctx context.Context, tx mcommon.DbExeAble, address string) (*StResult, error) {
+	address = strings.TrimSpace(address)
+	if !htrx.IsValidAddress(address) {
+		return newInvalid(address, ReasonFormat), nil
+	}
+	if mcommon.IsStringInSlice(trxBurnAddresses, address) {
+		return newInvalid(address, ReasonBurn), nil
+	}
+	tokenRows, err := app.SQLSelectTAppConfigTokenTrxColAll(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppConfigTokenTrxTokenAddress,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, tokenRow := range tokenRows {
+		if tokenRow.TokenAddress == address {
+			return newInvalid(address, ReasonContract), nil
+		}
+	}
+	return newValid(ctx, tx, address, nil)
+}
+
+// CheckSol 检测sol地址
+func CheckSol(ctx context.Context, tx mcommon.DbExeAble, address string) (*StResult, error) {
+	address = strings.TrimSpace(address)
+	if !hsol.IsValidAddress(address) {
+		return newInvalid(address, ReasonFormat), nil
+	}
+	if mcommon.IsStringInSlice(solBurnAddresses, address) {
+		return newInvalid(address, ReasonBurn), nil
+	}
+	tokenRows, err := app.SQLSelectTAppConfigTokenSolColAll(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppConfigTokenSolTokenAddress,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, tokenRow := range tokenRows {
+		if tokenRow.TokenAddress == address {
+			return newInvalid(address, ReasonContract), nil
+		}
+	}
+	return newValid(ctx, tx, address, nil)
+}
+
+// CheckXrp 检测xrp地址
+func CheckXrp(ctx context.Context, tx mcommon.DbExeAble, address string) (*StResult, error) {
+	address = strings.TrimSpace(address)
+	if !hxrp.IsValidAddress(address) {
+		return newInvalid(address, ReasonFormat), nil
+	}
+	if mcommon.IsStringInSlice(xrpBurnAddresses, address) {
+		return newInvalid(address, ReasonBurn), nil
+	}
+	return newValid(
+		ctx,
+		tx,
+		address,
+		[]string{
+			"cold_wallet_address_xrp",
+			"hot_wallet_address_xrp",
+		},
+	)
+}
+
+// newValid 可以提币的检测结果 并检测是否为本系统的地址
+// configKeys 为保存钱包地址的配置键
+func newValid(ctx context.Context, tx mcommon.DbExeAble, address string, configKeys []string) (*StResult, error) {
+	result := &StResult{
+		Address: address,
+		IsValid: true,
+	}
+	addressMap, err := app.SQLGetAddressKeyMap(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAddressKeyID,
+		},
+		[]string{address},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := addressMap[address]; ok {
+		result.IsInternal = true
+		return result, nil
+	}
+	if len(configKeys) == 0 {
+		return result, nil
+	}
+	configMap, err := app.SQLGetAppConfigStrMap(ctx, tx, configKeys)
+	if err != nil {
+		return nil, err
+	}
+	for _, configValue := range configMap {
+		if configValue == address {
+			result.IsInternal = true
+			break
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,119 @@
+package hxrp
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"go-dc-wallet/app"
+	"go-dc-wallet/xenv"
+	"strconv"
+	"strings"
+
+	"github.com/moremorefun/mcommon"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// XrpToDrops 数据单位
+	XrpToDrops = 1e6
+	// XrpDecimals 精度
+	XrpDecimals = 6
+	// CoinSymbol 单位标志
+	CoinSymbol = "xrp"
+	// MiniAddress destination tag 起始值
+	MiniAddress = 1000000000
+	// LastLedgerOffset 交易在签名时的 validated ledger 之后多少个 ledger 内有效
+	LastLedgerOffset = 20
+	// lsfRequireDestTag 账户要求收款时必须带 destination tag
+	lsfRequireDestTag = 0x00020000
+)
+
+// xrpToDropsDecimal 转换单位
+var xrpToDropsDecimal = decimal.NewFromInt(XrpToDrops)
+
+// GetCoinSymbol 获取对外使用的币种
+func GetCoinSymbol() string {
+	return app.GetAssetID(CoinSymbol, CoinSymbol)
+}
+
+// IsValidAddress 检测地址
+func IsValidAddress(address string) bool {
+	_, err := DecodeAddress(address)
+	return err == nil
+}
+
+// ParseDestinationTag 解析提币memo中的 destination tag 为空时返回nil
+func ParseDestinationTag(memo string) (*uint32, error) {
+	memo = strings.TrimSpace(memo)
+	if memo == "" {
+		return nil, nil
+	}
+	tag, err := strconv.ParseUint(memo, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	tag32 := uint32(tag)
+	return &tag32, nil
+}
+
+// XrpStrToDrops 转换金额 xrp to drops
+func XrpStrToDrops(balanceRealStr string) (int64, error) {
+	balanceReal, err := decimal.NewFromString(balanceRealStr)
+	if err != nil {
+		return 0, err
+	}
+	return balanceReal.Mul(xrpToDropsDecimal).IntPart(), nil
+}
+
+// DropsToXrpStr 转换金额 drops to xrp
+func DropsToXrpStr(drops int64) string {
+	return decimal.NewFromInt(drops).Div(xrpToDropsDecimal).StringFixed(XrpDecimals)
+}
+
+// parseDrops 解析 xrp 金额 其他代币和 unavailable 返回false
+func parseDrops(raw json.RawMessage) (int64, bool) {
+	var dropsStr string
+	err := json.Unmarshal(raw, &dropsStr)
+	if err != nil {
+		return 0, false
+	}
+	drops, err := strconv.ParseInt(dropsStr, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return drops, true
+}
+
+// getHotKey 获取热钱包私钥
+func getHotKey() (ed25519.PrivateKey, error) {
+	hotKeyValue, err := app.SQLGetTAppConfigStrValueByK(
+		context.Background(),
+		xenv.DbCon,
+		"hot_wallet_key_xrp",
+	)
+	if err != nil {
+		return nil, err
+	}
+	if hotKeyValue == "" {
+		return nil, errors.New("no hot_wallet_key_xrp")
+	}
+	seed, err := mcommon.AesDecrypt(hotKeyValue, xenv.Cfg.AESKey)
+	if err != nil {
+		return nil, err
+	}
+	return KeyFromSeed(seed)
+}
+
+// GetWithdrawFee 获取当前提币的xrp手续费
+func GetWithdrawFee(ctx context.Context, tx mcommon.DbExeAble) (string, error) {
+	feeValue, err := app.SQLGetTAppConfigIntValueByK(
+		ctx,
+		tx,
+		"xrp_tx_fee",
+	)
+	if err != nil {
+		return "", err
+	}
+	return DropsToXrpStr(feeValue), nil
+}
@@ -0,0 +1,250 @@
+package hxrp
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+)
+
+const (
+	// xrp 使用的 base58 字母表
+	xrpAlphabet = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+	// btc 使用的 base58 字母表
+	btcAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+// 编码前缀
+var (
+	accountIDPrefix   = []byte{0x00}
+	ed25519SeedPrefix = []byte{0x01, 0xe1, 0x4b}
+	signPrefix        = []byte{0x53, 0x54, 0x58, 0x00} // STX\0
+	txIDPrefix        = []byte{0x54, 0x58, 0x4e, 0x00} // TXN\0
+)
+
+// 字段类型
+const (
+	typeUInt16    = 1
+	typeUInt32    = 2
+	typeAmount    = 6
+	typeBlob      = 7
+	typeAccountID = 8
+)
+
+// 交易类型
+const (
+	txTypePayment = 0
+)
+
+// tfFullyCanonicalSig 要求签名为规范格式
+const tfFullyCanonicalSig = 0x80000000
+
+// translateAlphabet 转换 base58 字母表
+func translateAlphabet(s string, from string, to string) (string, error) {
+	var b strings.Builder
+	for _, c := range s {
+		i := strings.IndexRune(from, c)
+		if i < 0 {
+			return "", errors.New("error base58 char")
+		}
+		b.WriteByte(to[i])
+	}
+	return b.String(), nil
+}
+
+// xrpCheckEncode 带版本前缀和校验的 base58 编码
+func xrpCheckEncode(payload []byte, prefix []byte) string {
+	input := append(append([]byte{}, prefix...), payload...)
+	checksum := chainhash.DoubleHashB(input)
+	s := base58.Encode(append(input, checksum[:4]...))
+	r, _ := translateAlphabet(s, btcAlphabet, xrpAlphabet)
+	return r
+}
+
+// xrpCheckDecode 带版本前缀和校验的 base58 解码
+func xrpCheckDecode(s string, prefix []byte, payloadLen int) ([]byte, error) {
+	btcStr, err := translateAlphabet(s, xrpAlphabet, btcAlphabet)
+	if err != nil {
+		return nil, err
+	}
+	decoded := base58.Decode(btcStr)
+	if len(decoded) != len(prefix)+payloadLen+4 {
+		return nil, errors.New("error length")
+	}
+	if !bytes.Equal(decoded[:len(prefix)], prefix) {
+		return nil, errors.New("error prefix")
+	}
+	checksum := chainhash.DoubleHashB(decoded[:len(decoded)-4])
+	if !bytes.Equal(checksum[:4], decoded[len(decoded)-4:]) {
+		return nil, errors.New("error checksum")
+	}
+	return decoded[len(prefix) : len(decoded)-4], nil
+}
+
+// DecodeAddress 地址转换为 account id
+func DecodeAddress(address string) ([]byte, error) {
+	return xrpCheckDecode(address, accountIDPrefix, 20)
+}
+
+// PublicKeyToAddress 公钥转换为地址
+func PublicKeyToAddress(publicKey []byte) string {
+	return xrpCheckEncode(btcutil.Hash160(publicKey), accountIDPrefix)
+}
+
+// KeyFromSeed 从 sEd 开头的 ed25519 种子获取私钥
+func KeyFromSeed(seed string) (ed25519.PrivateKey, error) {
+	entropy, err := xrpCheckDecode(seed, ed25519SeedPrefix, 16)
+	if err != nil {
+		return nil, err
+	}
+	h := sha512Half(entropy)
+	return ed25519.NewKeyFromSeed(h), nil
+}
+
+// EncodeSeed 生成 sEd 开头的 ed25519 种子
+func EncodeSeed(entropy []byte) string {
+	return xrpCheckEncode(entropy, ed25519SeedPrefix)
+}
+
+// SigningPublicKey 签名使用的公钥 ed25519 公钥前加 0xED
+func SigningPublicKey(privateKey ed25519.PrivateKey) []byte {
+	return append([]byte{0xed}, privateKey.Public().(ed25519.PublicKey)...)
+}
+
+// StPayment xrp 转账
+type StPayment struct {
+	Account            string
+	Destination        string
+	DestinationTag     *uint32
+	Amount             int64 // drops
+	Fee                int64 // drops
+	Sequence           uint32
+	LastLedgerSequence uint32
+}
+
+// SignPayment 签名转账 返回 tx blob 和 tx hash
+func SignPayment(payment *StPayment, privateKey ed25519.PrivateKey) (string, string, error) {
+	signingPubKey := SigningPublicKey(privateKey)
+	if PublicKeyToAddress(signingPubKey) != payment.Account {
+		return "", "", errors.New("key not match account")
+	}
+	unsigned, err := serializePayment(payment, signingPubKey, nil)
+	if err != nil {
+		return "", "", err
+	}
+	signature := ed25519.Sign(privateKey, append(append([]byte{}, signPrefix...), unsigned...))
+	signed, err := serializePayment(payment, signingPubKey, signature)
+	if err != nil {
+		return "", "", err
+	}
+	txHash := sha512Half(append(append([]byte{}, txIDPrefix...), signed...))
+	return strings.ToUpper(hex.EncodeToString(signed)), strings.ToUpper(hex.EncodeToString(txHash)), nil
+}
+
+// serializePayment 按字段排序序列化 signature 为空时不包含签名字段
+func serializePayment(payment *StPayment, signingPubKey []byte, signature []byte) ([]byte, error) {
+	account, err := DecodeAddress(payment.Account)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := DecodeAddress(payment.Destination)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	// TransactionType
+	writeFieldID(&buf, typeUInt16, 2)
+	writeUint16(&buf, txTypePayment)
+	// Flags
+	writeFieldID(&buf, typeUInt32, 2)
+	writeUint32(&buf, tfFullyCanonicalSig)
+	// Sequence
+	writeFieldID(&buf, typeUInt32, 4)
+	writeUint32(&buf, payment.Sequence)
+	// DestinationTag
+	if payment.DestinationTag != nil {
+		writeFieldID(&buf, typeUInt32, 14)
+		writeUint32(&buf, *payment.DestinationTag)
+	}
+	// LastLedgerSequence
+	writeFieldID(&buf, typeUInt32, 27)
+	writeUint32(&buf, payment.LastLedgerSequence)
+	// Amount
+	writeFieldID(&buf, typeAmount, 1)
+	err = writeXrpAmount(&buf, payment.Amount)
+	if err != nil {
+		return nil, err
+	}
+	// Fee
+	writeFieldID(&buf, typeAmount, 8)
+	err = writeXrpAmount(&buf, payment.Fee)
+	if err != nil {
+		return nil, err
+	}
+	// SigningPubKey
+	writeFieldID(&buf, typeBlob, 3)
+	writeVL(&buf, signingPubKey)
+	// TxnSignature
+	if signature != nil {
+		writeFieldID(&buf, typeBlob, 4)
+		writeVL(&buf, signature)
+	}
+	// Account
+	writeFieldID(&buf, typeAccountID, 1)
+	writeVL(&buf, account)
+	// Destination
+	writeFieldID(&buf, typeAccountID, 3)
+	writeVL(&buf, destination)
+	return buf.Bytes(), nil
+}
+
+func writeFieldID(buf *bytes.Buffer, typeCode byte, fieldCode byte) {
+	if fieldCode < 16 {
+		buf.WriteByte(typeCode<<4 | fieldCode)
+		return
+	}
+	buf.WriteByte(typeCode << 4)
+	buf.WriteByte(fieldCode)
+}
+
+func writeUint16(buf *bytes.Buffer, v uint16) {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], v)
+	buf.Write(b[:])
+}
+
+func writeUint32(buf *bytes.Buffer, v uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	buf.Write(b[:])
+}
+
+// writeXrpAmount xrp 金额 最高位为0 第二位为正数标志
+func writeXrpAmount(buf *bytes.Buffer, drops int64) error {
+	if drops < 0 {
+		return errors.New("error amount")
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(drops)|0x4000000000000000)
+	buf.Write(b[:])
+	return nil
+}
+
+// writeVL 变长字段 只需要支持小于193字节
+func writeVL(buf *bytes.Buffer, data []byte) {
+	buf.WriteByte(byte(len(data)))
+	buf.Write(data)
+}
+
+// sha512Half sha512 的前32字节
+func sha512Half(data []byte) []byte {
+	h := sha512.Sum512(data)
+	return h[:32]
+}
@@ -0,0 +1,1026 @@
+package hxrp
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"go-dc-wallet/xrpclient"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moremorefun/mcommon"
+)
+
+// CheckAddressFree 检测剩余 destination tag 数
+func CheckAddressFree() {
+	lockKey := "XrpCheckAddressFree"
+	app.LockWrap(lockKey, func() {
+		// 获取配置 允许的最小剩余地址数
+		minFreeValue, err := app.SQLGetTAppConfigIntValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"min_free_address",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 获取当前剩余可用地址数
+		freeCount, err := app.SQLGetTAddressKeyFreeCount(
+			context.Background(),
+			xenv.DbCon,
+			CoinSymbol,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 如果数据库中剩余可用地址小于最小允许可用地址
+		if freeCount < minFreeValue {
+			var rows []*model.DBTAddressKey
+			// 获取最大值
+			maxAddress, err := app.SQLGetTAddressMaxIntOfEos(
+				context.Background(),
+				xenv.DbCon,
+				CoinSymbol,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			if maxAddress < MiniAddress {
+				maxAddress = MiniAddress
+			}
+			maxAddress++
+			// 遍历差值次数
+			for i := int64(0); i < minFreeValue-freeCount; i++ {
+				// 存入待添加队列
+				rows = append(rows, &model.DBTAddressKey{
+					Symbol:  CoinSymbol,
+					Address: fmt.Sprintf("%d", maxAddress+i),
+					Pwd:     "",
+					UseTag:  0,
+				})
+			}
+			// 一次性将生成的地址存入数据库
+			_, err = model.SQLCreateManyTAddressKey(
+				context.Background(),
+				xenv.DbCon,
+				rows,
+				true,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+	})
+}
+
+// CheckBlockSeek 检测到账
+// 只处理 validated ledger 中转入冷钱包且执行成功的 Payment
+// 到账金额使用 delivered_amount, 部分支付时 Amount 大于实际到账金额
+func CheckBlockSeek() {
+	lockKey := "XrpCheckBlockSeek"
+	app.LockWrap(lockKey, func() {
+		// 获取冷钱包地址
+		coldAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"cold_wallet_address_xrp",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		coldAddressValue = strings.TrimSpace(coldAddressValue)
+		if coldAddressValue == "" {
+			mcommon.Log.Errorf("no cold_wallet_address_xrp")
+			return
+		}
+		// 获取状态 当前处理完成的最新的ledger
+		seekValue, err := app.SQLGetTAppStatusIntValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"xrp_seek_num",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// rpc 获取当前最新的validated ledger
+		rpcLedgerIndex, err := xrpclient.RpcLedgerIndex(xrpclient.LedgerValidated)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		startI := seekValue + 1
+		endI := rpcLedgerIndex + 1
+		if startI >= endI {
+			return
+		}
+		// 遍历获取需要查询的ledger信息
+		for i := startI; i < endI; i++ {
+			rpcLedger, err := xrpclient.RpcLedger(i)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			if !rpcLedger.Validated {
+				mcommon.Log.Errorf("xrp ledger not validated: %d", i)
+				return
+			}
+			// 待处理交易
+			var ledgerTxRows []*model.DBTTxXrp
+			for _, rpcTx := range rpcLedger.Transactions {
+				txRow := parsePayment(rpcTx, coldAddressValue)
+				if txRow == nil {
+					continue
+				}
+				txRow.LedgerIndex = i
+				ledgerTxRows = append(ledgerTxRows, txRow)
+			}
+			// 从db中查询这些 destination tag 是否已分配
+			var tags []string
+			for _, ledgerTxRow := range ledgerTxRows {
+				if !mcommon.IsStringInSlice(tags, ledgerTxRow.Memo) {
+					tags = append(tags, ledgerTxRow.Memo)
+				}
+			}
+			var dbAddressRows []*model.DBTAddressKey
+			if len(tags) > 0 {
+				dbAddressRows, err = app.SQLSelectTAddressKeyColByAddressAndSymbol(
+					context.Background(),
+					xenv.DbCon,
+					[]string{
+						model.DBColTAddressKeyAddress,
+						model.DBColTAddressKeyUseTag,
+					},
+					CoinSymbol,
+					tags,
+				)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					return
+				}
+			}
+			// map[destination tag] => 产品id
+			tagProductMap := make(map[string]int64)
+			for _, dbAddressRow := range dbAddressRows {
+				if dbAddressRow.UseTag <= 0 {
+					// 未分配
+					continue
+				}
+				tagProductMap[dbAddressRow.Address] = dbAddressRow.UseTag
+			}
+			// 待插入数据
+			var dbTxRows []*model.DBTTxXrp
+			now := time.Now().Unix()
+			for _, ledgerTxRow := range ledgerTxRows {
+				productID, ok := tagProductMap[ledgerTxRow.Memo]
+				if !ok {
+					mcommon.Log.Warnf("xrp tx %s unknown destination tag: %s", ledgerTxRow.TxHash, ledgerTxRow.Memo)
+					continue
+				}
+				ledgerTxRow.ProductID = productID
+				ledgerTxRow.CreateAt = now
+				ledgerTxRow.HandleStatus = app.TxStatusInit
+				ledgerTxRow.HandleAt = now
+				dbTxRows = append(dbTxRows, ledgerTxRow)
+			}
+			// 插入交易数据
+			_, err = model.SQLCreateManyTTxXrp(
+				context.Background(),
+				xenv.DbCon,
+				dbTxRows,
+				true,
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			// 更新检查到的最新ledger
+			_, err = app.SQLUpdateTAppStatusIntByKGreater(
+				context.Background(),
+				xenv.DbCon,
+				&model.DBTAppStatusInt{
+					K: "xrp_seek_num",
+					V: i,
+				},
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+	})
+}
+
+// parsePayment 解析转入冷钱包的xrp充币 不是充币时返回nil
+func parsePayment(rpcTx *xrpclient.StTransaction, coldAddress string) *model.DBTTxXrp {
+	if rpcTx.TransactionType != "Payment" {
+		return nil
+	}
+	if rpcTx.Destination != coldAddress {
+		return nil
+	}
+	if rpcTx.DestinationTag == nil {
+		mcommon.Log.Warnf("xrp tx %s no destination tag", rpcTx.Hash)
+		return nil
+	}
+	meta := rpcTx.GetMeta()
+	if meta == nil || meta.TransactionResult != "tesSUCCESS" {
+		return nil
+	}
+	// 只处理 xrp, 不使用 Amount
+	drops, ok := parseDrops(meta.DeliveredAmount)
+	if !ok || drops <= 0 {
+		return nil
+	}
+	return &model.DBTTxXrp{
+		TxHash:      rpcTx.Hash,
+		FromAddress: rpcTx.Account,
+		ToAddress:   rpcTx.Destination,
+		Memo:        fmt.Sprintf("%d", *rpcTx.DestinationTag),
+		BalanceReal: DropsToXrpStr(drops),
+	}
+}
+
+// CheckTxNotify 创建冲币通知
+func CheckTxNotify() {
+	lockKey := "XrpCheckTxNotify"
+	app.LockWrap(lockKey, func() {
+		txRows, err := app.SQLSelectTTxXrpColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTTxXrpID,
+				model.DBColTTxXrpProductID,
+				model.DBColTTxXrpTxHash,
+				model.DBColTTxXrpToAddress,
+				model.DBColTTxXrpMemo,
+				model.DBColTTxXrpBalanceReal,
+			},
+			app.TxStatusInit,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var productIDs []int64
+		var addresses []string
+		for _, txRow := range txRows {
+			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
+				productIDs = append(productIDs, txRow.ProductID)
+			}
+			if !mcommon.IsStringInSlice(addresses, txRow.Memo) {
+				addresses = append(addresses, txRow.Memo)
+			}
+		}
+		productMap, err := app.SQLGetProductMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTProductID,
+				model.DBColTProductAppName,
+				model.DBColTProductCbURL,
+				model.DBColTProductAppSk,
+			},
+			productIDs,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		addressUserMap, err := app.SQLGetAddressUserMap(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressUserProductID,
+				model.DBColTAddressUserUserID,
+			},
+			[]string{CoinSymbol},
+			addresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		var notifyTxIDs []int64
+		var notifyRows []*model.DBTProductNotify
+		now := time.Now().Unix()
+		for _, txRow := range txRows {
+			productRow, ok := productMap[txRow.ProductID]
+			if !ok {
+				mcommon.Log.Warnf("no productMap: %d", txRow.ProductID)
+				notifyTxIDs = append(notifyTxIDs, txRow.ID)
+				continue
+			}
+			nonce := mcommon.GetUUIDStr()
+			reqObj := gin.H{
+				"tx_hash":     txRow.TxHash,
+				"app_name":    productRow.AppName,
+				"address":     txRow.ToAddress,
+				"memo":        txRow.Memo,
+				"balance":     txRow.BalanceReal,
+				"symbol":      GetCoinSymbol(),
+				"notify_type": app.NotifyTypeTx,
+			}
+			if userID := app.GetAddressUserID(addressUserMap, txRow.ProductID, txRow.Memo); userID != "" {
+				reqObj["user_id"] = userID
+			}
+			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
+			req, err := json.Marshal(reqObj)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+			notifyRows = append(notifyRows, &model.DBTProductNotify{
+				Nonce:        nonce,
+				ProductID:    txRow.ProductID,
+				ItemType:     app.SendRelationTypeTx,
+				ItemID:       txRow.ID,
+				NotifyType:   app.NotifyTypeTx,
+				TokenSymbol:  GetCoinSymbol(),
+				URL:          productRow.CbURL,
+				Msg:          string(req),
+				HandleStatus: app.NotifyStatusInit,
+				HandleMsg:    "",
+				CreateTime:   now,
+				UpdateTime:   now,
+			})
+			notifyTxIDs = append(notifyTxIDs, txRow.ID)
+		}
+		_, err = model.SQLCreateManyTProductNotify(
+			context.Background(),
+			xenv.DbCon,
+			notifyRows,
+			true,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = app.SQLUpdateTTxXrpStatusByIDs(
+			context.Background(),
+			xenv.DbCon,
+			notifyTxIDs,
+			model.DBTTxXrp{
+				HandleStatus: app.TxStatusNotify,
+				HandleMsg:    "notify",
+				HandleAt:     now,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
+
+// StHotWallet 生成提币交易时热钱包的状态
+type StHotWallet struct {
+	Address            string
+	Key                ed25519.PrivateKey
+	Balance            int64 // 扣除保留金额后的可用余额
+	Sequence           int64
+	LastLedgerSequence int64
+	Fee                int64
+	ReserveBase        int64
+}
+
+// CheckWithdraw 检测提现
+// 交易使用连续的账户序号, 上一批提币全部确认或过期后才生成新的交易
+func CheckWithdraw() {
+	lockKey := "XrpCheckWithdraw"
+	app.LockWrap(lockKey, func() {
+		// 获取需要处理的提币数据
+		withdrawRows, err := app.SQLSelectTWithdrawColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTWithdrawID,
+			},
+			app.WithdrawStatusInit,
+			[]string{GetCoinSymbol()},
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(withdrawRows) == 0 {
+			// 没有要处理的提币
+			return
+		}
+		// 获取热钱包地址
+		hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"hot_wallet_address_xrp",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 有未确认的交易时等待
+		pendingCount, err := app.SQLGetTSendXrpPendingCount(
+			context.Background(),
+			xenv.DbCon,
+			hotAddressValue,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if pendingCount > 0 {
+			return
+		}
+		// 获取热钱包私钥
+		key, err := getHotKey()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 获取手续费 drops
+		feeValue, err := app.SQLGetTAppConfigIntValueByK(
+			context.Background(),
+			xenv.DbCon,
+			"xrp_tx_fee",
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 获取保留金额和最新的validated ledger
+		rpcState, err := xrpclient.RpcServerState()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 获取热钱包余额和序号
+		rpcAccount, err := xrpclient.RpcAccountInfo(hotAddressValue, xrpclient.LedgerCurrent)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if rpcAccount == nil {
+			mcommon.Log.Errorf("xrp hot account not found: %s", hotAddressValue)
+			return
+		}
+		rpcBalance, err := strconv.ParseInt(rpcAccount.Balance, 10, 64)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		hotWallet := &StHotWallet{
+			Address:            hotAddressValue,
+			Key:                key,
+			Balance:            rpcBalance - rpcState.ReserveBase - rpcAccount.OwnerCount*rpcState.ReserveInc,
+			Sequence:           rpcAccount.Sequence,
+			LastLedgerSequence: rpcState.Seq + LastLedgerOffset,
+			Fee:                feeValue,
+			ReserveBase:        rpcState.ReserveBase,
+		}
+		for _, withdrawRow := range withdrawRows {
+			err = handleWithdraw(withdrawRow.ID, hotWallet)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+		}
+	})
+}
+
+func handleWithdraw(withdrawID int64, hotWallet *StHotWallet) error {
+	isComment := false
+	dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if !isComment {
+			_ = dbTx.Rollback()
+		}
+	}()
+	// 处理业务
+	withdrawRow, err := app.SQLGetTWithdrawColForUpdate(
+		context.Background(),
+		dbTx,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawBalanceReal,
+			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawMemo,
+			model.DBColTWithdrawSymbol,
+		},
+		withdrawID,
+		app.WithdrawStatusInit,
+	)
+	if err != nil {
+		return err
+	}
+	if withdrawRow == nil {
+		return nil
+	}
+	if withdrawRow.ToAddress == hotWallet.Address {
+		mcommon.Log.Errorf("xrp withdraw to hot address: %d", withdrawRow.ID)
+		return nil
+	}
+	destinationTag, err := ParseDestinationTag(withdrawRow.Memo)
+	if err != nil {
+		mcommon.Log.Errorf("xrp withdraw %d error destination tag: %s", withdrawRow.ID, withdrawRow.Memo)
+		return nil
+	}
+	drops, err := XrpStrToDrops(withdrawRow.BalanceReal)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		return nil
+	}
+	if hotWallet.Balance < drops+hotWallet.Fee {
+		// 金额不够
+		mcommon.Log.Errorf("xrp hot balance limit")
+		return nil
+	}
+	// 检测接收账户 避免交易上链失败扣除手续费
+	rpcToAccount, err := xrpclient.RpcAccountInfo(withdrawRow.ToAddress, xrpclient.LedgerValidated)
+	if err != nil {
+		return err
+	}
+	if rpcToAccount == nil && drops < hotWallet.ReserveBase {
+		// 未激活的账户 转入金额需要不低于保留金额
+		mcommon.Log.Errorf("xrp withdraw %d less than reserve to unfunded account", withdrawRow.ID)
+		return nil
+	}
+	if rpcToAccount != nil && rpcToAccount.Flags&lsfRequireDestTag != 0 && destinationTag == nil {
+		mcommon.Log.Errorf("xrp withdraw %d destination tag required", withdrawRow.ID)
+		return nil
+	}
+	txBlob, txHash, err := SignPayment(
+		&StPayment{
+			Account:            hotWallet.Address,
+			Destination:        withdrawRow.ToAddress,
+			DestinationTag:     destinationTag,
+			Amount:             drops,
+			Fee:                hotWallet.Fee,
+			Sequence:           uint32(hotWallet.Sequence),
+			LastLedgerSequence: uint32(hotWallet.LastLedgerSequence),
+		},
+		hotWallet.Key,
+	)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	_, err = app.SQLUpdateTWithdrawGenTx(
+		context.Background(),
+		dbTx,
+		&model.DBTWithdraw{
+			ID:           withdrawID,
+			TxHash:       txHash,
+			HandleStatus: app.WithdrawStatusHex,
+			HandleMsg:    "hex",
+			HandleTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = model.SQLCreateTSendXrp(
+		context.Background(),
+		dbTx,
+		&model.DBTSendXrp{
+			WithdrawID:         withdrawID,
+			TxHash:             txHash,
+			FromAddress:        hotWallet.Address,
+			ToAddress:          withdrawRow.ToAddress,
+			Memo:               withdrawRow.Memo,
+			BalanceReal:        DropsToXrpStr(drops),
+			Sequence:           hotWallet.Sequence,
+			LastLedgerSequence: hotWallet.LastLedgerSequence,
+			Hex:                txBlob,
+			CreateTime:         now,
+			HandleStatus:       app.SendStatusInit,
+			HandleMsg:          "",
+			HandleAt:           now,
+		},
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	// 处理完成
+	err = dbTx.Commit()
+	if err != nil {
+		return err
+	}
+	isComment = true
+	hotWallet.Balance -= drops + hotWallet.Fee
+	hotWallet.Sequence++
+	return nil
+}
+
+// genWithdrawNotifyRow 生成提币通知
+func genWithdrawNotifyRow(withdrawRow *model.DBTWithdraw, productRow *model.DBTProduct, txHash string, notifyType int64, now int64) (*model.DBTProductNotify, error) {
+	reqObj := gin.H{
+		"tx_hash":     txHash,
+		"balance":     withdrawRow.BalanceReal,
+		"app_name":    productRow.AppName,
+		"out_serial":  withdrawRow.OutSerial,
+		"address":     withdrawRow.ToAddress,
+		"symbol":      withdrawRow.Symbol,
+		"notify_type": notifyType,
+	}
+	reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
+	req, err := json.Marshal(reqObj)
+	if err != nil {
+		return nil, err
+	}
+	return &model.DBTProductNotify{
+		Nonce:        mcommon.GetUUIDStr(),
+		ProductID:    withdrawRow.ProductID,
+		ItemType:     app.SendRelationTypeWithdraw,
+		ItemID:       withdrawRow.ID,
+		NotifyType:   notifyType,
+		TokenSymbol:  withdrawRow.Symbol,
+		URL:          productRow.CbURL,
+		Msg:          string(req),
+		HandleStatus: app.NotifyStatusInit,
+		HandleMsg:    "",
+		CreateTime:   now,
+		UpdateTime:   now,
+	}, nil
+}
+
+// getWithdrawAndProductMap 获取发送数据关联的提币和产品
+func getWithdrawAndProductMap(sendRows []*model.DBTSendXrp) (map[int64]*model.DBTWithdraw, map[int64]*model.DBTProduct, error) {
+	var withdrawIDs []int64
+	for _, sendRow := range sendRows {
+		if !mcommon.IsIntInSlice(withdrawIDs, sendRow.WithdrawID) {
+			withdrawIDs = append(withdrawIDs, sendRow.WithdrawID)
+		}
+	}
+	withdrawMap, err := app.SQLGetWithdrawMap(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawProductID,
+			model.DBColTWithdrawOutSerial,
+			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawSymbol,
+			model.DBColTWithdrawBalanceReal,
+		},
+		withdrawIDs,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	var productIDs []int64
+	for _, withdrawRow := range withdrawMap {
+		if !mcommon.IsIntInSlice(productIDs, withdrawRow.ProductID) {
+			productIDs = append(productIDs, withdrawRow.ProductID)
+		}
+	}
+	productMap, err := app.SQLGetProductMap(
+		context.Background(),
+		xenv.DbCon,
+		[]string{
+			model.DBColTProductID,
+			model.DBColTProductAppName,
+			model.DBColTProductCbURL,
+			model.DBColTProductAppSk,
+		},
+		productIDs,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return withdrawMap, productMap, nil
+}
+
+// StSendResult 发送或确认后需要更新状态的数据
+type StSendResult struct {
+	SendIDs     []int64
+	WithdrawIDs []int64
+	NotifyRows  []*model.DBTProductNotify
+}
+
+// add 记录数据
+func (r *StSendResult) add(sendRow *model.DBTSendXrp) {
+	if !mcommon.IsIntInSlice(r.SendIDs, sendRow.ID) {
+		r.SendIDs = append(r.SendIDs, sendRow.ID)
+	}
+	if !mcommon.IsIntInSlice(r.WithdrawIDs, sendRow.WithdrawID) {
+		r.WithdrawIDs = append(r.WithdrawIDs, sendRow.WithdrawID)
+	}
+}
+
+// addNotify 记录数据并生成提币通知
+func (r *StSendResult) addNotify(sendRow *model.DBTSendXrp, withdrawMap map[int64]*model.DBTWithdraw, productMap map[int64]*model.DBTProduct, notifyType int64, now int64) error {
+	r.add(sendRow)
+	withdrawRow, ok := withdrawMap[sendRow.WithdrawID]
+	if !ok {
+		mcommon.Log.Errorf("withdrawMap no: %d", sendRow.WithdrawID)
+		return nil
+	}
+	productRow, ok := productMap[withdrawRow.ProductID]
+	if !ok {
+		mcommon.Log.Errorf("productMap no: %d", withdrawRow.ProductID)
+		return nil
+	}
+	notifyRow, err := genWithdrawNotifyRow(withdrawRow, productRow, sendRow.TxHash, notifyType, now)
+	if err != nil {
+		return err
+	}
+	r.NotifyRows = append(r.NotifyRows, notifyRow)
+	return nil
+}
+
+// save 更新状态
+func (r *StSendResult) save(withdrawStatus, sendStatus int64, msg string, now int64) error {
+	// 插入通知
+	_, err := model.SQLCreateManyTProductNotify(
+		context.Background(),
+		xenv.DbCon,
+		r.NotifyRows,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	// 更新提币状态
+	_, err = app.SQLUpdateTWithdrawStatusByIDs(
+		context.Background(),
+		xenv.DbCon,
+		r.WithdrawIDs,
+		&model.DBTWithdraw{
+			HandleStatus: withdrawStatus,
+			HandleMsg:    msg,
+			HandleTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	// 更新发送状态
+	_, err = app.SQLUpdateTSendXrpStatusByIDs(
+		context.Background(),
+		xenv.DbCon,
+		r.SendIDs,
+		model.DBTSendXrp{
+			HandleStatus: sendStatus,
+			HandleMsg:    msg,
+			HandleAt:     now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// saveExpired 超过 LastLedgerSequence 未上链的交易 提币重置为待处理 重新生成交易
+func (r *StSendResult) saveExpired(now int64) error {
+	return r.save(
+		app.WithdrawStatusInit,
+		app.SendStatusExpired,
+		"expired",
+		now,
+	)
+}
+
+// isSubmitOk 发送结果是否为已接受
+// tec 开头的结果已扣除手续费并占用序号, 最终结果在确认时处理
+func isSubmitOk(engineResult string) bool {
+	if strings.HasPrefix(engineResult, "tes") || strings.HasPrefix(engineResult, "tec") {
+		return true
+	}
+	switch engineResult {
+	case "terQUEUED", "terPRE_SEQ", "tefALREADY", "tefPAST_SEQ":
+		// 进入队列 等待前序交易 已经发送
+		return true
+	}
+	return false
+}
+
+// getTx 在交易的有效范围内查询交易
+func getTx(sendRow *model.DBTSendXrp) (*xrpclient.StTransaction, bool, error) {
+	return xrpclient.RpcTx(
+		sendRow.TxHash,
+		sendRow.LastLedgerSequence-LastLedgerOffset,
+		sendRow.LastLedgerSequence,
+	)
+}
+
+// CheckRawTxSend 发送交易
+func CheckRawTxSend() {
+	lockKey := "XrpCheckRawTxSend"
+	app.LockWrap(lockKey, func() {
+		// 获取待发送的数据
+		sendRows, err := app.SQLSelectTSendXrpColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTSendXrpID,
+				model.DBColTSendXrpTxHash,
+				model.DBColTSendXrpHex,
+				model.DBColTSendXrpWithdrawID,
+				model.DBColTSendXrpLastLedgerSequence,
+			},
+			app.SendStatusInit,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(sendRows) == 0 {
+			return
+		}
+		withdrawMap, productMap, err := getWithdrawAndProductMap(sendRows)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 先获取ledger再查询交易 避免交易在两次查询之间上链被误判为过期
+		rpcLedgerIndex, err := xrpclient.RpcLedgerIndex(xrpclient.LedgerValidated)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		now := time.Now().Unix()
+		var result StSendResult
+		var expiredResult StSendResult
+		for _, sendRow := range sendRows {
+			// 判定是否已经发送过
+			rpcTx, searchedAll, err := getTx(sendRow)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			if rpcTx == nil && rpcLedgerIndex > sendRow.LastLedgerSequence {
+				if searchedAll {
+					// 已过期 需要重新生成交易
+					expiredResult.add(sendRow)
+				} else {
+					mcommon.Log.Errorf("xrp node missing ledgers of tx: %s", sendRow.TxHash)
+				}
+				continue
+			}
+			if rpcTx == nil {
+				rpcResult, err := xrpclient.RpcSubmit(sendRow.Hex)
+				if err != nil {
+					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					continue
+				}
+				if !isSubmitOk(rpcResult.EngineResult) {
+					mcommon.Log.Errorf("xrp submit %s err: %s %s", sendRow.TxHash, rpcResult.EngineResult, rpcResult.EngineResultMessage)
+					continue
+				}
+			}
+			err = result.addNotify(sendRow, withdrawMap, productMap, app.NotifyTypeWithdrawSend, now)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		err = result.save(
+			app.WithdrawStatusSend,
+			app.SendStatusSend,
+			"send",
+			now,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		err = expiredResult.saveExpired(now)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
+
+// CheckRawTxConfirm 确认tx是否已在validated ledger中
+func CheckRawTxConfirm() {
+	lockKey := "XrpCheckRawTxConfirm"
+	app.LockWrap(lockKey, func() {
+		sendRows, err := app.SQLSelectTSendXrpColByStatus(
+			context.Background(),
+			xenv.DbCon,
+			[]string{
+				model.DBColTSendXrpID,
+				model.DBColTSendXrpTxHash,
+				model.DBColTSendXrpWithdrawID,
+				model.DBColTSendXrpLastLedgerSequence,
+			},
+			app.SendStatusSend,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(sendRows) == 0 {
+			return
+		}
+		withdrawMap, productMap, err := getWithdrawAndProductMap(sendRows)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 先获取ledger再查询交易 避免交易在两次查询之间上链被误判为过期
+		rpcLedgerIndex, err := xrpclient.RpcLedgerIndex(xrpclient.LedgerValidated)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		now := time.Now().Unix()
+		var result StSendResult
+		var failedResult StSendResult
+		var expiredResult StSendResult
+		for _, sendRow := range sendRows {
+			rpcTx, searchedAll, err := getTx(sendRow)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			if rpcTx == nil {
+				if rpcLedgerIndex > sendRow.LastLedgerSequence && searchedAll {
+					// 交易未上链且已过期 需要重新生成交易
+					expiredResult.add(sendRow)
+				}
+				continue
+			}
+			if !rpcTx.Validated {
+				continue
+			}
+			// 记录所在ledger和实际手续费 供提币查询使用
+			feeDrops, err := strconv.ParseInt(rpcTx.Fee, 10, 64)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				continue
+			}
+			_, err = app.SQLUpdateTSendXrpBlockNumFeeByTxHash(
+				context.Background(),
+				xenv.DbCon,
+				model.DBTSendXrp{
+					TxHash:   sendRow.TxHash,
+					BlockNum: rpcTx.LedgerIndex,
+					Fee:      DropsToXrpStr(feeDrops),
+				},
+			)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+			meta := rpcTx.GetMeta()
+			if meta == nil || meta.TransactionResult != "tesSUCCESS" {
+				// 交易执行失败 已扣除手续费 需要人工处理
+				rpcResult := ""
+				if meta != nil {
+					rpcResult = meta.TransactionResult
+				}
+				mcommon.Log.Errorf("xrp tx %s failed: %s", sendRow.TxHash, rpcResult)
+				failedResult.add(sendRow)
+				continue
+			}
+			err = result.addNotify(sendRow, withdrawMap, productMap, app.NotifyTypeWithdrawConfirm, now)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		err = result.save(
+			app.WithdrawStatusConfirm,
+			app.SendStatusConfirm,
+			"confirmed",
+			now,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		// 失败的交易已占用序号 不再阻塞后续提币, 提币保持已发送状态
+		err = failedResult.save(
+			app.WithdrawStatusSend,
+			app.SendStatusConfirm,
+			"failed",
+			now,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+		err = expiredResult.saveExpired(now)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
@@ -32,7 +32,26 @@ CREATE TABLE `t_address_key` (
   `use_tag` int(11) NOT NULL DEFAULT '0' COMMENT '占用标志 -1 作为热钱包占用\n0 未占用\n>0 作为用户冲币地址占用',
   PRIMARY KEY (`id`),
   UNIQUE KEY `id` (`id`),
-  UNIQUE KEY `t_address_key_address_idx` (`address`,`symbol`) USING BTREE
+  UNIQUE KEY `t_address_key_address_idx` (`address`,`symbol`) USING BTREE,
+  KEY `t_address_key_use_tag_idx` (`use_tag`,`symbol`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_address_user
+# ------------------------------------------------------------
+
+CREATE TABLE `t_address_user` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `product_id` int(11) NOT NULL COMMENT '产品id',
+  `user_id` varchar(64) NOT NULL COMMENT '产品用户id',
+  `symbol` varchar(128) NOT NULL COMMENT '币种',
+  `address` varchar(128) NOT NULL COMMENT '地址 eos wax tlos 为memo xrp 为destination tag',
+  `label` varchar(128) NOT NULL DEFAULT '' COMMENT '标签',
+  `create_time` bigint(20) NOT NULL COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `t_address_user_user_idx` (`product_id`,`user_id`,`symbol`) USING BTREE,
+  KEY `t_address_user_address_idx` (`address`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
@@ -68,6 +87,7 @@ CREATE TABLE `t_app_config_str` (
 
 CREATE TABLE `t_app_config_token` (
   `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `chain_symbol` varchar(128) NOT NULL DEFAULT 'eth' COMMENT '所在链',
   `token_address` varchar(128) NOT NULL DEFAULT '',
   `token_decimals` int(11) unsigned NOT NULL,
   `token_symbol` varchar(128) NOT NULL,
@@ -76,7 +96,7 @@ CREATE TABLE `t_app_config_token` (
   `org_min_balance` varchar(128) NOT NULL DEFAULT '0',
   `create_time` bigint(20) unsigned NOT NULL,
   PRIMARY KEY (`id`),
-  UNIQUE KEY `token_address` (`token_address`)
+  UNIQUE KEY `token_address` (`chain_symbol`,`token_address`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
@@ -99,6 +119,60 @@ CREATE TABLE `t_app_config_token_btc` (
 
 
 
+# Dump of table t_app_config_token_eos
+# ------------------------------------------------------------
+
+CREATE TABLE `t_app_config_token_eos` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `chain_symbol` varchar(128) NOT NULL DEFAULT 'eos' COMMENT '所在链',
+  `token_address` varchar(128) NOT NULL DEFAULT '' COMMENT '合约账户',
+  `token_symbol` varchar(128) NOT NULL DEFAULT '' COMMENT '代币符号',
+  `token_decimals` int(11) unsigned NOT NULL COMMENT '精度',
+  `create_at` bigint(20) unsigned NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `token_symbol` (`chain_symbol`,`token_symbol`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_app_config_token_sol
+# ------------------------------------------------------------
+
+CREATE TABLE `t_app_config_token_sol` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `token_address` varchar(128) NOT NULL DEFAULT '' COMMENT 'spl代币mint地址',
+  `token_decimals` int(11) unsigned NOT NULL COMMENT '精度',
+  `token_symbol` varchar(128) NOT NULL COMMENT '代币符号',
+  `cold_address` varchar(128) NOT NULL DEFAULT '' COMMENT '冷钱包地址',
+  `hot_address` varchar(128) NOT NULL DEFAULT '' COMMENT '热钱包地址',
+  `org_min_balance` varchar(128) NOT NULL DEFAULT '0' COMMENT '最小整理金额',
+  `create_time` bigint(20) unsigned NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `token_address` (`token_address`),
+  UNIQUE KEY `token_symbol` (`token_symbol`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_app_config_token_trx
+# ------------------------------------------------------------
+
+CREATE TABLE `t_app_config_token_trx` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `token_address` varchar(128) NOT NULL DEFAULT '' COMMENT 'trc20合约地址',
+  `token_decimals` int(11) unsigned NOT NULL COMMENT '精度',
+  `token_symbol` varchar(128) NOT NULL COMMENT '代币符号',
+  `cold_address` varchar(128) NOT NULL DEFAULT '' COMMENT '冷钱包地址',
+  `hot_address` varchar(128) NOT NULL DEFAULT '' COMMENT '热钱包地址',
+  `org_min_balance` varchar(128) NOT NULL DEFAULT '0' COMMENT '最小整理金额',
+  `create_time` bigint(20) unsigned NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `token_address` (`token_address`),
+  UNIQUE KEY `token_symbol` (`token_symbol`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
 # Dump of table t_app_lock
 # ------------------------------------------------------------
 
@@ -183,6 +257,7 @@ CREATE TABLE `t_product_notify` (
 
 CREATE TABLE `t_send` (
   `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `chain_symbol` varchar(128) NOT NULL DEFAULT 'eth' COMMENT '所在链',
   `related_type` tinyint(4) NOT NULL COMMENT '关联类型 1 零钱整理 2 提币',
   `related_id` int(11) unsigned NOT NULL COMMENT '关联id',
   `token_id` int(11) unsigned NOT NULL,
@@ -211,6 +286,7 @@ CREATE TABLE `t_send` (
 
 CREATE TABLE `t_send_btc` (
   `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `symbol` varchar(128) NOT NULL DEFAULT 'btc' COMMENT '币种',
   `related_type` tinyint(4) NOT NULL COMMENT '关联类型 1 零钱整理 2 提币',
   `related_id` int(11) unsigned NOT NULL COMMENT '关联id',
   `token_id` int(11) unsigned NOT NULL,
@@ -220,6 +296,7 @@ CREATE TABLE `t_send_btc` (
   `balance_real` varchar(128) NOT NULL COMMENT '打币金额 Ether',
   `gas` bigint(20) NOT NULL COMMENT 'gas消耗',
   `gas_price` bigint(20) NOT NULL COMMENT 'gasPrice',
+  `block_num` bigint(20) NOT NULL DEFAULT '0' COMMENT '所在块高',
   `hex` mediumtext NOT NULL COMMENT 'tx raw hex',
   `create_time` bigint(20) NOT NULL COMMENT '创建时间',
   `handle_status` tinyint(4) NOT NULL COMMENT '处理状态',
@@ -239,19 +316,105 @@ CREATE TABLE `t_send_btc` (
 CREATE TABLE `t_send_eos` (
   `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
   `withdraw_id` int(11) unsigned NOT NULL COMMENT '关联id',
+  `symbol` varchar(128) NOT NULL DEFAULT 'eos' COMMENT '币种',
   `tx_hash` varchar(128) NOT NULL DEFAULT '' COMMENT 'tx hash',
   `log_index` int(11) unsigned NOT NULL DEFAULT '0' COMMENT 'log_index',
   `from_address` varchar(128) NOT NULL DEFAULT '' COMMENT '打币地址',
   `to_address` varchar(128) NOT NULL COMMENT '收币地址',
   `memo` varchar(256) NOT NULL COMMENT '收币地址',
   `balance_real` varchar(128) NOT NULL COMMENT '打币金额 Ether',
+  `block_num` bigint(20) NOT NULL DEFAULT '0' COMMENT '所在块高',
   `hex` varchar(2048) NOT NULL COMMENT 'tx raw hex',
   `create_time` bigint(20) NOT NULL COMMENT '创建时间',
   `handle_status` tinyint(4) NOT NULL COMMENT '处理状态',
   `handle_msg` varchar(1024) NOT NULL DEFAULT '' COMMENT '处理消息',
   `handle_at` bigint(20) NOT NULL COMMENT '处理时间',
   PRIMARY KEY (`id`),
-  UNIQUE KEY `withdraw_id` (`withdraw_id`) USING BTREE,
+  UNIQUE KEY `withdraw_id` (`withdraw_id`,`tx_hash`) USING BTREE,
+  KEY `tx_hash` (`tx_hash`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_send_sol
+# ------------------------------------------------------------
+
+CREATE TABLE `t_send_sol` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `related_type` tinyint(4) NOT NULL COMMENT '关联类型 1 零钱整理 2 提币',
+  `related_id` int(11) unsigned NOT NULL COMMENT '关联id',
+  `token_id` int(11) unsigned NOT NULL COMMENT '代币id 0为sol',
+  `tx_id` varchar(128) NOT NULL DEFAULT '' COMMENT '交易签名',
+  `from_address` varchar(128) NOT NULL DEFAULT '' COMMENT '打币地址',
+  `to_address` varchar(128) NOT NULL COMMENT '收币地址',
+  `balance_real` varchar(128) NOT NULL COMMENT '打币金额',
+  `last_valid_height` bigint(20) NOT NULL DEFAULT '0' COMMENT 'blockhash最后有效的块高度',
+  `block_num` bigint(20) NOT NULL DEFAULT '0' COMMENT '所在slot',
+  `fee` varchar(128) NOT NULL DEFAULT '0' COMMENT '实际手续费',
+  `hex` varchar(2048) NOT NULL COMMENT 'tx raw base64',
+  `create_time` bigint(20) NOT NULL COMMENT '创建时间',
+  `handle_status` tinyint(4) NOT NULL COMMENT '处理状态',
+  `handle_msg` varchar(1024) NOT NULL DEFAULT '' COMMENT '处理消息',
+  `handle_time` bigint(20) NOT NULL COMMENT '处理时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `related_id` (`related_id`,`related_type`,`tx_id`) USING BTREE,
+  KEY `tx_id` (`tx_id`) USING BTREE,
+  KEY `t_send_sol_from_address_idx` (`from_address`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_send_trx
+# ------------------------------------------------------------
+
+CREATE TABLE `t_send_trx` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `related_type` tinyint(4) NOT NULL COMMENT '关联类型 1 零钱整理 2 提币',
+  `related_id` int(11) unsigned NOT NULL COMMENT '关联id',
+  `token_id` int(11) unsigned NOT NULL COMMENT '代币id 0为trx',
+  `tx_id` varchar(128) NOT NULL DEFAULT '' COMMENT 'tx hash',
+  `from_address` varchar(128) NOT NULL DEFAULT '' COMMENT '打币地址',
+  `to_address` varchar(128) NOT NULL COMMENT '收币地址',
+  `balance_real` varchar(128) NOT NULL COMMENT '打币金额',
+  `fee_limit` bigint(20) NOT NULL DEFAULT '0' COMMENT '合约调用最多消耗的trx sun',
+  `expiration` bigint(20) NOT NULL DEFAULT '0' COMMENT '交易过期时间 毫秒',
+  `block_num` bigint(20) NOT NULL DEFAULT '0' COMMENT '所在块高',
+  `fee` varchar(128) NOT NULL DEFAULT '0' COMMENT '实际手续费',
+  `hex` varchar(2048) NOT NULL COMMENT 'tx raw hex',
+  `create_time` bigint(20) NOT NULL COMMENT '创建时间',
+  `handle_status` tinyint(4) NOT NULL COMMENT '处理状态',
+  `handle_msg` varchar(1024) NOT NULL DEFAULT '' COMMENT '处理消息',
+  `handle_time` bigint(20) NOT NULL COMMENT '处理时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `related_id` (`related_id`,`related_type`,`tx_id`) USING BTREE,
+  KEY `tx_id` (`tx_id`) USING BTREE,
+  KEY `t_send_trx_from_address_idx` (`from_address`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_send_xrp
+# ------------------------------------------------------------
+
+CREATE TABLE `t_send_xrp` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `withdraw_id` int(11) unsigned NOT NULL COMMENT '关联id',
+  `tx_hash` varchar(128) NOT NULL DEFAULT '' COMMENT 'tx hash',
+  `from_address` varchar(128) NOT NULL DEFAULT '' COMMENT '打币地址',
+  `to_address` varchar(128) NOT NULL COMMENT '收币地址',
+  `memo` varchar(64) NOT NULL DEFAULT '' COMMENT 'destination tag',
+  `balance_real` varchar(128) NOT NULL COMMENT '打币金额',
+  `sequence` bigint(20) NOT NULL DEFAULT '0' COMMENT '账户序号',
+  `last_ledger_sequence` bigint(20) NOT NULL DEFAULT '0' COMMENT '交易最后有效的ledger',
+  `block_num` bigint(20) NOT NULL DEFAULT '0' COMMENT '所在ledger',
+  `fee` varchar(128) NOT NULL DEFAULT '0' COMMENT '实际手续费',
+  `hex` varchar(2048) NOT NULL COMMENT 'tx blob',
+  `create_time` bigint(20) NOT NULL COMMENT '创建时间',
+  `handle_status` tinyint(4) NOT NULL COMMENT '处理状态',
+  `handle_msg` varchar(1024) NOT NULL DEFAULT '' COMMENT '处理消息',
+  `handle_at` bigint(20) NOT NULL COMMENT '处理时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `withdraw_id` (`withdraw_id`,`tx_hash`) USING BTREE,
   KEY `tx_hash` (`tx_hash`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
@@ -262,8 +425,10 @@ CREATE TABLE `t_send_eos` (
 
 CREATE TABLE `t_tx` (
   `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `chain_symbol` varchar(128) NOT NULL DEFAULT 'eth' COMMENT '所在链',
   `product_id` int(11) unsigned NOT NULL,
   `tx_id` varchar(128) NOT NULL DEFAULT '' COMMENT '交易id',
+  `block_num` bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '所在块高',
   `from_address` varchar(128) NOT NULL DEFAULT '' COMMENT '来源地址',
   `to_address` varchar(128) NOT NULL DEFAULT '' COMMENT '目标地址',
   `balance_real` varchar(128) NOT NULL COMMENT '到账金额Ether',
@@ -275,8 +440,9 @@ CREATE TABLE `t_tx` (
   `org_msg` varchar(128) NOT NULL COMMENT '零钱整理消息',
   `org_time` bigint(20) unsigned NOT NULL COMMENT '零钱整理时间',
   PRIMARY KEY (`id`),
-  UNIQUE KEY `tx_id` (`tx_id`),
-  KEY `t_tx_org_status_idx` (`org_status`) USING BTREE
+  UNIQUE KEY `tx_id` (`chain_symbol`,`tx_id`),
+  KEY `t_tx_org_status_idx` (`org_status`) USING BTREE,
+  KEY `t_tx_product_id_create_time_idx` (`product_id`,`create_time`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
@@ -286,8 +452,10 @@ CREATE TABLE `t_tx` (
 
 CREATE TABLE `t_tx_btc` (
   `id` bigint(22) unsigned NOT NULL AUTO_INCREMENT,
+  `symbol` varchar(128) NOT NULL DEFAULT 'btc' COMMENT '币种',
   `product_id` bigint(22) unsigned NOT NULL,
   `block_hash` varchar(128) NOT NULL DEFAULT '',
+  `block_num` bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '所在块高',
   `tx_id` varchar(128) NOT NULL DEFAULT '',
   `vout_n` int(11) NOT NULL,
   `vout_address` varchar(128) NOT NULL DEFAULT '',
@@ -297,7 +465,30 @@ CREATE TABLE `t_tx_btc` (
   `handle_msg` varchar(128) NOT NULL DEFAULT '',
   `handle_time` bigint(22) unsigned NOT NULL,
   PRIMARY KEY (`id`),
-  UNIQUE KEY `tx_id` (`tx_id`,`vout_n`)
+  UNIQUE KEY `tx_id` (`tx_id`,`vout_n`),
+  KEY `t_tx_btc_product_id_create_time_idx` (`product_id`,`create_time`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_tx_btc_mempool
+# ------------------------------------------------------------
+
+CREATE TABLE `t_tx_btc_mempool` (
+  `id` bigint(22) unsigned NOT NULL AUTO_INCREMENT,
+  `symbol` varchar(128) NOT NULL DEFAULT 'btc' COMMENT '币种',
+  `product_id` bigint(22) unsigned NOT NULL,
+  `tx_id` varchar(128) NOT NULL DEFAULT '',
+  `vout_n` int(11) NOT NULL,
+  `vout_address` varchar(128) NOT NULL DEFAULT '',
+  `vout_value` varchar(128) NOT NULL DEFAULT '',
+  `create_time` bigint(22) unsigned NOT NULL,
+  `handle_status` tinyint(4) NOT NULL COMMENT '0 未通知\n1 已通知\n2 已打包\n3 已丢弃',
+  `handle_msg` varchar(128) NOT NULL DEFAULT '',
+  `handle_time` bigint(22) unsigned NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `tx_id` (`tx_id`,`vout_n`),
+  KEY `handle_status` (`symbol`,`handle_status`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
@@ -311,6 +502,7 @@ CREATE TABLE `t_tx_btc_token` (
   `token_index` int(11) NOT NULL,
   `token_symbol` varchar(128) NOT NULL,
   `block_hash` varchar(128) NOT NULL DEFAULT '',
+  `block_num` bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '所在块高',
   `tx_id` varchar(128) NOT NULL DEFAULT '',
   `from_address` varchar(128) NOT NULL DEFAULT '',
   `to_address` varchar(128) NOT NULL DEFAULT '',
@@ -325,7 +517,8 @@ CREATE TABLE `t_tx_btc_token` (
   `org_at` bigint(22) unsigned NOT NULL,
   PRIMARY KEY (`id`),
   UNIQUE KEY `tx_id` (`tx_id`),
-  KEY `t_tx_btc_token_org_status_idx` (`org_status`) USING BTREE
+  KEY `t_tx_btc_token_org_status_idx` (`org_status`) USING BTREE,
+  KEY `t_tx_btc_token_product_id_create_at_idx` (`product_id`,`create_at`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
@@ -335,6 +528,7 @@ CREATE TABLE `t_tx_btc_token` (
 
 CREATE TABLE `t_tx_btc_uxto` (
   `id` bigint(22) unsigned NOT NULL AUTO_INCREMENT,
+  `symbol` varchar(128) NOT NULL DEFAULT 'btc' COMMENT '币种',
   `uxto_type` tinyint(4) NOT NULL,
   `block_hash` varchar(128) NOT NULL DEFAULT '',
   `tx_id` varchar(128) NOT NULL DEFAULT '',
@@ -362,6 +556,7 @@ CREATE TABLE `t_tx_btc_uxto` (
 CREATE TABLE `t_tx_eos` (
   `id` bigint(22) unsigned NOT NULL AUTO_INCREMENT,
   `product_id` bigint(22) unsigned NOT NULL,
+  `symbol` varchar(128) NOT NULL DEFAULT 'eos',
   `tx_hash` varchar(128) NOT NULL DEFAULT '',
   `log_index` bigint(20) unsigned NOT NULL,
   `from_address` varchar(128) NOT NULL DEFAULT '',
@@ -372,8 +567,12 @@ CREATE TABLE `t_tx_eos` (
   `handle_status` tinyint(4) NOT NULL,
   `handle_msg` varchar(128) NOT NULL DEFAULT '',
   `handle_at` bigint(22) unsigned NOT NULL,
+  `block_num` bigint(20) unsigned NOT NULL DEFAULT '0',
+  `block_id` varchar(128) NOT NULL DEFAULT '',
   PRIMARY KEY (`id`),
-  UNIQUE KEY `tx_hash` (`tx_hash`,`log_index`)
+  UNIQUE KEY `tx_hash` (`tx_hash`,`log_index`),
+  KEY `block_num` (`block_num`),
+  KEY `t_tx_eos_product_id_create_at_idx` (`product_id`,`create_at`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
@@ -383,9 +582,11 @@ CREATE TABLE `t_tx_eos` (
 
 CREATE TABLE `t_tx_erc20` (
   `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `chain_symbol` varchar(128) NOT NULL DEFAULT 'eth' COMMENT '所在链',
   `token_id` int(11) unsigned NOT NULL,
   `product_id` int(11) unsigned NOT NULL,
   `tx_id` varchar(128) NOT NULL DEFAULT '' COMMENT '交易id',
+  `block_num` bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '所在块高',
   `from_address` varchar(128) NOT NULL DEFAULT '' COMMENT '来源地址',
   `to_address` varchar(128) NOT NULL DEFAULT '' COMMENT '目标地址',
   `balance_real` varchar(128) NOT NULL COMMENT '到账金额Ether',
@@ -397,8 +598,90 @@ CREATE TABLE `t_tx_erc20` (
   `org_msg` varchar(128) NOT NULL COMMENT '零钱整理消息',
   `org_time` bigint(20) unsigned NOT NULL COMMENT '零钱整理时间',
   PRIMARY KEY (`id`),
-  UNIQUE KEY `tx_id` (`tx_id`),
-  KEY `t_tx_erc20_org_status_idx` (`org_status`) USING BTREE
+  UNIQUE KEY `tx_id` (`chain_symbol`,`tx_id`),
+  KEY `t_tx_erc20_org_status_idx` (`org_status`) USING BTREE,
+  KEY `t_tx_erc20_product_id_create_time_idx` (`product_id`,`create_time`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_tx_sol
+# ------------------------------------------------------------
+
+CREATE TABLE `t_tx_sol` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `token_id` int(11) unsigned NOT NULL COMMENT '代币id 0为sol',
+  `product_id` int(11) unsigned NOT NULL,
+  `tx_id` varchar(128) NOT NULL DEFAULT '' COMMENT '交易签名',
+  `block_num` bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '所在slot',
+  `ix_index` int(11) NOT NULL DEFAULT '0' COMMENT '转账指令序号',
+  `from_address` varchar(128) NOT NULL DEFAULT '' COMMENT '来源地址',
+  `to_address` varchar(128) NOT NULL DEFAULT '' COMMENT '目标地址',
+  `token_account` varchar(128) NOT NULL DEFAULT '' COMMENT '接收代币的账户 sol为空',
+  `balance_real` varchar(128) NOT NULL COMMENT '到账金额',
+  `create_time` bigint(20) unsigned NOT NULL COMMENT '创建时间戳',
+  `handle_status` tinyint(4) NOT NULL COMMENT '处理状态',
+  `handle_msg` varchar(128) NOT NULL DEFAULT '' COMMENT '处理消息',
+  `handle_time` bigint(20) unsigned NOT NULL COMMENT '处理时间戳',
+  `org_status` tinyint(4) NOT NULL COMMENT '零钱整理状态',
+  `org_msg` varchar(128) NOT NULL COMMENT '零钱整理消息',
+  `org_time` bigint(20) unsigned NOT NULL COMMENT '零钱整理时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `tx_id` (`tx_id`,`ix_index`),
+  KEY `t_tx_sol_org_status_idx` (`org_status`) USING BTREE,
+  KEY `t_tx_sol_product_id_create_time_idx` (`product_id`,`create_time`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_tx_trx
+# ------------------------------------------------------------
+
+CREATE TABLE `t_tx_trx` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `token_id` int(11) unsigned NOT NULL COMMENT '代币id 0为trx',
+  `product_id` int(11) unsigned NOT NULL,
+  `tx_id` varchar(128) NOT NULL DEFAULT '' COMMENT '交易id',
+  `block_num` bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '所在块高',
+  `log_index` int(11) NOT NULL DEFAULT '-1' COMMENT 'trc20 log序号 trx为-1',
+  `from_address` varchar(128) NOT NULL DEFAULT '' COMMENT '来源地址',
+  `to_address` varchar(128) NOT NULL DEFAULT '' COMMENT '目标地址',
+  `balance_real` varchar(128) NOT NULL COMMENT '到账金额',
+  `create_time` bigint(20) unsigned NOT NULL COMMENT '创建时间戳',
+  `handle_status` tinyint(4) NOT NULL COMMENT '处理状态',
+  `handle_msg` varchar(128) NOT NULL DEFAULT '' COMMENT '处理消息',
+  `handle_time` bigint(20) unsigned NOT NULL COMMENT '处理时间戳',
+  `org_status` tinyint(4) NOT NULL COMMENT '零钱整理状态',
+  `org_msg` varchar(128) NOT NULL COMMENT '零钱整理消息',
+  `org_time` bigint(20) unsigned NOT NULL COMMENT '零钱整理时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `tx_id` (`tx_id`,`log_index`),
+  KEY `t_tx_trx_org_status_idx` (`org_status`) USING BTREE,
+  KEY `t_tx_trx_product_id_create_time_idx` (`product_id`,`create_time`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_tx_xrp
+# ------------------------------------------------------------
+
+CREATE TABLE `t_tx_xrp` (
+  `id` bigint(22) unsigned NOT NULL AUTO_INCREMENT,
+  `product_id` bigint(22) unsigned NOT NULL,
+  `tx_hash` varchar(128) NOT NULL DEFAULT '',
+  `ledger_index` bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '所在的validated ledger',
+  `from_address` varchar(128) NOT NULL DEFAULT '',
+  `to_address` varchar(128) NOT NULL,
+  `memo` varchar(64) NOT NULL DEFAULT '' COMMENT 'destination tag',
+  `balance_real` varchar(128) NOT NULL DEFAULT '' COMMENT '实际到账金额 delivered_amount',
+  `create_at` bigint(22) unsigned NOT NULL,
+  `handle_status` tinyint(4) NOT NULL,
+  `handle_msg` varchar(128) NOT NULL DEFAULT '',
+  `handle_at` bigint(22) unsigned NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `tx_hash` (`tx_hash`),
+  KEY `t_tx_xrp_handle_status_idx` (`handle_status`) USING BTREE,
+  KEY `t_tx_xrp_product_id_create_at_idx` (`product_id`,`create_at`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
@@ -422,7 +705,8 @@ CREATE TABLE `t_withdraw` (
   PRIMARY KEY (`id`),
   UNIQUE KEY `out_serial` (`out_serial`,`product_id`) USING BTREE,
   KEY `t_withdraw_tx_hash_idx` (`tx_hash`) USING BTREE,
-  KEY `t_withdraw_handle_status_symbol_idx` (`handle_status`,`symbol`) USING BTREE
+  KEY `t_withdraw_handle_status_symbol_idx` (`handle_status`,`symbol`) USING BTREE,
+  KEY `t_withdraw_product_id_create_time_idx` (`product_id`,`create_time`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
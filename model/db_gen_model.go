@@ -1,15 +1,19 @@
 package model
 
 // TableNames 所有表名
-var TableNames = []string{"t_address_key", "t_app_config_int", "t_app_config_str", "t_app_config_token", "t_app_config_token_btc", "t_app_lock", "t_app_status_int", "t_product", "t_product_nonce", "t_product_notify", "t_send", "t_send_btc", "t_send_eos", "t_tx", "t_tx_btc", "t_tx_btc_token", "t_tx_btc_uxto", "t_tx_eos", "t_tx_erc20", "t_withdraw"}
+var TableNames = []string{"t_address_key", "t_address_user", "t_app_config_int", "t_app_config_str", "t_app_config_token", "t_app_config_token_btc", "t_app_config_token_eos", "t_app_config_token_sol", "t_app_config_token_trx", "t_app_lock", "t_app_status_int", "t_product", "t_product_nonce", "t_product_notify", "t_send", "t_send_btc", "t_send_eos", "t_send_sol", "t_send_trx", "t_send_xrp", "t_tx", "t_tx_btc", "t_tx_btc_mempool", "t_tx_btc_token", "t_tx_btc_uxto", "t_tx_eos", "t_tx_erc20", "t_tx_sol", "t_tx_trx", "t_tx_xrp", "t_withdraw"}
 
 // 表名
 const (
 	DbTableTAddressKey        = "t_address_key"
+	DbTableTAddressUser       = "t_address_user"
 	DbTableTAppConfigInt      = "t_app_config_int"
 	DbTableTAppConfigStr      = "t_app_config_str"
 	DbTableTAppConfigToken    = "t_app_config_token"
 	DbTableTAppConfigTokenBtc = "t_app_config_token_btc"
+	DbTableTAppConfigTokenEos = "t_app_config_token_eos"
+	DbTableTAppConfigTokenSol = "t_app_config_token_sol"
+	DbTableTAppConfigTokenTrx = "t_app_config_token_trx"
 	DbTableTAppLock           = "t_app_lock"
 	DbTableTAppStatusInt      = "t_app_status_int"
 	DbTableTProduct           = "t_product"
@@ -18,12 +22,19 @@ const (
 	DbTableTSend              = "t_send"
 	DbTableTSendBtc           = "t_send_btc"
 	DbTableTSendEos           = "t_send_eos"
+	DbTableTSendSol           = "t_send_sol"
+	DbTableTSendTrx           = "t_send_trx"
+	DbTableTSendXrp           = "t_send_xrp"
 	DbTableTTx                = "t_tx"
 	DbTableTTxBtc             = "t_tx_btc"
+	DbTableTTxBtcMempool      = "t_tx_btc_mempool"
 	DbTableTTxBtcToken        = "t_tx_btc_token"
 	DbTableTTxBtcUxto         = "t_tx_btc_uxto"
 	DbTableTTxEos             = "t_tx_eos"
 	DbTableTTxErc20           = "t_tx_erc20"
+	DbTableTTxSol             = "t_tx_sol"
+	DbTableTTxTrx             = "t_tx_trx"
+	DbTableTTxXrp             = "t_tx_xrp"
 	DbTableTWithdraw          = "t_withdraw"
 )
 
@@ -73,6 +84,60 @@ type DBTAddressKey struct {
 	UseTag  int64  `db:"use_tag" json:"use_tag"` // 占用标志 -1 作为热钱包占用-0 未占用->0 作为用户冲币地址占用
 }
 
+// const TAddressUser full
+const (
+	DBColTAddressUserID         = "t_address_user.id"
+	DBColTAddressUserProductID  = "t_address_user.product_id"  // 产品id
+	DBColTAddressUserUserID     = "t_address_user.user_id"     // 产品用户id
+	DBColTAddressUserSymbol     = "t_address_user.symbol"      // 币种
+	DBColTAddressUserAddress    = "t_address_user.address"     // 地址 eos wax tlos 为memo xrp 为destination tag
+	DBColTAddressUserLabel      = "t_address_user.label"       // 标签
+	DBColTAddressUserCreateTime = "t_address_user.create_time" // 创建时间
+)
+
+// const TAddressUser short
+const (
+	DBColShortTAddressUserID         = "id"
+	DBColShortTAddressUserProductID  = "product_id"  // 产品id
+	DBColShortTAddressUserUserID     = "user_id"     // 产品用户id
+	DBColShortTAddressUserSymbol     = "symbol"      // 币种
+	DBColShortTAddressUserAddress    = "address"     // 地址 eos wax tlos 为memo xrp 为destination tag
+	DBColShortTAddressUserLabel      = "label"       // 标签
+	DBColShortTAddressUserCreateTime = "create_time" // 创建时间
+)
+
+// DBColTAddressUserAll 所有字段
+var DBColTAddressUserAll = []string{
+	"t_address_user.id",
+	"t_address_user.product_id",
+	"t_address_user.user_id",
+	"t_address_user.symbol",
+	"t_address_user.address",
+	"t_address_user.label",
+	"t_address_user.create_time",
+}
+
+// 表结构
+// DBTAddressUser t_address_user
+/*
+   id,
+   product_id,
+   user_id,
+   symbol,
+   address,
+   label,
+   create_time
+*/
+type DBTAddressUser struct {
+	ID         int64  `db:"id" json:"id"`
+	ProductID  int64  `db:"product_id" json:"product_id"`   // 产品id
+	UserID     string `db:"user_id" json:"user_id"`         // 产品用户id
+	Symbol     string `db:"symbol" json:"symbol"`           // 币种
+	Address    string `db:"address" json:"address"`         // 地址 eos wax tlos 为memo xrp 为destination tag
+	Label      string `db:"label" json:"label"`             // 标签
+	CreateTime int64  `db:"create_time" json:"create_time"` // 创建时间
+}
+
 // const TAppConfigInt full
 const (
 	DBColTAppConfigIntID = "t_app_config_int.id"
@@ -144,6 +209,7 @@ type DBTAppConfigStr struct {
 // const TAppConfigToken full
 const (
 	DBColTAppConfigTokenID            = "t_app_config_token.id"
+	DBColTAppConfigTokenChainSymbol   = "t_app_config_token.chain_symbol" // 所在链
 	DBColTAppConfigTokenTokenAddress  = "t_app_config_token.token_address"
 	DBColTAppConfigTokenTokenDecimals = "t_app_config_token.token_decimals"
 	DBColTAppConfigTokenTokenSymbol   = "t_app_config_token.token_symbol"
@@ -156,6 +222,7 @@ const (
 // const TAppConfigToken short
 const (
 	DBColShortTAppConfigTokenID            = "id"
+	DBColShortTAppConfigTokenChainSymbol   = "chain_symbol" // 所在链
 	DBColShortTAppConfigTokenTokenAddress  = "token_address"
 	DBColShortTAppConfigTokenTokenDecimals = "token_decimals"
 	DBColShortTAppConfigTokenTokenSymbol   = "token_symbol"
@@ -168,6 +235,7 @@ const (
 // DBColTAppConfigTokenAll 所有字段
 var DBColTAppConfigTokenAll = []string{
 	"t_app_config_token.id",
+	"t_app_config_token.chain_symbol",
 	"t_app_config_token.token_address",
 	"t_app_config_token.token_decimals",
 	"t_app_config_token.token_symbol",
@@ -181,6 +249,7 @@ var DBColTAppConfigTokenAll = []string{
 // DBTAppConfigToken t_app_config_token
 /*
    id,
+   chain_symbol,
    token_address,
    token_decimals,
    token_symbol,
@@ -191,6 +260,7 @@ var DBColTAppConfigTokenAll = []string{
 */
 type DBTAppConfigToken struct {
 	ID            int64  `db:"id" json:"id"`
+	ChainSymbol   string `db:"chain_symbol" json:"chain_symbol"` // 所在链
 	TokenAddress  string `db:"token_address" json:"token_address"`
 	TokenDecimals int64  `db:"token_decimals" json:"token_decimals"`
 	TokenSymbol   string `db:"token_symbol" json:"token_symbol"`
@@ -259,6 +329,173 @@ type DBTAppConfigTokenBtc struct {
 	CreateAt        int64  `db:"create_at" json:"create_at"`
 }
 
+// const TAppConfigTokenEos full
+const (
+	DBColTAppConfigTokenEosID            = "t_app_config_token_eos.id"
+	DBColTAppConfigTokenEosChainSymbol   = "t_app_config_token_eos.chain_symbol"   // 所在链
+	DBColTAppConfigTokenEosTokenAddress  = "t_app_config_token_eos.token_address"  // 合约账户
+	DBColTAppConfigTokenEosTokenSymbol   = "t_app_config_token_eos.token_symbol"   // 代币符号
+	DBColTAppConfigTokenEosTokenDecimals = "t_app_config_token_eos.token_decimals" // 精度
+	DBColTAppConfigTokenEosCreateAt      = "t_app_config_token_eos.create_at"
+)
+
+// const TAppConfigTokenEos short
+const (
+	DBColShortTAppConfigTokenEosID            = "id"
+	DBColShortTAppConfigTokenEosChainSymbol   = "chain_symbol"   // 所在链
+	DBColShortTAppConfigTokenEosTokenAddress  = "token_address"  // 合约账户
+	DBColShortTAppConfigTokenEosTokenSymbol   = "token_symbol"   // 代币符号
+	DBColShortTAppConfigTokenEosTokenDecimals = "token_decimals" // 精度
+	DBColShortTAppConfigTokenEosCreateAt      = "create_at"
+)
+
+// DBColTAppConfigTokenEosAll 所有字段
+var DBColTAppConfigTokenEosAll = []string{
+	"t_app_config_token_eos.id",
+	"t_app_config_token_eos.chain_symbol",
+	"t_app_config_token_eos.token_address",
+	"t_app_config_token_eos.token_symbol",
+	"t_app_config_token_eos.token_decimals",
+	"t_app_config_token_eos.create_at",
+}
+
+// 表结构
+// DBTAppConfigTokenEos t_app_config_token_eos
+/*
+   id,
+   chain_symbol,
+   token_address,
+   token_symbol,
+   token_decimals,
+   create_at
+*/
+type DBTAppConfigTokenEos struct {
+	ID            int64  `db:"id" json:"id"`
+	ChainSymbol   string `db:"chain_symbol" json:"chain_symbol"`     // 所在链
+	TokenAddress  string `db:"token_address" json:"token_address"`   // 合约账户
+	TokenSymbol   string `db:"token_symbol" json:"token_symbol"`     // 代币符号
+	TokenDecimals int64  `db:"token_decimals" json:"token_decimals"` // 精度
+	CreateAt      int64  `db:"create_at" json:"create_at"`
+}
+
+// const TAppConfigTokenSol full
+const (
+	DBColTAppConfigTokenSolID            = "t_app_config_token_sol.id"
+	DBColTAppConfigTokenSolTokenAddress  = "t_app_config_token_sol.token_address"   // spl代币mint地址
+	DBColTAppConfigTokenSolTokenDecimals = "t_app_config_token_sol.token_decimals"  // 精度
+	DBColTAppConfigTokenSolTokenSymbol   = "t_app_config_token_sol.token_symbol"    // 代币符号
+	DBColTAppConfigTokenSolColdAddress   = "t_app_config_token_sol.cold_address"    // 冷钱包地址
+	DBColTAppConfigTokenSolHotAddress    = "t_app_config_token_sol.hot_address"     // 热钱包地址
+	DBColTAppConfigTokenSolOrgMinBalance = "t_app_config_token_sol.org_min_balance" // 最小整理金额
+	DBColTAppConfigTokenSolCreateTime    = "t_app_config_token_sol.create_time"
+)
+
+// const TAppConfigTokenSol short
+const (
+	DBColShortTAppConfigTokenSolID            = "id"
+	DBColShortTAppConfigTokenSolTokenAddress  = "token_address"   // spl代币mint地址
+	DBColShortTAppConfigTokenSolTokenDecimals = "token_decimals"  // 精度
+	DBColShortTAppConfigTokenSolTokenSymbol   = "token_symbol"    // 代币符号
+	DBColShortTAppConfigTokenSolColdAddress   = "cold_address"    // 冷钱包地址
+	DBColShortTAppConfigTokenSolHotAddress    = "hot_address"     // 热钱包地址
+	DBColShortTAppConfigTokenSolOrgMinBalance = "org_min_balance" // 最小整理金额
+	DBColShortTAppConfigTokenSolCreateTime    = "create_time"
+)
+
+// DBColTAppConfigTokenSolAll 所有字段
+var DBColTAppConfigTokenSolAll = []string{
+	"t_app_config_token_sol.id",
+	"t_app_config_token_sol.token_address",
+	"t_app_config_token_sol.token_decimals",
+	"t_app_config_token_sol.token_symbol",
+	"t_app_config_token_sol.cold_address",
+	"t_app_config_token_sol.hot_address",
+	"t_app_config_token_sol.org_min_balance",
+	"t_app_config_token_sol.create_time",
+}
+
+// 表结构
+// DBTAppConfigTokenSol t_app_config_token_sol
+/*
+   id,
+   token_address,
+   token_decimals,
+   token_symbol,
+   cold_address,
+   hot_address,
+   org_min_balance,
+   create_time
+*/
+type DBTAppConfigTokenSol struct {
+	ID            int64  `db:"id" json:"id"`
+	TokenAddress  string `db:"token_address" json:"token_address"`     // spl代币mint地址
+	TokenDecimals int64  `db:"token_decimals" json:"token_decimals"`   // 精度
+	TokenSymbol   string `db:"token_symbol" json:"token_symbol"`       // 代币符号
+	ColdAddress   string `db:"cold_address" json:"cold_address"`       // 冷钱包地址
+	HotAddress    string `db:"hot_address" json:"hot_address"`         // 热钱包地址
+	OrgMinBalance string `db:"org_min_balance" json:"org_min_balance"` // 最小整理金额
+	CreateTime    int64  `db:"create_time" json:"create_time"`
+}
+
+// const TAppConfigTokenTrx full
+const (
+	DBColTAppConfigTokenTrxID            = "t_app_config_token_trx.id"
+	DBColTAppConfigTokenTrxTokenAddress  = "t_app_config_token_trx.token_address"   // trc20合约地址
+	DBColTAppConfigTokenTrxTokenDecimals = "t_app_config_token_trx.token_decimals"  // 精度
+	DBColTAppConfigTokenTrxTokenSymbol   = "t_app_config_token_trx.token_symbol"    // 代币符号
+	DBColTAppConfigTokenTrxColdAddress   = "t_app_config_token_trx.cold_address"    // 冷钱包地址
+	DBColTAppConfigTokenTrxHotAddress    = "t_app_config_token_trx.hot_address"     // 热钱包地址
+	DBColTAppConfigTokenTrxOrgMinBalance = "t_app_config_token_trx.org_min_balance" // 最小整理金额
+	DBColTAppConfigTokenTrxCreateTime    = "t_app_config_token_trx.create_time"
+)
+
+// const TAppConfigTokenTrx short
+const (
+	DBColShortTAppConfigTokenTrxID            = "id"
+	DBColShortTAppConfigTokenTrxTokenAddress  = "token_address"   // trc20合约地址
+	DBColShortTAppConfigTokenTrxTokenDecimals = "token_decimals"  // 精度
+	DBColShortTAppConfigTokenTrxTokenSymbol   = "token_symbol"    // 代币符号
+	DBColShortTAppConfigTokenTrxColdAddress   = "cold_address"    // 冷钱包地址
+	DBColShortTAppConfigTokenTrxHotAddress    = "hot_address"     // 热钱包地址
+	DBColShortTAppConfigTokenTrxOrgMinBalance = "org_min_balance" // 最小整理金额
+	DBColShortTAppConfigTokenTrxCreateTime    = "create_time"
+)
+
+// DBColTAppConfigTokenTrxAll 所有字段
+var DBColTAppConfigTokenTrxAll = []string{
+	"t_app_config_token_trx.id",
+	"t_app_config_token_trx.token_address",
+	"t_app_config_token_trx.token_decimals",
+	"t_app_config_token_trx.token_symbol",
+	"t_app_config_token_trx.cold_address",
+	"t_app_config_token_trx.hot_address",
+	"t_app_config_token_trx.org_min_balance",
+	"t_app_config_token_trx.create_time",
+}
+
+// 表结构
+// DBTAppConfigTokenTrx t_app_config_token_trx
+/*
+   id,
+   token_address,
+   token_decimals,
+   token_symbol,
+   cold_address,
+   hot_address,
+   org_min_balance,
+   create_time
+*/
+type DBTAppConfigTokenTrx struct {
+	ID            int64  `db:"id" json:"id"`
+	TokenAddress  string `db:"token_address" json:"token_address"`     // trc20合约地址
+	TokenDecimals int64  `db:"token_decimals" json:"token_decimals"`   // 精度
+	TokenSymbol   string `db:"token_symbol" json:"token_symbol"`       // 代币符号
+	ColdAddress   string `db:"cold_address" json:"cold_address"`       // 冷钱包地址
+	HotAddress    string `db:"hot_address" json:"hot_address"`         // 热钱包地址
+	OrgMinBalance string `db:"org_min_balance" json:"org_min_balance"` // 最小整理金额
+	CreateTime    int64  `db:"create_time" json:"create_time"`
+}
+
 // const TAppLock full
 const (
 	DBColTAppLockID         = "t_app_lock.id"
@@ -497,6 +734,7 @@ type DBTProductNotify struct {
 // const TSend full
 const (
 	DBColTSendID           = "t_send.id"
+	DBColTSendChainSymbol  = "t_send.chain_symbol" // 所在链
 	DBColTSendRelatedType  = "t_send.related_type" // 关联类型 1 零钱整理 2 提币
 	DBColTSendRelatedID    = "t_send.related_id"   // 关联id
 	DBColTSendTokenID      = "t_send.token_id"
@@ -517,6 +755,7 @@ const (
 // const TSend short
 const (
 	DBColShortTSendID           = "id"
+	DBColShortTSendChainSymbol  = "chain_symbol" // 所在链
 	DBColShortTSendRelatedType  = "related_type" // 关联类型 1 零钱整理 2 提币
 	DBColShortTSendRelatedID    = "related_id"   // 关联id
 	DBColShortTSendTokenID      = "token_id"
@@ -537,6 +776,7 @@ const (
 // DBColTSendAll 所有字段
 var DBColTSendAll = []string{
 	"t_send.id",
+	"t_send.chain_symbol",
 	"t_send.related_type",
 	"t_send.related_id",
 	"t_send.token_id",
@@ -558,6 +798,7 @@ var DBColTSendAll = []string{
 // DBTSend t_send
 /*
    id,
+   chain_symbol,
    related_type,
    related_id,
    token_id,
@@ -576,6 +817,7 @@ var DBColTSendAll = []string{
 */
 type DBTSend struct {
 	ID           int64  `db:"id" json:"id"`
+	ChainSymbol  string `db:"chain_symbol" json:"chain_symbol"` // 所在链
 	RelatedType  int64  `db:"related_type" json:"related_type"` // 关联类型 1 零钱整理 2 提币
 	RelatedID    int64  `db:"related_id" json:"related_id"`     // 关联id
 	TokenID      int64  `db:"token_id" json:"token_id"`
@@ -596,6 +838,7 @@ type DBTSend struct {
 // const TSendBtc full
 const (
 	DBColTSendBtcID           = "t_send_btc.id"
+	DBColTSendBtcSymbol       = "t_send_btc.symbol"       // 币种
 	DBColTSendBtcRelatedType  = "t_send_btc.related_type" // 关联类型 1 零钱整理 2 提币
 	DBColTSendBtcRelatedID    = "t_send_btc.related_id"   // 关联id
 	DBColTSendBtcTokenID      = "t_send_btc.token_id"
@@ -605,6 +848,7 @@ const (
 	DBColTSendBtcBalanceReal  = "t_send_btc.balance_real"  // 打币金额 Ether
 	DBColTSendBtcGas          = "t_send_btc.gas"           // gas消耗
 	DBColTSendBtcGasPrice     = "t_send_btc.gas_price"     // gasPrice
+	DBColTSendBtcBlockNum     = "t_send_btc.block_num"     // 所在块高
 	DBColTSendBtcHex          = "t_send_btc.hex"           // tx raw hex
 	DBColTSendBtcCreateTime   = "t_send_btc.create_time"   // 创建时间
 	DBColTSendBtcHandleStatus = "t_send_btc.handle_status" // 处理状态
@@ -615,6 +859,7 @@ const (
 // const TSendBtc short
 const (
 	DBColShortTSendBtcID           = "id"
+	DBColShortTSendBtcSymbol       = "symbol"       // 币种
 	DBColShortTSendBtcRelatedType  = "related_type" // 关联类型 1 零钱整理 2 提币
 	DBColShortTSendBtcRelatedID    = "related_id"   // 关联id
 	DBColShortTSendBtcTokenID      = "token_id"
@@ -624,6 +869,7 @@ const (
 	DBColShortTSendBtcBalanceReal  = "balance_real"  // 打币金额 Ether
 	DBColShortTSendBtcGas          = "gas"           // gas消耗
 	DBColShortTSendBtcGasPrice     = "gas_price"     // gasPrice
+	DBColShortTSendBtcBlockNum     = "block_num"     // 所在块高
 	DBColShortTSendBtcHex          = "hex"           // tx raw hex
 	DBColShortTSendBtcCreateTime   = "create_time"   // 创建时间
 	DBColShortTSendBtcHandleStatus = "handle_status" // 处理状态
@@ -634,6 +880,7 @@ const (
 // DBColTSendBtcAll 所有字段
 var DBColTSendBtcAll = []string{
 	"t_send_btc.id",
+	"t_send_btc.symbol",
 	"t_send_btc.related_type",
 	"t_send_btc.related_id",
 	"t_send_btc.token_id",
@@ -643,6 +890,7 @@ var DBColTSendBtcAll = []string{
 	"t_send_btc.balance_real",
 	"t_send_btc.gas",
 	"t_send_btc.gas_price",
+	"t_send_btc.block_num",
 	"t_send_btc.hex",
 	"t_send_btc.create_time",
 	"t_send_btc.handle_status",
@@ -654,6 +902,7 @@ var DBColTSendBtcAll = []string{
 // DBTSendBtc t_send_btc
 /*
    id,
+   symbol,
    related_type,
    related_id,
    token_id,
@@ -663,6 +912,7 @@ var DBColTSendBtcAll = []string{
    balance_real,
    gas,
    gas_price,
+   block_num,
    hex,
    create_time,
    handle_status,
@@ -671,6 +921,7 @@ var DBColTSendBtcAll = []string{
 */
 type DBTSendBtc struct {
 	ID           int64  `db:"id" json:"id"`
+	Symbol       string `db:"symbol" json:"symbol"`             // 币种
 	RelatedType  int64  `db:"related_type" json:"related_type"` // 关联类型 1 零钱整理 2 提币
 	RelatedID    int64  `db:"related_id" json:"related_id"`     // 关联id
 	TokenID      int64  `db:"token_id" json:"token_id"`
@@ -680,6 +931,7 @@ type DBTSendBtc struct {
 	BalanceReal  string `db:"balance_real" json:"balance_real"`   // 打币金额 Ether
 	Gas          int64  `db:"gas" json:"gas"`                     // gas消耗
 	GasPrice     int64  `db:"gas_price" json:"gas_price"`         // gasPrice
+	BlockNum     int64  `db:"block_num" json:"block_num"`         // 所在块高
 	Hex          string `db:"hex" json:"hex"`                     // tx raw hex
 	CreateTime   int64  `db:"create_time" json:"create_time"`     // 创建时间
 	HandleStatus int64  `db:"handle_status" json:"handle_status"` // 处理状态
@@ -691,12 +943,14 @@ type DBTSendBtc struct {
 const (
 	DBColTSendEosID           = "t_send_eos.id"
 	DBColTSendEosWithdrawID   = "t_send_eos.withdraw_id"   // 关联id
+	DBColTSendEosSymbol       = "t_send_eos.symbol"        // 币种
 	DBColTSendEosTxHash       = "t_send_eos.tx_hash"       // tx hash
 	DBColTSendEosLogIndex     = "t_send_eos.log_index"     // log_index
 	DBColTSendEosFromAddress  = "t_send_eos.from_address"  // 打币地址
 	DBColTSendEosToAddress    = "t_send_eos.to_address"    // 收币地址
 	DBColTSendEosMemo         = "t_send_eos.memo"          // 收币地址
 	DBColTSendEosBalanceReal  = "t_send_eos.balance_real"  // 打币金额 Ether
+	DBColTSendEosBlockNum     = "t_send_eos.block_num"     // 所在块高
 	DBColTSendEosHex          = "t_send_eos.hex"           // tx raw hex
 	DBColTSendEosCreateTime   = "t_send_eos.create_time"   // 创建时间
 	DBColTSendEosHandleStatus = "t_send_eos.handle_status" // 处理状态
@@ -708,12 +962,14 @@ const (
 const (
 	DBColShortTSendEosID           = "id"
 	DBColShortTSendEosWithdrawID   = "withdraw_id"   // 关联id
+	DBColShortTSendEosSymbol       = "symbol"        // 币种
 	DBColShortTSendEosTxHash       = "tx_hash"       // tx hash
 	DBColShortTSendEosLogIndex     = "log_index"     // log_index
 	DBColShortTSendEosFromAddress  = "from_address"  // 打币地址
 	DBColShortTSendEosToAddress    = "to_address"    // 收币地址
 	DBColShortTSendEosMemo         = "memo"          // 收币地址
 	DBColShortTSendEosBalanceReal  = "balance_real"  // 打币金额 Ether
+	DBColShortTSendEosBlockNum     = "block_num"     // 所在块高
 	DBColShortTSendEosHex          = "hex"           // tx raw hex
 	DBColShortTSendEosCreateTime   = "create_time"   // 创建时间
 	DBColShortTSendEosHandleStatus = "handle_status" // 处理状态
@@ -725,12 +981,14 @@ const (
 var DBColTSendEosAll = []string{
 	"t_send_eos.id",
 	"t_send_eos.withdraw_id",
+	"t_send_eos.symbol",
 	"t_send_eos.tx_hash",
 	"t_send_eos.log_index",
 	"t_send_eos.from_address",
 	"t_send_eos.to_address",
 	"t_send_eos.memo",
 	"t_send_eos.balance_real",
+	"t_send_eos.block_num",
 	"t_send_eos.hex",
 	"t_send_eos.create_time",
 	"t_send_eos.handle_status",
@@ -743,12 +1001,14 @@ var DBColTSendEosAll = []string{
 /*
    id,
    withdraw_id,
+   symbol,
    tx_hash,
    log_index,
    from_address,
    to_address,
    memo,
    balance_real,
+   block_num,
    hex,
    create_time,
    handle_status,
@@ -758,12 +1018,14 @@ var DBColTSendEosAll = []string{
 type DBTSendEos struct {
 	ID           int64  `db:"id" json:"id"`
 	WithdrawID   int64  `db:"withdraw_id" json:"withdraw_id"`     // 关联id
+	Symbol       string `db:"symbol" json:"symbol"`               // 币种
 	TxHash       string `db:"tx_hash" json:"tx_hash"`             // tx hash
 	LogIndex     int64  `db:"log_index" json:"log_index"`         // log_index
 	FromAddress  string `db:"from_address" json:"from_address"`   // 打币地址
 	ToAddress    string `db:"to_address" json:"to_address"`       // 收币地址
 	Memo         string `db:"memo" json:"memo"`                   // 收币地址
 	BalanceReal  string `db:"balance_real" json:"balance_real"`   // 打币金额 Ether
+	BlockNum     int64  `db:"block_num" json:"block_num"`         // 所在块高
 	Hex          string `db:"hex" json:"hex"`                     // tx raw hex
 	CreateTime   int64  `db:"create_time" json:"create_time"`     // 创建时间
 	HandleStatus int64  `db:"handle_status" json:"handle_status"` // 处理状态
@@ -771,11 +1033,315 @@ type DBTSendEos struct {
 	HandleAt     int64  `db:"handle_at" json:"handle_at"`         // 处理时间
 }
 
+// const TSendSol full
+const (
+	DBColTSendSolID              = "t_send_sol.id"
+	DBColTSendSolRelatedType     = "t_send_sol.related_type"      // 关联类型 1 零钱整理 2 提币
+	DBColTSendSolRelatedID       = "t_send_sol.related_id"        // 关联id
+	DBColTSendSolTokenID         = "t_send_sol.token_id"          // 代币id 0为sol
+	DBColTSendSolTxID            = "t_send_sol.tx_id"             // 交易签名
+	DBColTSendSolFromAddress     = "t_send_sol.from_address"      // 打币地址
+	DBColTSendSolToAddress       = "t_send_sol.to_address"        // 收币地址
+	DBColTSendSolBalanceReal     = "t_send_sol.balance_real"      // 打币金额
+	DBColTSendSolLastValidHeight = "t_send_sol.last_valid_height" // blockhash最后有效的块高度
+	DBColTSendSolBlockNum        = "t_send_sol.block_num"         // 所在slot
+	DBColTSendSolFee             = "t_send_sol.fee"               // 实际手续费
+	DBColTSendSolHex             = "t_send_sol.hex"               // tx raw base64
+	DBColTSendSolCreateTime      = "t_send_sol.create_time"       // 创建时间
+	DBColTSendSolHandleStatus    = "t_send_sol.handle_status"     // 处理状态
+	DBColTSendSolHandleMsg       = "t_send_sol.handle_msg"        // 处理消息
+	DBColTSendSolHandleTime      = "t_send_sol.handle_time"       // 处理时间
+)
+
+// const TSendSol short
+const (
+	DBColShortTSendSolID              = "id"
+	DBColShortTSendSolRelatedType     = "related_type"      // 关联类型 1 零钱整理 2 提币
+	DBColShortTSendSolRelatedID       = "related_id"        // 关联id
+	DBColShortTSendSolTokenID         = "token_id"          // 代币id 0为sol
+	DBColShortTSendSolTxID            = "tx_id"             // 交易签名
+	DBColShortTSendSolFromAddress     = "from_address"      // 打币地址
+	DBColShortTSendSolToAddress       = "to_address"        // 收币地址
+	DBColShortTSendSolBalanceReal     = "balance_real"      // 打币金额
+	DBColShortTSendSolLastValidHeight = "last_valid_height" // blockhash最后有效的块高度
+	DBColShortTSendSolBlockNum        = "block_num"         // 所在slot
+	DBColShortTSendSolFee             = "fee"               // 实际手续费
+	DBColShortTSendSolHex             = "hex"               // tx raw base64
+	DBColShortTSendSolCreateTime      = "create_time"       // 创建时间
+	DBColShortTSendSolHandleStatus    = "handle_status"     // 处理状态
+	DBColShortTSendSolHandleMsg       = "handle_msg"        // 处理消息
+	DBColShortTSendSolHandleTime      = "handle_time"       // 处理时间
+)
+
+// DBColTSendSolAll 所有字段
+var DBColTSendSolAll = []string{
+	"t_send_sol.id",
+	"t_send_sol.related_type",
+	"t_send_sol.related_id",
+	"t_send_sol.token_id",
+	"t_send_sol.tx_id",
+	"t_send_sol.from_address",
+	"t_send_sol.to_address",
+	"t_send_sol.balance_real",
+	"t_send_sol.last_valid_height",
+	"t_send_sol.block_num",
+	"t_send_sol.fee",
+	"t_send_sol.hex",
+	"t_send_sol.create_time",
+	"t_send_sol.handle_status",
+	"t_send_sol.handle_msg",
+	"t_send_sol.handle_time",
+}
+
+// 表结构
+// DBTSendSol t_send_sol
+/*
+   id,
+   related_type,
+   related_id,
+   token_id,
+   tx_id,
+   from_address,
+   to_address,
+   balance_real,
+   last_valid_height,
+   block_num,
+   fee,
+   hex,
+   create_time,
+   handle_status,
+   handle_msg,
+   handle_time
+*/
+type DBTSendSol struct {
+	ID              int64  `db:"id" json:"id"`
+	RelatedType     int64  `db:"related_type" json:"related_type"`           // 关联类型 1 零钱整理 2 提币
+	RelatedID       int64  `db:"related_id" json:"related_id"`               // 关联id
+	TokenID         int64  `db:"token_id" json:"token_id"`                   // 代币id 0为sol
+	TxID            string `db:"tx_id" json:"tx_id"`                         // 交易签名
+	FromAddress     string `db:"from_address" json:"from_address"`           // 打币地址
+	ToAddress       string `db:"to_address" json:"to_address"`               // 收币地址
+	BalanceReal     string `db:"balance_real" json:"balance_real"`           // 打币金额
+	LastValidHeight int64  `db:"last_valid_height" json:"last_valid_height"` // blockhash最后有效的块高度
+	BlockNum        int64  `db:"block_num" json:"block_num"`                 // 所在slot
+	Fee             string `db:"fee" json:"fee"`                             // 实际手续费
+	Hex             string `db:"hex" json:"hex"`                             // tx raw base64
+	CreateTime      int64  `db:"create_time" json:"create_time"`             // 创建时间
+	HandleStatus    int64  `db:"handle_status" json:"handle_status"`         // 处理状态
+	HandleMsg       string `db:"handle_msg" json:"handle_msg"`               // 处理消息
+	HandleTime      int64  `db:"handle_time" json:"handle_time"`             // 处理时间
+}
+
+// const TSendTrx full
+const (
+	DBColTSendTrxID           = "t_send_trx.id"
+	DBColTSendTrxRelatedType  = "t_send_trx.related_type"  // 关联类型 1 零钱整理 2 提币
+	DBColTSendTrxRelatedID    = "t_send_trx.related_id"    // 关联id
+	DBColTSendTrxTokenID      = "t_send_trx.token_id"      // 代币id 0为trx
+	DBColTSendTrxTxID         = "t_send_trx.tx_id"         // tx hash
+	DBColTSendTrxFromAddress  = "t_send_trx.from_address"  // 打币地址
+	DBColTSendTrxToAddress    = "t_send_trx.to_address"    // 收币地址
+	DBColTSendTrxBalanceReal  = "t_send_trx.balance_real"  // 打币金额
+	DBColTSendTrxFeeLimit     = "t_send_trx.fee_limit"     // 合约调用最多消耗的trx sun
+	DBColTSendTrxExpiration   = "t_send_trx.expiration"    // 交易过期时间 毫秒
+	DBColTSendTrxBlockNum     = "t_send_trx.block_num"     // 所在块高
+	DBColTSendTrxFee          = "t_send_trx.fee"           // 实际手续费
+	DBColTSendTrxHex          = "t_send_trx.hex"           // tx raw hex
+	DBColTSendTrxCreateTime   = "t_send_trx.create_time"   // 创建时间
+	DBColTSendTrxHandleStatus = "t_send_trx.handle_status" // 处理状态
+	DBColTSendTrxHandleMsg    = "t_send_trx.handle_msg"    // 处理消息
+	DBColTSendTrxHandleTime   = "t_send_trx.handle_time"   // 处理时间
+)
+
+// const TSendTrx short
+const (
+	DBColShortTSendTrxID           = "id"
+	DBColShortTSendTrxRelatedType  = "related_type"  // 关联类型 1 零钱整理 2 提币
+	DBColShortTSendTrxRelatedID    = "related_id"    // 关联id
+	DBColShortTSendTrxTokenID      = "token_id"      // 代币id 0为trx
+	DBColShortTSendTrxTxID         = "tx_id"         // tx hash
+	DBColShortTSendTrxFromAddress  = "from_address"  // 打币地址
+	DBColShortTSendTrxToAddress    = "to_address"    // 收币地址
+	DBColShortTSendTrxBalanceReal  = "balance_real"  // 打币金额
+	DBColShortTSendTrxFeeLimit     = "fee_limit"     // 合约调用最多消耗的trx sun
+	DBColShortTSendTrxExpiration   = "expiration"    // 交易过期时间 毫秒
+	DBColShortTSendTrxBlockNum     = "block_num"     // 所在块高
+	DBColShortTSendTrxFee          = "fee"           // 实际手续费
+	DBColShortTSendTrxHex          = "hex"           // tx raw hex
+	DBColShortTSendTrxCreateTime   = "create_time"   // 创建时间
+	DBColShortTSendTrxHandleStatus = "handle_status" // 处理状态
+	DBColShortTSendTrxHandleMsg    = "handle_msg"    // 处理消息
+	DBColShortTSendTrxHandleTime   = "handle_time"   // 处理时间
+)
+
+// DBColTSendTrxAll 所有字段
+var DBColTSendTrxAll = []string{
+	"t_send_trx.id",
+	"t_send_trx.related_type",
+	"t_send_trx.related_id",
+	"t_send_trx.token_id",
+	"t_send_trx.tx_id",
+	"t_send_trx.from_address",
+	"t_send_trx.to_address",
+	"t_send_trx.balance_real",
+	"t_send_trx.fee_limit",
+	"t_send_trx.expiration",
+	"t_send_trx.block_num",
+	"t_send_trx.fee",
+	"t_send_trx.hex",
+	"t_send_trx.create_time",
+	"t_send_trx.handle_status",
+	"t_send_trx.handle_msg",
+	"t_send_trx.handle_time",
+}
+
+// 表结构
+// DBTSendTrx t_send_trx
+/*
+   id,
+   related_type,
+   related_id,
+   token_id,
+   tx_id,
+   from_address,
+   to_address,
+   balance_real,
+   fee_limit,
+   expiration,
+   block_num,
+   fee,
+   hex,
+   create_time,
+   handle_status,
+   handle_msg,
+   handle_time
+*/
+type DBTSendTrx struct {
+	ID           int64  `db:"id" json:"id"`
+	RelatedType  int64  `db:"related_type" json:"related_type"`   // 关联类型 1 零钱整理 2 提币
+	RelatedID    int64  `db:"related_id" json:"related_id"`       // 关联id
+	TokenID      int64  `db:"token_id" json:"token_id"`           // 代币id 0为trx
+	TxID         string `db:"tx_id" json:"tx_id"`                 // tx hash
+	FromAddress  string `db:"from_address" json:"from_address"`   // 打币地址
+	ToAddress    string `db:"to_address" json:"to_address"`       // 收币地址
+	BalanceReal  string `db:"balance_real" json:"balance_real"`   // 打币金额
+	FeeLimit     int64  `db:"fee_limit" json:"fee_limit"`         // 合约调用最多消耗的trx sun
+	Expiration   int64  `db:"expiration" json:"expiration"`       // 交易过期时间 毫秒
+	BlockNum     int64  `db:"block_num" json:"block_num"`         // 所在块高
+	Fee          string `db:"fee" json:"fee"`                     // 实际手续费
+	Hex          string `db:"hex" json:"hex"`                     // tx raw hex
+	CreateTime   int64  `db:"create_time" json:"create_time"`     // 创建时间
+	HandleStatus int64  `db:"handle_status" json:"handle_status"` // 处理状态
+	HandleMsg    string `db:"handle_msg" json:"handle_msg"`       // 处理消息
+	HandleTime   int64  `db:"handle_time" json:"handle_time"`     // 处理时间
+}
+
+// const TSendXrp full
+const (
+	DBColTSendXrpID                 = "t_send_xrp.id"
+	DBColTSendXrpWithdrawID         = "t_send_xrp.withdraw_id"          // 关联id
+	DBColTSendXrpTxHash             = "t_send_xrp.tx_hash"              // tx hash
+	DBColTSendXrpFromAddress        = "t_send_xrp.from_address"         // 打币地址
+	DBColTSendXrpToAddress          = "t_send_xrp.to_address"           // 收币地址
+	DBColTSendXrpMemo               = "t_send_xrp.memo"                 // destination tag
+	DBColTSendXrpBalanceReal        = "t_send_xrp.balance_real"         // 打币金额
+	DBColTSendXrpSequence           = "t_send_xrp.sequence"             // 账户序号
+	DBColTSendXrpLastLedgerSequence = "t_send_xrp.last_ledger_sequence" // 交易最后有效的ledger
+	DBColTSendXrpBlockNum           = "t_send_xrp.block_num"            // 所在ledger
+	DBColTSendXrpFee                = "t_send_xrp.fee"                  // 实际手续费
+	DBColTSendXrpHex                = "t_send_xrp.hex"                  // tx blob
+	DBColTSendXrpCreateTime         = "t_send_xrp.create_time"          // 创建时间
+	DBColTSendXrpHandleStatus       = "t_send_xrp.handle_status"        // 处理状态
+	DBColTSendXrpHandleMsg          = "t_send_xrp.handle_msg"           // 处理消息
+	DBColTSendXrpHandleAt           = "t_send_xrp.handle_at"            // 处理时间
+)
+
+// const TSendXrp short
+const (
+	DBColShortTSendXrpID                 = "id"
+	DBColShortTSendXrpWithdrawID         = "withdraw_id"          // 关联id
+	DBColShortTSendXrpTxHash             = "tx_hash"              // tx hash
+	DBColShortTSendXrpFromAddress        = "from_address"         // 打币地址
+	DBColShortTSendXrpToAddress          = "to_address"           // 收币地址
+	DBColShortTSendXrpMemo               = "memo"                 // destination tag
+	DBColShortTSendXrpBalanceReal        = "balance_real"         // 打币金额
+	DBColShortTSendXrpSequence           = "sequence"             // 账户序号
+	DBColShortTSendXrpLastLedgerSequence = "last_ledger_sequence" // 交易最后有效的ledger
+	DBColShortTSendXrpBlockNum           = "block_num"            // 所在ledger
+	DBColShortTSendXrpFee                = "fee"                  // 实际手续费
+	DBColShortTSendXrpHex                = "hex"                  // tx blob
+	DBColShortTSendXrpCreateTime         = "create_time"          // 创建时间
+	DBColShortTSendXrpHandleStatus       = "handle_status"        // 处理状态
+	DBColShortTSendXrpHandleMsg          = "handle_msg"           // 处理消息
+	DBColShortTSendXrpHandleAt           = "handle_at"            // 处理时间
+)
+
+// DBColTSendXrpAll 所有字段
+var DBColTSendXrpAll = []string{
+	"t_send_xrp.id",
+	"t_send_xrp.withdraw_id",
+	"t_send_xrp.tx_hash",
+	"t_send_xrp.from_address",
+	"t_send_xrp.to_address",
+	"t_send_xrp.memo",
+	"t_send_xrp.balance_real",
+	"t_send_xrp.sequence",
+	"t_send_xrp.last_ledger_sequence",
+	"t_send_xrp.block_num",
+	"t_send_xrp.fee",
+	"t_send_xrp.hex",
+	"t_send_xrp.create_time",
+	"t_send_xrp.handle_status",
+	"t_send_xrp.handle_msg",
+	"t_send_xrp.handle_at",
+}
+
+// 表结构
+// DBTSendXrp t_send_xrp
+/*
+   id,
+   withdraw_id,
+   tx_hash,
+   from_address,
+   to_address,
+   memo,
+   balance_real,
+   sequence,
+   last_ledger_sequence,
+   block_num,
+   fee,
+   hex,
+   create_time,
+   handle_status,
+   handle_msg,
+   handle_at
+*/
+type DBTSendXrp struct {
+	ID                 int64  `db:"id" json:"id"`
+	WithdrawID         int64  `db:"withdraw_id" json:"withdraw_id"`                   // 关联id
+	TxHash             string `db:"tx_hash" json:"tx_hash"`                           // tx hash
+	FromAddress        string `db:"from_address" json:"from_address"`                 // 打币地址
+	ToAddress          string `db:"to_address" json:"to_address"`                     // 收币地址
+	Memo               string `db:"memo" json:"memo"`                                 // destination tag
+	BalanceReal        string `db:"balance_real" json:"balance_real"`                 // 打币金额
+	Sequence           int64  `db:"sequence" json:"sequence"`                         // 账户序号
+	LastLedgerSequence int64  `db:"last_ledger_sequence" json:"last_ledger_sequence"` // 交易最后有效的ledger
+	BlockNum           int64  `db:"block_num" json:"block_num"`                       // 所在ledger
+	Fee                string `db:"fee" json:"fee"`                                   // 实际手续费
+	Hex                string `db:"hex" json:"hex"`                                   // tx blob
+	CreateTime         int64  `db:"create_time" json:"create_time"`                   // 创建时间
+	HandleStatus       int64  `db:"handle_status" json:"handle_status"`               // 处理状态
+	HandleMsg          string `db:"handle_msg" json:"handle_msg"`                     // 处理消息
+	HandleAt           int64  `db:"handle_at" json:"handle_at"`                       // 处理时间
+}
+
 // const TTx full
 const (
 	DBColTTxID           = "t_tx.id"
+	DBColTTxChainSymbol  = "t_tx.chain_symbol" // 所在链
 	DBColTTxProductID    = "t_tx.product_id"
 	DBColTTxTxID         = "t_tx.tx_id"         // 交易id
+	DBColTTxBlockNum     = "t_tx.block_num"     // 所在块高
 	DBColTTxFromAddress  = "t_tx.from_address"  // 来源地址
 	DBColTTxToAddress    = "t_tx.to_address"    // 目标地址
 	DBColTTxBalanceReal  = "t_tx.balance_real"  // 到账金额Ether
@@ -791,8 +1357,10 @@ const (
 // const TTx short
 const (
 	DBColShortTTxID           = "id"
+	DBColShortTTxChainSymbol  = "chain_symbol" // 所在链
 	DBColShortTTxProductID    = "product_id"
 	DBColShortTTxTxID         = "tx_id"         // 交易id
+	DBColShortTTxBlockNum     = "block_num"     // 所在块高
 	DBColShortTTxFromAddress  = "from_address"  // 来源地址
 	DBColShortTTxToAddress    = "to_address"    // 目标地址
 	DBColShortTTxBalanceReal  = "balance_real"  // 到账金额Ether
@@ -808,8 +1376,10 @@ const (
 // DBColTTxAll 所有字段
 var DBColTTxAll = []string{
 	"t_tx.id",
+	"t_tx.chain_symbol",
 	"t_tx.product_id",
 	"t_tx.tx_id",
+	"t_tx.block_num",
 	"t_tx.from_address",
 	"t_tx.to_address",
 	"t_tx.balance_real",
@@ -826,8 +1396,10 @@ var DBColTTxAll = []string{
 // DBTTx t_tx
 /*
    id,
+   chain_symbol,
    product_id,
    tx_id,
+   block_num,
    from_address,
    to_address,
    balance_real,
@@ -841,8 +1413,10 @@ var DBColTTxAll = []string{
 */
 type DBTTx struct {
 	ID           int64  `db:"id" json:"id"`
+	ChainSymbol  string `db:"chain_symbol" json:"chain_symbol"` // 所在链
 	ProductID    int64  `db:"product_id" json:"product_id"`
 	TxID         string `db:"tx_id" json:"tx_id"`                 // 交易id
+	BlockNum     int64  `db:"block_num" json:"block_num"`         // 所在块高
 	FromAddress  string `db:"from_address" json:"from_address"`   // 来源地址
 	ToAddress    string `db:"to_address" json:"to_address"`       // 目标地址
 	BalanceReal  string `db:"balance_real" json:"balance_real"`   // 到账金额Ether
@@ -858,8 +1432,10 @@ type DBTTx struct {
 // const TTxBtc full
 const (
 	DBColTTxBtcID           = "t_tx_btc.id"
+	DBColTTxBtcSymbol       = "t_tx_btc.symbol" // 币种
 	DBColTTxBtcProductID    = "t_tx_btc.product_id"
 	DBColTTxBtcBlockHash    = "t_tx_btc.block_hash"
+	DBColTTxBtcBlockNum     = "t_tx_btc.block_num" // 所在块高
 	DBColTTxBtcTxID         = "t_tx_btc.tx_id"
 	DBColTTxBtcVoutN        = "t_tx_btc.vout_n"
 	DBColTTxBtcVoutAddress  = "t_tx_btc.vout_address"
@@ -873,8 +1449,10 @@ const (
 // const TTxBtc short
 const (
 	DBColShortTTxBtcID           = "id"
+	DBColShortTTxBtcSymbol       = "symbol" // 币种
 	DBColShortTTxBtcProductID    = "product_id"
 	DBColShortTTxBtcBlockHash    = "block_hash"
+	DBColShortTTxBtcBlockNum     = "block_num" // 所在块高
 	DBColShortTTxBtcTxID         = "tx_id"
 	DBColShortTTxBtcVoutN        = "vout_n"
 	DBColShortTTxBtcVoutAddress  = "vout_address"
@@ -888,8 +1466,10 @@ const (
 // DBColTTxBtcAll 所有字段
 var DBColTTxBtcAll = []string{
 	"t_tx_btc.id",
+	"t_tx_btc.symbol",
 	"t_tx_btc.product_id",
 	"t_tx_btc.block_hash",
+	"t_tx_btc.block_num",
 	"t_tx_btc.tx_id",
 	"t_tx_btc.vout_n",
 	"t_tx_btc.vout_address",
@@ -904,8 +1484,10 @@ var DBColTTxBtcAll = []string{
 // DBTTxBtc t_tx_btc
 /*
    id,
+   symbol,
    product_id,
    block_hash,
+   block_num,
    tx_id,
    vout_n,
    vout_address,
@@ -917,8 +1499,10 @@ var DBColTTxBtcAll = []string{
 */
 type DBTTxBtc struct {
 	ID           int64  `db:"id" json:"id"`
+	Symbol       string `db:"symbol" json:"symbol"` // 币种
 	ProductID    int64  `db:"product_id" json:"product_id"`
 	BlockHash    string `db:"block_hash" json:"block_hash"`
+	BlockNum     int64  `db:"block_num" json:"block_num"` // 所在块高
 	TxID         string `db:"tx_id" json:"tx_id"`
 	VoutN        int64  `db:"vout_n" json:"vout_n"`
 	VoutAddress  string `db:"vout_address" json:"vout_address"`
@@ -929,6 +1513,80 @@ type DBTTxBtc struct {
 	HandleTime   int64  `db:"handle_time" json:"handle_time"`
 }
 
+// const TTxBtcMempool full
+const (
+	DBColTTxBtcMempoolID           = "t_tx_btc_mempool.id"
+	DBColTTxBtcMempoolSymbol       = "t_tx_btc_mempool.symbol" // 币种
+	DBColTTxBtcMempoolProductID    = "t_tx_btc_mempool.product_id"
+	DBColTTxBtcMempoolTxID         = "t_tx_btc_mempool.tx_id"
+	DBColTTxBtcMempoolVoutN        = "t_tx_btc_mempool.vout_n"
+	DBColTTxBtcMempoolVoutAddress  = "t_tx_btc_mempool.vout_address"
+	DBColTTxBtcMempoolVoutValue    = "t_tx_btc_mempool.vout_value"
+	DBColTTxBtcMempoolCreateTime   = "t_tx_btc_mempool.create_time"
+	DBColTTxBtcMempoolHandleStatus = "t_tx_btc_mempool.handle_status" // 0 未通知-1 已通知-2 已打包-3 已丢弃
+	DBColTTxBtcMempoolHandleMsg    = "t_tx_btc_mempool.handle_msg"
+	DBColTTxBtcMempoolHandleTime   = "t_tx_btc_mempool.handle_time"
+)
+
+// const TTxBtcMempool short
+const (
+	DBColShortTTxBtcMempoolID           = "id"
+	DBColShortTTxBtcMempoolSymbol       = "symbol" // 币种
+	DBColShortTTxBtcMempoolProductID    = "product_id"
+	DBColShortTTxBtcMempoolTxID         = "tx_id"
+	DBColShortTTxBtcMempoolVoutN        = "vout_n"
+	DBColShortTTxBtcMempoolVoutAddress  = "vout_address"
+	DBColShortTTxBtcMempoolVoutValue    = "vout_value"
+	DBColShortTTxBtcMempoolCreateTime   = "create_time"
+	DBColShortTTxBtcMempoolHandleStatus = "handle_status" // 0 未通知-1 已通知-2 已打包-3 已丢弃
+	DBColShortTTxBtcMempoolHandleMsg    = "handle_msg"
+	DBColShortTTxBtcMempoolHandleTime   = "handle_time"
+)
+
+// DBColTTxBtcMempoolAll 所有字段
+var DBColTTxBtcMempoolAll = []string{
+	"t_tx_btc_mempool.id",
+	"t_tx_btc_mempool.symbol",
+	"t_tx_btc_mempool.product_id",
+	"t_tx_btc_mempool.tx_id",
+	"t_tx_btc_mempool.vout_n",
+	"t_tx_btc_mempool.vout_address",
+	"t_tx_btc_mempool.vout_value",
+	"t_tx_btc_mempool.create_time",
+	"t_tx_btc_mempool.handle_status",
+	"t_tx_btc_mempool.handle_msg",
+	"t_tx_btc_mempool.handle_time",
+}
+
+// 表结构
+// DBTTxBtcMempool t_tx_btc_mempool
+/*
+   id,
+   symbol,
+   product_id,
+   tx_id,
+   vout_n,
+   vout_address,
+   vout_value,
+   create_time,
+   handle_status,
+   handle_msg,
+   handle_time
+*/
+type DBTTxBtcMempool struct {
+	ID           int64  `db:"id" json:"id"`
+	Symbol       string `db:"symbol" json:"symbol"` // 币种
+	ProductID    int64  `db:"product_id" json:"product_id"`
+	TxID         string `db:"tx_id" json:"tx_id"`
+	VoutN        int64  `db:"vout_n" json:"vout_n"`
+	VoutAddress  string `db:"vout_address" json:"vout_address"`
+	VoutValue    string `db:"vout_value" json:"vout_value"`
+	CreateTime   int64  `db:"create_time" json:"create_time"`
+	HandleStatus int64  `db:"handle_status" json:"handle_status"` // 0 未通知-1 已通知-2 已打包-3 已丢弃
+	HandleMsg    string `db:"handle_msg" json:"handle_msg"`
+	HandleTime   int64  `db:"handle_time" json:"handle_time"`
+}
+
 // const TTxBtcToken full
 const (
 	DBColTTxBtcTokenID           = "t_tx_btc_token.id"
@@ -936,6 +1594,7 @@ const (
 	DBColTTxBtcTokenTokenIndex   = "t_tx_btc_token.token_index"
 	DBColTTxBtcTokenTokenSymbol  = "t_tx_btc_token.token_symbol"
 	DBColTTxBtcTokenBlockHash    = "t_tx_btc_token.block_hash"
+	DBColTTxBtcTokenBlockNum     = "t_tx_btc_token.block_num" // 所在块高
 	DBColTTxBtcTokenTxID         = "t_tx_btc_token.tx_id"
 	DBColTTxBtcTokenFromAddress  = "t_tx_btc_token.from_address"
 	DBColTTxBtcTokenToAddress    = "t_tx_btc_token.to_address"
@@ -957,6 +1616,7 @@ const (
 	DBColShortTTxBtcTokenTokenIndex   = "token_index"
 	DBColShortTTxBtcTokenTokenSymbol  = "token_symbol"
 	DBColShortTTxBtcTokenBlockHash    = "block_hash"
+	DBColShortTTxBtcTokenBlockNum     = "block_num" // 所在块高
 	DBColShortTTxBtcTokenTxID         = "tx_id"
 	DBColShortTTxBtcTokenFromAddress  = "from_address"
 	DBColShortTTxBtcTokenToAddress    = "to_address"
@@ -978,6 +1638,7 @@ var DBColTTxBtcTokenAll = []string{
 	"t_tx_btc_token.token_index",
 	"t_tx_btc_token.token_symbol",
 	"t_tx_btc_token.block_hash",
+	"t_tx_btc_token.block_num",
 	"t_tx_btc_token.tx_id",
 	"t_tx_btc_token.from_address",
 	"t_tx_btc_token.to_address",
@@ -1000,6 +1661,7 @@ var DBColTTxBtcTokenAll = []string{
    token_index,
    token_symbol,
    block_hash,
+   block_num,
    tx_id,
    from_address,
    to_address,
@@ -1019,6 +1681,7 @@ type DBTTxBtcToken struct {
 	TokenIndex   int64  `db:"token_index" json:"token_index"`
 	TokenSymbol  string `db:"token_symbol" json:"token_symbol"`
 	BlockHash    string `db:"block_hash" json:"block_hash"`
+	BlockNum     int64  `db:"block_num" json:"block_num"` // 所在块高
 	TxID         string `db:"tx_id" json:"tx_id"`
 	FromAddress  string `db:"from_address" json:"from_address"`
 	ToAddress    string `db:"to_address" json:"to_address"`
@@ -1036,6 +1699,7 @@ type DBTTxBtcToken struct {
 // const TTxBtcUxto full
 const (
 	DBColTTxBtcUxtoID           = "t_tx_btc_uxto.id"
+	DBColTTxBtcUxtoSymbol       = "t_tx_btc_uxto.symbol" // 币种
 	DBColTTxBtcUxtoUxtoType     = "t_tx_btc_uxto.uxto_type"
 	DBColTTxBtcUxtoBlockHash    = "t_tx_btc_uxto.block_hash"
 	DBColTTxBtcUxtoTxID         = "t_tx_btc_uxto.tx_id"
@@ -1054,6 +1718,7 @@ const (
 // const TTxBtcUxto short
 const (
 	DBColShortTTxBtcUxtoID           = "id"
+	DBColShortTTxBtcUxtoSymbol       = "symbol" // 币种
 	DBColShortTTxBtcUxtoUxtoType     = "uxto_type"
 	DBColShortTTxBtcUxtoBlockHash    = "block_hash"
 	DBColShortTTxBtcUxtoTxID         = "tx_id"
@@ -1072,6 +1737,7 @@ const (
 // DBColTTxBtcUxtoAll 所有字段
 var DBColTTxBtcUxtoAll = []string{
 	"t_tx_btc_uxto.id",
+	"t_tx_btc_uxto.symbol",
 	"t_tx_btc_uxto.uxto_type",
 	"t_tx_btc_uxto.block_hash",
 	"t_tx_btc_uxto.tx_id",
@@ -1091,6 +1757,7 @@ var DBColTTxBtcUxtoAll = []string{
 // DBTTxBtcUxto t_tx_btc_uxto
 /*
    id,
+   symbol,
    uxto_type,
    block_hash,
    tx_id,
@@ -1107,6 +1774,7 @@ var DBColTTxBtcUxtoAll = []string{
 */
 type DBTTxBtcUxto struct {
 	ID           int64  `db:"id" json:"id"`
+	Symbol       string `db:"symbol" json:"symbol"` // 币种
 	UxtoType     int64  `db:"uxto_type" json:"uxto_type"`
 	BlockHash    string `db:"block_hash" json:"block_hash"`
 	TxID         string `db:"tx_id" json:"tx_id"`
@@ -1126,6 +1794,7 @@ type DBTTxBtcUxto struct {
 const (
 	DBColTTxEosID           = "t_tx_eos.id"
 	DBColTTxEosProductID    = "t_tx_eos.product_id"
+	DBColTTxEosSymbol       = "t_tx_eos.symbol"
 	DBColTTxEosTxHash       = "t_tx_eos.tx_hash"
 	DBColTTxEosLogIndex     = "t_tx_eos.log_index"
 	DBColTTxEosFromAddress  = "t_tx_eos.from_address"
@@ -1136,12 +1805,15 @@ const (
 	DBColTTxEosHandleStatus = "t_tx_eos.handle_status"
 	DBColTTxEosHandleMsg    = "t_tx_eos.handle_msg"
 	DBColTTxEosHandleAt     = "t_tx_eos.handle_at"
+	DBColTTxEosBlockNum     = "t_tx_eos.block_num"
+	DBColTTxEosBlockID      = "t_tx_eos.block_id"
 )
 
 // const TTxEos short
 const (
 	DBColShortTTxEosID           = "id"
 	DBColShortTTxEosProductID    = "product_id"
+	DBColShortTTxEosSymbol       = "symbol"
 	DBColShortTTxEosTxHash       = "tx_hash"
 	DBColShortTTxEosLogIndex     = "log_index"
 	DBColShortTTxEosFromAddress  = "from_address"
@@ -1152,12 +1824,15 @@ const (
 	DBColShortTTxEosHandleStatus = "handle_status"
 	DBColShortTTxEosHandleMsg    = "handle_msg"
 	DBColShortTTxEosHandleAt     = "handle_at"
+	DBColShortTTxEosBlockNum     = "block_num"
+	DBColShortTTxEosBlockID      = "block_id"
 )
 
 // DBColTTxEosAll 所有字段
 var DBColTTxEosAll = []string{
 	"t_tx_eos.id",
 	"t_tx_eos.product_id",
+	"t_tx_eos.symbol",
 	"t_tx_eos.tx_hash",
 	"t_tx_eos.log_index",
 	"t_tx_eos.from_address",
@@ -1168,6 +1843,8 @@ var DBColTTxEosAll = []string{
 	"t_tx_eos.handle_status",
 	"t_tx_eos.handle_msg",
 	"t_tx_eos.handle_at",
+	"t_tx_eos.block_num",
+	"t_tx_eos.block_id",
 }
 
 // 表结构
@@ -1175,6 +1852,7 @@ var DBColTTxEosAll = []string{
 /*
    id,
    product_id,
+   symbol,
    tx_hash,
    log_index,
    from_address,
@@ -1184,11 +1862,14 @@ var DBColTTxEosAll = []string{
    create_at,
    handle_status,
    handle_msg,
-   handle_at
+   handle_at,
+   block_num,
+   block_id
 */
 type DBTTxEos struct {
 	ID           int64  `db:"id" json:"id"`
 	ProductID    int64  `db:"product_id" json:"product_id"`
+	Symbol       string `db:"symbol" json:"symbol"`
 	TxHash       string `db:"tx_hash" json:"tx_hash"`
 	LogIndex     int64  `db:"log_index" json:"log_index"`
 	FromAddress  string `db:"from_address" json:"from_address"`
@@ -1199,14 +1880,18 @@ type DBTTxEos struct {
 	HandleStatus int64  `db:"handle_status" json:"handle_status"`
 	HandleMsg    string `db:"handle_msg" json:"handle_msg"`
 	HandleAt     int64  `db:"handle_at" json:"handle_at"`
+	BlockNum     int64  `db:"block_num" json:"block_num"`
+	BlockID      string `db:"block_id" json:"block_id"`
 }
 
 // const TTxErc20 full
 const (
 	DBColTTxErc20ID           = "t_tx_erc20.id"
+	DBColTTxErc20ChainSymbol  = "t_tx_erc20.chain_symbol" // 所在链
 	DBColTTxErc20TokenID      = "t_tx_erc20.token_id"
 	DBColTTxErc20ProductID    = "t_tx_erc20.product_id"
 	DBColTTxErc20TxID         = "t_tx_erc20.tx_id"         // 交易id
+	DBColTTxErc20BlockNum     = "t_tx_erc20.block_num"     // 所在块高
 	DBColTTxErc20FromAddress  = "t_tx_erc20.from_address"  // 来源地址
 	DBColTTxErc20ToAddress    = "t_tx_erc20.to_address"    // 目标地址
 	DBColTTxErc20BalanceReal  = "t_tx_erc20.balance_real"  // 到账金额Ether
@@ -1222,9 +1907,11 @@ const (
 // const TTxErc20 short
 const (
 	DBColShortTTxErc20ID           = "id"
+	DBColShortTTxErc20ChainSymbol  = "chain_symbol" // 所在链
 	DBColShortTTxErc20TokenID      = "token_id"
 	DBColShortTTxErc20ProductID    = "product_id"
 	DBColShortTTxErc20TxID         = "tx_id"         // 交易id
+	DBColShortTTxErc20BlockNum     = "block_num"     // 所在块高
 	DBColShortTTxErc20FromAddress  = "from_address"  // 来源地址
 	DBColShortTTxErc20ToAddress    = "to_address"    // 目标地址
 	DBColShortTTxErc20BalanceReal  = "balance_real"  // 到账金额Ether
@@ -1240,9 +1927,11 @@ const (
 // DBColTTxErc20All 所有字段
 var DBColTTxErc20All = []string{
 	"t_tx_erc20.id",
+	"t_tx_erc20.chain_symbol",
 	"t_tx_erc20.token_id",
 	"t_tx_erc20.product_id",
 	"t_tx_erc20.tx_id",
+	"t_tx_erc20.block_num",
 	"t_tx_erc20.from_address",
 	"t_tx_erc20.to_address",
 	"t_tx_erc20.balance_real",
@@ -1259,9 +1948,11 @@ var DBColTTxErc20All = []string{
 // DBTTxErc20 t_tx_erc20
 /*
    id,
+   chain_symbol,
    token_id,
    product_id,
    tx_id,
+   block_num,
    from_address,
    to_address,
    balance_real,
@@ -1275,9 +1966,11 @@ var DBColTTxErc20All = []string{
 */
 type DBTTxErc20 struct {
 	ID           int64  `db:"id" json:"id"`
+	ChainSymbol  string `db:"chain_symbol" json:"chain_symbol"` // 所在链
 	TokenID      int64  `db:"token_id" json:"token_id"`
 	ProductID    int64  `db:"product_id" json:"product_id"`
 	TxID         string `db:"tx_id" json:"tx_id"`                 // 交易id
+	BlockNum     int64  `db:"block_num" json:"block_num"`         // 所在块高
 	FromAddress  string `db:"from_address" json:"from_address"`   // 来源地址
 	ToAddress    string `db:"to_address" json:"to_address"`       // 目标地址
 	BalanceReal  string `db:"balance_real" json:"balance_real"`   // 到账金额Ether
@@ -1290,6 +1983,288 @@ type DBTTxErc20 struct {
 	OrgTime      int64  `db:"org_time" json:"org_time"`           // 零钱整理时间
 }
 
+// const TTxSol full
+const (
+	DBColTTxSolID           = "t_tx_sol.id"
+	DBColTTxSolTokenID      = "t_tx_sol.token_id" // 代币id 0为sol
+	DBColTTxSolProductID    = "t_tx_sol.product_id"
+	DBColTTxSolTxID         = "t_tx_sol.tx_id"         // 交易签名
+	DBColTTxSolBlockNum     = "t_tx_sol.block_num"     // 所在slot
+	DBColTTxSolIxIndex      = "t_tx_sol.ix_index"      // 转账指令序号
+	DBColTTxSolFromAddress  = "t_tx_sol.from_address"  // 来源地址
+	DBColTTxSolToAddress    = "t_tx_sol.to_address"    // 目标地址
+	DBColTTxSolTokenAccount = "t_tx_sol.token_account" // 接收代币的账户 sol为空
+	DBColTTxSolBalanceReal  = "t_tx_sol.balance_real"  // 到账金额
+	DBColTTxSolCreateTime   = "t_tx_sol.create_time"   // 创建时间戳
+	DBColTTxSolHandleStatus = "t_tx_sol.handle_status" // 处理状态
+	DBColTTxSolHandleMsg    = "t_tx_sol.handle_msg"    // 处理消息
+	DBColTTxSolHandleTime   = "t_tx_sol.handle_time"   // 处理时间戳
+	DBColTTxSolOrgStatus    = "t_tx_sol.org_status"    // 零钱整理状态
+	DBColTTxSolOrgMsg       = "t_tx_sol.org_msg"       // 零钱整理消息
+	DBColTTxSolOrgTime      = "t_tx_sol.org_time"      // 零钱整理时间
+)
+
+// const TTxSol short
+const (
+	DBColShortTTxSolID           = "id"
+	DBColShortTTxSolTokenID      = "token_id" // 代币id 0为sol
+	DBColShortTTxSolProductID    = "product_id"
+	DBColShortTTxSolTxID         = "tx_id"         // 交易签名
+	DBColShortTTxSolBlockNum     = "block_num"     // 所在slot
+	DBColShortTTxSolIxIndex      = "ix_index"      // 转账指令序号
+	DBColShortTTxSolFromAddress  = "from_address"  // 来源地址
+	DBColShortTTxSolToAddress    = "to_address"    // 目标地址
+	DBColShortTTxSolTokenAccount = "token_account" // 接收代币的账户 sol为空
+	DBColShortTTxSolBalanceReal  = "balance_real"  // 到账金额
+	DBColShortTTxSolCreateTime   = "create_time"   // 创建时间戳
+	DBColShortTTxSolHandleStatus = "handle_status" // 处理状态
+	DBColShortTTxSolHandleMsg    = "handle_msg"    // 处理消息
+	DBColShortTTxSolHandleTime   = "handle_time"   // 处理时间戳
+	DBColShortTTxSolOrgStatus    = "org_status"    // 零钱整理状态
+	DBColShortTTxSolOrgMsg       = "org_msg"       // 零钱整理消息
+	DBColShortTTxSolOrgTime      = "org_time"      // 零钱整理时间
+)
+
+// DBColTTxSolAll 所有字段
+var DBColTTxSolAll = []string{
+	"t_tx_sol.id",
+	"t_tx_sol.token_id",
+	"t_tx_sol.product_id",
+	"t_tx_sol.tx_id",
+	"t_tx_sol.block_num",
+	"t_tx_sol.ix_index",
+	"t_tx_sol.from_address",
+	"t_tx_sol.to_address",
+	"t_tx_sol.token_account",
+	"t_tx_sol.balance_real",
+	"t_tx_sol.create_time",
+	"t_tx_sol.handle_status",
+	"t_tx_sol.handle_msg",
+	"t_tx_sol.handle_time",
+	"t_tx_sol.org_status",
+	"t_tx_sol.org_msg",
+	"t_tx_sol.org_time",
+}
+
+// 表结构
+// DBTTxSol t_tx_sol
+/*
+   id,
+   token_id,
+   product_id,
+   tx_id,
+   block_num,
+   ix_index,
+   from_address,
+   to_address,
+   token_account,
+   balance_real,
+   create_time,
+   handle_status,
+   handle_msg,
+   handle_time,
+   org_status,
+   org_msg,
+   org_time
+*/
+type DBTTxSol struct {
+	ID           int64  `db:"id" json:"id"`
+	TokenID      int64  `db:"token_id" json:"token_id"` // 代币id 0为sol
+	ProductID    int64  `db:"product_id" json:"product_id"`
+	TxID         string `db:"tx_id" json:"tx_id"`                 // 交易签名
+	BlockNum     int64  `db:"block_num" json:"block_num"`         // 所在slot
+	IxIndex      int64  `db:"ix_index" json:"ix_index"`           // 转账指令序号
+	FromAddress  string `db:"from_address" json:"from_address"`   // 来源地址
+	ToAddress    string `db:"to_address" json:"to_address"`       // 目标地址
+	TokenAccount string `db:"token_account" json:"token_account"` // 接收代币的账户 sol为空
+	BalanceReal  string `db:"balance_real" json:"balance_real"`   // 到账金额
+	CreateTime   int64  `db:"create_time" json:"create_time"`     // 创建时间戳
+	HandleStatus int64  `db:"handle_status" json:"handle_status"` // 处理状态
+	HandleMsg    string `db:"handle_msg" json:"handle_msg"`       // 处理消息
+	HandleTime   int64  `db:"handle_time" json:"handle_time"`     // 处理时间戳
+	OrgStatus    int64  `db:"org_status" json:"org_status"`       // 零钱整理状态
+	OrgMsg       string `db:"org_msg" json:"org_msg"`             // 零钱整理消息
+	OrgTime      int64  `db:"org_time" json:"org_time"`           // 零钱整理时间
+}
+
+// const TTxTrx full
+const (
+	DBColTTxTrxID           = "t_tx_trx.id"
+	DBColTTxTrxTokenID      = "t_tx_trx.token_id" // 代币id 0为trx
+	DBColTTxTrxProductID    = "t_tx_trx.product_id"
+	DBColTTxTrxTxID         = "t_tx_trx.tx_id"         // 交易id
+	DBColTTxTrxBlockNum     = "t_tx_trx.block_num"     // 所在块高
+	DBColTTxTrxLogIndex     = "t_tx_trx.log_index"     // trc20 log序号 trx为-1
+	DBColTTxTrxFromAddress  = "t_tx_trx.from_address"  // 来源地址
+	DBColTTxTrxToAddress    = "t_tx_trx.to_address"    // 目标地址
+	DBColTTxTrxBalanceReal  = "t_tx_trx.balance_real"  // 到账金额
+	DBColTTxTrxCreateTime   = "t_tx_trx.create_time"   // 创建时间戳
+	DBColTTxTrxHandleStatus = "t_tx_trx.handle_status" // 处理状态
+	DBColTTxTrxHandleMsg    = "t_tx_trx.handle_msg"    // 处理消息
+	DBColTTxTrxHandleTime   = "t_tx_trx.handle_time"   // 处理时间戳
+	DBColTTxTrxOrgStatus    = "t_tx_trx.org_status"    // 零钱整理状态
+	DBColTTxTrxOrgMsg       = "t_tx_trx.org_msg"       // 零钱整理消息
+	DBColTTxTrxOrgTime      = "t_tx_trx.org_time"      // 零钱整理时间
+)
+
+// const TTxTrx short
+const (
+	DBColShortTTxTrxID           = "id"
+	DBColShortTTxTrxTokenID      = "token_id" // 代币id 0为trx
+	DBColShortTTxTrxProductID    = "product_id"
+	DBColShortTTxTrxTxID         = "tx_id"         // 交易id
+	DBColShortTTxTrxBlockNum     = "block_num"     // 所在块高
+	DBColShortTTxTrxLogIndex     = "log_index"     // trc20 log序号 trx为-1
+	DBColShortTTxTrxFromAddress  = "from_address"  // 来源地址
+	DBColShortTTxTrxToAddress    = "to_address"    // 目标地址
+	DBColShortTTxTrxBalanceReal  = "balance_real"  // 到账金额
+	DBColShortTTxTrxCreateTime   = "create_time"   // 创建时间戳
+	DBColShortTTxTrxHandleStatus = "handle_status" // 处理状态
+	DBColShortTTxTrxHandleMsg    = "handle_msg"    // 处理消息
+	DBColShortTTxTrxHandleTime   = "handle_time"   // 处理时间戳
+	DBColShortTTxTrxOrgStatus    = "org_status"    // 零钱整理状态
+	DBColShortTTxTrxOrgMsg       = "org_msg"       // 零钱整理消息
+	DBColShortTTxTrxOrgTime      = "org_time"      // 零钱整理时间
+)
+
+// DBColTTxTrxAll 所有字段
+var DBColTTxTrxAll = []string{
+	"t_tx_trx.id",
+	"t_tx_trx.token_id",
+	"t_tx_trx.product_id",
+	"t_tx_trx.tx_id",
+	"t_tx_trx.block_num",
+	"t_tx_trx.log_index",
+	"t_tx_trx.from_address",
+	"t_tx_trx.to_address",
+	"t_tx_trx.balance_real",
+	"t_tx_trx.create_time",
+	"t_tx_trx.handle_status",
+	"t_tx_trx.handle_msg",
+	"t_tx_trx.handle_time",
+	"t_tx_trx.org_status",
+	"t_tx_trx.org_msg",
+	"t_tx_trx.org_time",
+}
+
+// 表结构
+// DBTTxTrx t_tx_trx
+/*
+   id,
+   token_id,
+   product_id,
+   tx_id,
+   block_num,
+   log_index,
+   from_address,
+   to_address,
+   balance_real,
+   create_time,
+   handle_status,
+   handle_msg,
+   handle_time,
+   org_status,
+   org_msg,
+   org_time
+*/
+type DBTTxTrx struct {
+	ID           int64  `db:"id" json:"id"`
+	TokenID      int64  `db:"token_id" json:"token_id"` // 代币id 0为trx
+	ProductID    int64  `db:"product_id" json:"product_id"`
+	TxID         string `db:"tx_id" json:"tx_id"`                 // 交易id
+	BlockNum     int64  `db:"block_num" json:"block_num"`         // 所在块高
+	LogIndex     int64  `db:"log_index" json:"log_index"`         // trc20 log序号 trx为-1
+	FromAddress  string `db:"from_address" json:"from_address"`   // 来源地址
+	ToAddress    string `db:"to_address" json:"to_address"`       // 目标地址
+	BalanceReal  string `db:"balance_real" json:"balance_real"`   // 到账金额
+	CreateTime   int64  `db:"create_time" json:"create_time"`     // 创建时间戳
+	HandleStatus int64  `db:"handle_status" json:"handle_status"` // 处理状态
+	HandleMsg    string `db:"handle_msg" json:"handle_msg"`       // 处理消息
+	HandleTime   int64  `db:"handle_time" json:"handle_time"`     // 处理时间戳
+	OrgStatus    int64  `db:"org_status" json:"org_status"`       // 零钱整理状态
+	OrgMsg       string `db:"org_msg" json:"org_msg"`             // 零钱整理消息
+	OrgTime      int64  `db:"org_time" json:"org_time"`           // 零钱整理时间
+}
+
+// const TTxXrp full
+const (
+	DBColTTxXrpID           = "t_tx_xrp.id"
+	DBColTTxXrpProductID    = "t_tx_xrp.product_id"
+	DBColTTxXrpTxHash       = "t_tx_xrp.tx_hash"
+	DBColTTxXrpLedgerIndex  = "t_tx_xrp.ledger_index" // 所在的validated ledger
+	DBColTTxXrpFromAddress  = "t_tx_xrp.from_address"
+	DBColTTxXrpToAddress    = "t_tx_xrp.to_address"
+	DBColTTxXrpMemo         = "t_tx_xrp.memo"         // destination tag
+	DBColTTxXrpBalanceReal  = "t_tx_xrp.balance_real" // 实际到账金额 delivered_amount
+	DBColTTxXrpCreateAt     = "t_tx_xrp.create_at"
+	DBColTTxXrpHandleStatus = "t_tx_xrp.handle_status"
+	DBColTTxXrpHandleMsg    = "t_tx_xrp.handle_msg"
+	DBColTTxXrpHandleAt     = "t_tx_xrp.handle_at"
+)
+
+// const TTxXrp short
+const (
+	DBColShortTTxXrpID           = "id"
+	DBColShortTTxXrpProductID    = "product_id"
+	DBColShortTTxXrpTxHash       = "tx_hash"
+	DBColShortTTxXrpLedgerIndex  = "ledger_index" // 所在的validated ledger
+	DBColShortTTxXrpFromAddress  = "from_address"
+	DBColShortTTxXrpToAddress    = "to_address"
+	DBColShortTTxXrpMemo         = "memo"         // destination tag
+	DBColShortTTxXrpBalanceReal  = "balance_real" // 实际到账金额 delivered_amount
+	DBColShortTTxXrpCreateAt     = "create_at"
+	DBColShortTTxXrpHandleStatus = "handle_status"
+	DBColShortTTxXrpHandleMsg    = "handle_msg"
+	DBColShortTTxXrpHandleAt     = "handle_at"
+)
+
+// DBColTTxXrpAll 所有字段
+var DBColTTxXrpAll = []string{
+	"t_tx_xrp.id",
+	"t_tx_xrp.product_id",
+	"t_tx_xrp.tx_hash",
+	"t_tx_xrp.ledger_index",
+	"t_tx_xrp.from_address",
+	"t_tx_xrp.to_address",
+	"t_tx_xrp.memo",
+	"t_tx_xrp.balance_real",
+	"t_tx_xrp.create_at",
+	"t_tx_xrp.handle_status",
+	"t_tx_xrp.handle_msg",
+	"t_tx_xrp.handle_at",
+}
+
+// 表结构
+// DBTTxXrp t_tx_xrp
+/*
+   id,
+   product_id,
+   tx_hash,
+   ledger_index,
+   from_address,
+   to_address,
+   memo,
+   balance_real,
+   create_at,
+   handle_status,
+   handle_msg,
+   handle_at
+*/
+type DBTTxXrp struct {
+	ID           int64  `db:"id" json:"id"`
+	ProductID    int64  `db:"product_id" json:"product_id"`
+	TxHash       string `db:"tx_hash" json:"tx_hash"`
+	LedgerIndex  int64  `db:"ledger_index" json:"ledger_index"` // 所在的validated ledger
+	FromAddress  string `db:"from_address" json:"from_address"`
+	ToAddress    string `db:"to_address" json:"to_address"`
+	Memo         string `db:"memo" json:"memo"`                 // destination tag
+	BalanceReal  string `db:"balance_real" json:"balance_real"` // 实际到账金额 delivered_amount
+	CreateAt     int64  `db:"create_at" json:"create_at"`
+	HandleStatus int64  `db:"handle_status" json:"handle_status"`
+	HandleMsg    string `db:"handle_msg" json:"handle_msg"`
+	HandleAt     int64  `db:"handle_at" json:"handle_at"`
+}
+
 // const TWithdraw full
 const (
 	DBColTWithdrawID           = "t_withdraw.id"
@@ -495,8 +495,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTAppConfigInt 创建
-func SQLCreateTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigInt, isIgnore bool) (int64, error) {
+// SQLCreateTAddressUser 创建
+func SQLCreateTAddressUser(ctx context.Context, tx mcommon.DbExeAble, row *DBTAddressUser, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -504,29 +504,41 @@ func SQLCreateTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTA
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_int ( ")
+	query.WriteString("INTO t_address_user ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       k,
-       v
+       product_id,
+       user_id,
+       symbol,
+       address,
+       label,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :k,
-    :v
+    :product_id,
+    :user_id,
+    :symbol,
+    :address,
+    :label,
+    :create_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":          row.ID,
+			"product_id":  row.ProductID,
+			"user_id":     row.UserID,
+			"symbol":      row.Symbol,
+			"address":     row.Address,
+			"label":       row.Label,
+			"create_time": row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -535,25 +547,33 @@ func SQLCreateTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTA
 	return lastID, nil
 }
 
-// SQLCreateTAppConfigIntDuplicate 创建更新
-func SQLCreateTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigInt, updates []string) (int64, error) {
+// SQLCreateTAddressUserDuplicate 创建更新
+func SQLCreateTAddressUserDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAddressUser, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_int ( ")
+	query.WriteString("INSERT INTO t_address_user ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       k,
-       v
+       product_id,
+       user_id,
+       symbol,
+       address,
+       label,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :k,
-    :v
+    :product_id,
+    :user_id,
+    :symbol,
+    :address,
+    :label,
+    :create_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -576,9 +596,13 @@ func SQLCreateTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":          row.ID,
+			"product_id":  row.ProductID,
+			"user_id":     row.UserID,
+			"symbol":      row.Symbol,
+			"address":     row.Address,
+			"label":       row.Label,
+			"create_time": row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -587,8 +611,8 @@ func SQLCreateTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	return lastID, nil
 }
 
-// SQLCreateManyTAppConfigInt 创建多个
-func SQLCreateManyTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigInt, isIgnore bool) (int64, error) {
+// SQLCreateManyTAddressUser 创建多个
+func SQLCreateManyTAddressUser(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAddressUser, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -599,8 +623,12 @@ func SQLCreateManyTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, rows
 				args,
 				[]interface{}{
 					row.ID,
-					row.K,
-					row.V,
+					row.ProductID,
+					row.UserID,
+					row.Symbol,
+					row.Address,
+					row.Label,
+					row.CreateTime,
 				},
 			)
 		}
@@ -609,8 +637,12 @@ func SQLCreateManyTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, rows
 			args = append(
 				args,
 				[]interface{}{
-					row.K,
-					row.V,
+					row.ProductID,
+					row.UserID,
+					row.Symbol,
+					row.Address,
+					row.Label,
+					row.CreateTime,
 				},
 			)
 		}
@@ -622,13 +654,17 @@ func SQLCreateManyTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, rows
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_int ( ")
+	query.WriteString("INTO t_address_user ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    k,
-    v
+    product_id,
+    user_id,
+    symbol,
+    address,
+    label,
+    create_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -644,8 +680,8 @@ func SQLCreateManyTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, rows
 	return count, nil
 }
 
-// SQLCreateManyTAppConfigIntDuplicate 创建多个
-func SQLCreateManyTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigInt, updates []string) (int64, error) {
+// SQLCreateManyTAddressUserDuplicate 创建多个
+func SQLCreateManyTAddressUserDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAddressUser, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -656,8 +692,12 @@ func SQLCreateManyTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 				args,
 				[]interface{}{
 					row.ID,
-					row.K,
-					row.V,
+					row.ProductID,
+					row.UserID,
+					row.Symbol,
+					row.Address,
+					row.Label,
+					row.CreateTime,
 				},
 			)
 		}
@@ -666,8 +706,12 @@ func SQLCreateManyTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 			args = append(
 				args,
 				[]interface{}{
-					row.K,
-					row.V,
+					row.ProductID,
+					row.UserID,
+					row.Symbol,
+					row.Address,
+					row.Label,
+					row.CreateTime,
 				},
 			)
 		}
@@ -675,13 +719,17 @@ func SQLCreateManyTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_int ( ")
+	query.WriteString("INSERT INTO t_address_user ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    k,
-    v
+    product_id,
+    user_id,
+    symbol,
+    address,
+    label,
+    create_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -713,18 +761,18 @@ func SQLCreateManyTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	return count, nil
 }
 
-// SQLGetTAppConfigIntCol 根据id查询
-func SQLGetTAppConfigIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigInt, error) {
+// SQLGetTAddressUserCol 根据id查询
+func SQLGetTAddressUserCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAddressUser, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_int
+	t_address_user
 WHERE
 	id=:id`)
 
-	var row DBTAppConfigInt
+	var row DBTAddressUser
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -743,8 +791,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTAppConfigIntColKV 根据id查询
-func SQLGetTAppConfigIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigInt, error) {
+// SQLGetTAddressUserColKV 根据id查询
+func SQLGetTAddressUserColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAddressUser, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -755,7 +803,7 @@ func SQLGetTAppConfigIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_int
+	t_address_user
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -785,7 +833,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTAppConfigInt
+	var row DBTAddressUser
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -802,8 +850,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTAppConfigIntCol 根据ids获取
-func SQLSelectTAppConfigIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigInt, error) {
+// SQLSelectTAddressUserCol 根据ids获取
+func SQLSelectTAddressUserCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAddressUser, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -812,7 +860,7 @@ func SQLSelectTAppConfigIntCol(ctx context.Context, tx mcommon.DbExeAble, cols [
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_int
+	t_address_user
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -826,7 +874,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTAppConfigInt
+	var rows []*DBTAddressUser
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -842,8 +890,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTAppConfigIntColKV 根据ids获取
-func SQLSelectTAppConfigIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigInt, error) {
+// SQLSelectTAddressUserColKV 根据ids获取
+func SQLSelectTAddressUserColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAddressUser, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -854,7 +902,7 @@ func SQLSelectTAppConfigIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_int
+	t_address_user
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -895,7 +943,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTAppConfigInt
+	var rows []*DBTAddressUser
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -909,22 +957,30 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTAppConfigInt 更新
-func SQLUpdateTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigInt) (int64, error) {
+// SQLUpdateTAddressUser 更新
+func SQLUpdateTAddressUser(ctx context.Context, tx mcommon.DbExeAble, row *DBTAddressUser) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_app_config_int
+	t_address_user
 SET
-    k=:k,
-    v=:v
+    product_id=:product_id,
+    user_id=:user_id,
+    symbol=:symbol,
+    address=:address,
+    label=:label,
+    create_time=:create_time
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":          row.ID,
+			"product_id":  row.ProductID,
+			"user_id":     row.UserID,
+			"symbol":      row.Symbol,
+			"address":     row.Address,
+			"label":       row.Label,
+			"create_time": row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -933,14 +989,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTAppConfigInt 删除
-func SQLDeleteTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAddressUser 删除
+func SQLDeleteTAddressUser(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_app_config_int
+	t_address_user
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -953,8 +1009,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTAppConfigStr 创建
-func SQLCreateTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigStr, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigInt 创建
+func SQLCreateTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigInt, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -962,7 +1018,7 @@ func SQLCreateTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, row *DBTA
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_str ( ")
+	query.WriteString("INTO t_app_config_int ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
@@ -993,12 +1049,12 @@ func SQLCreateTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, row *DBTA
 	return lastID, nil
 }
 
-// SQLCreateTAppConfigStrDuplicate 创建更新
-func SQLCreateTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigStr, updates []string) (int64, error) {
+// SQLCreateTAppConfigIntDuplicate 创建更新
+func SQLCreateTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigInt, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_str ( ")
+	query.WriteString("INSERT INTO t_app_config_int ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
@@ -1045,8 +1101,8 @@ func SQLCreateTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	return lastID, nil
 }
 
-// SQLCreateManyTAppConfigStr 创建多个
-func SQLCreateManyTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigStr, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppConfigInt 创建多个
+func SQLCreateManyTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigInt, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -1080,7 +1136,7 @@ func SQLCreateManyTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, rows
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_str ( ")
+	query.WriteString("INTO t_app_config_int ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
@@ -1102,8 +1158,8 @@ func SQLCreateManyTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, rows
 	return count, nil
 }
 
-// SQLCreateManyTAppConfigStrDuplicate 创建多个
-func SQLCreateManyTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigStr, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigIntDuplicate 创建多个
+func SQLCreateManyTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigInt, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -1133,7 +1189,7 @@ func SQLCreateManyTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_str ( ")
+	query.WriteString("INSERT INTO t_app_config_int ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
@@ -1171,18 +1227,18 @@ func SQLCreateManyTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	return count, nil
 }
 
-// SQLGetTAppConfigStrCol 根据id查询
-func SQLGetTAppConfigStrCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigStr, error) {
+// SQLGetTAppConfigIntCol 根据id查询
+func SQLGetTAppConfigIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigInt, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_str
+	t_app_config_int
 WHERE
 	id=:id`)
 
-	var row DBTAppConfigStr
+	var row DBTAppConfigInt
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -1201,8 +1257,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTAppConfigStrColKV 根据id查询
-func SQLGetTAppConfigStrColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigStr, error) {
+// SQLGetTAppConfigIntColKV 根据id查询
+func SQLGetTAppConfigIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigInt, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -1213,7 +1269,7 @@ func SQLGetTAppConfigStrColKV(ctx context.Context, tx mcommon.DbExeAble, cols []
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_str
+	t_app_config_int
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -1243,7 +1299,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTAppConfigStr
+	var row DBTAppConfigInt
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -1260,8 +1316,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTAppConfigStrCol 根据ids获取
-func SQLSelectTAppConfigStrCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigStr, error) {
+// SQLSelectTAppConfigIntCol 根据ids获取
+func SQLSelectTAppConfigIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigInt, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -1270,7 +1326,7 @@ func SQLSelectTAppConfigStrCol(ctx context.Context, tx mcommon.DbExeAble, cols [
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_str
+	t_app_config_int
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -1284,7 +1340,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTAppConfigStr
+	var rows []*DBTAppConfigInt
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -1300,8 +1356,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTAppConfigStrColKV 根据ids获取
-func SQLSelectTAppConfigStrColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigStr, error) {
+// SQLSelectTAppConfigIntColKV 根据ids获取
+func SQLSelectTAppConfigIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigInt, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -1312,7 +1368,7 @@ func SQLSelectTAppConfigStrColKV(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_str
+	t_app_config_int
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -1353,7 +1409,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTAppConfigStr
+	var rows []*DBTAppConfigInt
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -1367,13 +1423,13 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTAppConfigStr 更新
-func SQLUpdateTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigStr) (int64, error) {
+// SQLUpdateTAppConfigInt 更新
+func SQLUpdateTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigInt) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_app_config_str
+	t_app_config_int
 SET
     k=:k,
     v=:v
@@ -1391,14 +1447,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTAppConfigStr 删除
-func SQLDeleteTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigInt 删除
+func SQLDeleteTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_app_config_str
+	t_app_config_int
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -1411,8 +1467,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTAppConfigToken 创建
-func SQLCreateTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigToken, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigStr 创建
+func SQLCreateTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigStr, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -1420,44 +1476,29 @@ func SQLCreateTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row *DB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_token ( ")
+	query.WriteString("INTO t_app_config_str ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       token_address,
-       token_decimals,
-       token_symbol,
-       cold_address,
-       hot_address,
-       org_min_balance,
-       create_time
+       k,
+       v
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :token_address,
-    :token_decimals,
-    :token_symbol,
-    :cold_address,
-    :hot_address,
-    :org_min_balance,
-    :create_time
+    :k,
+    :v
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":              row.ID,
-			"token_address":   row.TokenAddress,
-			"token_decimals":  row.TokenDecimals,
-			"token_symbol":    row.TokenSymbol,
-			"cold_address":    row.ColdAddress,
-			"hot_address":     row.HotAddress,
-			"org_min_balance": row.OrgMinBalance,
-			"create_time":     row.CreateTime,
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
 		},
 	)
 	if err != nil {
@@ -1466,35 +1507,25 @@ func SQLCreateTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row *DB
 	return lastID, nil
 }
 
-// SQLCreateTAppConfigTokenDuplicate 创建更新
-func SQLCreateTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigToken, updates []string) (int64, error) {
+// SQLCreateTAppConfigStrDuplicate 创建更新
+func SQLCreateTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigStr, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_token ( ")
+	query.WriteString("INSERT INTO t_app_config_str ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       token_address,
-       token_decimals,
-       token_symbol,
-       cold_address,
-       hot_address,
-       org_min_balance,
-       create_time
+       k,
+       v
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :token_address,
-    :token_decimals,
-    :token_symbol,
-    :cold_address,
-    :hot_address,
-    :org_min_balance,
-    :create_time
+    :k,
+    :v
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -1517,14 +1548,9 @@ func SQLCreateTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":              row.ID,
-			"token_address":   row.TokenAddress,
-			"token_decimals":  row.TokenDecimals,
-			"token_symbol":    row.TokenSymbol,
-			"cold_address":    row.ColdAddress,
-			"hot_address":     row.HotAddress,
-			"org_min_balance": row.OrgMinBalance,
-			"create_time":     row.CreateTime,
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
 		},
 	)
 	if err != nil {
@@ -1533,9 +1559,9 @@ func SQLCreateTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble
 	return lastID, nil
 }
 
-// SQLCreateManyTAppConfigToken 创建多个
-func SQLCreateManyTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigToken, isIgnore bool) (int64, error) {
-	if len(rows) == 0 {
+// SQLCreateManyTAppConfigStr 创建多个
+func SQLCreateManyTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigStr, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
 		return 0, nil
 	}
 	var args []interface{}
@@ -1545,13 +1571,8 @@ func SQLCreateManyTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row
 				args,
 				[]interface{}{
 					row.ID,
-					row.TokenAddress,
-					row.TokenDecimals,
-					row.TokenSymbol,
-					row.ColdAddress,
-					row.HotAddress,
-					row.OrgMinBalance,
-					row.CreateTime,
+					row.K,
+					row.V,
 				},
 			)
 		}
@@ -1560,13 +1581,8 @@ func SQLCreateManyTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row
 			args = append(
 				args,
 				[]interface{}{
-					row.TokenAddress,
-					row.TokenDecimals,
-					row.TokenSymbol,
-					row.ColdAddress,
-					row.HotAddress,
-					row.OrgMinBalance,
-					row.CreateTime,
+					row.K,
+					row.V,
 				},
 			)
 		}
@@ -1578,18 +1594,13 @@ func SQLCreateManyTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_token ( ")
+	query.WriteString("INTO t_app_config_str ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    token_address,
-    token_decimals,
-    token_symbol,
-    cold_address,
-    hot_address,
-    org_min_balance,
-    create_time
+    k,
+    v
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -1605,8 +1616,8 @@ func SQLCreateManyTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row
 	return count, nil
 }
 
-// SQLCreateManyTAppConfigTokenDuplicate 创建多个
-func SQLCreateManyTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigToken, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigStrDuplicate 创建多个
+func SQLCreateManyTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigStr, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -1617,13 +1628,8 @@ func SQLCreateManyTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExe
 				args,
 				[]interface{}{
 					row.ID,
-					row.TokenAddress,
-					row.TokenDecimals,
-					row.TokenSymbol,
-					row.ColdAddress,
-					row.HotAddress,
-					row.OrgMinBalance,
-					row.CreateTime,
+					row.K,
+					row.V,
 				},
 			)
 		}
@@ -1632,13 +1638,8 @@ func SQLCreateManyTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExe
 			args = append(
 				args,
 				[]interface{}{
-					row.TokenAddress,
-					row.TokenDecimals,
-					row.TokenSymbol,
-					row.ColdAddress,
-					row.HotAddress,
-					row.OrgMinBalance,
-					row.CreateTime,
+					row.K,
+					row.V,
 				},
 			)
 		}
@@ -1646,18 +1647,13 @@ func SQLCreateManyTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExe
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_token ( ")
+	query.WriteString("INSERT INTO t_app_config_str ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    token_address,
-    token_decimals,
-    token_symbol,
-    cold_address,
-    hot_address,
-    org_min_balance,
-    create_time
+    k,
+    v
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -1689,18 +1685,18 @@ func SQLCreateManyTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExe
 	return count, nil
 }
 
-// SQLGetTAppConfigTokenCol 根据id查询
-func SQLGetTAppConfigTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigToken, error) {
+// SQLGetTAppConfigStrCol 根据id查询
+func SQLGetTAppConfigStrCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigStr, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token
+	t_app_config_str
 WHERE
 	id=:id`)
 
-	var row DBTAppConfigToken
+	var row DBTAppConfigStr
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -1719,8 +1715,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTAppConfigTokenColKV 根据id查询
-func SQLGetTAppConfigTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigToken, error) {
+// SQLGetTAppConfigStrColKV 根据id查询
+func SQLGetTAppConfigStrColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigStr, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -1731,7 +1727,7 @@ func SQLGetTAppConfigTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token
+	t_app_config_str
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -1761,7 +1757,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTAppConfigToken
+	var row DBTAppConfigStr
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -1778,8 +1774,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTAppConfigTokenCol 根据ids获取
-func SQLSelectTAppConfigTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigToken, error) {
+// SQLSelectTAppConfigStrCol 根据ids获取
+func SQLSelectTAppConfigStrCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigStr, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -1788,7 +1784,7 @@ func SQLSelectTAppConfigTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token
+	t_app_config_str
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -1802,7 +1798,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTAppConfigToken
+	var rows []*DBTAppConfigStr
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -1818,8 +1814,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTAppConfigTokenColKV 根据ids获取
-func SQLSelectTAppConfigTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigToken, error) {
+// SQLSelectTAppConfigStrColKV 根据ids获取
+func SQLSelectTAppConfigStrColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigStr, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -1830,7 +1826,7 @@ func SQLSelectTAppConfigTokenColKV(ctx context.Context, tx mcommon.DbExeAble, co
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token
+	t_app_config_str
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -1871,7 +1867,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTAppConfigToken
+	var rows []*DBTAppConfigStr
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -1885,32 +1881,22 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTAppConfigToken 更新
-func SQLUpdateTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigToken) (int64, error) {
+// SQLUpdateTAppConfigStr 更新
+func SQLUpdateTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigStr) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_app_config_token
+	t_app_config_str
 SET
-    token_address=:token_address,
-    token_decimals=:token_decimals,
-    token_symbol=:token_symbol,
-    cold_address=:cold_address,
-    hot_address=:hot_address,
-    org_min_balance=:org_min_balance,
-    create_time=:create_time
+    k=:k,
+    v=:v
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":              row.ID,
-			"token_address":   row.TokenAddress,
-			"token_decimals":  row.TokenDecimals,
-			"token_symbol":    row.TokenSymbol,
-			"cold_address":    row.ColdAddress,
-			"hot_address":     row.HotAddress,
-			"org_min_balance": row.OrgMinBalance,
-			"create_time":     row.CreateTime,
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
 		},
 	)
 	if err != nil {
@@ -1919,14 +1905,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTAppConfigToken 删除
-func SQLDeleteTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigStr 删除
+func SQLDeleteTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_app_config_token
+	t_app_config_str
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -1939,8 +1925,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTAppConfigTokenBtc 创建
-func SQLCreateTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenBtc, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigToken 创建
+func SQLCreateTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigToken, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -1948,44 +1934,47 @@ func SQLCreateTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, row
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_token_btc ( ")
+	query.WriteString("INTO t_app_config_token ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       token_index,
+       chain_symbol,
+       token_address,
+       token_decimals,
        token_symbol,
        cold_address,
        hot_address,
-       fee_address,
-       tx_org_min_balance,
-       create_at
+       org_min_balance,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :token_index,
+    :chain_symbol,
+    :token_address,
+    :token_decimals,
     :token_symbol,
     :cold_address,
     :hot_address,
-    :fee_address,
-    :tx_org_min_balance,
-    :create_at
+    :org_min_balance,
+    :create_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":                 row.ID,
-			"token_index":        row.TokenIndex,
-			"token_symbol":       row.TokenSymbol,
-			"cold_address":       row.ColdAddress,
-			"hot_address":        row.HotAddress,
-			"fee_address":        row.FeeAddress,
-			"tx_org_min_balance": row.TxOrgMinBalance,
-			"create_at":          row.CreateAt,
+			"id":              row.ID,
+			"chain_symbol":    row.ChainSymbol,
+			"token_address":   row.TokenAddress,
+			"token_decimals":  row.TokenDecimals,
+			"token_symbol":    row.TokenSymbol,
+			"cold_address":    row.ColdAddress,
+			"hot_address":     row.HotAddress,
+			"org_min_balance": row.OrgMinBalance,
+			"create_time":     row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -1994,35 +1983,37 @@ func SQLCreateTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, row
 	return lastID, nil
 }
 
-// SQLCreateTAppConfigTokenBtcDuplicate 创建更新
-func SQLCreateTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenBtc, updates []string) (int64, error) {
+// SQLCreateTAppConfigTokenDuplicate 创建更新
+func SQLCreateTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigToken, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_token_btc ( ")
+	query.WriteString("INSERT INTO t_app_config_token ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       token_index,
+       chain_symbol,
+       token_address,
+       token_decimals,
        token_symbol,
        cold_address,
        hot_address,
-       fee_address,
-       tx_org_min_balance,
-       create_at
+       org_min_balance,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :token_index,
+    :chain_symbol,
+    :token_address,
+    :token_decimals,
     :token_symbol,
     :cold_address,
     :hot_address,
-    :fee_address,
-    :tx_org_min_balance,
-    :create_at
+    :org_min_balance,
+    :create_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -2045,14 +2036,15 @@ func SQLCreateTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.DbExeA
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":                 row.ID,
-			"token_index":        row.TokenIndex,
-			"token_symbol":       row.TokenSymbol,
-			"cold_address":       row.ColdAddress,
-			"hot_address":        row.HotAddress,
-			"fee_address":        row.FeeAddress,
-			"tx_org_min_balance": row.TxOrgMinBalance,
-			"create_at":          row.CreateAt,
+			"id":              row.ID,
+			"chain_symbol":    row.ChainSymbol,
+			"token_address":   row.TokenAddress,
+			"token_decimals":  row.TokenDecimals,
+			"token_symbol":    row.TokenSymbol,
+			"cold_address":    row.ColdAddress,
+			"hot_address":     row.HotAddress,
+			"org_min_balance": row.OrgMinBalance,
+			"create_time":     row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -2061,8 +2053,8 @@ func SQLCreateTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.DbExeA
 	return lastID, nil
 }
 
-// SQLCreateManyTAppConfigTokenBtc 创建多个
-func SQLCreateManyTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenBtc, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppConfigToken 创建多个
+func SQLCreateManyTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigToken, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -2073,13 +2065,14 @@ func SQLCreateManyTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble,
 				args,
 				[]interface{}{
 					row.ID,
-					row.TokenIndex,
+					row.ChainSymbol,
+					row.TokenAddress,
+					row.TokenDecimals,
 					row.TokenSymbol,
 					row.ColdAddress,
 					row.HotAddress,
-					row.FeeAddress,
-					row.TxOrgMinBalance,
-					row.CreateAt,
+					row.OrgMinBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -2088,13 +2081,14 @@ func SQLCreateManyTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble,
 			args = append(
 				args,
 				[]interface{}{
-					row.TokenIndex,
+					row.ChainSymbol,
+					row.TokenAddress,
+					row.TokenDecimals,
 					row.TokenSymbol,
 					row.ColdAddress,
 					row.HotAddress,
-					row.FeeAddress,
-					row.TxOrgMinBalance,
-					row.CreateAt,
+					row.OrgMinBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -2106,18 +2100,19 @@ func SQLCreateManyTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble,
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_token_btc ( ")
+	query.WriteString("INTO t_app_config_token ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    token_index,
+    chain_symbol,
+    token_address,
+    token_decimals,
     token_symbol,
     cold_address,
     hot_address,
-    fee_address,
-    tx_org_min_balance,
-    create_at
+    org_min_balance,
+    create_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -2133,8 +2128,8 @@ func SQLCreateManyTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble,
 	return count, nil
 }
 
-// SQLCreateManyTAppConfigTokenBtcDuplicate 创建多个
-func SQLCreateManyTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenBtc, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigTokenDuplicate 创建多个
+func SQLCreateManyTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigToken, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -2145,13 +2140,14 @@ func SQLCreateManyTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.Db
 				args,
 				[]interface{}{
 					row.ID,
-					row.TokenIndex,
+					row.ChainSymbol,
+					row.TokenAddress,
+					row.TokenDecimals,
 					row.TokenSymbol,
 					row.ColdAddress,
 					row.HotAddress,
-					row.FeeAddress,
-					row.TxOrgMinBalance,
-					row.CreateAt,
+					row.OrgMinBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -2160,13 +2156,14 @@ func SQLCreateManyTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.Db
 			args = append(
 				args,
 				[]interface{}{
-					row.TokenIndex,
+					row.ChainSymbol,
+					row.TokenAddress,
+					row.TokenDecimals,
 					row.TokenSymbol,
 					row.ColdAddress,
 					row.HotAddress,
-					row.FeeAddress,
-					row.TxOrgMinBalance,
-					row.CreateAt,
+					row.OrgMinBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -2174,18 +2171,19 @@ func SQLCreateManyTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.Db
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_token_btc ( ")
+	query.WriteString("INSERT INTO t_app_config_token ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    token_index,
+    chain_symbol,
+    token_address,
+    token_decimals,
     token_symbol,
     cold_address,
     hot_address,
-    fee_address,
-    tx_org_min_balance,
-    create_at
+    org_min_balance,
+    create_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -2217,18 +2215,18 @@ func SQLCreateManyTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.Db
 	return count, nil
 }
 
-// SQLGetTAppConfigTokenBtcCol 根据id查询
-func SQLGetTAppConfigTokenBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigTokenBtc, error) {
+// SQLGetTAppConfigTokenCol 根据id查询
+func SQLGetTAppConfigTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigToken, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token_btc
+	t_app_config_token
 WHERE
 	id=:id`)
 
-	var row DBTAppConfigTokenBtc
+	var row DBTAppConfigToken
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -2247,8 +2245,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTAppConfigTokenBtcColKV 根据id查询
-func SQLGetTAppConfigTokenBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigTokenBtc, error) {
+// SQLGetTAppConfigTokenColKV 根据id查询
+func SQLGetTAppConfigTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigToken, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -2259,7 +2257,7 @@ func SQLGetTAppConfigTokenBtcColKV(ctx context.Context, tx mcommon.DbExeAble, co
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token_btc
+	t_app_config_token
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -2289,7 +2287,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTAppConfigTokenBtc
+	var row DBTAppConfigToken
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -2306,8 +2304,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTAppConfigTokenBtcCol 根据ids获取
-func SQLSelectTAppConfigTokenBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigTokenBtc, error) {
+// SQLSelectTAppConfigTokenCol 根据ids获取
+func SQLSelectTAppConfigTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigToken, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -2316,7 +2314,7 @@ func SQLSelectTAppConfigTokenBtcCol(ctx context.Context, tx mcommon.DbExeAble, c
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token_btc
+	t_app_config_token
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -2330,7 +2328,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTAppConfigTokenBtc
+	var rows []*DBTAppConfigToken
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -2346,8 +2344,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTAppConfigTokenBtcColKV 根据ids获取
-func SQLSelectTAppConfigTokenBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigTokenBtc, error) {
+// SQLSelectTAppConfigTokenColKV 根据ids获取
+func SQLSelectTAppConfigTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigToken, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -2358,7 +2356,7 @@ func SQLSelectTAppConfigTokenBtcColKV(ctx context.Context, tx mcommon.DbExeAble,
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token_btc
+	t_app_config_token
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -2399,7 +2397,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTAppConfigTokenBtc
+	var rows []*DBTAppConfigToken
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -2413,32 +2411,34 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTAppConfigTokenBtc 更新
-func SQLUpdateTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenBtc) (int64, error) {
+// SQLUpdateTAppConfigToken 更新
+func SQLUpdateTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigToken) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_app_config_token_btc
+	t_app_config_token
 SET
-    token_index=:token_index,
+    chain_symbol=:chain_symbol,
+    token_address=:token_address,
+    token_decimals=:token_decimals,
     token_symbol=:token_symbol,
     cold_address=:cold_address,
     hot_address=:hot_address,
-    fee_address=:fee_address,
-    tx_org_min_balance=:tx_org_min_balance,
-    create_at=:create_at
+    org_min_balance=:org_min_balance,
+    create_time=:create_time
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":                 row.ID,
-			"token_index":        row.TokenIndex,
-			"token_symbol":       row.TokenSymbol,
-			"cold_address":       row.ColdAddress,
-			"hot_address":        row.HotAddress,
-			"fee_address":        row.FeeAddress,
-			"tx_org_min_balance": row.TxOrgMinBalance,
-			"create_at":          row.CreateAt,
+			"id":              row.ID,
+			"chain_symbol":    row.ChainSymbol,
+			"token_address":   row.TokenAddress,
+			"token_decimals":  row.TokenDecimals,
+			"token_symbol":    row.TokenSymbol,
+			"cold_address":    row.ColdAddress,
+			"hot_address":     row.HotAddress,
+			"org_min_balance": row.OrgMinBalance,
+			"create_time":     row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -2447,14 +2447,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTAppConfigTokenBtc 删除
-func SQLDeleteTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigToken 删除
+func SQLDeleteTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_app_config_token_btc
+	t_app_config_token
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -2467,8 +2467,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTAppLock 创建
-func SQLCreateTAppLock(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLock, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigTokenBtc 创建
+func SQLCreateTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenBtc, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -2476,32 +2476,44 @@ func SQLCreateTAppLock(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLoc
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_lock ( ")
+	query.WriteString("INTO t_app_config_token_btc ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       k,
-       v,
-       create_time
+       token_index,
+       token_symbol,
+       cold_address,
+       hot_address,
+       fee_address,
+       tx_org_min_balance,
+       create_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :k,
-    :v,
-    :create_time
+    :token_index,
+    :token_symbol,
+    :cold_address,
+    :hot_address,
+    :fee_address,
+    :tx_org_min_balance,
+    :create_at
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":          row.ID,
-			"k":           row.K,
-			"v":           row.V,
-			"create_time": row.CreateTime,
+			"id":                 row.ID,
+			"token_index":        row.TokenIndex,
+			"token_symbol":       row.TokenSymbol,
+			"cold_address":       row.ColdAddress,
+			"hot_address":        row.HotAddress,
+			"fee_address":        row.FeeAddress,
+			"tx_org_min_balance": row.TxOrgMinBalance,
+			"create_at":          row.CreateAt,
 		},
 	)
 	if err != nil {
@@ -2510,27 +2522,35 @@ func SQLCreateTAppLock(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLoc
 	return lastID, nil
 }
 
-// SQLCreateTAppLockDuplicate 创建更新
-func SQLCreateTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLock, updates []string) (int64, error) {
+// SQLCreateTAppConfigTokenBtcDuplicate 创建更新
+func SQLCreateTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenBtc, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_lock ( ")
+	query.WriteString("INSERT INTO t_app_config_token_btc ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       k,
-       v,
-       create_time
+       token_index,
+       token_symbol,
+       cold_address,
+       hot_address,
+       fee_address,
+       tx_org_min_balance,
+       create_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :k,
-    :v,
-    :create_time
+    :token_index,
+    :token_symbol,
+    :cold_address,
+    :hot_address,
+    :fee_address,
+    :tx_org_min_balance,
+    :create_at
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -2553,10 +2573,14 @@ func SQLCreateTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":          row.ID,
-			"k":           row.K,
-			"v":           row.V,
-			"create_time": row.CreateTime,
+			"id":                 row.ID,
+			"token_index":        row.TokenIndex,
+			"token_symbol":       row.TokenSymbol,
+			"cold_address":       row.ColdAddress,
+			"hot_address":        row.HotAddress,
+			"fee_address":        row.FeeAddress,
+			"tx_org_min_balance": row.TxOrgMinBalance,
+			"create_at":          row.CreateAt,
 		},
 	)
 	if err != nil {
@@ -2565,8 +2589,8 @@ func SQLCreateTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 	return lastID, nil
 }
 
-// SQLCreateManyTAppLock 创建多个
-func SQLCreateManyTAppLock(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppLock, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppConfigTokenBtc 创建多个
+func SQLCreateManyTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenBtc, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -2577,9 +2601,13 @@ func SQLCreateManyTAppLock(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 				args,
 				[]interface{}{
 					row.ID,
-					row.K,
-					row.V,
-					row.CreateTime,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.FeeAddress,
+					row.TxOrgMinBalance,
+					row.CreateAt,
 				},
 			)
 		}
@@ -2588,9 +2616,13 @@ func SQLCreateManyTAppLock(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 			args = append(
 				args,
 				[]interface{}{
-					row.K,
-					row.V,
-					row.CreateTime,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.FeeAddress,
+					row.TxOrgMinBalance,
+					row.CreateAt,
 				},
 			)
 		}
@@ -2602,14 +2634,18 @@ func SQLCreateManyTAppLock(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_lock ( ")
+	query.WriteString("INTO t_app_config_token_btc ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    k,
-    v,
-    create_time
+    token_index,
+    token_symbol,
+    cold_address,
+    hot_address,
+    fee_address,
+    tx_org_min_balance,
+    create_at
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -2625,8 +2661,8 @@ func SQLCreateManyTAppLock(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	return count, nil
 }
 
-// SQLCreateManyTAppLockDuplicate 创建多个
-func SQLCreateManyTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppLock, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigTokenBtcDuplicate 创建多个
+func SQLCreateManyTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenBtc, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -2637,9 +2673,13 @@ func SQLCreateManyTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 				args,
 				[]interface{}{
 					row.ID,
-					row.K,
-					row.V,
-					row.CreateTime,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.FeeAddress,
+					row.TxOrgMinBalance,
+					row.CreateAt,
 				},
 			)
 		}
@@ -2648,9 +2688,13 @@ func SQLCreateManyTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 			args = append(
 				args,
 				[]interface{}{
-					row.K,
-					row.V,
-					row.CreateTime,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.FeeAddress,
+					row.TxOrgMinBalance,
+					row.CreateAt,
 				},
 			)
 		}
@@ -2658,14 +2702,18 @@ func SQLCreateManyTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_lock ( ")
+	query.WriteString("INSERT INTO t_app_config_token_btc ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    k,
-    v,
-    create_time
+    token_index,
+    token_symbol,
+    cold_address,
+    hot_address,
+    fee_address,
+    tx_org_min_balance,
+    create_at
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -2697,18 +2745,18 @@ func SQLCreateManyTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	return count, nil
 }
 
-// SQLGetTAppLockCol 根据id查询
-func SQLGetTAppLockCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppLock, error) {
+// SQLGetTAppConfigTokenBtcCol 根据id查询
+func SQLGetTAppConfigTokenBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigTokenBtc, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_lock
+	t_app_config_token_btc
 WHERE
 	id=:id`)
 
-	var row DBTAppLock
+	var row DBTAppConfigTokenBtc
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -2727,8 +2775,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTAppLockColKV 根据id查询
-func SQLGetTAppLockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppLock, error) {
+// SQLGetTAppConfigTokenBtcColKV 根据id查询
+func SQLGetTAppConfigTokenBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigTokenBtc, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -2739,7 +2787,7 @@ func SQLGetTAppLockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_lock
+	t_app_config_token_btc
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -2769,7 +2817,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTAppLock
+	var row DBTAppConfigTokenBtc
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -2786,8 +2834,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTAppLockCol 根据ids获取
-func SQLSelectTAppLockCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppLock, error) {
+// SQLSelectTAppConfigTokenBtcCol 根据ids获取
+func SQLSelectTAppConfigTokenBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigTokenBtc, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -2796,7 +2844,7 @@ func SQLSelectTAppLockCol(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_lock
+	t_app_config_token_btc
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -2810,7 +2858,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTAppLock
+	var rows []*DBTAppConfigTokenBtc
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -2826,8 +2874,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTAppLockColKV 根据ids获取
-func SQLSelectTAppLockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppLock, error) {
+// SQLSelectTAppConfigTokenBtcColKV 根据ids获取
+func SQLSelectTAppConfigTokenBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigTokenBtc, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -2838,7 +2886,7 @@ func SQLSelectTAppLockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_lock
+	t_app_config_token_btc
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -2879,7 +2927,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTAppLock
+	var rows []*DBTAppConfigTokenBtc
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -2893,24 +2941,32 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTAppLock 更新
-func SQLUpdateTAppLock(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLock) (int64, error) {
+// SQLUpdateTAppConfigTokenBtc 更新
+func SQLUpdateTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenBtc) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_app_lock
+	t_app_config_token_btc
 SET
-    k=:k,
-    v=:v,
-    create_time=:create_time
+    token_index=:token_index,
+    token_symbol=:token_symbol,
+    cold_address=:cold_address,
+    hot_address=:hot_address,
+    fee_address=:fee_address,
+    tx_org_min_balance=:tx_org_min_balance,
+    create_at=:create_at
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":          row.ID,
-			"k":           row.K,
-			"v":           row.V,
-			"create_time": row.CreateTime,
+			"id":                 row.ID,
+			"token_index":        row.TokenIndex,
+			"token_symbol":       row.TokenSymbol,
+			"cold_address":       row.ColdAddress,
+			"hot_address":        row.HotAddress,
+			"fee_address":        row.FeeAddress,
+			"tx_org_min_balance": row.TxOrgMinBalance,
+			"create_at":          row.CreateAt,
 		},
 	)
 	if err != nil {
@@ -2919,14 +2975,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTAppLock 删除
-func SQLDeleteTAppLock(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigTokenBtc 删除
+func SQLDeleteTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_app_lock
+	t_app_config_token_btc
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -2939,8 +2995,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTAppStatusInt 创建
-func SQLCreateTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppStatusInt, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigTokenEos 创建
+func SQLCreateTAppConfigTokenEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenEos, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -2948,29 +3004,38 @@ func SQLCreateTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTA
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_status_int ( ")
+	query.WriteString("INTO t_app_config_token_eos ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       k,
-       v
+       chain_symbol,
+       token_address,
+       token_symbol,
+       token_decimals,
+       create_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :k,
-    :v
+    :chain_symbol,
+    :token_address,
+    :token_symbol,
+    :token_decimals,
+    :create_at
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":             row.ID,
+			"chain_symbol":   row.ChainSymbol,
+			"token_address":  row.TokenAddress,
+			"token_symbol":   row.TokenSymbol,
+			"token_decimals": row.TokenDecimals,
+			"create_at":      row.CreateAt,
 		},
 	)
 	if err != nil {
@@ -2979,25 +3044,31 @@ func SQLCreateTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTA
 	return lastID, nil
 }
 
-// SQLCreateTAppStatusIntDuplicate 创建更新
-func SQLCreateTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppStatusInt, updates []string) (int64, error) {
+// SQLCreateTAppConfigTokenEosDuplicate 创建更新
+func SQLCreateTAppConfigTokenEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenEos, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_status_int ( ")
+	query.WriteString("INSERT INTO t_app_config_token_eos ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       k,
-       v
+       chain_symbol,
+       token_address,
+       token_symbol,
+       token_decimals,
+       create_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :k,
-    :v
+    :chain_symbol,
+    :token_address,
+    :token_symbol,
+    :token_decimals,
+    :create_at
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -3020,9 +3091,12 @@ func SQLCreateTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":             row.ID,
+			"chain_symbol":   row.ChainSymbol,
+			"token_address":  row.TokenAddress,
+			"token_symbol":   row.TokenSymbol,
+			"token_decimals": row.TokenDecimals,
+			"create_at":      row.CreateAt,
 		},
 	)
 	if err != nil {
@@ -3031,8 +3105,8 @@ func SQLCreateTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	return lastID, nil
 }
 
-// SQLCreateManyTAppStatusInt 创建多个
-func SQLCreateManyTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppStatusInt, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppConfigTokenEos 创建多个
+func SQLCreateManyTAppConfigTokenEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenEos, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -3043,8 +3117,11 @@ func SQLCreateManyTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, rows
 				args,
 				[]interface{}{
 					row.ID,
-					row.K,
-					row.V,
+					row.ChainSymbol,
+					row.TokenAddress,
+					row.TokenSymbol,
+					row.TokenDecimals,
+					row.CreateAt,
 				},
 			)
 		}
@@ -3053,8 +3130,11 @@ func SQLCreateManyTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, rows
 			args = append(
 				args,
 				[]interface{}{
-					row.K,
-					row.V,
+					row.ChainSymbol,
+					row.TokenAddress,
+					row.TokenSymbol,
+					row.TokenDecimals,
+					row.CreateAt,
 				},
 			)
 		}
@@ -3066,13 +3146,16 @@ func SQLCreateManyTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, rows
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_status_int ( ")
+	query.WriteString("INTO t_app_config_token_eos ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    k,
-    v
+    chain_symbol,
+    token_address,
+    token_symbol,
+    token_decimals,
+    create_at
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -3088,8 +3171,8 @@ func SQLCreateManyTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, rows
 	return count, nil
 }
 
-// SQLCreateManyTAppStatusIntDuplicate 创建多个
-func SQLCreateManyTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppStatusInt, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigTokenEosDuplicate 创建多个
+func SQLCreateManyTAppConfigTokenEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenEos, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -3100,8 +3183,11 @@ func SQLCreateManyTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 				args,
 				[]interface{}{
 					row.ID,
-					row.K,
-					row.V,
+					row.ChainSymbol,
+					row.TokenAddress,
+					row.TokenSymbol,
+					row.TokenDecimals,
+					row.CreateAt,
 				},
 			)
 		}
@@ -3110,8 +3196,11 @@ func SQLCreateManyTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 			args = append(
 				args,
 				[]interface{}{
-					row.K,
-					row.V,
+					row.ChainSymbol,
+					row.TokenAddress,
+					row.TokenSymbol,
+					row.TokenDecimals,
+					row.CreateAt,
 				},
 			)
 		}
@@ -3119,13 +3208,16 @@ func SQLCreateManyTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_status_int ( ")
+	query.WriteString("INSERT INTO t_app_config_token_eos ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    k,
-    v
+    chain_symbol,
+    token_address,
+    token_symbol,
+    token_decimals,
+    create_at
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -3157,18 +3249,18 @@ func SQLCreateManyTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	return count, nil
 }
 
-// SQLGetTAppStatusIntCol 根据id查询
-func SQLGetTAppStatusIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppStatusInt, error) {
+// SQLGetTAppConfigTokenEosCol 根据id查询
+func SQLGetTAppConfigTokenEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigTokenEos, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_status_int
+	t_app_config_token_eos
 WHERE
 	id=:id`)
 
-	var row DBTAppStatusInt
+	var row DBTAppConfigTokenEos
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -3187,8 +3279,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTAppStatusIntColKV 根据id查询
-func SQLGetTAppStatusIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppStatusInt, error) {
+// SQLGetTAppConfigTokenEosColKV 根据id查询
+func SQLGetTAppConfigTokenEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigTokenEos, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -3199,7 +3291,7 @@ func SQLGetTAppStatusIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_status_int
+	t_app_config_token_eos
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -3229,7 +3321,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTAppStatusInt
+	var row DBTAppConfigTokenEos
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -3246,8 +3338,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTAppStatusIntCol 根据ids获取
-func SQLSelectTAppStatusIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppStatusInt, error) {
+// SQLSelectTAppConfigTokenEosCol 根据ids获取
+func SQLSelectTAppConfigTokenEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigTokenEos, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -3256,7 +3348,7 @@ func SQLSelectTAppStatusIntCol(ctx context.Context, tx mcommon.DbExeAble, cols [
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_status_int
+	t_app_config_token_eos
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -3270,7 +3362,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTAppStatusInt
+	var rows []*DBTAppConfigTokenEos
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -3286,8 +3378,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTAppStatusIntColKV 根据ids获取
-func SQLSelectTAppStatusIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppStatusInt, error) {
+// SQLSelectTAppConfigTokenEosColKV 根据ids获取
+func SQLSelectTAppConfigTokenEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigTokenEos, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -3298,7 +3390,7 @@ func SQLSelectTAppStatusIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_status_int
+	t_app_config_token_eos
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -3339,7 +3431,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTAppStatusInt
+	var rows []*DBTAppConfigTokenEos
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -3353,22 +3445,28 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTAppStatusInt 更新
-func SQLUpdateTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppStatusInt) (int64, error) {
+// SQLUpdateTAppConfigTokenEos 更新
+func SQLUpdateTAppConfigTokenEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenEos) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_app_status_int
+	t_app_config_token_eos
 SET
-    k=:k,
-    v=:v
+    chain_symbol=:chain_symbol,
+    token_address=:token_address,
+    token_symbol=:token_symbol,
+    token_decimals=:token_decimals,
+    create_at=:create_at
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":             row.ID,
+			"chain_symbol":   row.ChainSymbol,
+			"token_address":  row.TokenAddress,
+			"token_symbol":   row.TokenSymbol,
+			"token_decimals": row.TokenDecimals,
+			"create_at":      row.CreateAt,
 		},
 	)
 	if err != nil {
@@ -3377,14 +3475,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTAppStatusInt 删除
-func SQLDeleteTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigTokenEos 删除
+func SQLDeleteTAppConfigTokenEos(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_app_status_int
+	t_app_config_token_eos
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -3397,8 +3495,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTProduct 创建
-func SQLCreateTProduct(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduct, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigTokenSol 创建
+func SQLCreateTAppConfigTokenSol(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenSol, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -3406,35 +3504,44 @@ func SQLCreateTProduct(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduc
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_product ( ")
+	query.WriteString("INTO t_app_config_token_sol ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       app_name,
-       app_sk,
-       cb_url,
-       whitelist_ip
+       token_address,
+       token_decimals,
+       token_symbol,
+       cold_address,
+       hot_address,
+       org_min_balance,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :app_name,
-    :app_sk,
-    :cb_url,
-    :whitelist_ip
+    :token_address,
+    :token_decimals,
+    :token_symbol,
+    :cold_address,
+    :hot_address,
+    :org_min_balance,
+    :create_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":           row.ID,
-			"app_name":     row.AppName,
-			"app_sk":       row.AppSk,
-			"cb_url":       row.CbURL,
-			"whitelist_ip": row.WhitelistIP,
+			"id":              row.ID,
+			"token_address":   row.TokenAddress,
+			"token_decimals":  row.TokenDecimals,
+			"token_symbol":    row.TokenSymbol,
+			"cold_address":    row.ColdAddress,
+			"hot_address":     row.HotAddress,
+			"org_min_balance": row.OrgMinBalance,
+			"create_time":     row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -3443,29 +3550,35 @@ func SQLCreateTProduct(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduc
 	return lastID, nil
 }
 
-// SQLCreateTProductDuplicate 创建更新
-func SQLCreateTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduct, updates []string) (int64, error) {
+// SQLCreateTAppConfigTokenSolDuplicate 创建更新
+func SQLCreateTAppConfigTokenSolDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenSol, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_product ( ")
+	query.WriteString("INSERT INTO t_app_config_token_sol ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       app_name,
-       app_sk,
-       cb_url,
-       whitelist_ip
+       token_address,
+       token_decimals,
+       token_symbol,
+       cold_address,
+       hot_address,
+       org_min_balance,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :app_name,
-    :app_sk,
-    :cb_url,
-    :whitelist_ip
+    :token_address,
+    :token_decimals,
+    :token_symbol,
+    :cold_address,
+    :hot_address,
+    :org_min_balance,
+    :create_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -3488,11 +3601,14 @@ func SQLCreateTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":           row.ID,
-			"app_name":     row.AppName,
-			"app_sk":       row.AppSk,
-			"cb_url":       row.CbURL,
-			"whitelist_ip": row.WhitelistIP,
+			"id":              row.ID,
+			"token_address":   row.TokenAddress,
+			"token_decimals":  row.TokenDecimals,
+			"token_symbol":    row.TokenSymbol,
+			"cold_address":    row.ColdAddress,
+			"hot_address":     row.HotAddress,
+			"org_min_balance": row.OrgMinBalance,
+			"create_time":     row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -3501,8 +3617,8 @@ func SQLCreateTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 	return lastID, nil
 }
 
-// SQLCreateManyTProduct 创建多个
-func SQLCreateManyTProduct(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProduct, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppConfigTokenSol 创建多个
+func SQLCreateManyTAppConfigTokenSol(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenSol, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -3513,10 +3629,13 @@ func SQLCreateManyTProduct(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 				args,
 				[]interface{}{
 					row.ID,
-					row.AppName,
-					row.AppSk,
-					row.CbURL,
-					row.WhitelistIP,
+					row.TokenAddress,
+					row.TokenDecimals,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.OrgMinBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -3525,10 +3644,13 @@ func SQLCreateManyTProduct(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 			args = append(
 				args,
 				[]interface{}{
-					row.AppName,
-					row.AppSk,
-					row.CbURL,
-					row.WhitelistIP,
+					row.TokenAddress,
+					row.TokenDecimals,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.OrgMinBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -3540,15 +3662,18 @@ func SQLCreateManyTProduct(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_product ( ")
+	query.WriteString("INTO t_app_config_token_sol ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    app_name,
-    app_sk,
-    cb_url,
-    whitelist_ip
+    token_address,
+    token_decimals,
+    token_symbol,
+    cold_address,
+    hot_address,
+    org_min_balance,
+    create_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -3564,8 +3689,8 @@ func SQLCreateManyTProduct(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	return count, nil
 }
 
-// SQLCreateManyTProductDuplicate 创建多个
-func SQLCreateManyTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProduct, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigTokenSolDuplicate 创建多个
+func SQLCreateManyTAppConfigTokenSolDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenSol, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -3576,10 +3701,13 @@ func SQLCreateManyTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 				args,
 				[]interface{}{
 					row.ID,
-					row.AppName,
-					row.AppSk,
-					row.CbURL,
-					row.WhitelistIP,
+					row.TokenAddress,
+					row.TokenDecimals,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.OrgMinBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -3588,10 +3716,13 @@ func SQLCreateManyTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 			args = append(
 				args,
 				[]interface{}{
-					row.AppName,
-					row.AppSk,
-					row.CbURL,
-					row.WhitelistIP,
+					row.TokenAddress,
+					row.TokenDecimals,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.OrgMinBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -3599,15 +3730,18 @@ func SQLCreateManyTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_product ( ")
+	query.WriteString("INSERT INTO t_app_config_token_sol ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    app_name,
-    app_sk,
-    cb_url,
-    whitelist_ip
+    token_address,
+    token_decimals,
+    token_symbol,
+    cold_address,
+    hot_address,
+    org_min_balance,
+    create_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -3639,18 +3773,18 @@ func SQLCreateManyTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	return count, nil
 }
 
-// SQLGetTProductCol 根据id查询
-func SQLGetTProductCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTProduct, error) {
+// SQLGetTAppConfigTokenSolCol 根据id查询
+func SQLGetTAppConfigTokenSolCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigTokenSol, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product
+	t_app_config_token_sol
 WHERE
 	id=:id`)
 
-	var row DBTProduct
+	var row DBTAppConfigTokenSol
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -3669,8 +3803,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTProductColKV 根据id查询
-func SQLGetTProductColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTProduct, error) {
+// SQLGetTAppConfigTokenSolColKV 根据id查询
+func SQLGetTAppConfigTokenSolColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigTokenSol, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -3681,7 +3815,7 @@ func SQLGetTProductColKV(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product
+	t_app_config_token_sol
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -3711,7 +3845,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTProduct
+	var row DBTAppConfigTokenSol
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -3728,8 +3862,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTProductCol 根据ids获取
-func SQLSelectTProductCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTProduct, error) {
+// SQLSelectTAppConfigTokenSolCol 根据ids获取
+func SQLSelectTAppConfigTokenSolCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigTokenSol, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -3738,7 +3872,7 @@ func SQLSelectTProductCol(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product
+	t_app_config_token_sol
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -3752,7 +3886,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTProduct
+	var rows []*DBTAppConfigTokenSol
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -3768,8 +3902,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTProductColKV 根据ids获取
-func SQLSelectTProductColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTProduct, error) {
+// SQLSelectTAppConfigTokenSolColKV 根据ids获取
+func SQLSelectTAppConfigTokenSolColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigTokenSol, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -3780,7 +3914,7 @@ func SQLSelectTProductColKV(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product
+	t_app_config_token_sol
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -3821,7 +3955,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTProduct
+	var rows []*DBTAppConfigTokenSol
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -3835,26 +3969,32 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTProduct 更新
-func SQLUpdateTProduct(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduct) (int64, error) {
+// SQLUpdateTAppConfigTokenSol 更新
+func SQLUpdateTAppConfigTokenSol(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenSol) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_product
+	t_app_config_token_sol
 SET
-    app_name=:app_name,
-    app_sk=:app_sk,
-    cb_url=:cb_url,
-    whitelist_ip=:whitelist_ip
+    token_address=:token_address,
+    token_decimals=:token_decimals,
+    token_symbol=:token_symbol,
+    cold_address=:cold_address,
+    hot_address=:hot_address,
+    org_min_balance=:org_min_balance,
+    create_time=:create_time
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":           row.ID,
-			"app_name":     row.AppName,
-			"app_sk":       row.AppSk,
-			"cb_url":       row.CbURL,
-			"whitelist_ip": row.WhitelistIP,
+			"id":              row.ID,
+			"token_address":   row.TokenAddress,
+			"token_decimals":  row.TokenDecimals,
+			"token_symbol":    row.TokenSymbol,
+			"cold_address":    row.ColdAddress,
+			"hot_address":     row.HotAddress,
+			"org_min_balance": row.OrgMinBalance,
+			"create_time":     row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -3863,14 +4003,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTProduct 删除
-func SQLDeleteTProduct(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigTokenSol 删除
+func SQLDeleteTAppConfigTokenSol(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_product
+	t_app_config_token_sol
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -3883,8 +4023,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTProductNonce 创建
-func SQLCreateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNonce, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigTokenTrx 创建
+func SQLCreateTAppConfigTokenTrx(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenTrx, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -3892,19 +4032,29 @@ func SQLCreateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTP
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_product_nonce ( ")
+	query.WriteString("INTO t_app_config_token_trx ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       c,
+       token_address,
+       token_decimals,
+       token_symbol,
+       cold_address,
+       hot_address,
+       org_min_balance,
        create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :c,
+    :token_address,
+    :token_decimals,
+    :token_symbol,
+    :cold_address,
+    :hot_address,
+    :org_min_balance,
     :create_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
@@ -3912,9 +4062,14 @@ func SQLCreateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTP
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":          row.ID,
-			"c":           row.C,
-			"create_time": row.CreateTime,
+			"id":              row.ID,
+			"token_address":   row.TokenAddress,
+			"token_decimals":  row.TokenDecimals,
+			"token_symbol":    row.TokenSymbol,
+			"cold_address":    row.ColdAddress,
+			"hot_address":     row.HotAddress,
+			"org_min_balance": row.OrgMinBalance,
+			"create_time":     row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -3923,24 +4078,34 @@ func SQLCreateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTP
 	return lastID, nil
 }
 
-// SQLCreateTProductNonceDuplicate 创建更新
-func SQLCreateTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNonce, updates []string) (int64, error) {
+// SQLCreateTAppConfigTokenTrxDuplicate 创建更新
+func SQLCreateTAppConfigTokenTrxDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenTrx, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_product_nonce ( ")
+	query.WriteString("INSERT INTO t_app_config_token_trx ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       c,
+       token_address,
+       token_decimals,
+       token_symbol,
+       cold_address,
+       hot_address,
+       org_min_balance,
        create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :c,
+    :token_address,
+    :token_decimals,
+    :token_symbol,
+    :cold_address,
+    :hot_address,
+    :org_min_balance,
     :create_time
 ) `)
 	updatesLen := len(updates)
@@ -3964,9 +4129,14 @@ func SQLCreateTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":          row.ID,
-			"c":           row.C,
-			"create_time": row.CreateTime,
+			"id":              row.ID,
+			"token_address":   row.TokenAddress,
+			"token_decimals":  row.TokenDecimals,
+			"token_symbol":    row.TokenSymbol,
+			"cold_address":    row.ColdAddress,
+			"hot_address":     row.HotAddress,
+			"org_min_balance": row.OrgMinBalance,
+			"create_time":     row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -3975,8 +4145,8 @@ func SQLCreateTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	return lastID, nil
 }
 
-// SQLCreateManyTProductNonce 创建多个
-func SQLCreateManyTProductNonce(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNonce, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppConfigTokenTrx 创建多个
+func SQLCreateManyTAppConfigTokenTrx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenTrx, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -3987,7 +4157,12 @@ func SQLCreateManyTProductNonce(ctx context.Context, tx mcommon.DbExeAble, rows
 				args,
 				[]interface{}{
 					row.ID,
-					row.C,
+					row.TokenAddress,
+					row.TokenDecimals,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.OrgMinBalance,
 					row.CreateTime,
 				},
 			)
@@ -3997,7 +4172,12 @@ func SQLCreateManyTProductNonce(ctx context.Context, tx mcommon.DbExeAble, rows
 			args = append(
 				args,
 				[]interface{}{
-					row.C,
+					row.TokenAddress,
+					row.TokenDecimals,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.OrgMinBalance,
 					row.CreateTime,
 				},
 			)
@@ -4010,12 +4190,17 @@ func SQLCreateManyTProductNonce(ctx context.Context, tx mcommon.DbExeAble, rows
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_product_nonce ( ")
+	query.WriteString("INTO t_app_config_token_trx ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    c,
+    token_address,
+    token_decimals,
+    token_symbol,
+    cold_address,
+    hot_address,
+    org_min_balance,
     create_time
 ) VALUES
     %s`)
@@ -4032,8 +4217,8 @@ func SQLCreateManyTProductNonce(ctx context.Context, tx mcommon.DbExeAble, rows
 	return count, nil
 }
 
-// SQLCreateManyTProductNonceDuplicate 创建多个
-func SQLCreateManyTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNonce, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigTokenTrxDuplicate 创建多个
+func SQLCreateManyTAppConfigTokenTrxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenTrx, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -4044,7 +4229,12 @@ func SQLCreateManyTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAb
 				args,
 				[]interface{}{
 					row.ID,
-					row.C,
+					row.TokenAddress,
+					row.TokenDecimals,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.OrgMinBalance,
 					row.CreateTime,
 				},
 			)
@@ -4054,7 +4244,12 @@ func SQLCreateManyTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAb
 			args = append(
 				args,
 				[]interface{}{
-					row.C,
+					row.TokenAddress,
+					row.TokenDecimals,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.OrgMinBalance,
 					row.CreateTime,
 				},
 			)
@@ -4063,12 +4258,17 @@ func SQLCreateManyTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_product_nonce ( ")
+	query.WriteString("INSERT INTO t_app_config_token_trx ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    c,
+    token_address,
+    token_decimals,
+    token_symbol,
+    cold_address,
+    hot_address,
+    org_min_balance,
     create_time
 ) VALUES
     %s`)
@@ -4101,18 +4301,18 @@ func SQLCreateManyTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	return count, nil
 }
 
-// SQLGetTProductNonceCol 根据id查询
-func SQLGetTProductNonceCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTProductNonce, error) {
+// SQLGetTAppConfigTokenTrxCol 根据id查询
+func SQLGetTAppConfigTokenTrxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigTokenTrx, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_nonce
+	t_app_config_token_trx
 WHERE
 	id=:id`)
 
-	var row DBTProductNonce
+	var row DBTAppConfigTokenTrx
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -4131,8 +4331,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTProductNonceColKV 根据id查询
-func SQLGetTProductNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTProductNonce, error) {
+// SQLGetTAppConfigTokenTrxColKV 根据id查询
+func SQLGetTAppConfigTokenTrxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigTokenTrx, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -4143,7 +4343,7 @@ func SQLGetTProductNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_nonce
+	t_app_config_token_trx
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -4173,7 +4373,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTProductNonce
+	var row DBTAppConfigTokenTrx
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -4190,8 +4390,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTProductNonceCol 根据ids获取
-func SQLSelectTProductNonceCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTProductNonce, error) {
+// SQLSelectTAppConfigTokenTrxCol 根据ids获取
+func SQLSelectTAppConfigTokenTrxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigTokenTrx, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -4200,7 +4400,7 @@ func SQLSelectTProductNonceCol(ctx context.Context, tx mcommon.DbExeAble, cols [
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_nonce
+	t_app_config_token_trx
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -4214,7 +4414,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTProductNonce
+	var rows []*DBTAppConfigTokenTrx
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -4230,8 +4430,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTProductNonceColKV 根据ids获取
-func SQLSelectTProductNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTProductNonce, error) {
+// SQLSelectTAppConfigTokenTrxColKV 根据ids获取
+func SQLSelectTAppConfigTokenTrxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigTokenTrx, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -4242,7 +4442,7 @@ func SQLSelectTProductNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_nonce
+	t_app_config_token_trx
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -4283,7 +4483,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTProductNonce
+	var rows []*DBTAppConfigTokenTrx
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -4297,22 +4497,32 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTProductNonce 更新
-func SQLUpdateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNonce) (int64, error) {
+// SQLUpdateTAppConfigTokenTrx 更新
+func SQLUpdateTAppConfigTokenTrx(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenTrx) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_product_nonce
+	t_app_config_token_trx
 SET
-    c=:c,
+    token_address=:token_address,
+    token_decimals=:token_decimals,
+    token_symbol=:token_symbol,
+    cold_address=:cold_address,
+    hot_address=:hot_address,
+    org_min_balance=:org_min_balance,
     create_time=:create_time
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":          row.ID,
-			"c":           row.C,
-			"create_time": row.CreateTime,
+			"id":              row.ID,
+			"token_address":   row.TokenAddress,
+			"token_decimals":  row.TokenDecimals,
+			"token_symbol":    row.TokenSymbol,
+			"cold_address":    row.ColdAddress,
+			"hot_address":     row.HotAddress,
+			"org_min_balance": row.OrgMinBalance,
+			"create_time":     row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -4321,14 +4531,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTProductNonce 删除
-func SQLDeleteTProductNonce(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigTokenTrx 删除
+func SQLDeleteTAppConfigTokenTrx(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_product_nonce
+	t_app_config_token_trx
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -4341,8 +4551,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTProductNotify 创建
-func SQLCreateTProductNotify(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNotify, isIgnore bool) (int64, error) {
+// SQLCreateTAppLock 创建
+func SQLCreateTAppLock(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLock, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -4350,59 +4560,32 @@ func SQLCreateTProductNotify(ctx context.Context, tx mcommon.DbExeAble, row *DBT
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_product_notify ( ")
+	query.WriteString("INTO t_app_lock ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       nonce,
-       product_id,
-       item_type,
-       item_id,
-       notify_type,
-       token_symbol,
-       url,
-       msg,
-       handle_status,
-       handle_msg,
-       create_time,
-       update_time
+       k,
+       v,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :nonce,
-    :product_id,
-    :item_type,
-    :item_id,
-    :notify_type,
-    :token_symbol,
-    :url,
-    :msg,
-    :handle_status,
-    :handle_msg,
-    :create_time,
-    :update_time
+    :k,
+    :v,
+    :create_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":            row.ID,
-			"nonce":         row.Nonce,
-			"product_id":    row.ProductID,
-			"item_type":     row.ItemType,
-			"item_id":       row.ItemID,
-			"notify_type":   row.NotifyType,
-			"token_symbol":  row.TokenSymbol,
-			"url":           row.URL,
-			"msg":           row.Msg,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"create_time":   row.CreateTime,
-			"update_time":   row.UpdateTime,
+			"id":          row.ID,
+			"k":           row.K,
+			"v":           row.V,
+			"create_time": row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -4411,45 +4594,27 @@ func SQLCreateTProductNotify(ctx context.Context, tx mcommon.DbExeAble, row *DBT
 	return lastID, nil
 }
 
-// SQLCreateTProductNotifyDuplicate 创建更新
-func SQLCreateTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNotify, updates []string) (int64, error) {
+// SQLCreateTAppLockDuplicate 创建更新
+func SQLCreateTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLock, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_product_notify ( ")
+	query.WriteString("INSERT INTO t_app_lock ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       nonce,
-       product_id,
-       item_type,
-       item_id,
-       notify_type,
-       token_symbol,
-       url,
-       msg,
-       handle_status,
-       handle_msg,
-       create_time,
-       update_time
+       k,
+       v,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :nonce,
-    :product_id,
-    :item_type,
-    :item_id,
-    :notify_type,
-    :token_symbol,
-    :url,
-    :msg,
-    :handle_status,
-    :handle_msg,
-    :create_time,
-    :update_time
+    :k,
+    :v,
+    :create_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -4472,19 +4637,10 @@ func SQLCreateTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":            row.ID,
-			"nonce":         row.Nonce,
-			"product_id":    row.ProductID,
-			"item_type":     row.ItemType,
-			"item_id":       row.ItemID,
-			"notify_type":   row.NotifyType,
-			"token_symbol":  row.TokenSymbol,
-			"url":           row.URL,
-			"msg":           row.Msg,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"create_time":   row.CreateTime,
-			"update_time":   row.UpdateTime,
+			"id":          row.ID,
+			"k":           row.K,
+			"v":           row.V,
+			"create_time": row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -4493,8 +4649,8 @@ func SQLCreateTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	return lastID, nil
 }
 
-// SQLCreateManyTProductNotify 创建多个
-func SQLCreateManyTProductNotify(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNotify, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppLock 创建多个
+func SQLCreateManyTAppLock(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppLock, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -4505,18 +4661,9 @@ func SQLCreateManyTProductNotify(ctx context.Context, tx mcommon.DbExeAble, rows
 				args,
 				[]interface{}{
 					row.ID,
-					row.Nonce,
-					row.ProductID,
-					row.ItemType,
-					row.ItemID,
-					row.NotifyType,
-					row.TokenSymbol,
-					row.URL,
-					row.Msg,
-					row.HandleStatus,
-					row.HandleMsg,
+					row.K,
+					row.V,
 					row.CreateTime,
-					row.UpdateTime,
 				},
 			)
 		}
@@ -4525,18 +4672,9 @@ func SQLCreateManyTProductNotify(ctx context.Context, tx mcommon.DbExeAble, rows
 			args = append(
 				args,
 				[]interface{}{
-					row.Nonce,
-					row.ProductID,
-					row.ItemType,
-					row.ItemID,
-					row.NotifyType,
-					row.TokenSymbol,
-					row.URL,
-					row.Msg,
-					row.HandleStatus,
-					row.HandleMsg,
+					row.K,
+					row.V,
 					row.CreateTime,
-					row.UpdateTime,
 				},
 			)
 		}
@@ -4548,23 +4686,14 @@ func SQLCreateManyTProductNotify(ctx context.Context, tx mcommon.DbExeAble, rows
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_product_notify ( ")
+	query.WriteString("INTO t_app_lock ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    nonce,
-    product_id,
-    item_type,
-    item_id,
-    notify_type,
-    token_symbol,
-    url,
-    msg,
-    handle_status,
-    handle_msg,
-    create_time,
-    update_time
+    k,
+    v,
+    create_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -4580,8 +4709,8 @@ func SQLCreateManyTProductNotify(ctx context.Context, tx mcommon.DbExeAble, rows
 	return count, nil
 }
 
-// SQLCreateManyTProductNotifyDuplicate 创建多个
-func SQLCreateManyTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNotify, updates []string) (int64, error) {
+// SQLCreateManyTAppLockDuplicate 创建多个
+func SQLCreateManyTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppLock, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -4592,18 +4721,9 @@ func SQLCreateManyTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeA
 				args,
 				[]interface{}{
 					row.ID,
-					row.Nonce,
-					row.ProductID,
-					row.ItemType,
-					row.ItemID,
-					row.NotifyType,
-					row.TokenSymbol,
-					row.URL,
-					row.Msg,
-					row.HandleStatus,
-					row.HandleMsg,
+					row.K,
+					row.V,
 					row.CreateTime,
-					row.UpdateTime,
 				},
 			)
 		}
@@ -4612,18 +4732,9 @@ func SQLCreateManyTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeA
 			args = append(
 				args,
 				[]interface{}{
-					row.Nonce,
-					row.ProductID,
-					row.ItemType,
-					row.ItemID,
-					row.NotifyType,
-					row.TokenSymbol,
-					row.URL,
-					row.Msg,
-					row.HandleStatus,
-					row.HandleMsg,
+					row.K,
+					row.V,
 					row.CreateTime,
-					row.UpdateTime,
 				},
 			)
 		}
@@ -4631,23 +4742,14 @@ func SQLCreateManyTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeA
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_product_notify ( ")
+	query.WriteString("INSERT INTO t_app_lock ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    nonce,
-    product_id,
-    item_type,
-    item_id,
-    notify_type,
-    token_symbol,
-    url,
-    msg,
-    handle_status,
-    handle_msg,
-    create_time,
-    update_time
+    k,
+    v,
+    create_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -4679,18 +4781,18 @@ func SQLCreateManyTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeA
 	return count, nil
 }
 
-// SQLGetTProductNotifyCol 根据id查询
-func SQLGetTProductNotifyCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTProductNotify, error) {
+// SQLGetTAppLockCol 根据id查询
+func SQLGetTAppLockCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppLock, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_notify
+	t_app_lock
 WHERE
 	id=:id`)
 
-	var row DBTProductNotify
+	var row DBTAppLock
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -4709,8 +4811,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTProductNotifyColKV 根据id查询
-func SQLGetTProductNotifyColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTProductNotify, error) {
+// SQLGetTAppLockColKV 根据id查询
+func SQLGetTAppLockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppLock, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -4721,7 +4823,7 @@ func SQLGetTProductNotifyColKV(ctx context.Context, tx mcommon.DbExeAble, cols [
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_notify
+	t_app_lock
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -4751,7 +4853,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTProductNotify
+	var row DBTAppLock
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -4768,8 +4870,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTProductNotifyCol 根据ids获取
-func SQLSelectTProductNotifyCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTProductNotify, error) {
+// SQLSelectTAppLockCol 根据ids获取
+func SQLSelectTAppLockCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppLock, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -4778,7 +4880,7 @@ func SQLSelectTProductNotifyCol(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_notify
+	t_app_lock
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -4792,7 +4894,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTProductNotify
+	var rows []*DBTAppLock
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -4808,8 +4910,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTProductNotifyColKV 根据ids获取
-func SQLSelectTProductNotifyColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTProductNotify, error) {
+// SQLSelectTAppLockColKV 根据ids获取
+func SQLSelectTAppLockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppLock, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -4820,7 +4922,7 @@ func SQLSelectTProductNotifyColKV(ctx context.Context, tx mcommon.DbExeAble, col
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_notify
+	t_app_lock
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -4861,7 +4963,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTProductNotify
+	var rows []*DBTAppLock
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -4875,42 +4977,24 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTProductNotify 更新
-func SQLUpdateTProductNotify(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNotify) (int64, error) {
+// SQLUpdateTAppLock 更新
+func SQLUpdateTAppLock(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLock) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_product_notify
+	t_app_lock
 SET
-    nonce=:nonce,
-    product_id=:product_id,
-    item_type=:item_type,
-    item_id=:item_id,
-    notify_type=:notify_type,
-    token_symbol=:token_symbol,
-    url=:url,
-    msg=:msg,
-    handle_status=:handle_status,
-    handle_msg=:handle_msg,
-    create_time=:create_time,
-    update_time=:update_time
+    k=:k,
+    v=:v,
+    create_time=:create_time
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":            row.ID,
-			"nonce":         row.Nonce,
-			"product_id":    row.ProductID,
-			"item_type":     row.ItemType,
-			"item_id":       row.ItemID,
-			"notify_type":   row.NotifyType,
-			"token_symbol":  row.TokenSymbol,
-			"url":           row.URL,
-			"msg":           row.Msg,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"create_time":   row.CreateTime,
-			"update_time":   row.UpdateTime,
+			"id":          row.ID,
+			"k":           row.K,
+			"v":           row.V,
+			"create_time": row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -4919,14 +5003,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTProductNotify 删除
-func SQLDeleteTProductNotify(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppLock 删除
+func SQLDeleteTAppLock(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_product_notify
+	t_app_lock
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -4939,8 +5023,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTSend 创建
-func SQLCreateTSend(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend, isIgnore bool) (int64, error) {
+// SQLCreateTAppStatusInt 创建
+func SQLCreateTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppStatusInt, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -4948,68 +5032,29 @@ func SQLCreateTSend(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend, isI
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_send ( ")
+	query.WriteString("INTO t_app_status_int ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       related_type,
-       related_id,
-       token_id,
-       tx_id,
-       from_address,
-       to_address,
-       balance_real,
-       gas,
-       gas_price,
-       nonce,
-       hex,
-       create_time,
-       handle_status,
-       handle_msg,
-       handle_time
+       k,
+       v
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :related_type,
-    :related_id,
-    :token_id,
-    :tx_id,
-    :from_address,
-    :to_address,
-    :balance_real,
-    :gas,
-    :gas_price,
-    :nonce,
-    :hex,
-    :create_time,
-    :handle_status,
-    :handle_msg,
-    :handle_time
+    :k,
+    :v
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":            row.ID,
-			"related_type":  row.RelatedType,
-			"related_id":    row.RelatedID,
-			"token_id":      row.TokenID,
-			"tx_id":         row.TxID,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
-			"gas":           row.Gas,
-			"gas_price":     row.GasPrice,
-			"nonce":         row.Nonce,
-			"hex":           row.Hex,
-			"create_time":   row.CreateTime,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
 		},
 	)
 	if err != nil {
@@ -5018,51 +5063,25 @@ func SQLCreateTSend(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend, isI
 	return lastID, nil
 }
 
-// SQLCreateTSendDuplicate 创建更新
-func SQLCreateTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend, updates []string) (int64, error) {
+// SQLCreateTAppStatusIntDuplicate 创建更新
+func SQLCreateTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppStatusInt, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_send ( ")
+	query.WriteString("INSERT INTO t_app_status_int ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       related_type,
-       related_id,
-       token_id,
-       tx_id,
-       from_address,
-       to_address,
-       balance_real,
-       gas,
-       gas_price,
-       nonce,
-       hex,
-       create_time,
-       handle_status,
-       handle_msg,
-       handle_time
+       k,
+       v
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :related_type,
-    :related_id,
-    :token_id,
-    :tx_id,
-    :from_address,
-    :to_address,
-    :balance_real,
-    :gas,
-    :gas_price,
-    :nonce,
-    :hex,
-    :create_time,
-    :handle_status,
-    :handle_msg,
-    :handle_time
+    :k,
+    :v
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -5085,22 +5104,9 @@ func SQLCreateTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBT
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":            row.ID,
-			"related_type":  row.RelatedType,
-			"related_id":    row.RelatedID,
-			"token_id":      row.TokenID,
-			"tx_id":         row.TxID,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
-			"gas":           row.Gas,
-			"gas_price":     row.GasPrice,
-			"nonce":         row.Nonce,
-			"hex":           row.Hex,
-			"create_time":   row.CreateTime,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
 		},
 	)
 	if err != nil {
@@ -5109,8 +5115,8 @@ func SQLCreateTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBT
 	return lastID, nil
 }
 
-// SQLCreateManyTSend 创建多个
-func SQLCreateManyTSend(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSend, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppStatusInt 创建多个
+func SQLCreateManyTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppStatusInt, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -5121,21 +5127,8 @@ func SQLCreateManyTSend(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSe
 				args,
 				[]interface{}{
 					row.ID,
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
-					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Nonce,
-					row.Hex,
-					row.CreateTime,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.HandleTime,
+					row.K,
+					row.V,
 				},
 			)
 		}
@@ -5144,21 +5137,8 @@ func SQLCreateManyTSend(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSe
 			args = append(
 				args,
 				[]interface{}{
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
-					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Nonce,
-					row.Hex,
-					row.CreateTime,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.HandleTime,
+					row.K,
+					row.V,
 				},
 			)
 		}
@@ -5170,26 +5150,13 @@ func SQLCreateManyTSend(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSe
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_send ( ")
+	query.WriteString("INTO t_app_status_int ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    related_type,
-    related_id,
-    token_id,
-    tx_id,
-    from_address,
-    to_address,
-    balance_real,
-    gas,
-    gas_price,
-    nonce,
-    hex,
-    create_time,
-    handle_status,
-    handle_msg,
-    handle_time
+    k,
+    v
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -5205,8 +5172,8 @@ func SQLCreateManyTSend(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSe
 	return count, nil
 }
 
-// SQLCreateManyTSendDuplicate 创建多个
-func SQLCreateManyTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSend, updates []string) (int64, error) {
+// SQLCreateManyTAppStatusIntDuplicate 创建多个
+func SQLCreateManyTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppStatusInt, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -5217,21 +5184,8 @@ func SQLCreateManyTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows
 				args,
 				[]interface{}{
 					row.ID,
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
-					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Nonce,
-					row.Hex,
-					row.CreateTime,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.HandleTime,
+					row.K,
+					row.V,
 				},
 			)
 		}
@@ -5240,21 +5194,8 @@ func SQLCreateManyTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows
 			args = append(
 				args,
 				[]interface{}{
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
-					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Nonce,
-					row.Hex,
-					row.CreateTime,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.HandleTime,
+					row.K,
+					row.V,
 				},
 			)
 		}
@@ -5262,26 +5203,13 @@ func SQLCreateManyTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_send ( ")
+	query.WriteString("INSERT INTO t_app_status_int ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    related_type,
-    related_id,
-    token_id,
-    tx_id,
-    from_address,
-    to_address,
-    balance_real,
-    gas,
-    gas_price,
-    nonce,
-    hex,
-    create_time,
-    handle_status,
-    handle_msg,
-    handle_time
+    k,
+    v
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -5313,18 +5241,18 @@ func SQLCreateManyTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows
 	return count, nil
 }
 
-// SQLGetTSendCol 根据id查询
-func SQLGetTSendCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSend, error) {
+// SQLGetTAppStatusIntCol 根据id查询
+func SQLGetTAppStatusIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppStatusInt, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send
+	t_app_status_int
 WHERE
 	id=:id`)
 
-	var row DBTSend
+	var row DBTAppStatusInt
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -5343,8 +5271,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTSendColKV 根据id查询
-func SQLGetTSendColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSend, error) {
+// SQLGetTAppStatusIntColKV 根据id查询
+func SQLGetTAppStatusIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppStatusInt, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -5355,7 +5283,7 @@ func SQLGetTSendColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string,
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send
+	t_app_status_int
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -5385,7 +5313,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTSend
+	var row DBTAppStatusInt
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -5402,8 +5330,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTSendCol 根据ids获取
-func SQLSelectTSendCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSend, error) {
+// SQLSelectTAppStatusIntCol 根据ids获取
+func SQLSelectTAppStatusIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppStatusInt, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -5412,7 +5340,7 @@ func SQLSelectTSendCol(ctx context.Context, tx mcommon.DbExeAble, cols []string,
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send
+	t_app_status_int
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -5426,7 +5354,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTSend
+	var rows []*DBTAppStatusInt
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -5442,8 +5370,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTSendColKV 根据ids获取
-func SQLSelectTSendColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSend, error) {
+// SQLSelectTAppStatusIntColKV 根据ids获取
+func SQLSelectTAppStatusIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppStatusInt, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -5454,7 +5382,7 @@ func SQLSelectTSendColKV(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send
+	t_app_status_int
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -5495,7 +5423,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTSend
+	var rows []*DBTAppStatusInt
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -5509,25 +5437,6636 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTSend 更新
-func SQLUpdateTSend(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend) (int64, error) {
+// SQLUpdateTAppStatusInt 更新
+func SQLUpdateTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppStatusInt) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_app_status_int
+SET
+    k=:k,
+    v=:v
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTAppStatusInt 删除
+func SQLDeleteTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_app_status_int
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTProduct 创建
+func SQLCreateTProduct(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduct, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_product ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       app_name,
+       app_sk,
+       cb_url,
+       whitelist_ip
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :app_name,
+    :app_sk,
+    :cb_url,
+    :whitelist_ip
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":           row.ID,
+			"app_name":     row.AppName,
+			"app_sk":       row.AppSk,
+			"cb_url":       row.CbURL,
+			"whitelist_ip": row.WhitelistIP,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTProductDuplicate 创建更新
+func SQLCreateTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduct, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_product ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       app_name,
+       app_sk,
+       cb_url,
+       whitelist_ip
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :app_name,
+    :app_sk,
+    :cb_url,
+    :whitelist_ip
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":           row.ID,
+			"app_name":     row.AppName,
+			"app_sk":       row.AppSk,
+			"cb_url":       row.CbURL,
+			"whitelist_ip": row.WhitelistIP,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTProduct 创建多个
+func SQLCreateManyTProduct(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProduct, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.AppName,
+					row.AppSk,
+					row.CbURL,
+					row.WhitelistIP,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.AppName,
+					row.AppSk,
+					row.CbURL,
+					row.WhitelistIP,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_product ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    app_name,
+    app_sk,
+    cb_url,
+    whitelist_ip
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTProductDuplicate 创建多个
+func SQLCreateManyTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProduct, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.AppName,
+					row.AppSk,
+					row.CbURL,
+					row.WhitelistIP,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.AppName,
+					row.AppSk,
+					row.CbURL,
+					row.WhitelistIP,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_product ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    app_name,
+    app_sk,
+    cb_url,
+    whitelist_ip
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTProductCol 根据id查询
+func SQLGetTProductCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTProduct, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product
+WHERE
+	id=:id`)
+
+	var row DBTProduct
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTProductColKV 根据id查询
+func SQLGetTProductColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTProduct, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTProduct
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTProductCol 根据ids获取
+func SQLSelectTProductCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTProduct, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTProduct
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTProductColKV 根据ids获取
+func SQLSelectTProductColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTProduct, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTProduct
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTProduct 更新
+func SQLUpdateTProduct(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduct) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_product
+SET
+    app_name=:app_name,
+    app_sk=:app_sk,
+    cb_url=:cb_url,
+    whitelist_ip=:whitelist_ip
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":           row.ID,
+			"app_name":     row.AppName,
+			"app_sk":       row.AppSk,
+			"cb_url":       row.CbURL,
+			"whitelist_ip": row.WhitelistIP,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTProduct 删除
+func SQLDeleteTProduct(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_product
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTProductNonce 创建
+func SQLCreateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNonce, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_product_nonce ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       c,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :c,
+    :create_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":          row.ID,
+			"c":           row.C,
+			"create_time": row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTProductNonceDuplicate 创建更新
+func SQLCreateTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNonce, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_product_nonce ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       c,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :c,
+    :create_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":          row.ID,
+			"c":           row.C,
+			"create_time": row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTProductNonce 创建多个
+func SQLCreateManyTProductNonce(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNonce, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.C,
+					row.CreateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.C,
+					row.CreateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_product_nonce ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    c,
+    create_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTProductNonceDuplicate 创建多个
+func SQLCreateManyTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNonce, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.C,
+					row.CreateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.C,
+					row.CreateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_product_nonce ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    c,
+    create_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTProductNonceCol 根据id查询
+func SQLGetTProductNonceCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTProductNonce, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_nonce
+WHERE
+	id=:id`)
+
+	var row DBTProductNonce
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTProductNonceColKV 根据id查询
+func SQLGetTProductNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTProductNonce, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_nonce
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTProductNonce
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTProductNonceCol 根据ids获取
+func SQLSelectTProductNonceCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTProductNonce, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_nonce
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTProductNonce
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTProductNonceColKV 根据ids获取
+func SQLSelectTProductNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTProductNonce, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_nonce
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTProductNonce
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTProductNonce 更新
+func SQLUpdateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNonce) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_product_nonce
+SET
+    c=:c,
+    create_time=:create_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":          row.ID,
+			"c":           row.C,
+			"create_time": row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTProductNonce 删除
+func SQLDeleteTProductNonce(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_product_nonce
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTProductNotify 创建
+func SQLCreateTProductNotify(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNotify, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_product_notify ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       nonce,
+       product_id,
+       item_type,
+       item_id,
+       notify_type,
+       token_symbol,
+       url,
+       msg,
+       handle_status,
+       handle_msg,
+       create_time,
+       update_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :nonce,
+    :product_id,
+    :item_type,
+    :item_id,
+    :notify_type,
+    :token_symbol,
+    :url,
+    :msg,
+    :handle_status,
+    :handle_msg,
+    :create_time,
+    :update_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"nonce":         row.Nonce,
+			"product_id":    row.ProductID,
+			"item_type":     row.ItemType,
+			"item_id":       row.ItemID,
+			"notify_type":   row.NotifyType,
+			"token_symbol":  row.TokenSymbol,
+			"url":           row.URL,
+			"msg":           row.Msg,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"create_time":   row.CreateTime,
+			"update_time":   row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTProductNotifyDuplicate 创建更新
+func SQLCreateTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNotify, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_product_notify ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       nonce,
+       product_id,
+       item_type,
+       item_id,
+       notify_type,
+       token_symbol,
+       url,
+       msg,
+       handle_status,
+       handle_msg,
+       create_time,
+       update_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :nonce,
+    :product_id,
+    :item_type,
+    :item_id,
+    :notify_type,
+    :token_symbol,
+    :url,
+    :msg,
+    :handle_status,
+    :handle_msg,
+    :create_time,
+    :update_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"nonce":         row.Nonce,
+			"product_id":    row.ProductID,
+			"item_type":     row.ItemType,
+			"item_id":       row.ItemID,
+			"notify_type":   row.NotifyType,
+			"token_symbol":  row.TokenSymbol,
+			"url":           row.URL,
+			"msg":           row.Msg,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"create_time":   row.CreateTime,
+			"update_time":   row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTProductNotify 创建多个
+func SQLCreateManyTProductNotify(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNotify, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Nonce,
+					row.ProductID,
+					row.ItemType,
+					row.ItemID,
+					row.NotifyType,
+					row.TokenSymbol,
+					row.URL,
+					row.Msg,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.CreateTime,
+					row.UpdateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Nonce,
+					row.ProductID,
+					row.ItemType,
+					row.ItemID,
+					row.NotifyType,
+					row.TokenSymbol,
+					row.URL,
+					row.Msg,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.CreateTime,
+					row.UpdateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_product_notify ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    nonce,
+    product_id,
+    item_type,
+    item_id,
+    notify_type,
+    token_symbol,
+    url,
+    msg,
+    handle_status,
+    handle_msg,
+    create_time,
+    update_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTProductNotifyDuplicate 创建多个
+func SQLCreateManyTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNotify, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Nonce,
+					row.ProductID,
+					row.ItemType,
+					row.ItemID,
+					row.NotifyType,
+					row.TokenSymbol,
+					row.URL,
+					row.Msg,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.CreateTime,
+					row.UpdateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Nonce,
+					row.ProductID,
+					row.ItemType,
+					row.ItemID,
+					row.NotifyType,
+					row.TokenSymbol,
+					row.URL,
+					row.Msg,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.CreateTime,
+					row.UpdateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_product_notify ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    nonce,
+    product_id,
+    item_type,
+    item_id,
+    notify_type,
+    token_symbol,
+    url,
+    msg,
+    handle_status,
+    handle_msg,
+    create_time,
+    update_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTProductNotifyCol 根据id查询
+func SQLGetTProductNotifyCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTProductNotify, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_notify
+WHERE
+	id=:id`)
+
+	var row DBTProductNotify
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTProductNotifyColKV 根据id查询
+func SQLGetTProductNotifyColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTProductNotify, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_notify
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTProductNotify
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTProductNotifyCol 根据ids获取
+func SQLSelectTProductNotifyCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTProductNotify, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_notify
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTProductNotify
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTProductNotifyColKV 根据ids获取
+func SQLSelectTProductNotifyColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTProductNotify, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_notify
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTProductNotify
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTProductNotify 更新
+func SQLUpdateTProductNotify(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNotify) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_product_notify
+SET
+    nonce=:nonce,
+    product_id=:product_id,
+    item_type=:item_type,
+    item_id=:item_id,
+    notify_type=:notify_type,
+    token_symbol=:token_symbol,
+    url=:url,
+    msg=:msg,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    create_time=:create_time,
+    update_time=:update_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":            row.ID,
+			"nonce":         row.Nonce,
+			"product_id":    row.ProductID,
+			"item_type":     row.ItemType,
+			"item_id":       row.ItemID,
+			"notify_type":   row.NotifyType,
+			"token_symbol":  row.TokenSymbol,
+			"url":           row.URL,
+			"msg":           row.Msg,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"create_time":   row.CreateTime,
+			"update_time":   row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTProductNotify 删除
+func SQLDeleteTProductNotify(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_product_notify
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTSend 创建
+func SQLCreateTSend(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       chain_symbol,
+       related_type,
+       related_id,
+       token_id,
+       tx_id,
+       from_address,
+       to_address,
+       balance_real,
+       gas,
+       gas_price,
+       nonce,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :chain_symbol,
+    :related_type,
+    :related_id,
+    :token_id,
+    :tx_id,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :gas,
+    :gas_price,
+    :nonce,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"chain_symbol":  row.ChainSymbol,
+			"related_type":  row.RelatedType,
+			"related_id":    row.RelatedID,
+			"token_id":      row.TokenID,
+			"tx_id":         row.TxID,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"gas":           row.Gas,
+			"gas_price":     row.GasPrice,
+			"nonce":         row.Nonce,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTSendDuplicate 创建更新
+func SQLCreateTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       chain_symbol,
+       related_type,
+       related_id,
+       token_id,
+       tx_id,
+       from_address,
+       to_address,
+       balance_real,
+       gas,
+       gas_price,
+       nonce,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :chain_symbol,
+    :related_type,
+    :related_id,
+    :token_id,
+    :tx_id,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :gas,
+    :gas_price,
+    :nonce,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"chain_symbol":  row.ChainSymbol,
+			"related_type":  row.RelatedType,
+			"related_id":    row.RelatedID,
+			"token_id":      row.TokenID,
+			"tx_id":         row.TxID,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"gas":           row.Gas,
+			"gas_price":     row.GasPrice,
+			"nonce":         row.Nonce,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTSend 创建多个
+func SQLCreateManyTSend(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSend, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.ChainSymbol,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasPrice,
+					row.Nonce,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ChainSymbol,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasPrice,
+					row.Nonce,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    chain_symbol,
+    related_type,
+    related_id,
+    token_id,
+    tx_id,
+    from_address,
+    to_address,
+    balance_real,
+    gas,
+    gas_price,
+    nonce,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTSendDuplicate 创建多个
+func SQLCreateManyTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSend, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.ChainSymbol,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasPrice,
+					row.Nonce,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ChainSymbol,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasPrice,
+					row.Nonce,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    chain_symbol,
+    related_type,
+    related_id,
+    token_id,
+    tx_id,
+    from_address,
+    to_address,
+    balance_real,
+    gas,
+    gas_price,
+    nonce,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendCol 根据id查询
+func SQLGetTSendCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSend, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send
+WHERE
+	id=:id`)
+
+	var row DBTSend
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTSendColKV 根据id查询
+func SQLGetTSendColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSend, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTSend
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTSendCol 根据ids获取
+func SQLSelectTSendCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSend, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTSend
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTSendColKV 根据ids获取
+func SQLSelectTSendColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSend, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTSend
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSend 更新
+func SQLUpdateTSend(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send
+SET
+    chain_symbol=:chain_symbol,
+    related_type=:related_type,
+    related_id=:related_id,
+    token_id=:token_id,
+    tx_id=:tx_id,
+    from_address=:from_address,
+    to_address=:to_address,
+    balance_real=:balance_real,
+    gas=:gas,
+    gas_price=:gas_price,
+    nonce=:nonce,
+    hex=:hex,
+    create_time=:create_time,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":            row.ID,
+			"chain_symbol":  row.ChainSymbol,
+			"related_type":  row.RelatedType,
+			"related_id":    row.RelatedID,
+			"token_id":      row.TokenID,
+			"tx_id":         row.TxID,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"gas":           row.Gas,
+			"gas_price":     row.GasPrice,
+			"nonce":         row.Nonce,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTSend 删除
+func SQLDeleteTSend(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_send
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTSendBtc 创建
+func SQLCreateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBtc, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_btc ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       symbol,
+       related_type,
+       related_id,
+       token_id,
+       tx_id,
+       from_address,
+       to_address,
+       balance_real,
+       gas,
+       gas_price,
+       block_num,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :symbol,
+    :related_type,
+    :related_id,
+    :token_id,
+    :tx_id,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :gas,
+    :gas_price,
+    :block_num,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"symbol":        row.Symbol,
+			"related_type":  row.RelatedType,
+			"related_id":    row.RelatedID,
+			"token_id":      row.TokenID,
+			"tx_id":         row.TxID,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"gas":           row.Gas,
+			"gas_price":     row.GasPrice,
+			"block_num":     row.BlockNum,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTSendBtcDuplicate 创建更新
+func SQLCreateTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBtc, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_btc ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       symbol,
+       related_type,
+       related_id,
+       token_id,
+       tx_id,
+       from_address,
+       to_address,
+       balance_real,
+       gas,
+       gas_price,
+       block_num,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :symbol,
+    :related_type,
+    :related_id,
+    :token_id,
+    :tx_id,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :gas,
+    :gas_price,
+    :block_num,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"symbol":        row.Symbol,
+			"related_type":  row.RelatedType,
+			"related_id":    row.RelatedID,
+			"token_id":      row.TokenID,
+			"tx_id":         row.TxID,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"gas":           row.Gas,
+			"gas_price":     row.GasPrice,
+			"block_num":     row.BlockNum,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTSendBtc 创建多个
+func SQLCreateManyTSendBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendBtc, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Symbol,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasPrice,
+					row.BlockNum,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Symbol,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasPrice,
+					row.BlockNum,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_btc ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    symbol,
+    related_type,
+    related_id,
+    token_id,
+    tx_id,
+    from_address,
+    to_address,
+    balance_real,
+    gas,
+    gas_price,
+    block_num,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTSendBtcDuplicate 创建多个
+func SQLCreateManyTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendBtc, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Symbol,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasPrice,
+					row.BlockNum,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Symbol,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasPrice,
+					row.BlockNum,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_btc ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    symbol,
+    related_type,
+    related_id,
+    token_id,
+    tx_id,
+    from_address,
+    to_address,
+    balance_real,
+    gas,
+    gas_price,
+    block_num,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendBtcCol 根据id查询
+func SQLGetTSendBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendBtc, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_btc
+WHERE
+	id=:id`)
+
+	var row DBTSendBtc
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTSendBtcColKV 根据id查询
+func SQLGetTSendBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendBtc, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_btc
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTSendBtc
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTSendBtcCol 根据ids获取
+func SQLSelectTSendBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendBtc, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_btc
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTSendBtc
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTSendBtcColKV 根据ids获取
+func SQLSelectTSendBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendBtc, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_btc
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTSendBtc
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSendBtc 更新
+func SQLUpdateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBtc) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_btc
+SET
+    symbol=:symbol,
+    related_type=:related_type,
+    related_id=:related_id,
+    token_id=:token_id,
+    tx_id=:tx_id,
+    from_address=:from_address,
+    to_address=:to_address,
+    balance_real=:balance_real,
+    gas=:gas,
+    gas_price=:gas_price,
+    block_num=:block_num,
+    hex=:hex,
+    create_time=:create_time,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":            row.ID,
+			"symbol":        row.Symbol,
+			"related_type":  row.RelatedType,
+			"related_id":    row.RelatedID,
+			"token_id":      row.TokenID,
+			"tx_id":         row.TxID,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"gas":           row.Gas,
+			"gas_price":     row.GasPrice,
+			"block_num":     row.BlockNum,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTSendBtc 删除
+func SQLDeleteTSendBtc(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_send_btc
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTSendEos 创建
+func SQLCreateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEos, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_eos ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       withdraw_id,
+       symbol,
+       tx_hash,
+       log_index,
+       from_address,
+       to_address,
+       memo,
+       balance_real,
+       block_num,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_at
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :withdraw_id,
+    :symbol,
+    :tx_hash,
+    :log_index,
+    :from_address,
+    :to_address,
+    :memo,
+    :balance_real,
+    :block_num,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_at
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"withdraw_id":   row.WithdrawID,
+			"symbol":        row.Symbol,
+			"tx_hash":       row.TxHash,
+			"log_index":     row.LogIndex,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"memo":          row.Memo,
+			"balance_real":  row.BalanceReal,
+			"block_num":     row.BlockNum,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_at":     row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTSendEosDuplicate 创建更新
+func SQLCreateTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEos, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_eos ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       withdraw_id,
+       symbol,
+       tx_hash,
+       log_index,
+       from_address,
+       to_address,
+       memo,
+       balance_real,
+       block_num,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_at
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :withdraw_id,
+    :symbol,
+    :tx_hash,
+    :log_index,
+    :from_address,
+    :to_address,
+    :memo,
+    :balance_real,
+    :block_num,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_at
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"withdraw_id":   row.WithdrawID,
+			"symbol":        row.Symbol,
+			"tx_hash":       row.TxHash,
+			"log_index":     row.LogIndex,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"memo":          row.Memo,
+			"balance_real":  row.BalanceReal,
+			"block_num":     row.BlockNum,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_at":     row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTSendEos 创建多个
+func SQLCreateManyTSendEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendEos, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.WithdrawID,
+					row.Symbol,
+					row.TxHash,
+					row.LogIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.BlockNum,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleAt,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.WithdrawID,
+					row.Symbol,
+					row.TxHash,
+					row.LogIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.BlockNum,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleAt,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_eos ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    withdraw_id,
+    symbol,
+    tx_hash,
+    log_index,
+    from_address,
+    to_address,
+    memo,
+    balance_real,
+    block_num,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_at
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTSendEosDuplicate 创建多个
+func SQLCreateManyTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendEos, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.WithdrawID,
+					row.Symbol,
+					row.TxHash,
+					row.LogIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.BlockNum,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleAt,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.WithdrawID,
+					row.Symbol,
+					row.TxHash,
+					row.LogIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.BlockNum,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleAt,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_eos ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    withdraw_id,
+    symbol,
+    tx_hash,
+    log_index,
+    from_address,
+    to_address,
+    memo,
+    balance_real,
+    block_num,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_at
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendEosCol 根据id查询
+func SQLGetTSendEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendEos, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_eos
+WHERE
+	id=:id`)
+
+	var row DBTSendEos
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTSendEosColKV 根据id查询
+func SQLGetTSendEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendEos, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_eos
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTSendEos
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTSendEosCol 根据ids获取
+func SQLSelectTSendEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendEos, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_eos
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTSendEos
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTSendEosColKV 根据ids获取
+func SQLSelectTSendEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendEos, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_eos
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTSendEos
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSendEos 更新
+func SQLUpdateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEos) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_eos
+SET
+    withdraw_id=:withdraw_id,
+    symbol=:symbol,
+    tx_hash=:tx_hash,
+    log_index=:log_index,
+    from_address=:from_address,
+    to_address=:to_address,
+    memo=:memo,
+    balance_real=:balance_real,
+    block_num=:block_num,
+    hex=:hex,
+    create_time=:create_time,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_at=:handle_at
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":            row.ID,
+			"withdraw_id":   row.WithdrawID,
+			"symbol":        row.Symbol,
+			"tx_hash":       row.TxHash,
+			"log_index":     row.LogIndex,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"memo":          row.Memo,
+			"balance_real":  row.BalanceReal,
+			"block_num":     row.BlockNum,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_at":     row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTSendEos 删除
+func SQLDeleteTSendEos(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_send_eos
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTSendSol 创建
+func SQLCreateTSendSol(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendSol, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_sol ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       related_type,
+       related_id,
+       token_id,
+       tx_id,
+       from_address,
+       to_address,
+       balance_real,
+       last_valid_height,
+       block_num,
+       fee,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :related_type,
+    :related_id,
+    :token_id,
+    :tx_id,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :last_valid_height,
+    :block_num,
+    :fee,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":                row.ID,
+			"related_type":      row.RelatedType,
+			"related_id":        row.RelatedID,
+			"token_id":          row.TokenID,
+			"tx_id":             row.TxID,
+			"from_address":      row.FromAddress,
+			"to_address":        row.ToAddress,
+			"balance_real":      row.BalanceReal,
+			"last_valid_height": row.LastValidHeight,
+			"block_num":         row.BlockNum,
+			"fee":               row.Fee,
+			"hex":               row.Hex,
+			"create_time":       row.CreateTime,
+			"handle_status":     row.HandleStatus,
+			"handle_msg":        row.HandleMsg,
+			"handle_time":       row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTSendSolDuplicate 创建更新
+func SQLCreateTSendSolDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendSol, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_sol ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       related_type,
+       related_id,
+       token_id,
+       tx_id,
+       from_address,
+       to_address,
+       balance_real,
+       last_valid_height,
+       block_num,
+       fee,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :related_type,
+    :related_id,
+    :token_id,
+    :tx_id,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :last_valid_height,
+    :block_num,
+    :fee,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":                row.ID,
+			"related_type":      row.RelatedType,
+			"related_id":        row.RelatedID,
+			"token_id":          row.TokenID,
+			"tx_id":             row.TxID,
+			"from_address":      row.FromAddress,
+			"to_address":        row.ToAddress,
+			"balance_real":      row.BalanceReal,
+			"last_valid_height": row.LastValidHeight,
+			"block_num":         row.BlockNum,
+			"fee":               row.Fee,
+			"hex":               row.Hex,
+			"create_time":       row.CreateTime,
+			"handle_status":     row.HandleStatus,
+			"handle_msg":        row.HandleMsg,
+			"handle_time":       row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTSendSol 创建多个
+func SQLCreateManyTSendSol(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendSol, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.LastValidHeight,
+					row.BlockNum,
+					row.Fee,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.LastValidHeight,
+					row.BlockNum,
+					row.Fee,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_sol ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    related_type,
+    related_id,
+    token_id,
+    tx_id,
+    from_address,
+    to_address,
+    balance_real,
+    last_valid_height,
+    block_num,
+    fee,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTSendSolDuplicate 创建多个
+func SQLCreateManyTSendSolDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendSol, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.LastValidHeight,
+					row.BlockNum,
+					row.Fee,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.LastValidHeight,
+					row.BlockNum,
+					row.Fee,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_sol ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    related_type,
+    related_id,
+    token_id,
+    tx_id,
+    from_address,
+    to_address,
+    balance_real,
+    last_valid_height,
+    block_num,
+    fee,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendSolCol 根据id查询
+func SQLGetTSendSolCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendSol, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_sol
+WHERE
+	id=:id`)
+
+	var row DBTSendSol
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTSendSolColKV 根据id查询
+func SQLGetTSendSolColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendSol, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_sol
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTSendSol
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTSendSolCol 根据ids获取
+func SQLSelectTSendSolCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendSol, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_sol
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTSendSol
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTSendSolColKV 根据ids获取
+func SQLSelectTSendSolColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendSol, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_sol
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTSendSol
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSendSol 更新
+func SQLUpdateTSendSol(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendSol) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_sol
+SET
+    related_type=:related_type,
+    related_id=:related_id,
+    token_id=:token_id,
+    tx_id=:tx_id,
+    from_address=:from_address,
+    to_address=:to_address,
+    balance_real=:balance_real,
+    last_valid_height=:last_valid_height,
+    block_num=:block_num,
+    fee=:fee,
+    hex=:hex,
+    create_time=:create_time,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":                row.ID,
+			"related_type":      row.RelatedType,
+			"related_id":        row.RelatedID,
+			"token_id":          row.TokenID,
+			"tx_id":             row.TxID,
+			"from_address":      row.FromAddress,
+			"to_address":        row.ToAddress,
+			"balance_real":      row.BalanceReal,
+			"last_valid_height": row.LastValidHeight,
+			"block_num":         row.BlockNum,
+			"fee":               row.Fee,
+			"hex":               row.Hex,
+			"create_time":       row.CreateTime,
+			"handle_status":     row.HandleStatus,
+			"handle_msg":        row.HandleMsg,
+			"handle_time":       row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTSendSol 删除
+func SQLDeleteTSendSol(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_send_sol
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTSendTrx 创建
+func SQLCreateTSendTrx(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendTrx, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_trx ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       related_type,
+       related_id,
+       token_id,
+       tx_id,
+       from_address,
+       to_address,
+       balance_real,
+       fee_limit,
+       expiration,
+       block_num,
+       fee,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :related_type,
+    :related_id,
+    :token_id,
+    :tx_id,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :fee_limit,
+    :expiration,
+    :block_num,
+    :fee,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"related_type":  row.RelatedType,
+			"related_id":    row.RelatedID,
+			"token_id":      row.TokenID,
+			"tx_id":         row.TxID,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"fee_limit":     row.FeeLimit,
+			"expiration":    row.Expiration,
+			"block_num":     row.BlockNum,
+			"fee":           row.Fee,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTSendTrxDuplicate 创建更新
+func SQLCreateTSendTrxDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendTrx, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_trx ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       related_type,
+       related_id,
+       token_id,
+       tx_id,
+       from_address,
+       to_address,
+       balance_real,
+       fee_limit,
+       expiration,
+       block_num,
+       fee,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :related_type,
+    :related_id,
+    :token_id,
+    :tx_id,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :fee_limit,
+    :expiration,
+    :block_num,
+    :fee,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"related_type":  row.RelatedType,
+			"related_id":    row.RelatedID,
+			"token_id":      row.TokenID,
+			"tx_id":         row.TxID,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"fee_limit":     row.FeeLimit,
+			"expiration":    row.Expiration,
+			"block_num":     row.BlockNum,
+			"fee":           row.Fee,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTSendTrx 创建多个
+func SQLCreateManyTSendTrx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendTrx, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.FeeLimit,
+					row.Expiration,
+					row.BlockNum,
+					row.Fee,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.FeeLimit,
+					row.Expiration,
+					row.BlockNum,
+					row.Fee,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_trx ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    related_type,
+    related_id,
+    token_id,
+    tx_id,
+    from_address,
+    to_address,
+    balance_real,
+    fee_limit,
+    expiration,
+    block_num,
+    fee,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTSendTrxDuplicate 创建多个
+func SQLCreateManyTSendTrxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendTrx, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.FeeLimit,
+					row.Expiration,
+					row.BlockNum,
+					row.Fee,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.FeeLimit,
+					row.Expiration,
+					row.BlockNum,
+					row.Fee,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_trx ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    related_type,
+    related_id,
+    token_id,
+    tx_id,
+    from_address,
+    to_address,
+    balance_real,
+    fee_limit,
+    expiration,
+    block_num,
+    fee,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendTrxCol 根据id查询
+func SQLGetTSendTrxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendTrx, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_trx
+WHERE
+	id=:id`)
+
+	var row DBTSendTrx
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTSendTrxColKV 根据id查询
+func SQLGetTSendTrxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendTrx, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_trx
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTSendTrx
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTSendTrxCol 根据ids获取
+func SQLSelectTSendTrxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendTrx, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_trx
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTSendTrx
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTSendTrxColKV 根据ids获取
+func SQLSelectTSendTrxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendTrx, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_trx
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTSendTrx
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSendTrx 更新
+func SQLUpdateTSendTrx(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendTrx) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_trx
+SET
+    related_type=:related_type,
+    related_id=:related_id,
+    token_id=:token_id,
+    tx_id=:tx_id,
+    from_address=:from_address,
+    to_address=:to_address,
+    balance_real=:balance_real,
+    fee_limit=:fee_limit,
+    expiration=:expiration,
+    block_num=:block_num,
+    fee=:fee,
+    hex=:hex,
+    create_time=:create_time,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":            row.ID,
+			"related_type":  row.RelatedType,
+			"related_id":    row.RelatedID,
+			"token_id":      row.TokenID,
+			"tx_id":         row.TxID,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"fee_limit":     row.FeeLimit,
+			"expiration":    row.Expiration,
+			"block_num":     row.BlockNum,
+			"fee":           row.Fee,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTSendTrx 删除
+func SQLDeleteTSendTrx(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_send_trx
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTSendXrp 创建
+func SQLCreateTSendXrp(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendXrp, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_xrp ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       withdraw_id,
+       tx_hash,
+       from_address,
+       to_address,
+       memo,
+       balance_real,
+       sequence,
+       last_ledger_sequence,
+       block_num,
+       fee,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_at
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :withdraw_id,
+    :tx_hash,
+    :from_address,
+    :to_address,
+    :memo,
+    :balance_real,
+    :sequence,
+    :last_ledger_sequence,
+    :block_num,
+    :fee,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_at
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":                   row.ID,
+			"withdraw_id":          row.WithdrawID,
+			"tx_hash":              row.TxHash,
+			"from_address":         row.FromAddress,
+			"to_address":           row.ToAddress,
+			"memo":                 row.Memo,
+			"balance_real":         row.BalanceReal,
+			"sequence":             row.Sequence,
+			"last_ledger_sequence": row.LastLedgerSequence,
+			"block_num":            row.BlockNum,
+			"fee":                  row.Fee,
+			"hex":                  row.Hex,
+			"create_time":          row.CreateTime,
+			"handle_status":        row.HandleStatus,
+			"handle_msg":           row.HandleMsg,
+			"handle_at":            row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTSendXrpDuplicate 创建更新
+func SQLCreateTSendXrpDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendXrp, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_xrp ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       withdraw_id,
+       tx_hash,
+       from_address,
+       to_address,
+       memo,
+       balance_real,
+       sequence,
+       last_ledger_sequence,
+       block_num,
+       fee,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_at
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :withdraw_id,
+    :tx_hash,
+    :from_address,
+    :to_address,
+    :memo,
+    :balance_real,
+    :sequence,
+    :last_ledger_sequence,
+    :block_num,
+    :fee,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_at
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":                   row.ID,
+			"withdraw_id":          row.WithdrawID,
+			"tx_hash":              row.TxHash,
+			"from_address":         row.FromAddress,
+			"to_address":           row.ToAddress,
+			"memo":                 row.Memo,
+			"balance_real":         row.BalanceReal,
+			"sequence":             row.Sequence,
+			"last_ledger_sequence": row.LastLedgerSequence,
+			"block_num":            row.BlockNum,
+			"fee":                  row.Fee,
+			"hex":                  row.Hex,
+			"create_time":          row.CreateTime,
+			"handle_status":        row.HandleStatus,
+			"handle_msg":           row.HandleMsg,
+			"handle_at":            row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTSendXrp 创建多个
+func SQLCreateManyTSendXrp(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendXrp, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.WithdrawID,
+					row.TxHash,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.Sequence,
+					row.LastLedgerSequence,
+					row.BlockNum,
+					row.Fee,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleAt,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.WithdrawID,
+					row.TxHash,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.Sequence,
+					row.LastLedgerSequence,
+					row.BlockNum,
+					row.Fee,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleAt,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_xrp ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    withdraw_id,
+    tx_hash,
+    from_address,
+    to_address,
+    memo,
+    balance_real,
+    sequence,
+    last_ledger_sequence,
+    block_num,
+    fee,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_at
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTSendXrpDuplicate 创建多个
+func SQLCreateManyTSendXrpDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendXrp, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.WithdrawID,
+					row.TxHash,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.Sequence,
+					row.LastLedgerSequence,
+					row.BlockNum,
+					row.Fee,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleAt,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.WithdrawID,
+					row.TxHash,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.Sequence,
+					row.LastLedgerSequence,
+					row.BlockNum,
+					row.Fee,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleAt,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_xrp ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    withdraw_id,
+    tx_hash,
+    from_address,
+    to_address,
+    memo,
+    balance_real,
+    sequence,
+    last_ledger_sequence,
+    block_num,
+    fee,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_at
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendXrpCol 根据id查询
+func SQLGetTSendXrpCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendXrp, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_xrp
+WHERE
+	id=:id`)
+
+	var row DBTSendXrp
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTSendXrpColKV 根据id查询
+func SQLGetTSendXrpColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendXrp, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_xrp
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTSendXrp
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTSendXrpCol 根据ids获取
+func SQLSelectTSendXrpCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendXrp, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_xrp
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTSendXrp
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTSendXrpColKV 根据ids获取
+func SQLSelectTSendXrpColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendXrp, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_xrp
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTSendXrp
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSendXrp 更新
+func SQLUpdateTSendXrp(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendXrp) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_xrp
+SET
+    withdraw_id=:withdraw_id,
+    tx_hash=:tx_hash,
+    from_address=:from_address,
+    to_address=:to_address,
+    memo=:memo,
+    balance_real=:balance_real,
+    sequence=:sequence,
+    last_ledger_sequence=:last_ledger_sequence,
+    block_num=:block_num,
+    fee=:fee,
+    hex=:hex,
+    create_time=:create_time,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_at=:handle_at
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":                   row.ID,
+			"withdraw_id":          row.WithdrawID,
+			"tx_hash":              row.TxHash,
+			"from_address":         row.FromAddress,
+			"to_address":           row.ToAddress,
+			"memo":                 row.Memo,
+			"balance_real":         row.BalanceReal,
+			"sequence":             row.Sequence,
+			"last_ledger_sequence": row.LastLedgerSequence,
+			"block_num":            row.BlockNum,
+			"fee":                  row.Fee,
+			"hex":                  row.Hex,
+			"create_time":          row.CreateTime,
+			"handle_status":        row.HandleStatus,
+			"handle_msg":           row.HandleMsg,
+			"handle_at":            row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTSendXrp 删除
+func SQLDeleteTSendXrp(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_send_xrp
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTTx 创建
+func SQLCreateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_tx ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       chain_symbol,
+       product_id,
+       tx_id,
+       block_num,
+       from_address,
+       to_address,
+       balance_real,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time,
+       org_status,
+       org_msg,
+       org_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :chain_symbol,
+    :product_id,
+    :tx_id,
+    :block_num,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time,
+    :org_status,
+    :org_msg,
+    :org_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"chain_symbol":  row.ChainSymbol,
+			"product_id":    row.ProductID,
+			"tx_id":         row.TxID,
+			"block_num":     row.BlockNum,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTTxDuplicate 创建更新
+func SQLCreateTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_tx ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       chain_symbol,
+       product_id,
+       tx_id,
+       block_num,
+       from_address,
+       to_address,
+       balance_real,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time,
+       org_status,
+       org_msg,
+       org_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :chain_symbol,
+    :product_id,
+    :tx_id,
+    :block_num,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time,
+    :org_status,
+    :org_msg,
+    :org_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"chain_symbol":  row.ChainSymbol,
+			"product_id":    row.ProductID,
+			"tx_id":         row.TxID,
+			"block_num":     row.BlockNum,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTTx 创建多个
+func SQLCreateManyTTx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.ChainSymbol,
+					row.ProductID,
+					row.TxID,
+					row.BlockNum,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ChainSymbol,
+					row.ProductID,
+					row.TxID,
+					row.BlockNum,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_tx ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    chain_symbol,
+    product_id,
+    tx_id,
+    block_num,
+    from_address,
+    to_address,
+    balance_real,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time,
+    org_status,
+    org_msg,
+    org_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTTxDuplicate 创建多个
+func SQLCreateManyTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.ChainSymbol,
+					row.ProductID,
+					row.TxID,
+					row.BlockNum,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ChainSymbol,
+					row.ProductID,
+					row.TxID,
+					row.BlockNum,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_tx ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    chain_symbol,
+    product_id,
+    tx_id,
+    block_num,
+    from_address,
+    to_address,
+    balance_real,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time,
+    org_status,
+    org_msg,
+    org_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTTxCol 根据id查询
+func SQLGetTTxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTx, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx
+WHERE
+	id=:id`)
+
+	var row DBTTx
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTTxColKV 根据id查询
+func SQLGetTTxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTx, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTTx
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTTxCol 根据ids获取
+func SQLSelectTTxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTx, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTTx
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTTxColKV 根据ids获取
+func SQLSelectTTxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTx, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTTx
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTx 更新
+func SQLUpdateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx
+SET
+    chain_symbol=:chain_symbol,
+    product_id=:product_id,
+    tx_id=:tx_id,
+    block_num=:block_num,
+    from_address=:from_address,
+    to_address=:to_address,
+    balance_real=:balance_real,
+    create_time=:create_time,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time,
+    org_status=:org_status,
+    org_msg=:org_msg,
+    org_time=:org_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":            row.ID,
+			"chain_symbol":  row.ChainSymbol,
+			"product_id":    row.ProductID,
+			"tx_id":         row.TxID,
+			"block_num":     row.BlockNum,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTTx 删除
+func SQLDeleteTTx(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_tx
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTTxBtc 创建
+func SQLCreateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_tx_btc ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       symbol,
+       product_id,
+       block_hash,
+       block_num,
+       tx_id,
+       vout_n,
+       vout_address,
+       vout_value,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :symbol,
+    :product_id,
+    :block_hash,
+    :block_num,
+    :tx_id,
+    :vout_n,
+    :vout_address,
+    :vout_value,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"symbol":        row.Symbol,
+			"product_id":    row.ProductID,
+			"block_hash":    row.BlockHash,
+			"block_num":     row.BlockNum,
+			"tx_id":         row.TxID,
+			"vout_n":        row.VoutN,
+			"vout_address":  row.VoutAddress,
+			"vout_value":    row.VoutValue,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTTxBtcDuplicate 创建更新
+func SQLCreateTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_tx_btc ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       symbol,
+       product_id,
+       block_hash,
+       block_num,
+       tx_id,
+       vout_n,
+       vout_address,
+       vout_value,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :symbol,
+    :product_id,
+    :block_hash,
+    :block_num,
+    :tx_id,
+    :vout_n,
+    :vout_address,
+    :vout_value,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"symbol":        row.Symbol,
+			"product_id":    row.ProductID,
+			"block_hash":    row.BlockHash,
+			"block_num":     row.BlockNum,
+			"tx_id":         row.TxID,
+			"vout_n":        row.VoutN,
+			"vout_address":  row.VoutAddress,
+			"vout_value":    row.VoutValue,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTTxBtc 创建多个
+func SQLCreateManyTTxBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtc, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Symbol,
+					row.ProductID,
+					row.BlockHash,
+					row.BlockNum,
+					row.TxID,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Symbol,
+					row.ProductID,
+					row.BlockHash,
+					row.BlockNum,
+					row.TxID,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_tx_btc ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    symbol,
+    product_id,
+    block_hash,
+    block_num,
+    tx_id,
+    vout_n,
+    vout_address,
+    vout_value,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTTxBtcDuplicate 创建多个
+func SQLCreateManyTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtc, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Symbol,
+					row.ProductID,
+					row.BlockHash,
+					row.BlockNum,
+					row.TxID,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Symbol,
+					row.ProductID,
+					row.BlockHash,
+					row.BlockNum,
+					row.TxID,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_tx_btc ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    symbol,
+    product_id,
+    block_hash,
+    block_num,
+    tx_id,
+    vout_n,
+    vout_address,
+    vout_value,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTTxBtcCol 根据id查询
+func SQLGetTTxBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtc, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_btc
+WHERE
+	id=:id`)
+
+	var row DBTTxBtc
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTTxBtcColKV 根据id查询
+func SQLGetTTxBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtc, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_btc
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTTxBtc
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTTxBtcCol 根据ids获取
+func SQLSelectTTxBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtc, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_btc
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTTxBtc
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTTxBtcColKV 根据ids获取
+func SQLSelectTTxBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtc, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_btc
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTTxBtc
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxBtc 更新
+func SQLUpdateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_send
+	t_tx_btc
 SET
-    related_type=:related_type,
-    related_id=:related_id,
-    token_id=:token_id,
+    symbol=:symbol,
+    product_id=:product_id,
+    block_hash=:block_hash,
+    block_num=:block_num,
     tx_id=:tx_id,
-    from_address=:from_address,
-    to_address=:to_address,
-    balance_real=:balance_real,
-    gas=:gas,
-    gas_price=:gas_price,
-    nonce=:nonce,
-    hex=:hex,
+    vout_n=:vout_n,
+    vout_address=:vout_address,
+    vout_value=:vout_value,
     create_time=:create_time,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
@@ -5536,17 +12075,14 @@ WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
-			"related_type":  row.RelatedType,
-			"related_id":    row.RelatedID,
-			"token_id":      row.TokenID,
+			"symbol":        row.Symbol,
+			"product_id":    row.ProductID,
+			"block_hash":    row.BlockHash,
+			"block_num":     row.BlockNum,
 			"tx_id":         row.TxID,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
-			"gas":           row.Gas,
-			"gas_price":     row.GasPrice,
-			"nonce":         row.Nonce,
-			"hex":           row.Hex,
+			"vout_n":        row.VoutN,
+			"vout_address":  row.VoutAddress,
+			"vout_value":    row.VoutValue,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
@@ -5559,14 +12095,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTSend 删除
-func SQLDeleteTSend(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxBtc 删除
+func SQLDeleteTTxBtc(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_send
+	t_tx_btc
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -5579,8 +12115,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTSendBtc 创建
-func SQLCreateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBtc, isIgnore bool) (int64, error) {
+// SQLCreateTTxBtcMempool 创建
+func SQLCreateTTxBtcMempool(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcMempool, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -5588,21 +12124,17 @@ func SQLCreateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBt
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_send_btc ( ")
+	query.WriteString("INTO t_tx_btc_mempool ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       related_type,
-       related_id,
-       token_id,
+       symbol,
+       product_id,
        tx_id,
-       from_address,
-       to_address,
-       balance_real,
-       gas,
-       gas_price,
-       hex,
+       vout_n,
+       vout_address,
+       vout_value,
        create_time,
        handle_status,
        handle_msg,
@@ -5612,16 +12144,12 @@ func SQLCreateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBt
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :related_type,
-    :related_id,
-    :token_id,
+    :symbol,
+    :product_id,
     :tx_id,
-    :from_address,
-    :to_address,
-    :balance_real,
-    :gas,
-    :gas_price,
-    :hex,
+    :vout_n,
+    :vout_address,
+    :vout_value,
     :create_time,
     :handle_status,
     :handle_msg,
@@ -5633,16 +12161,12 @@ func SQLCreateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBt
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"related_type":  row.RelatedType,
-			"related_id":    row.RelatedID,
-			"token_id":      row.TokenID,
+			"symbol":        row.Symbol,
+			"product_id":    row.ProductID,
 			"tx_id":         row.TxID,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
-			"gas":           row.Gas,
-			"gas_price":     row.GasPrice,
-			"hex":           row.Hex,
+			"vout_n":        row.VoutN,
+			"vout_address":  row.VoutAddress,
+			"vout_value":    row.VoutValue,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
@@ -5655,26 +12179,22 @@ func SQLCreateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBt
 	return lastID, nil
 }
 
-// SQLCreateTSendBtcDuplicate 创建更新
-func SQLCreateTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBtc, updates []string) (int64, error) {
+// SQLCreateTTxBtcMempoolDuplicate 创建更新
+func SQLCreateTTxBtcMempoolDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcMempool, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_send_btc ( ")
+	query.WriteString("INSERT INTO t_tx_btc_mempool ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       related_type,
-       related_id,
-       token_id,
+       symbol,
+       product_id,
        tx_id,
-       from_address,
-       to_address,
-       balance_real,
-       gas,
-       gas_price,
-       hex,
+       vout_n,
+       vout_address,
+       vout_value,
        create_time,
        handle_status,
        handle_msg,
@@ -5684,16 +12204,12 @@ func SQLCreateTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :related_type,
-    :related_id,
-    :token_id,
+    :symbol,
+    :product_id,
     :tx_id,
-    :from_address,
-    :to_address,
-    :balance_real,
-    :gas,
-    :gas_price,
-    :hex,
+    :vout_n,
+    :vout_address,
+    :vout_value,
     :create_time,
     :handle_status,
     :handle_msg,
@@ -5721,16 +12237,12 @@ func SQLCreateTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"related_type":  row.RelatedType,
-			"related_id":    row.RelatedID,
-			"token_id":      row.TokenID,
+			"symbol":        row.Symbol,
+			"product_id":    row.ProductID,
 			"tx_id":         row.TxID,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
-			"gas":           row.Gas,
-			"gas_price":     row.GasPrice,
-			"hex":           row.Hex,
+			"vout_n":        row.VoutN,
+			"vout_address":  row.VoutAddress,
+			"vout_value":    row.VoutValue,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
@@ -5743,8 +12255,8 @@ func SQLCreateTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 	return lastID, nil
 }
 
-// SQLCreateManyTSendBtc 创建多个
-func SQLCreateManyTSendBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendBtc, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxBtcMempool 创建多个
+func SQLCreateManyTTxBtcMempool(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcMempool, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -5755,16 +12267,12 @@ func SQLCreateManyTSendBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 				args,
 				[]interface{}{
 					row.ID,
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
+					row.Symbol,
+					row.ProductID,
 					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Hex,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
@@ -5777,16 +12285,12 @@ func SQLCreateManyTSendBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 			args = append(
 				args,
 				[]interface{}{
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
+					row.Symbol,
+					row.ProductID,
 					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Hex,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
@@ -5802,21 +12306,17 @@ func SQLCreateManyTSendBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_send_btc ( ")
+	query.WriteString("INTO t_tx_btc_mempool ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    related_type,
-    related_id,
-    token_id,
+    symbol,
+    product_id,
     tx_id,
-    from_address,
-    to_address,
-    balance_real,
-    gas,
-    gas_price,
-    hex,
+    vout_n,
+    vout_address,
+    vout_value,
     create_time,
     handle_status,
     handle_msg,
@@ -5836,8 +12336,8 @@ func SQLCreateManyTSendBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	return count, nil
 }
 
-// SQLCreateManyTSendBtcDuplicate 创建多个
-func SQLCreateManyTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendBtc, updates []string) (int64, error) {
+// SQLCreateManyTTxBtcMempoolDuplicate 创建多个
+func SQLCreateManyTTxBtcMempoolDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcMempool, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -5848,16 +12348,12 @@ func SQLCreateManyTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 				args,
 				[]interface{}{
 					row.ID,
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
+					row.Symbol,
+					row.ProductID,
 					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Hex,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
@@ -5870,16 +12366,12 @@ func SQLCreateManyTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 			args = append(
 				args,
 				[]interface{}{
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
+					row.Symbol,
+					row.ProductID,
 					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Hex,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
@@ -5891,21 +12383,17 @@ func SQLCreateManyTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_send_btc ( ")
+	query.WriteString("INSERT INTO t_tx_btc_mempool ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    related_type,
-    related_id,
-    token_id,
+    symbol,
+    product_id,
     tx_id,
-    from_address,
-    to_address,
-    balance_real,
-    gas,
-    gas_price,
-    hex,
+    vout_n,
+    vout_address,
+    vout_value,
     create_time,
     handle_status,
     handle_msg,
@@ -5941,18 +12429,18 @@ func SQLCreateManyTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	return count, nil
 }
 
-// SQLGetTSendBtcCol 根据id查询
-func SQLGetTSendBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendBtc, error) {
+// SQLGetTTxBtcMempoolCol 根据id查询
+func SQLGetTTxBtcMempoolCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtcMempool, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_btc
+	t_tx_btc_mempool
 WHERE
 	id=:id`)
 
-	var row DBTSendBtc
+	var row DBTTxBtcMempool
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -5971,8 +12459,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTSendBtcColKV 根据id查询
-func SQLGetTSendBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendBtc, error) {
+// SQLGetTTxBtcMempoolColKV 根据id查询
+func SQLGetTTxBtcMempoolColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtcMempool, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -5983,7 +12471,7 @@ func SQLGetTSendBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_btc
+	t_tx_btc_mempool
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -6013,7 +12501,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTSendBtc
+	var row DBTTxBtcMempool
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -6030,8 +12518,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTSendBtcCol 根据ids获取
-func SQLSelectTSendBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendBtc, error) {
+// SQLSelectTTxBtcMempoolCol 根据ids获取
+func SQLSelectTTxBtcMempoolCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtcMempool, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -6040,7 +12528,7 @@ func SQLSelectTSendBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_btc
+	t_tx_btc_mempool
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -6054,7 +12542,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTSendBtc
+	var rows []*DBTTxBtcMempool
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -6070,8 +12558,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTSendBtcColKV 根据ids获取
-func SQLSelectTSendBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendBtc, error) {
+// SQLSelectTTxBtcMempoolColKV 根据ids获取
+func SQLSelectTTxBtcMempoolColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtcMempool, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -6082,7 +12570,7 @@ func SQLSelectTSendBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_btc
+	t_tx_btc_mempool
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -6123,7 +12611,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTSendBtc
+	var rows []*DBTTxBtcMempool
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -6137,24 +12625,20 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTSendBtc 更新
-func SQLUpdateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBtc) (int64, error) {
+// SQLUpdateTTxBtcMempool 更新
+func SQLUpdateTTxBtcMempool(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcMempool) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_send_btc
+	t_tx_btc_mempool
 SET
-    related_type=:related_type,
-    related_id=:related_id,
-    token_id=:token_id,
+    symbol=:symbol,
+    product_id=:product_id,
     tx_id=:tx_id,
-    from_address=:from_address,
-    to_address=:to_address,
-    balance_real=:balance_real,
-    gas=:gas,
-    gas_price=:gas_price,
-    hex=:hex,
+    vout_n=:vout_n,
+    vout_address=:vout_address,
+    vout_value=:vout_value,
     create_time=:create_time,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
@@ -6163,16 +12647,12 @@ WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
-			"related_type":  row.RelatedType,
-			"related_id":    row.RelatedID,
-			"token_id":      row.TokenID,
+			"symbol":        row.Symbol,
+			"product_id":    row.ProductID,
 			"tx_id":         row.TxID,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
-			"gas":           row.Gas,
-			"gas_price":     row.GasPrice,
-			"hex":           row.Hex,
+			"vout_n":        row.VoutN,
+			"vout_address":  row.VoutAddress,
+			"vout_value":    row.VoutValue,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
@@ -6185,14 +12665,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTSendBtc 删除
-func SQLDeleteTSendBtc(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxBtcMempool 删除
+func SQLDeleteTTxBtcMempool(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_send_btc
+	t_tx_btc_mempool
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -6205,8 +12685,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTSendEos 创建
-func SQLCreateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEos, isIgnore bool) (int64, error) {
+// SQLCreateTTxBtcToken 创建
+func SQLCreateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcToken, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -6214,40 +12694,50 @@ func SQLCreateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEo
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_send_eos ( ")
+	query.WriteString("INTO t_tx_btc_token ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       withdraw_id,
-       tx_hash,
-       log_index,
+       product_id,
+       token_index,
+       token_symbol,
+       block_hash,
+       block_num,
+       tx_id,
        from_address,
        to_address,
-       memo,
-       balance_real,
-       hex,
-       create_time,
+       value,
+       blocktime,
+       create_at,
        handle_status,
        handle_msg,
-       handle_at
+       handle_at,
+       org_status,
+       org_msg,
+       org_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :withdraw_id,
-    :tx_hash,
-    :log_index,
+    :product_id,
+    :token_index,
+    :token_symbol,
+    :block_hash,
+    :block_num,
+    :tx_id,
     :from_address,
     :to_address,
-    :memo,
-    :balance_real,
-    :hex,
-    :create_time,
+    :value,
+    :blocktime,
+    :create_at,
     :handle_status,
     :handle_msg,
-    :handle_at
+    :handle_at,
+    :org_status,
+    :org_msg,
+    :org_at
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
@@ -6255,18 +12745,23 @@ func SQLCreateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEo
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"withdraw_id":   row.WithdrawID,
-			"tx_hash":       row.TxHash,
-			"log_index":     row.LogIndex,
+			"product_id":    row.ProductID,
+			"token_index":   row.TokenIndex,
+			"token_symbol":  row.TokenSymbol,
+			"block_hash":    row.BlockHash,
+			"block_num":     row.BlockNum,
+			"tx_id":         row.TxID,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"memo":          row.Memo,
-			"balance_real":  row.BalanceReal,
-			"hex":           row.Hex,
-			"create_time":   row.CreateTime,
+			"value":         row.Value,
+			"blocktime":     row.Blocktime,
+			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_at":     row.HandleAt,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_at":        row.OrgAt,
 		},
 	)
 	if err != nil {
@@ -6275,45 +12770,55 @@ func SQLCreateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEo
 	return lastID, nil
 }
 
-// SQLCreateTSendEosDuplicate 创建更新
-func SQLCreateTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEos, updates []string) (int64, error) {
+// SQLCreateTTxBtcTokenDuplicate 创建更新
+func SQLCreateTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcToken, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_send_eos ( ")
+	query.WriteString("INSERT INTO t_tx_btc_token ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       withdraw_id,
-       tx_hash,
-       log_index,
+       product_id,
+       token_index,
+       token_symbol,
+       block_hash,
+       block_num,
+       tx_id,
        from_address,
        to_address,
-       memo,
-       balance_real,
-       hex,
-       create_time,
+       value,
+       blocktime,
+       create_at,
        handle_status,
        handle_msg,
-       handle_at
+       handle_at,
+       org_status,
+       org_msg,
+       org_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :withdraw_id,
-    :tx_hash,
-    :log_index,
+    :product_id,
+    :token_index,
+    :token_symbol,
+    :block_hash,
+    :block_num,
+    :tx_id,
     :from_address,
     :to_address,
-    :memo,
-    :balance_real,
-    :hex,
-    :create_time,
+    :value,
+    :blocktime,
+    :create_at,
     :handle_status,
     :handle_msg,
-    :handle_at
+    :handle_at,
+    :org_status,
+    :org_msg,
+    :org_at
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -6337,18 +12842,23 @@ func SQLCreateTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"withdraw_id":   row.WithdrawID,
-			"tx_hash":       row.TxHash,
-			"log_index":     row.LogIndex,
+			"product_id":    row.ProductID,
+			"token_index":   row.TokenIndex,
+			"token_symbol":  row.TokenSymbol,
+			"block_hash":    row.BlockHash,
+			"block_num":     row.BlockNum,
+			"tx_id":         row.TxID,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"memo":          row.Memo,
-			"balance_real":  row.BalanceReal,
-			"hex":           row.Hex,
-			"create_time":   row.CreateTime,
+			"value":         row.Value,
+			"blocktime":     row.Blocktime,
+			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_at":     row.HandleAt,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_at":        row.OrgAt,
 		},
 	)
 	if err != nil {
@@ -6357,8 +12867,8 @@ func SQLCreateTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 	return lastID, nil
 }
 
-// SQLCreateManyTSendEos 创建多个
-func SQLCreateManyTSendEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendEos, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxBtcToken 创建多个
+func SQLCreateManyTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcToken, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -6369,18 +12879,23 @@ func SQLCreateManyTSendEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 				args,
 				[]interface{}{
 					row.ID,
-					row.WithdrawID,
-					row.TxHash,
-					row.LogIndex,
+					row.ProductID,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.BlockHash,
+					row.BlockNum,
+					row.TxID,
 					row.FromAddress,
 					row.ToAddress,
-					row.Memo,
-					row.BalanceReal,
-					row.Hex,
-					row.CreateTime,
+					row.Value,
+					row.Blocktime,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleAt,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgAt,
 				},
 			)
 		}
@@ -6389,18 +12904,23 @@ func SQLCreateManyTSendEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 			args = append(
 				args,
 				[]interface{}{
-					row.WithdrawID,
-					row.TxHash,
-					row.LogIndex,
+					row.ProductID,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.BlockHash,
+					row.BlockNum,
+					row.TxID,
 					row.FromAddress,
 					row.ToAddress,
-					row.Memo,
-					row.BalanceReal,
-					row.Hex,
-					row.CreateTime,
+					row.Value,
+					row.Blocktime,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleAt,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgAt,
 				},
 			)
 		}
@@ -6412,23 +12932,28 @@ func SQLCreateManyTSendEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_send_eos ( ")
+	query.WriteString("INTO t_tx_btc_token ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    withdraw_id,
-    tx_hash,
-    log_index,
+    product_id,
+    token_index,
+    token_symbol,
+    block_hash,
+    block_num,
+    tx_id,
     from_address,
     to_address,
-    memo,
-    balance_real,
-    hex,
-    create_time,
+    value,
+    blocktime,
+    create_at,
     handle_status,
     handle_msg,
-    handle_at
+    handle_at,
+    org_status,
+    org_msg,
+    org_at
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -6444,8 +12969,8 @@ func SQLCreateManyTSendEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	return count, nil
 }
 
-// SQLCreateManyTSendEosDuplicate 创建多个
-func SQLCreateManyTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendEos, updates []string) (int64, error) {
+// SQLCreateManyTTxBtcTokenDuplicate 创建多个
+func SQLCreateManyTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcToken, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -6456,18 +12981,23 @@ func SQLCreateManyTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 				args,
 				[]interface{}{
 					row.ID,
-					row.WithdrawID,
-					row.TxHash,
-					row.LogIndex,
+					row.ProductID,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.BlockHash,
+					row.BlockNum,
+					row.TxID,
 					row.FromAddress,
 					row.ToAddress,
-					row.Memo,
-					row.BalanceReal,
-					row.Hex,
-					row.CreateTime,
+					row.Value,
+					row.Blocktime,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleAt,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgAt,
 				},
 			)
 		}
@@ -6476,18 +13006,23 @@ func SQLCreateManyTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 			args = append(
 				args,
 				[]interface{}{
-					row.WithdrawID,
-					row.TxHash,
-					row.LogIndex,
+					row.ProductID,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.BlockHash,
+					row.BlockNum,
+					row.TxID,
 					row.FromAddress,
 					row.ToAddress,
-					row.Memo,
-					row.BalanceReal,
-					row.Hex,
-					row.CreateTime,
+					row.Value,
+					row.Blocktime,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleAt,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgAt,
 				},
 			)
 		}
@@ -6495,23 +13030,28 @@ func SQLCreateManyTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_send_eos ( ")
+	query.WriteString("INSERT INTO t_tx_btc_token ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    withdraw_id,
-    tx_hash,
-    log_index,
+    product_id,
+    token_index,
+    token_symbol,
+    block_hash,
+    block_num,
+    tx_id,
     from_address,
     to_address,
-    memo,
-    balance_real,
-    hex,
-    create_time,
+    value,
+    blocktime,
+    create_at,
     handle_status,
     handle_msg,
-    handle_at
+    handle_at,
+    org_status,
+    org_msg,
+    org_at
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -6543,18 +13083,18 @@ func SQLCreateManyTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	return count, nil
 }
 
-// SQLGetTSendEosCol 根据id查询
-func SQLGetTSendEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendEos, error) {
+// SQLGetTTxBtcTokenCol 根据id查询
+func SQLGetTTxBtcTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtcToken, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_eos
+	t_tx_btc_token
 WHERE
 	id=:id`)
 
-	var row DBTSendEos
+	var row DBTTxBtcToken
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -6573,8 +13113,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTSendEosColKV 根据id查询
-func SQLGetTSendEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendEos, error) {
+// SQLGetTTxBtcTokenColKV 根据id查询
+func SQLGetTTxBtcTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtcToken, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -6585,7 +13125,7 @@ func SQLGetTSendEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_eos
+	t_tx_btc_token
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -6615,7 +13155,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTSendEos
+	var row DBTTxBtcToken
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -6632,8 +13172,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTSendEosCol 根据ids获取
-func SQLSelectTSendEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendEos, error) {
+// SQLSelectTTxBtcTokenCol 根据ids获取
+func SQLSelectTTxBtcTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtcToken, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -6642,7 +13182,7 @@ func SQLSelectTSendEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_eos
+	t_tx_btc_token
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -6656,7 +13196,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTSendEos
+	var rows []*DBTTxBtcToken
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -6672,8 +13212,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTSendEosColKV 根据ids获取
-func SQLSelectTSendEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendEos, error) {
+// SQLSelectTTxBtcTokenColKV 根据ids获取
+func SQLSelectTTxBtcTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtcToken, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -6684,7 +13224,7 @@ func SQLSelectTSendEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_eos
+	t_tx_btc_token
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -6725,7 +13265,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTSendEos
+	var rows []*DBTTxBtcToken
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -6739,42 +13279,52 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTSendEos 更新
-func SQLUpdateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEos) (int64, error) {
+// SQLUpdateTTxBtcToken 更新
+func SQLUpdateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcToken) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_send_eos
+	t_tx_btc_token
 SET
-    withdraw_id=:withdraw_id,
-    tx_hash=:tx_hash,
-    log_index=:log_index,
+    product_id=:product_id,
+    token_index=:token_index,
+    token_symbol=:token_symbol,
+    block_hash=:block_hash,
+    block_num=:block_num,
+    tx_id=:tx_id,
     from_address=:from_address,
     to_address=:to_address,
-    memo=:memo,
-    balance_real=:balance_real,
-    hex=:hex,
-    create_time=:create_time,
+    value=:value,
+    blocktime=:blocktime,
+    create_at=:create_at,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
-    handle_at=:handle_at
+    handle_at=:handle_at,
+    org_status=:org_status,
+    org_msg=:org_msg,
+    org_at=:org_at
 WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
-			"withdraw_id":   row.WithdrawID,
-			"tx_hash":       row.TxHash,
-			"log_index":     row.LogIndex,
+			"product_id":    row.ProductID,
+			"token_index":   row.TokenIndex,
+			"token_symbol":  row.TokenSymbol,
+			"block_hash":    row.BlockHash,
+			"block_num":     row.BlockNum,
+			"tx_id":         row.TxID,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"memo":          row.Memo,
-			"balance_real":  row.BalanceReal,
-			"hex":           row.Hex,
-			"create_time":   row.CreateTime,
+			"value":         row.Value,
+			"blocktime":     row.Blocktime,
+			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_at":     row.HandleAt,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_at":        row.OrgAt,
 		},
 	)
 	if err != nil {
@@ -6783,14 +13333,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTSendEos 删除
-func SQLDeleteTSendEos(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxBtcToken 删除
+func SQLDeleteTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_send_eos
+	t_tx_btc_token
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -6803,8 +13353,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTTx 创建
-func SQLCreateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, isIgnore bool) (int64, error) {
+// SQLCreateTTxBtcUxto 创建
+func SQLCreateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcUxto, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -6812,40 +13362,44 @@ func SQLCreateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, isIgnor
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx ( ")
+	query.WriteString("INTO t_tx_btc_uxto ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       product_id,
+       symbol,
+       uxto_type,
+       block_hash,
        tx_id,
-       from_address,
-       to_address,
-       balance_real,
+       vout_n,
+       vout_address,
+       vout_value,
+       vout_script,
        create_time,
+       spend_tx_id,
+       spend_n,
        handle_status,
        handle_msg,
-       handle_time,
-       org_status,
-       org_msg,
-       org_time
+       handle_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :product_id,
+    :symbol,
+    :uxto_type,
+    :block_hash,
     :tx_id,
-    :from_address,
-    :to_address,
-    :balance_real,
+    :vout_n,
+    :vout_address,
+    :vout_value,
+    :vout_script,
     :create_time,
+    :spend_tx_id,
+    :spend_n,
     :handle_status,
     :handle_msg,
-    :handle_time,
-    :org_status,
-    :org_msg,
-    :org_time
+    :handle_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
@@ -6853,18 +13407,20 @@ func SQLCreateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, isIgnor
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"product_id":    row.ProductID,
+			"symbol":        row.Symbol,
+			"uxto_type":     row.UxtoType,
+			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
+			"vout_n":        row.VoutN,
+			"vout_address":  row.VoutAddress,
+			"vout_value":    row.VoutValue,
+			"vout_script":   row.VoutScript,
 			"create_time":   row.CreateTime,
+			"spend_tx_id":   row.SpendTxID,
+			"spend_n":       row.SpendN,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
-			"org_status":    row.OrgStatus,
-			"org_msg":       row.OrgMsg,
-			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -6873,45 +13429,49 @@ func SQLCreateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, isIgnor
 	return lastID, nil
 }
 
-// SQLCreateTTxDuplicate 创建更新
-func SQLCreateTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, updates []string) (int64, error) {
+// SQLCreateTTxBtcUxtoDuplicate 创建更新
+func SQLCreateTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcUxto, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx ( ")
+	query.WriteString("INSERT INTO t_tx_btc_uxto ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       product_id,
+       symbol,
+       uxto_type,
+       block_hash,
        tx_id,
-       from_address,
-       to_address,
-       balance_real,
+       vout_n,
+       vout_address,
+       vout_value,
+       vout_script,
        create_time,
+       spend_tx_id,
+       spend_n,
        handle_status,
        handle_msg,
-       handle_time,
-       org_status,
-       org_msg,
-       org_time
+       handle_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :product_id,
+    :symbol,
+    :uxto_type,
+    :block_hash,
     :tx_id,
-    :from_address,
-    :to_address,
-    :balance_real,
+    :vout_n,
+    :vout_address,
+    :vout_value,
+    :vout_script,
     :create_time,
+    :spend_tx_id,
+    :spend_n,
     :handle_status,
     :handle_msg,
-    :handle_time,
-    :org_status,
-    :org_msg,
-    :org_time
+    :handle_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -6935,18 +13495,20 @@ func SQLCreateTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"product_id":    row.ProductID,
+			"symbol":        row.Symbol,
+			"uxto_type":     row.UxtoType,
+			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
+			"vout_n":        row.VoutN,
+			"vout_address":  row.VoutAddress,
+			"vout_value":    row.VoutValue,
+			"vout_script":   row.VoutScript,
 			"create_time":   row.CreateTime,
+			"spend_tx_id":   row.SpendTxID,
+			"spend_n":       row.SpendN,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
-			"org_status":    row.OrgStatus,
-			"org_msg":       row.OrgMsg,
-			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -6955,8 +13517,8 @@ func SQLCreateTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx
 	return lastID, nil
 }
 
-// SQLCreateManyTTx 创建多个
-func SQLCreateManyTTx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxBtcUxto 创建多个
+func SQLCreateManyTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcUxto, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -6967,18 +13529,20 @@ func SQLCreateManyTTx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx,
 				args,
 				[]interface{}{
 					row.ID,
-					row.ProductID,
+					row.Symbol,
+					row.UxtoType,
+					row.BlockHash,
 					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
+					row.VoutScript,
 					row.CreateTime,
+					row.SpendTxID,
+					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
-					row.OrgStatus,
-					row.OrgMsg,
-					row.OrgTime,
 				},
 			)
 		}
@@ -6987,18 +13551,20 @@ func SQLCreateManyTTx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx,
 			args = append(
 				args,
 				[]interface{}{
-					row.ProductID,
+					row.Symbol,
+					row.UxtoType,
+					row.BlockHash,
 					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
+					row.VoutScript,
 					row.CreateTime,
+					row.SpendTxID,
+					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
-					row.OrgStatus,
-					row.OrgMsg,
-					row.OrgTime,
 				},
 			)
 		}
@@ -7010,23 +13576,25 @@ func SQLCreateManyTTx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx,
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx ( ")
+	query.WriteString("INTO t_tx_btc_uxto ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    product_id,
+    symbol,
+    uxto_type,
+    block_hash,
     tx_id,
-    from_address,
-    to_address,
-    balance_real,
+    vout_n,
+    vout_address,
+    vout_value,
+    vout_script,
     create_time,
+    spend_tx_id,
+    spend_n,
     handle_status,
     handle_msg,
-    handle_time,
-    org_status,
-    org_msg,
-    org_time
+    handle_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -7042,8 +13610,8 @@ func SQLCreateManyTTx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx,
 	return count, nil
 }
 
-// SQLCreateManyTTxDuplicate 创建多个
-func SQLCreateManyTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx, updates []string) (int64, error) {
+// SQLCreateManyTTxBtcUxtoDuplicate 创建多个
+func SQLCreateManyTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcUxto, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -7054,18 +13622,20 @@ func SQLCreateManyTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows [
 				args,
 				[]interface{}{
 					row.ID,
-					row.ProductID,
+					row.Symbol,
+					row.UxtoType,
+					row.BlockHash,
 					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
+					row.VoutScript,
 					row.CreateTime,
+					row.SpendTxID,
+					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
-					row.OrgStatus,
-					row.OrgMsg,
-					row.OrgTime,
 				},
 			)
 		}
@@ -7074,18 +13644,20 @@ func SQLCreateManyTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows [
 			args = append(
 				args,
 				[]interface{}{
-					row.ProductID,
+					row.Symbol,
+					row.UxtoType,
+					row.BlockHash,
 					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
+					row.VoutScript,
 					row.CreateTime,
+					row.SpendTxID,
+					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
-					row.OrgStatus,
-					row.OrgMsg,
-					row.OrgTime,
 				},
 			)
 		}
@@ -7093,23 +13665,25 @@ func SQLCreateManyTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows [
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx ( ")
+	query.WriteString("INSERT INTO t_tx_btc_uxto ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    product_id,
-    tx_id,
-    from_address,
-    to_address,
-    balance_real,
+    symbol,
+    uxto_type,
+    block_hash,
+    tx_id,
+    vout_n,
+    vout_address,
+    vout_value,
+    vout_script,
     create_time,
+    spend_tx_id,
+    spend_n,
     handle_status,
     handle_msg,
-    handle_time,
-    org_status,
-    org_msg,
-    org_time
+    handle_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -7141,18 +13715,18 @@ func SQLCreateManyTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows [
 	return count, nil
 }
 
-// SQLGetTTxCol 根据id查询
-func SQLGetTTxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTx, error) {
+// SQLGetTTxBtcUxtoCol 根据id查询
+func SQLGetTTxBtcUxtoCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtcUxto, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx
+	t_tx_btc_uxto
 WHERE
 	id=:id`)
 
-	var row DBTTx
+	var row DBTTxBtcUxto
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -7171,8 +13745,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTTxColKV 根据id查询
-func SQLGetTTxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTx, error) {
+// SQLGetTTxBtcUxtoColKV 根据id查询
+func SQLGetTTxBtcUxtoColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtcUxto, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -7183,7 +13757,7 @@ func SQLGetTTxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, ke
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx
+	t_tx_btc_uxto
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -7213,7 +13787,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTTx
+	var row DBTTxBtcUxto
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -7230,8 +13804,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTTxCol 根据ids获取
-func SQLSelectTTxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTx, error) {
+// SQLSelectTTxBtcUxtoCol 根据ids获取
+func SQLSelectTTxBtcUxtoCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtcUxto, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -7240,7 +13814,7 @@ func SQLSelectTTxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, i
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx
+	t_tx_btc_uxto
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -7254,7 +13828,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTTx
+	var rows []*DBTTxBtcUxto
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -7270,8 +13844,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxColKV 根据ids获取
-func SQLSelectTTxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTx, error) {
+// SQLSelectTTxBtcUxtoColKV 根据ids获取
+func SQLSelectTTxBtcUxtoColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtcUxto, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -7282,7 +13856,7 @@ func SQLSelectTTxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string,
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx
+	t_tx_btc_uxto
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -7323,7 +13897,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTTx
+	var rows []*DBTTxBtcUxto
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -7337,42 +13911,46 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTTx 更新
-func SQLUpdateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx) (int64, error) {
+// SQLUpdateTTxBtcUxto 更新
+func SQLUpdateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcUxto) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_tx
+	t_tx_btc_uxto
 SET
-    product_id=:product_id,
+    symbol=:symbol,
+    uxto_type=:uxto_type,
+    block_hash=:block_hash,
     tx_id=:tx_id,
-    from_address=:from_address,
-    to_address=:to_address,
-    balance_real=:balance_real,
+    vout_n=:vout_n,
+    vout_address=:vout_address,
+    vout_value=:vout_value,
+    vout_script=:vout_script,
     create_time=:create_time,
+    spend_tx_id=:spend_tx_id,
+    spend_n=:spend_n,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
-    handle_time=:handle_time,
-    org_status=:org_status,
-    org_msg=:org_msg,
-    org_time=:org_time
+    handle_time=:handle_time
 WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
-			"product_id":    row.ProductID,
+			"symbol":        row.Symbol,
+			"uxto_type":     row.UxtoType,
+			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
+			"vout_n":        row.VoutN,
+			"vout_address":  row.VoutAddress,
+			"vout_value":    row.VoutValue,
+			"vout_script":   row.VoutScript,
 			"create_time":   row.CreateTime,
+			"spend_tx_id":   row.SpendTxID,
+			"spend_n":       row.SpendN,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
-			"org_status":    row.OrgStatus,
-			"org_msg":       row.OrgMsg,
-			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -7381,14 +13959,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTTx 删除
-func SQLDeleteTTx(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxBtcUxto 删除
+func SQLDeleteTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_tx
+	t_tx_btc_uxto
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -7401,8 +13979,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTTxBtc 创建
-func SQLCreateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, isIgnore bool) (int64, error) {
+// SQLCreateTTxEos 创建
+func SQLCreateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -7410,36 +13988,44 @@ func SQLCreateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, i
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_btc ( ")
+	query.WriteString("INTO t_tx_eos ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
        product_id,
-       block_hash,
-       tx_id,
-       vout_n,
-       vout_address,
-       vout_value,
-       create_time,
+       symbol,
+       tx_hash,
+       log_index,
+       from_address,
+       to_address,
+       memo,
+       balance_real,
+       create_at,
        handle_status,
        handle_msg,
-       handle_time
+       handle_at,
+       block_num,
+       block_id
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
     :product_id,
-    :block_hash,
-    :tx_id,
-    :vout_n,
-    :vout_address,
-    :vout_value,
-    :create_time,
+    :symbol,
+    :tx_hash,
+    :log_index,
+    :from_address,
+    :to_address,
+    :memo,
+    :balance_real,
+    :create_at,
     :handle_status,
     :handle_msg,
-    :handle_time
+    :handle_at,
+    :block_num,
+    :block_id
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
@@ -7448,15 +14034,19 @@ func SQLCreateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, i
 		mcommon.H{
 			"id":            row.ID,
 			"product_id":    row.ProductID,
-			"block_hash":    row.BlockHash,
-			"tx_id":         row.TxID,
-			"vout_n":        row.VoutN,
-			"vout_address":  row.VoutAddress,
-			"vout_value":    row.VoutValue,
-			"create_time":   row.CreateTime,
+			"symbol":        row.Symbol,
+			"tx_hash":       row.TxHash,
+			"log_index":     row.LogIndex,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"memo":          row.Memo,
+			"balance_real":  row.BalanceReal,
+			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
+			"handle_at":     row.HandleAt,
+			"block_num":     row.BlockNum,
+			"block_id":      row.BlockID,
 		},
 	)
 	if err != nil {
@@ -7465,41 +14055,49 @@ func SQLCreateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, i
 	return lastID, nil
 }
 
-// SQLCreateTTxBtcDuplicate 创建更新
-func SQLCreateTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, updates []string) (int64, error) {
+// SQLCreateTTxEosDuplicate 创建更新
+func SQLCreateTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_btc ( ")
+	query.WriteString("INSERT INTO t_tx_eos ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
        product_id,
-       block_hash,
-       tx_id,
-       vout_n,
-       vout_address,
-       vout_value,
-       create_time,
+       symbol,
+       tx_hash,
+       log_index,
+       from_address,
+       to_address,
+       memo,
+       balance_real,
+       create_at,
        handle_status,
        handle_msg,
-       handle_time
+       handle_at,
+       block_num,
+       block_id
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
     :product_id,
-    :block_hash,
-    :tx_id,
-    :vout_n,
-    :vout_address,
-    :vout_value,
-    :create_time,
+    :symbol,
+    :tx_hash,
+    :log_index,
+    :from_address,
+    :to_address,
+    :memo,
+    :balance_real,
+    :create_at,
     :handle_status,
     :handle_msg,
-    :handle_time
+    :handle_at,
+    :block_num,
+    :block_id
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -7524,15 +14122,19 @@ func SQLCreateTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DB
 		mcommon.H{
 			"id":            row.ID,
 			"product_id":    row.ProductID,
-			"block_hash":    row.BlockHash,
-			"tx_id":         row.TxID,
-			"vout_n":        row.VoutN,
-			"vout_address":  row.VoutAddress,
-			"vout_value":    row.VoutValue,
-			"create_time":   row.CreateTime,
+			"symbol":        row.Symbol,
+			"tx_hash":       row.TxHash,
+			"log_index":     row.LogIndex,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"memo":          row.Memo,
+			"balance_real":  row.BalanceReal,
+			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
+			"handle_at":     row.HandleAt,
+			"block_num":     row.BlockNum,
+			"block_id":      row.BlockID,
 		},
 	)
 	if err != nil {
@@ -7541,8 +14143,8 @@ func SQLCreateTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DB
 	return lastID, nil
 }
 
-// SQLCreateManyTTxBtc 创建多个
-func SQLCreateManyTTxBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtc, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxEos 创建多个
+func SQLCreateManyTTxEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxEos, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -7554,15 +14156,19 @@ func SQLCreateManyTTxBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 				[]interface{}{
 					row.ID,
 					row.ProductID,
-					row.BlockHash,
-					row.TxID,
-					row.VoutN,
-					row.VoutAddress,
-					row.VoutValue,
-					row.CreateTime,
+					row.Symbol,
+					row.TxHash,
+					row.LogIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleTime,
+					row.HandleAt,
+					row.BlockNum,
+					row.BlockID,
 				},
 			)
 		}
@@ -7572,15 +14178,19 @@ func SQLCreateManyTTxBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 				args,
 				[]interface{}{
 					row.ProductID,
-					row.BlockHash,
-					row.TxID,
-					row.VoutN,
-					row.VoutAddress,
-					row.VoutValue,
-					row.CreateTime,
+					row.Symbol,
+					row.TxHash,
+					row.LogIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleTime,
+					row.HandleAt,
+					row.BlockNum,
+					row.BlockID,
 				},
 			)
 		}
@@ -7592,21 +14202,25 @@ func SQLCreateManyTTxBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_btc ( ")
+	query.WriteString("INTO t_tx_eos ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
     product_id,
-    block_hash,
-    tx_id,
-    vout_n,
-    vout_address,
-    vout_value,
-    create_time,
+    symbol,
+    tx_hash,
+    log_index,
+    from_address,
+    to_address,
+    memo,
+    balance_real,
+    create_at,
     handle_status,
     handle_msg,
-    handle_time
+    handle_at,
+    block_num,
+    block_id
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -7622,8 +14236,8 @@ func SQLCreateManyTTxBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 	return count, nil
 }
 
-// SQLCreateManyTTxBtcDuplicate 创建多个
-func SQLCreateManyTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtc, updates []string) (int64, error) {
+// SQLCreateManyTTxEosDuplicate 创建多个
+func SQLCreateManyTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxEos, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -7635,15 +14249,19 @@ func SQLCreateManyTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 				[]interface{}{
 					row.ID,
 					row.ProductID,
-					row.BlockHash,
-					row.TxID,
-					row.VoutN,
-					row.VoutAddress,
-					row.VoutValue,
-					row.CreateTime,
+					row.Symbol,
+					row.TxHash,
+					row.LogIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleTime,
+					row.HandleAt,
+					row.BlockNum,
+					row.BlockID,
 				},
 			)
 		}
@@ -7653,15 +14271,19 @@ func SQLCreateManyTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 				args,
 				[]interface{}{
 					row.ProductID,
-					row.BlockHash,
-					row.TxID,
-					row.VoutN,
-					row.VoutAddress,
-					row.VoutValue,
-					row.CreateTime,
+					row.Symbol,
+					row.TxHash,
+					row.LogIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleTime,
+					row.HandleAt,
+					row.BlockNum,
+					row.BlockID,
 				},
 			)
 		}
@@ -7669,21 +14291,25 @@ func SQLCreateManyTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_btc ( ")
+	query.WriteString("INSERT INTO t_tx_eos ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
     product_id,
-    block_hash,
-    tx_id,
-    vout_n,
-    vout_address,
-    vout_value,
-    create_time,
+    symbol,
+    tx_hash,
+    log_index,
+    from_address,
+    to_address,
+    memo,
+    balance_real,
+    create_at,
     handle_status,
     handle_msg,
-    handle_time
+    handle_at,
+    block_num,
+    block_id
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -7715,18 +14341,18 @@ func SQLCreateManyTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 	return count, nil
 }
 
-// SQLGetTTxBtcCol 根据id查询
-func SQLGetTTxBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtc, error) {
+// SQLGetTTxEosCol 根据id查询
+func SQLGetTTxEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxEos, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc
+	t_tx_eos
 WHERE
 	id=:id`)
 
-	var row DBTTxBtc
+	var row DBTTxEos
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -7745,8 +14371,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTTxBtcColKV 根据id查询
-func SQLGetTTxBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtc, error) {
+// SQLGetTTxEosColKV 根据id查询
+func SQLGetTTxEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxEos, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -7757,7 +14383,7 @@ func SQLGetTTxBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string,
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc
+	t_tx_eos
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -7787,7 +14413,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTTxBtc
+	var row DBTTxEos
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -7804,8 +14430,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTTxBtcCol 根据ids获取
-func SQLSelectTTxBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtc, error) {
+// SQLSelectTTxEosCol 根据ids获取
+func SQLSelectTTxEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxEos, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -7814,7 +14440,7 @@ func SQLSelectTTxBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc
+	t_tx_eos
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -7828,7 +14454,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTTxBtc
+	var rows []*DBTTxEos
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -7844,8 +14470,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxBtcColKV 根据ids获取
-func SQLSelectTTxBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtc, error) {
+// SQLSelectTTxEosColKV 根据ids获取
+func SQLSelectTTxEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxEos, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -7856,7 +14482,7 @@ func SQLSelectTTxBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc
+	t_tx_eos
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -7897,7 +14523,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTTxBtc
+	var rows []*DBTTxEos
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -7911,38 +14537,46 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTTxBtc 更新
-func SQLUpdateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc) (int64, error) {
+// SQLUpdateTTxEos 更新
+func SQLUpdateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_tx_btc
+	t_tx_eos
 SET
     product_id=:product_id,
-    block_hash=:block_hash,
-    tx_id=:tx_id,
-    vout_n=:vout_n,
-    vout_address=:vout_address,
-    vout_value=:vout_value,
-    create_time=:create_time,
+    symbol=:symbol,
+    tx_hash=:tx_hash,
+    log_index=:log_index,
+    from_address=:from_address,
+    to_address=:to_address,
+    memo=:memo,
+    balance_real=:balance_real,
+    create_at=:create_at,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
-    handle_time=:handle_time
+    handle_at=:handle_at,
+    block_num=:block_num,
+    block_id=:block_id
 WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
 			"product_id":    row.ProductID,
-			"block_hash":    row.BlockHash,
-			"tx_id":         row.TxID,
-			"vout_n":        row.VoutN,
-			"vout_address":  row.VoutAddress,
-			"vout_value":    row.VoutValue,
-			"create_time":   row.CreateTime,
+			"symbol":        row.Symbol,
+			"tx_hash":       row.TxHash,
+			"log_index":     row.LogIndex,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"memo":          row.Memo,
+			"balance_real":  row.BalanceReal,
+			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
+			"handle_at":     row.HandleAt,
+			"block_num":     row.BlockNum,
+			"block_id":      row.BlockID,
 		},
 	)
 	if err != nil {
@@ -7951,14 +14585,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTTxBtc 删除
-func SQLDeleteTTxBtc(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxEos 删除
+func SQLDeleteTTxEos(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_tx_btc
+	t_tx_eos
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -7971,8 +14605,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTTxBtcToken 创建
-func SQLCreateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcToken, isIgnore bool) (int64, error) {
+// SQLCreateTTxErc20 创建
+func SQLCreateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -7980,48 +14614,46 @@ func SQLCreateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_btc_token ( ")
+	query.WriteString("INTO t_tx_erc20 ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
+       chain_symbol,
+       token_id,
        product_id,
-       token_index,
-       token_symbol,
-       block_hash,
        tx_id,
+       block_num,
        from_address,
        to_address,
-       value,
-       blocktime,
-       create_at,
+       balance_real,
+       create_time,
        handle_status,
        handle_msg,
-       handle_at,
+       handle_time,
        org_status,
        org_msg,
-       org_at
+       org_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
+    :chain_symbol,
+    :token_id,
     :product_id,
-    :token_index,
-    :token_symbol,
-    :block_hash,
     :tx_id,
+    :block_num,
     :from_address,
     :to_address,
-    :value,
-    :blocktime,
-    :create_at,
+    :balance_real,
+    :create_time,
     :handle_status,
     :handle_msg,
-    :handle_at,
+    :handle_time,
     :org_status,
     :org_msg,
-    :org_at
+    :org_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
@@ -8029,22 +14661,21 @@ func SQLCreateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxB
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
+			"chain_symbol":  row.ChainSymbol,
+			"token_id":      row.TokenID,
 			"product_id":    row.ProductID,
-			"token_index":   row.TokenIndex,
-			"token_symbol":  row.TokenSymbol,
-			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
+			"block_num":     row.BlockNum,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"value":         row.Value,
-			"blocktime":     row.Blocktime,
-			"create_at":     row.CreateAt,
+			"balance_real":  row.BalanceReal,
+			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_at":     row.HandleAt,
+			"handle_time":   row.HandleTime,
 			"org_status":    row.OrgStatus,
 			"org_msg":       row.OrgMsg,
-			"org_at":        row.OrgAt,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -8053,53 +14684,51 @@ func SQLCreateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxB
 	return lastID, nil
 }
 
-// SQLCreateTTxBtcTokenDuplicate 创建更新
-func SQLCreateTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcToken, updates []string) (int64, error) {
+// SQLCreateTTxErc20Duplicate 创建更新
+func SQLCreateTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_btc_token ( ")
+	query.WriteString("INSERT INTO t_tx_erc20 ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
+       chain_symbol,
+       token_id,
        product_id,
-       token_index,
-       token_symbol,
-       block_hash,
        tx_id,
+       block_num,
        from_address,
        to_address,
-       value,
-       blocktime,
-       create_at,
+       balance_real,
+       create_time,
        handle_status,
        handle_msg,
-       handle_at,
+       handle_time,
        org_status,
        org_msg,
-       org_at
+       org_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
+    :chain_symbol,
+    :token_id,
     :product_id,
-    :token_index,
-    :token_symbol,
-    :block_hash,
     :tx_id,
+    :block_num,
     :from_address,
     :to_address,
-    :value,
-    :blocktime,
-    :create_at,
+    :balance_real,
+    :create_time,
     :handle_status,
     :handle_msg,
-    :handle_at,
+    :handle_time,
     :org_status,
     :org_msg,
-    :org_at
+    :org_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -8123,22 +14752,21 @@ func SQLCreateTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, ro
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
+			"chain_symbol":  row.ChainSymbol,
+			"token_id":      row.TokenID,
 			"product_id":    row.ProductID,
-			"token_index":   row.TokenIndex,
-			"token_symbol":  row.TokenSymbol,
-			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
+			"block_num":     row.BlockNum,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"value":         row.Value,
-			"blocktime":     row.Blocktime,
-			"create_at":     row.CreateAt,
+			"balance_real":  row.BalanceReal,
+			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_at":     row.HandleAt,
+			"handle_time":   row.HandleTime,
 			"org_status":    row.OrgStatus,
 			"org_msg":       row.OrgMsg,
-			"org_at":        row.OrgAt,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -8147,8 +14775,8 @@ func SQLCreateTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, ro
 	return lastID, nil
 }
 
-// SQLCreateManyTTxBtcToken 创建多个
-func SQLCreateManyTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcToken, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxErc20 创建多个
+func SQLCreateManyTTxErc20(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxErc20, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -8159,22 +14787,21 @@ func SQLCreateManyTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, rows []
 				args,
 				[]interface{}{
 					row.ID,
+					row.ChainSymbol,
+					row.TokenID,
 					row.ProductID,
-					row.TokenIndex,
-					row.TokenSymbol,
-					row.BlockHash,
 					row.TxID,
+					row.BlockNum,
 					row.FromAddress,
 					row.ToAddress,
-					row.Value,
-					row.Blocktime,
-					row.CreateAt,
+					row.BalanceReal,
+					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleAt,
+					row.HandleTime,
 					row.OrgStatus,
 					row.OrgMsg,
-					row.OrgAt,
+					row.OrgTime,
 				},
 			)
 		}
@@ -8183,22 +14810,21 @@ func SQLCreateManyTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, rows []
 			args = append(
 				args,
 				[]interface{}{
+					row.ChainSymbol,
+					row.TokenID,
 					row.ProductID,
-					row.TokenIndex,
-					row.TokenSymbol,
-					row.BlockHash,
 					row.TxID,
+					row.BlockNum,
 					row.FromAddress,
 					row.ToAddress,
-					row.Value,
-					row.Blocktime,
-					row.CreateAt,
+					row.BalanceReal,
+					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleAt,
+					row.HandleTime,
 					row.OrgStatus,
 					row.OrgMsg,
-					row.OrgAt,
+					row.OrgTime,
 				},
 			)
 		}
@@ -8210,27 +14836,26 @@ func SQLCreateManyTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, rows []
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_btc_token ( ")
+	query.WriteString("INTO t_tx_erc20 ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
+    chain_symbol,
+    token_id,
     product_id,
-    token_index,
-    token_symbol,
-    block_hash,
     tx_id,
+    block_num,
     from_address,
     to_address,
-    value,
-    blocktime,
-    create_at,
+    balance_real,
+    create_time,
     handle_status,
     handle_msg,
-    handle_at,
+    handle_time,
     org_status,
     org_msg,
-    org_at
+    org_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -8246,8 +14871,8 @@ func SQLCreateManyTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, rows []
 	return count, nil
 }
 
-// SQLCreateManyTTxBtcTokenDuplicate 创建多个
-func SQLCreateManyTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcToken, updates []string) (int64, error) {
+// SQLCreateManyTTxErc20Duplicate 创建多个
+func SQLCreateManyTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxErc20, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -8258,22 +14883,21 @@ func SQLCreateManyTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble
 				args,
 				[]interface{}{
 					row.ID,
+					row.ChainSymbol,
+					row.TokenID,
 					row.ProductID,
-					row.TokenIndex,
-					row.TokenSymbol,
-					row.BlockHash,
 					row.TxID,
+					row.BlockNum,
 					row.FromAddress,
 					row.ToAddress,
-					row.Value,
-					row.Blocktime,
-					row.CreateAt,
+					row.BalanceReal,
+					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleAt,
+					row.HandleTime,
 					row.OrgStatus,
 					row.OrgMsg,
-					row.OrgAt,
+					row.OrgTime,
 				},
 			)
 		}
@@ -8282,22 +14906,21 @@ func SQLCreateManyTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble
 			args = append(
 				args,
 				[]interface{}{
+					row.ChainSymbol,
+					row.TokenID,
 					row.ProductID,
-					row.TokenIndex,
-					row.TokenSymbol,
-					row.BlockHash,
 					row.TxID,
+					row.BlockNum,
 					row.FromAddress,
 					row.ToAddress,
-					row.Value,
-					row.Blocktime,
-					row.CreateAt,
+					row.BalanceReal,
+					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleAt,
+					row.HandleTime,
 					row.OrgStatus,
 					row.OrgMsg,
-					row.OrgAt,
+					row.OrgTime,
 				},
 			)
 		}
@@ -8305,27 +14928,26 @@ func SQLCreateManyTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_btc_token ( ")
+	query.WriteString("INSERT INTO t_tx_erc20 ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
+    chain_symbol,
+    token_id,
     product_id,
-    token_index,
-    token_symbol,
-    block_hash,
     tx_id,
+    block_num,
     from_address,
     to_address,
-    value,
-    blocktime,
-    create_at,
+    balance_real,
+    create_time,
     handle_status,
     handle_msg,
-    handle_at,
+    handle_time,
     org_status,
     org_msg,
-    org_at
+    org_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -8357,18 +14979,18 @@ func SQLCreateManyTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble
 	return count, nil
 }
 
-// SQLGetTTxBtcTokenCol 根据id查询
-func SQLGetTTxBtcTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtcToken, error) {
+// SQLGetTTxErc20Col 根据id查询
+func SQLGetTTxErc20Col(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxErc20, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_token
+	t_tx_erc20
 WHERE
 	id=:id`)
 
-	var row DBTTxBtcToken
+	var row DBTTxErc20
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -8387,8 +15009,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTTxBtcTokenColKV 根据id查询
-func SQLGetTTxBtcTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtcToken, error) {
+// SQLGetTTxErc20ColKV 根据id查询
+func SQLGetTTxErc20ColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxErc20, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -8399,7 +15021,7 @@ func SQLGetTTxBtcTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_token
+	t_tx_erc20
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -8429,7 +15051,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTTxBtcToken
+	var row DBTTxErc20
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -8446,8 +15068,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTTxBtcTokenCol 根据ids获取
-func SQLSelectTTxBtcTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtcToken, error) {
+// SQLSelectTTxErc20Col 根据ids获取
+func SQLSelectTTxErc20Col(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxErc20, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -8456,7 +15078,7 @@ func SQLSelectTTxBtcTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []s
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_token
+	t_tx_erc20
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -8470,7 +15092,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTTxBtcToken
+	var rows []*DBTTxErc20
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -8486,8 +15108,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxBtcTokenColKV 根据ids获取
-func SQLSelectTTxBtcTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtcToken, error) {
+// SQLSelectTTxErc20ColKV 根据ids获取
+func SQLSelectTTxErc20ColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxErc20, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -8498,7 +15120,7 @@ func SQLSelectTTxBtcTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols [
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_token
+	t_tx_erc20
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -8539,7 +15161,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTTxBtcToken
+	var rows []*DBTTxErc20
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -8553,50 +15175,48 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTTxBtcToken 更新
-func SQLUpdateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcToken) (int64, error) {
+// SQLUpdateTTxErc20 更新
+func SQLUpdateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_tx_btc_token
+	t_tx_erc20
 SET
+    chain_symbol=:chain_symbol,
+    token_id=:token_id,
     product_id=:product_id,
-    token_index=:token_index,
-    token_symbol=:token_symbol,
-    block_hash=:block_hash,
     tx_id=:tx_id,
+    block_num=:block_num,
     from_address=:from_address,
     to_address=:to_address,
-    value=:value,
-    blocktime=:blocktime,
-    create_at=:create_at,
+    balance_real=:balance_real,
+    create_time=:create_time,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
-    handle_at=:handle_at,
+    handle_time=:handle_time,
     org_status=:org_status,
     org_msg=:org_msg,
-    org_at=:org_at
+    org_time=:org_time
 WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
+			"chain_symbol":  row.ChainSymbol,
+			"token_id":      row.TokenID,
 			"product_id":    row.ProductID,
-			"token_index":   row.TokenIndex,
-			"token_symbol":  row.TokenSymbol,
-			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
+			"block_num":     row.BlockNum,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"value":         row.Value,
-			"blocktime":     row.Blocktime,
-			"create_at":     row.CreateAt,
+			"balance_real":  row.BalanceReal,
+			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_at":     row.HandleAt,
+			"handle_time":   row.HandleTime,
 			"org_status":    row.OrgStatus,
 			"org_msg":       row.OrgMsg,
-			"org_at":        row.OrgAt,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -8605,14 +15225,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTTxBtcToken 删除
-func SQLDeleteTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxErc20 删除
+func SQLDeleteTTxErc20(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_tx_btc_token
+	t_tx_erc20
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -8625,8 +15245,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTTxBtcUxto 创建
-func SQLCreateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcUxto, isIgnore bool) (int64, error) {
+// SQLCreateTTxSol 创建
+func SQLCreateTTxSol(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxSol, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -8634,42 +15254,48 @@ func SQLCreateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBt
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_btc_uxto ( ")
+	query.WriteString("INTO t_tx_sol ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       uxto_type,
-       block_hash,
+       token_id,
+       product_id,
        tx_id,
-       vout_n,
-       vout_address,
-       vout_value,
-       vout_script,
+       block_num,
+       ix_index,
+       from_address,
+       to_address,
+       token_account,
+       balance_real,
        create_time,
-       spend_tx_id,
-       spend_n,
        handle_status,
        handle_msg,
-       handle_time
+       handle_time,
+       org_status,
+       org_msg,
+       org_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :uxto_type,
-    :block_hash,
+    :token_id,
+    :product_id,
     :tx_id,
-    :vout_n,
-    :vout_address,
-    :vout_value,
-    :vout_script,
+    :block_num,
+    :ix_index,
+    :from_address,
+    :to_address,
+    :token_account,
+    :balance_real,
     :create_time,
-    :spend_tx_id,
-    :spend_n,
     :handle_status,
     :handle_msg,
-    :handle_time
+    :handle_time,
+    :org_status,
+    :org_msg,
+    :org_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
@@ -8677,19 +15303,22 @@ func SQLCreateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBt
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"uxto_type":     row.UxtoType,
-			"block_hash":    row.BlockHash,
+			"token_id":      row.TokenID,
+			"product_id":    row.ProductID,
 			"tx_id":         row.TxID,
-			"vout_n":        row.VoutN,
-			"vout_address":  row.VoutAddress,
-			"vout_value":    row.VoutValue,
-			"vout_script":   row.VoutScript,
+			"block_num":     row.BlockNum,
+			"ix_index":      row.IxIndex,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"token_account": row.TokenAccount,
+			"balance_real":  row.BalanceReal,
 			"create_time":   row.CreateTime,
-			"spend_tx_id":   row.SpendTxID,
-			"spend_n":       row.SpendN,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -8698,47 +15327,53 @@ func SQLCreateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBt
 	return lastID, nil
 }
 
-// SQLCreateTTxBtcUxtoDuplicate 创建更新
-func SQLCreateTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcUxto, updates []string) (int64, error) {
+// SQLCreateTTxSolDuplicate 创建更新
+func SQLCreateTTxSolDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxSol, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_btc_uxto ( ")
+	query.WriteString("INSERT INTO t_tx_sol ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       uxto_type,
-       block_hash,
+       token_id,
+       product_id,
        tx_id,
-       vout_n,
-       vout_address,
-       vout_value,
-       vout_script,
+       block_num,
+       ix_index,
+       from_address,
+       to_address,
+       token_account,
+       balance_real,
        create_time,
-       spend_tx_id,
-       spend_n,
        handle_status,
        handle_msg,
-       handle_time
+       handle_time,
+       org_status,
+       org_msg,
+       org_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :uxto_type,
-    :block_hash,
+    :token_id,
+    :product_id,
     :tx_id,
-    :vout_n,
-    :vout_address,
-    :vout_value,
-    :vout_script,
+    :block_num,
+    :ix_index,
+    :from_address,
+    :to_address,
+    :token_account,
+    :balance_real,
     :create_time,
-    :spend_tx_id,
-    :spend_n,
     :handle_status,
     :handle_msg,
-    :handle_time
+    :handle_time,
+    :org_status,
+    :org_msg,
+    :org_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -8762,19 +15397,22 @@ func SQLCreateTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"uxto_type":     row.UxtoType,
-			"block_hash":    row.BlockHash,
+			"token_id":      row.TokenID,
+			"product_id":    row.ProductID,
 			"tx_id":         row.TxID,
-			"vout_n":        row.VoutN,
-			"vout_address":  row.VoutAddress,
-			"vout_value":    row.VoutValue,
-			"vout_script":   row.VoutScript,
+			"block_num":     row.BlockNum,
+			"ix_index":      row.IxIndex,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"token_account": row.TokenAccount,
+			"balance_real":  row.BalanceReal,
 			"create_time":   row.CreateTime,
-			"spend_tx_id":   row.SpendTxID,
-			"spend_n":       row.SpendN,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -8783,8 +15421,8 @@ func SQLCreateTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 	return lastID, nil
 }
 
-// SQLCreateManyTTxBtcUxto 创建多个
-func SQLCreateManyTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcUxto, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxSol 创建多个
+func SQLCreateManyTTxSol(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxSol, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -8795,19 +15433,22 @@ func SQLCreateManyTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, rows []*
 				args,
 				[]interface{}{
 					row.ID,
-					row.UxtoType,
-					row.BlockHash,
+					row.TokenID,
+					row.ProductID,
 					row.TxID,
-					row.VoutN,
-					row.VoutAddress,
-					row.VoutValue,
-					row.VoutScript,
+					row.BlockNum,
+					row.IxIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.TokenAccount,
+					row.BalanceReal,
 					row.CreateTime,
-					row.SpendTxID,
-					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -8816,19 +15457,22 @@ func SQLCreateManyTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, rows []*
 			args = append(
 				args,
 				[]interface{}{
-					row.UxtoType,
-					row.BlockHash,
+					row.TokenID,
+					row.ProductID,
 					row.TxID,
-					row.VoutN,
-					row.VoutAddress,
-					row.VoutValue,
-					row.VoutScript,
+					row.BlockNum,
+					row.IxIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.TokenAccount,
+					row.BalanceReal,
 					row.CreateTime,
-					row.SpendTxID,
-					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -8840,24 +15484,27 @@ func SQLCreateManyTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, rows []*
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_btc_uxto ( ")
+	query.WriteString("INTO t_tx_sol ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    uxto_type,
-    block_hash,
+    token_id,
+    product_id,
     tx_id,
-    vout_n,
-    vout_address,
-    vout_value,
-    vout_script,
+    block_num,
+    ix_index,
+    from_address,
+    to_address,
+    token_account,
+    balance_real,
     create_time,
-    spend_tx_id,
-    spend_n,
     handle_status,
     handle_msg,
-    handle_time
+    handle_time,
+    org_status,
+    org_msg,
+    org_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -8873,8 +15520,8 @@ func SQLCreateManyTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, rows []*
 	return count, nil
 }
 
-// SQLCreateManyTTxBtcUxtoDuplicate 创建多个
-func SQLCreateManyTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcUxto, updates []string) (int64, error) {
+// SQLCreateManyTTxSolDuplicate 创建多个
+func SQLCreateManyTTxSolDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxSol, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -8885,19 +15532,22 @@ func SQLCreateManyTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 				args,
 				[]interface{}{
 					row.ID,
-					row.UxtoType,
-					row.BlockHash,
+					row.TokenID,
+					row.ProductID,
 					row.TxID,
-					row.VoutN,
-					row.VoutAddress,
-					row.VoutValue,
-					row.VoutScript,
+					row.BlockNum,
+					row.IxIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.TokenAccount,
+					row.BalanceReal,
 					row.CreateTime,
-					row.SpendTxID,
-					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -8906,19 +15556,22 @@ func SQLCreateManyTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 			args = append(
 				args,
 				[]interface{}{
-					row.UxtoType,
-					row.BlockHash,
+					row.TokenID,
+					row.ProductID,
 					row.TxID,
-					row.VoutN,
-					row.VoutAddress,
-					row.VoutValue,
-					row.VoutScript,
+					row.BlockNum,
+					row.IxIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.TokenAccount,
+					row.BalanceReal,
 					row.CreateTime,
-					row.SpendTxID,
-					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -8926,24 +15579,27 @@ func SQLCreateManyTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_btc_uxto ( ")
+	query.WriteString("INSERT INTO t_tx_sol ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    uxto_type,
-    block_hash,
+    token_id,
+    product_id,
     tx_id,
-    vout_n,
-    vout_address,
-    vout_value,
-    vout_script,
+    block_num,
+    ix_index,
+    from_address,
+    to_address,
+    token_account,
+    balance_real,
     create_time,
-    spend_tx_id,
-    spend_n,
     handle_status,
     handle_msg,
-    handle_time
+    handle_time,
+    org_status,
+    org_msg,
+    org_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -8975,18 +15631,18 @@ func SQLCreateManyTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	return count, nil
 }
 
-// SQLGetTTxBtcUxtoCol 根据id查询
-func SQLGetTTxBtcUxtoCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtcUxto, error) {
+// SQLGetTTxSolCol 根据id查询
+func SQLGetTTxSolCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxSol, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_uxto
+	t_tx_sol
 WHERE
 	id=:id`)
 
-	var row DBTTxBtcUxto
+	var row DBTTxSol
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -9005,8 +15661,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTTxBtcUxtoColKV 根据id查询
-func SQLGetTTxBtcUxtoColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtcUxto, error) {
+// SQLGetTTxSolColKV 根据id查询
+func SQLGetTTxSolColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxSol, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -9017,7 +15673,7 @@ func SQLGetTTxBtcUxtoColKV(ctx context.Context, tx mcommon.DbExeAble, cols []str
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_uxto
+	t_tx_sol
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -9047,7 +15703,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTTxBtcUxto
+	var row DBTTxSol
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -9064,8 +15720,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTTxBtcUxtoCol 根据ids获取
-func SQLSelectTTxBtcUxtoCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtcUxto, error) {
+// SQLSelectTTxSolCol 根据ids获取
+func SQLSelectTTxSolCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxSol, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -9074,7 +15730,7 @@ func SQLSelectTTxBtcUxtoCol(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_uxto
+	t_tx_sol
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -9088,7 +15744,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTTxBtcUxto
+	var rows []*DBTTxSol
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -9104,8 +15760,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxBtcUxtoColKV 根据ids获取
-func SQLSelectTTxBtcUxtoColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtcUxto, error) {
+// SQLSelectTTxSolColKV 根据ids获取
+func SQLSelectTTxSolColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxSol, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -9116,7 +15772,7 @@ func SQLSelectTTxBtcUxtoColKV(ctx context.Context, tx mcommon.DbExeAble, cols []
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_uxto
+	t_tx_sol
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -9157,7 +15813,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTTxBtcUxto
+	var rows []*DBTTxSol
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -9171,44 +15827,50 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTTxBtcUxto 更新
-func SQLUpdateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcUxto) (int64, error) {
+// SQLUpdateTTxSol 更新
+func SQLUpdateTTxSol(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxSol) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_tx_btc_uxto
+	t_tx_sol
 SET
-    uxto_type=:uxto_type,
-    block_hash=:block_hash,
+    token_id=:token_id,
+    product_id=:product_id,
     tx_id=:tx_id,
-    vout_n=:vout_n,
-    vout_address=:vout_address,
-    vout_value=:vout_value,
-    vout_script=:vout_script,
+    block_num=:block_num,
+    ix_index=:ix_index,
+    from_address=:from_address,
+    to_address=:to_address,
+    token_account=:token_account,
+    balance_real=:balance_real,
     create_time=:create_time,
-    spend_tx_id=:spend_tx_id,
-    spend_n=:spend_n,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
-    handle_time=:handle_time
+    handle_time=:handle_time,
+    org_status=:org_status,
+    org_msg=:org_msg,
+    org_time=:org_time
 WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
-			"uxto_type":     row.UxtoType,
-			"block_hash":    row.BlockHash,
+			"token_id":      row.TokenID,
+			"product_id":    row.ProductID,
 			"tx_id":         row.TxID,
-			"vout_n":        row.VoutN,
-			"vout_address":  row.VoutAddress,
-			"vout_value":    row.VoutValue,
-			"vout_script":   row.VoutScript,
+			"block_num":     row.BlockNum,
+			"ix_index":      row.IxIndex,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"token_account": row.TokenAccount,
+			"balance_real":  row.BalanceReal,
 			"create_time":   row.CreateTime,
-			"spend_tx_id":   row.SpendTxID,
-			"spend_n":       row.SpendN,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -9217,14 +15879,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTTxBtcUxto 删除
-func SQLDeleteTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxSol 删除
+func SQLDeleteTTxSol(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_tx_btc_uxto
+	t_tx_sol
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -9237,8 +15899,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTTxEos 创建
-func SQLCreateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, isIgnore bool) (int64, error) {
+// SQLCreateTTxTrx 创建
+func SQLCreateTTxTrx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxTrx, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -9246,38 +15908,46 @@ func SQLCreateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, i
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_eos ( ")
+	query.WriteString("INTO t_tx_trx ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
+       token_id,
        product_id,
-       tx_hash,
+       tx_id,
+       block_num,
        log_index,
        from_address,
        to_address,
-       memo,
        balance_real,
-       create_at,
+       create_time,
        handle_status,
        handle_msg,
-       handle_at
+       handle_time,
+       org_status,
+       org_msg,
+       org_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
+    :token_id,
     :product_id,
-    :tx_hash,
+    :tx_id,
+    :block_num,
     :log_index,
     :from_address,
     :to_address,
-    :memo,
     :balance_real,
-    :create_at,
+    :create_time,
     :handle_status,
     :handle_msg,
-    :handle_at
+    :handle_time,
+    :org_status,
+    :org_msg,
+    :org_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
@@ -9285,17 +15955,21 @@ func SQLCreateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, i
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
+			"token_id":      row.TokenID,
 			"product_id":    row.ProductID,
-			"tx_hash":       row.TxHash,
+			"tx_id":         row.TxID,
+			"block_num":     row.BlockNum,
 			"log_index":     row.LogIndex,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"memo":          row.Memo,
 			"balance_real":  row.BalanceReal,
-			"create_at":     row.CreateAt,
+			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_at":     row.HandleAt,
+			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -9304,43 +15978,51 @@ func SQLCreateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, i
 	return lastID, nil
 }
 
-// SQLCreateTTxEosDuplicate 创建更新
-func SQLCreateTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, updates []string) (int64, error) {
+// SQLCreateTTxTrxDuplicate 创建更新
+func SQLCreateTTxTrxDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxTrx, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_eos ( ")
+	query.WriteString("INSERT INTO t_tx_trx ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
+       token_id,
        product_id,
-       tx_hash,
+       tx_id,
+       block_num,
        log_index,
        from_address,
        to_address,
-       memo,
        balance_real,
-       create_at,
+       create_time,
        handle_status,
        handle_msg,
-       handle_at
+       handle_time,
+       org_status,
+       org_msg,
+       org_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
+    :token_id,
     :product_id,
-    :tx_hash,
+    :tx_id,
+    :block_num,
     :log_index,
     :from_address,
     :to_address,
-    :memo,
     :balance_real,
-    :create_at,
+    :create_time,
     :handle_status,
     :handle_msg,
-    :handle_at
+    :handle_time,
+    :org_status,
+    :org_msg,
+    :org_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -9364,17 +16046,21 @@ func SQLCreateTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DB
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
+			"token_id":      row.TokenID,
 			"product_id":    row.ProductID,
-			"tx_hash":       row.TxHash,
+			"tx_id":         row.TxID,
+			"block_num":     row.BlockNum,
 			"log_index":     row.LogIndex,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"memo":          row.Memo,
 			"balance_real":  row.BalanceReal,
-			"create_at":     row.CreateAt,
+			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_at":     row.HandleAt,
+			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -9383,8 +16069,8 @@ func SQLCreateTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DB
 	return lastID, nil
 }
 
-// SQLCreateManyTTxEos 创建多个
-func SQLCreateManyTTxEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxEos, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxTrx 创建多个
+func SQLCreateManyTTxTrx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxTrx, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -9395,17 +16081,21 @@ func SQLCreateManyTTxEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 				args,
 				[]interface{}{
 					row.ID,
+					row.TokenID,
 					row.ProductID,
-					row.TxHash,
+					row.TxID,
+					row.BlockNum,
 					row.LogIndex,
 					row.FromAddress,
 					row.ToAddress,
-					row.Memo,
 					row.BalanceReal,
-					row.CreateAt,
+					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleAt,
+					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -9414,17 +16104,21 @@ func SQLCreateManyTTxEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 			args = append(
 				args,
 				[]interface{}{
+					row.TokenID,
 					row.ProductID,
-					row.TxHash,
+					row.TxID,
+					row.BlockNum,
 					row.LogIndex,
 					row.FromAddress,
 					row.ToAddress,
-					row.Memo,
 					row.BalanceReal,
-					row.CreateAt,
+					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleAt,
+					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -9436,22 +16130,26 @@ func SQLCreateManyTTxEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_eos ( ")
+	query.WriteString("INTO t_tx_trx ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
+    token_id,
     product_id,
-    tx_hash,
+    tx_id,
+    block_num,
     log_index,
     from_address,
     to_address,
-    memo,
     balance_real,
-    create_at,
+    create_time,
     handle_status,
     handle_msg,
-    handle_at
+    handle_time,
+    org_status,
+    org_msg,
+    org_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -9467,8 +16165,8 @@ func SQLCreateManyTTxEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 	return count, nil
 }
 
-// SQLCreateManyTTxEosDuplicate 创建多个
-func SQLCreateManyTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxEos, updates []string) (int64, error) {
+// SQLCreateManyTTxTrxDuplicate 创建多个
+func SQLCreateManyTTxTrxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxTrx, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -9479,17 +16177,21 @@ func SQLCreateManyTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 				args,
 				[]interface{}{
 					row.ID,
+					row.TokenID,
 					row.ProductID,
-					row.TxHash,
+					row.TxID,
+					row.BlockNum,
 					row.LogIndex,
 					row.FromAddress,
 					row.ToAddress,
-					row.Memo,
 					row.BalanceReal,
-					row.CreateAt,
+					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleAt,
+					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -9498,17 +16200,21 @@ func SQLCreateManyTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 			args = append(
 				args,
 				[]interface{}{
+					row.TokenID,
 					row.ProductID,
-					row.TxHash,
+					row.TxID,
+					row.BlockNum,
 					row.LogIndex,
 					row.FromAddress,
 					row.ToAddress,
-					row.Memo,
 					row.BalanceReal,
-					row.CreateAt,
+					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleAt,
+					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -9516,22 +16222,26 @@ func SQLCreateManyTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_eos ( ")
+	query.WriteString("INSERT INTO t_tx_trx ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
+    token_id,
     product_id,
-    tx_hash,
+    tx_id,
+    block_num,
     log_index,
     from_address,
     to_address,
-    memo,
     balance_real,
-    create_at,
+    create_time,
     handle_status,
     handle_msg,
-    handle_at
+    handle_time,
+    org_status,
+    org_msg,
+    org_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -9563,18 +16273,18 @@ func SQLCreateManyTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 	return count, nil
 }
 
-// SQLGetTTxEosCol 根据id查询
-func SQLGetTTxEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxEos, error) {
+// SQLGetTTxTrxCol 根据id查询
+func SQLGetTTxTrxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxTrx, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_eos
+	t_tx_trx
 WHERE
 	id=:id`)
 
-	var row DBTTxEos
+	var row DBTTxTrx
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -9593,8 +16303,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTTxEosColKV 根据id查询
-func SQLGetTTxEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxEos, error) {
+// SQLGetTTxTrxColKV 根据id查询
+func SQLGetTTxTrxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxTrx, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -9605,7 +16315,7 @@ func SQLGetTTxEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string,
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_eos
+	t_tx_trx
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -9635,7 +16345,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTTxEos
+	var row DBTTxTrx
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -9652,8 +16362,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTTxEosCol 根据ids获取
-func SQLSelectTTxEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxEos, error) {
+// SQLSelectTTxTrxCol 根据ids获取
+func SQLSelectTTxTrxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxTrx, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -9662,7 +16372,7 @@ func SQLSelectTTxEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_eos
+	t_tx_trx
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -9676,7 +16386,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTTxEos
+	var rows []*DBTTxTrx
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -9692,8 +16402,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxEosColKV 根据ids获取
-func SQLSelectTTxEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxEos, error) {
+// SQLSelectTTxTrxColKV 根据ids获取
+func SQLSelectTTxTrxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxTrx, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -9704,7 +16414,7 @@ func SQLSelectTTxEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_eos
+	t_tx_trx
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -9745,7 +16455,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTTxEos
+	var rows []*DBTTxTrx
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -9759,40 +16469,48 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTTxEos 更新
-func SQLUpdateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos) (int64, error) {
+// SQLUpdateTTxTrx 更新
+func SQLUpdateTTxTrx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxTrx) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_tx_eos
+	t_tx_trx
 SET
+    token_id=:token_id,
     product_id=:product_id,
-    tx_hash=:tx_hash,
+    tx_id=:tx_id,
+    block_num=:block_num,
     log_index=:log_index,
     from_address=:from_address,
     to_address=:to_address,
-    memo=:memo,
     balance_real=:balance_real,
-    create_at=:create_at,
+    create_time=:create_time,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
-    handle_at=:handle_at
+    handle_time=:handle_time,
+    org_status=:org_status,
+    org_msg=:org_msg,
+    org_time=:org_time
 WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
+			"token_id":      row.TokenID,
 			"product_id":    row.ProductID,
-			"tx_hash":       row.TxHash,
+			"tx_id":         row.TxID,
+			"block_num":     row.BlockNum,
 			"log_index":     row.LogIndex,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"memo":          row.Memo,
 			"balance_real":  row.BalanceReal,
-			"create_at":     row.CreateAt,
+			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_at":     row.HandleAt,
+			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -9801,14 +16519,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTTxEos 删除
-func SQLDeleteTTxEos(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxTrx 删除
+func SQLDeleteTTxTrx(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_tx_eos
+	t_tx_trx
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -9821,8 +16539,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTTxErc20 创建
-func SQLCreateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20, isIgnore bool) (int64, error) {
+// SQLCreateTTxXrp 创建
+func SQLCreateTTxXrp(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxXrp, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -9830,42 +16548,38 @@ func SQLCreateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc2
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_erc20 ( ")
+	query.WriteString("INTO t_tx_xrp ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       token_id,
        product_id,
-       tx_id,
+       tx_hash,
+       ledger_index,
        from_address,
        to_address,
+       memo,
        balance_real,
-       create_time,
+       create_at,
        handle_status,
        handle_msg,
-       handle_time,
-       org_status,
-       org_msg,
-       org_time
+       handle_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :token_id,
     :product_id,
-    :tx_id,
+    :tx_hash,
+    :ledger_index,
     :from_address,
     :to_address,
+    :memo,
     :balance_real,
-    :create_time,
+    :create_at,
     :handle_status,
     :handle_msg,
-    :handle_time,
-    :org_status,
-    :org_msg,
-    :org_time
+    :handle_at
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
@@ -9873,19 +16587,17 @@ func SQLCreateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc2
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"token_id":      row.TokenID,
 			"product_id":    row.ProductID,
-			"tx_id":         row.TxID,
+			"tx_hash":       row.TxHash,
+			"ledger_index":  row.LedgerIndex,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
+			"memo":          row.Memo,
 			"balance_real":  row.BalanceReal,
-			"create_time":   row.CreateTime,
+			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
-			"org_status":    row.OrgStatus,
-			"org_msg":       row.OrgMsg,
-			"org_time":      row.OrgTime,
+			"handle_at":     row.HandleAt,
 		},
 	)
 	if err != nil {
@@ -9894,47 +16606,43 @@ func SQLCreateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc2
 	return lastID, nil
 }
 
-// SQLCreateTTxErc20Duplicate 创建更新
-func SQLCreateTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20, updates []string) (int64, error) {
+// SQLCreateTTxXrpDuplicate 创建更新
+func SQLCreateTTxXrpDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxXrp, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_erc20 ( ")
+	query.WriteString("INSERT INTO t_tx_xrp ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       token_id,
        product_id,
-       tx_id,
+       tx_hash,
+       ledger_index,
        from_address,
        to_address,
+       memo,
        balance_real,
-       create_time,
+       create_at,
        handle_status,
        handle_msg,
-       handle_time,
-       org_status,
-       org_msg,
-       org_time
+       handle_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :token_id,
     :product_id,
-    :tx_id,
+    :tx_hash,
+    :ledger_index,
     :from_address,
     :to_address,
+    :memo,
     :balance_real,
-    :create_time,
+    :create_at,
     :handle_status,
     :handle_msg,
-    :handle_time,
-    :org_status,
-    :org_msg,
-    :org_time
+    :handle_at
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -9958,19 +16666,17 @@ func SQLCreateTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"token_id":      row.TokenID,
 			"product_id":    row.ProductID,
-			"tx_id":         row.TxID,
+			"tx_hash":       row.TxHash,
+			"ledger_index":  row.LedgerIndex,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
+			"memo":          row.Memo,
 			"balance_real":  row.BalanceReal,
-			"create_time":   row.CreateTime,
+			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
-			"org_status":    row.OrgStatus,
-			"org_msg":       row.OrgMsg,
-			"org_time":      row.OrgTime,
+			"handle_at":     row.HandleAt,
 		},
 	)
 	if err != nil {
@@ -9979,8 +16685,8 @@ func SQLCreateTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 	return lastID, nil
 }
 
-// SQLCreateManyTTxErc20 创建多个
-func SQLCreateManyTTxErc20(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxErc20, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxXrp 创建多个
+func SQLCreateManyTTxXrp(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxXrp, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -9991,19 +16697,17 @@ func SQLCreateManyTTxErc20(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 				args,
 				[]interface{}{
 					row.ID,
-					row.TokenID,
 					row.ProductID,
-					row.TxID,
+					row.TxHash,
+					row.LedgerIndex,
 					row.FromAddress,
 					row.ToAddress,
+					row.Memo,
 					row.BalanceReal,
-					row.CreateTime,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleTime,
-					row.OrgStatus,
-					row.OrgMsg,
-					row.OrgTime,
+					row.HandleAt,
 				},
 			)
 		}
@@ -10012,19 +16716,17 @@ func SQLCreateManyTTxErc20(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 			args = append(
 				args,
 				[]interface{}{
-					row.TokenID,
 					row.ProductID,
-					row.TxID,
+					row.TxHash,
+					row.LedgerIndex,
 					row.FromAddress,
 					row.ToAddress,
+					row.Memo,
 					row.BalanceReal,
-					row.CreateTime,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleTime,
-					row.OrgStatus,
-					row.OrgMsg,
-					row.OrgTime,
+					row.HandleAt,
 				},
 			)
 		}
@@ -10036,24 +16738,22 @@ func SQLCreateManyTTxErc20(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_erc20 ( ")
+	query.WriteString("INTO t_tx_xrp ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    token_id,
     product_id,
-    tx_id,
+    tx_hash,
+    ledger_index,
     from_address,
     to_address,
+    memo,
     balance_real,
-    create_time,
+    create_at,
     handle_status,
     handle_msg,
-    handle_time,
-    org_status,
-    org_msg,
-    org_time
+    handle_at
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -10069,8 +16769,8 @@ func SQLCreateManyTTxErc20(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	return count, nil
 }
 
-// SQLCreateManyTTxErc20Duplicate 创建多个
-func SQLCreateManyTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxErc20, updates []string) (int64, error) {
+// SQLCreateManyTTxXrpDuplicate 创建多个
+func SQLCreateManyTTxXrpDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxXrp, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -10081,19 +16781,17 @@ func SQLCreateManyTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, r
 				args,
 				[]interface{}{
 					row.ID,
-					row.TokenID,
 					row.ProductID,
-					row.TxID,
+					row.TxHash,
+					row.LedgerIndex,
 					row.FromAddress,
 					row.ToAddress,
+					row.Memo,
 					row.BalanceReal,
-					row.CreateTime,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleTime,
-					row.OrgStatus,
-					row.OrgMsg,
-					row.OrgTime,
+					row.HandleAt,
 				},
 			)
 		}
@@ -10102,19 +16800,17 @@ func SQLCreateManyTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, r
 			args = append(
 				args,
 				[]interface{}{
-					row.TokenID,
 					row.ProductID,
-					row.TxID,
+					row.TxHash,
+					row.LedgerIndex,
 					row.FromAddress,
 					row.ToAddress,
+					row.Memo,
 					row.BalanceReal,
-					row.CreateTime,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleTime,
-					row.OrgStatus,
-					row.OrgMsg,
-					row.OrgTime,
+					row.HandleAt,
 				},
 			)
 		}
@@ -10122,24 +16818,22 @@ func SQLCreateManyTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_erc20 ( ")
+	query.WriteString("INSERT INTO t_tx_xrp ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    token_id,
     product_id,
-    tx_id,
+    tx_hash,
+    ledger_index,
     from_address,
     to_address,
+    memo,
     balance_real,
-    create_time,
+    create_at,
     handle_status,
     handle_msg,
-    handle_time,
-    org_status,
-    org_msg,
-    org_time
+    handle_at
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -10171,18 +16865,18 @@ func SQLCreateManyTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	return count, nil
 }
 
-// SQLGetTTxErc20Col 根据id查询
-func SQLGetTTxErc20Col(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxErc20, error) {
+// SQLGetTTxXrpCol 根据id查询
+func SQLGetTTxXrpCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxXrp, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_erc20
+	t_tx_xrp
 WHERE
 	id=:id`)
 
-	var row DBTTxErc20
+	var row DBTTxXrp
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -10201,8 +16895,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTTxErc20ColKV 根据id查询
-func SQLGetTTxErc20ColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxErc20, error) {
+// SQLGetTTxXrpColKV 根据id查询
+func SQLGetTTxXrpColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxXrp, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -10213,7 +16907,7 @@ func SQLGetTTxErc20ColKV(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_erc20
+	t_tx_xrp
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -10243,7 +16937,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTTxErc20
+	var row DBTTxXrp
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -10260,8 +16954,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTTxErc20Col 根据ids获取
-func SQLSelectTTxErc20Col(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxErc20, error) {
+// SQLSelectTTxXrpCol 根据ids获取
+func SQLSelectTTxXrpCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxXrp, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -10270,7 +16964,7 @@ func SQLSelectTTxErc20Col(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_erc20
+	t_tx_xrp
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -10284,7 +16978,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTTxErc20
+	var rows []*DBTTxXrp
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -10300,8 +16994,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxErc20ColKV 根据ids获取
-func SQLSelectTTxErc20ColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxErc20, error) {
+// SQLSelectTTxXrpColKV 根据ids获取
+func SQLSelectTTxXrpColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxXrp, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -10312,7 +17006,7 @@ func SQLSelectTTxErc20ColKV(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_erc20
+	t_tx_xrp
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -10353,7 +17047,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTTxErc20
+	var rows []*DBTTxXrp
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -10367,44 +17061,40 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTTxErc20 更新
-func SQLUpdateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20) (int64, error) {
+// SQLUpdateTTxXrp 更新
+func SQLUpdateTTxXrp(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxXrp) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_tx_erc20
+	t_tx_xrp
 SET
-    token_id=:token_id,
     product_id=:product_id,
-    tx_id=:tx_id,
+    tx_hash=:tx_hash,
+    ledger_index=:ledger_index,
     from_address=:from_address,
     to_address=:to_address,
+    memo=:memo,
     balance_real=:balance_real,
-    create_time=:create_time,
+    create_at=:create_at,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
-    handle_time=:handle_time,
-    org_status=:org_status,
-    org_msg=:org_msg,
-    org_time=:org_time
+    handle_at=:handle_at
 WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
-			"token_id":      row.TokenID,
 			"product_id":    row.ProductID,
-			"tx_id":         row.TxID,
+			"tx_hash":       row.TxHash,
+			"ledger_index":  row.LedgerIndex,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
+			"memo":          row.Memo,
 			"balance_real":  row.BalanceReal,
-			"create_time":   row.CreateTime,
+			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
-			"org_status":    row.OrgStatus,
-			"org_msg":       row.OrgMsg,
-			"org_time":      row.OrgTime,
+			"handle_at":     row.HandleAt,
 		},
 	)
 	if err != nil {
@@ -10413,14 +17103,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTTxErc20 删除
-func SQLDeleteTTxErc20(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxXrp 删除
+func SQLDeleteTTxXrp(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_tx_erc20
+	t_tx_xrp
 WHERE
 	id=:id`,
 		mcommon.H{
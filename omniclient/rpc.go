@@ -9,9 +9,17 @@ import (
 	"github.com/parnurzeal/gorequest"
 )
 
-var locOmniRPCUser string
-var locOmniRPCPwd string
-var rpcURI string
+// Client rpc客户端
+type Client struct {
+	rpcURI  string
+	rpcUser string
+	rpcPwd  string
+}
+
+var defaultClient = &Client{}
+
+// RpcErrorCodeInvalidAddressOrKey 交易或地址不存在
+const RpcErrorCodeInvalidAddressOrKey = -5
 
 type StRpcRespError struct {
 	Code    int64  `json:"code"`
@@ -95,6 +103,16 @@ type StBlockResult struct {
 	Nextblockhash     string        `json:"nextblockhash"`
 }
 
+type StBlockTxIDsResult struct {
+	Hash              string   `json:"hash"`
+	Confirmations     int64    `json:"confirmations"`
+	Height            int64    `json:"height"`
+	Tx                []string `json:"tx"`
+	Time              int64    `json:"time"`
+	Previousblockhash string   `json:"previousblockhash"`
+	Nextblockhash     string   `json:"nextblockhash"`
+}
+
 type StOmniTx struct {
 	Txid             string `json:"txid"`
 	Fee              string `json:"fee"`
@@ -123,13 +141,29 @@ type StOmniBalanceResult struct {
 
 // InitClient 初始化客户端
 func InitClient(omniRPCHost, omniRPCUser, omniRPCPwd string) {
-	rpcURI = omniRPCHost
-	locOmniRPCUser = omniRPCUser
-	locOmniRPCPwd = omniRPCPwd
+	defaultClient = NewClient(omniRPCHost, omniRPCUser, omniRPCPwd)
+}
+
+// NewClient 创建客户端
+func NewClient(rpcHost, rpcUser, rpcPwd string) *Client {
+	return &Client{
+		rpcURI:  rpcHost,
+		rpcUser: rpcUser,
+		rpcPwd:  rpcPwd,
+	}
+}
+
+// DefaultClient 获取默认客户端
+func DefaultClient() *Client {
+	return defaultClient
 }
 
 func doReq(method string, arqs []interface{}, resp interface{}) error {
-	_, body, errs := gorequest.New().SetBasicAuth(locOmniRPCUser, locOmniRPCPwd).Timeout(time.Minute * 5).Post(rpcURI).Send(StRpcReq{
+	return defaultClient.doReq(method, arqs, resp)
+}
+
+func (client *Client) doReq(method string, arqs []interface{}, resp interface{}) error {
+	_, body, errs := gorequest.New().SetBasicAuth(client.rpcUser, client.rpcPwd).Timeout(time.Minute * 5).Post(client.rpcURI).Send(StRpcReq{
 		Jsonrpc: "1.0",
 		ID:      mcommon.GetUUIDStr(),
 		Method:  method,
@@ -147,11 +181,16 @@ func doReq(method string, arqs []interface{}, resp interface{}) error {
 
 // RpcGetBlockCount 获取block number
 func RpcGetBlockCount() (int64, error) {
+	return defaultClient.RpcGetBlockCount()
+}
+
+// RpcGetBlockCount 获取block number
+func (client *Client) RpcGetBlockCount() (int64, error) {
 	resp := struct {
 		StRpcResp
 		Result int64 `json:"result"`
 	}{}
-	err := doReq(
+	err := client.doReq(
 		"getblockcount",
 		nil,
 		&resp,
@@ -167,11 +206,16 @@ func RpcGetBlockCount() (int64, error) {
 
 // RpcGetBlockHash 获取block hash
 func RpcGetBlockHash(blockHeight int64) (string, error) {
+	return defaultClient.RpcGetBlockHash(blockHeight)
+}
+
+// RpcGetBlockHash 获取block hash
+func (client *Client) RpcGetBlockHash(blockHeight int64) (string, error) {
 	resp := struct {
 		StRpcResp
 		Result string `json:"result"`
 	}{}
-	err := doReq(
+	err := client.doReq(
 		"getblockhash",
 		[]interface{}{blockHeight},
 		&resp,
@@ -187,11 +231,16 @@ func RpcGetBlockHash(blockHeight int64) (string, error) {
 
 // RpcGetBlockVerbose 获取block 内容
 func RpcGetBlockVerbose(blockHash string) (*StBlockResult, error) {
+	return defaultClient.RpcGetBlockVerbose(blockHash)
+}
+
+// RpcGetBlockVerbose 获取block 内容
+func (client *Client) RpcGetBlockVerbose(blockHash string) (*StBlockResult, error) {
 	resp := struct {
 		StRpcResp
 		Result *StBlockResult `json:"result"`
 	}{}
-	err := doReq(
+	err := client.doReq(
 		"getblock",
 		[]interface{}{blockHash, 2},
 		&resp,
@@ -205,13 +254,39 @@ func RpcGetBlockVerbose(blockHash string) (*StBlockResult, error) {
 	return resp.Result, nil
 }
 
+// RpcGetBlockTxIDs 获取block 内容, 只包含txid
+// 用于不支持 getblock verbosity=2 的节点
+func (client *Client) RpcGetBlockTxIDs(blockHash string) (*StBlockTxIDsResult, error) {
+	resp := struct {
+		StRpcResp
+		Result *StBlockTxIDsResult `json:"result"`
+	}{}
+	err := client.doReq(
+		"getblock",
+		[]interface{}{blockHash, true},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	return resp.Result, nil
+}
+
 // RpcGetRawTransactionVerbose 获取tx
 func RpcGetRawTransactionVerbose(txHash string) (*StTxResult, error) {
+	return defaultClient.RpcGetRawTransactionVerbose(txHash)
+}
+
+// RpcGetRawTransactionVerbose 获取tx
+func (client *Client) RpcGetRawTransactionVerbose(txHash string) (*StTxResult, error) {
 	resp := struct {
 		StRpcResp
 		Result *StTxResult `json:"result"`
 	}{}
-	err := doReq(
+	err := client.doReq(
 		"getrawtransaction",
 		[]interface{}{txHash, 1},
 		&resp,
@@ -225,13 +300,43 @@ func RpcGetRawTransactionVerbose(txHash string) (*StTxResult, error) {
 	return resp.Result, nil
 }
 
+// RpcGetRawMempool 获取内存池中的txid
+func RpcGetRawMempool() ([]string, error) {
+	return defaultClient.RpcGetRawMempool()
+}
+
+// RpcGetRawMempool 获取内存池中的txid
+func (client *Client) RpcGetRawMempool() ([]string, error) {
+	resp := struct {
+		StRpcResp
+		Result []string `json:"result"`
+	}{}
+	err := client.doReq(
+		"getrawmempool",
+		nil,
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	return resp.Result, nil
+}
+
 // RpcDecodeRawTransaction 解析tx
 func RpcDecodeRawTransaction(txHex string) (*StTxResult, error) {
+	return defaultClient.RpcDecodeRawTransaction(txHex)
+}
+
+// RpcDecodeRawTransaction 解析tx
+func (client *Client) RpcDecodeRawTransaction(txHex string) (*StTxResult, error) {
 	resp := struct {
 		StRpcResp
 		Result *StTxResult `json:"result"`
 	}{}
-	err := doReq(
+	err := client.doReq(
 		"decoderawtransaction",
 		[]interface{}{txHex},
 		&resp,
@@ -247,11 +352,16 @@ func RpcDecodeRawTransaction(txHex string) (*StTxResult, error) {
 
 // RpcSendRawTransaction 发送tx
 func RpcSendRawTransaction(txHex string) (*string, error) {
+	return defaultClient.RpcSendRawTransaction(txHex)
+}
+
+// RpcSendRawTransaction 发送tx
+func (client *Client) RpcSendRawTransaction(txHex string) (*string, error) {
 	resp := struct {
 		StRpcResp
 		Result *string `json:"result"`
 	}{}
-	err := doReq(
+	err := client.doReq(
 		"sendrawtransaction",
 		[]interface{}{txHex},
 		&resp,
@@ -0,0 +1,489 @@
+package solclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/parnurzeal/gorequest"
+)
+
+// 数据确认级别
+const (
+	CommitmentConfirmed = "confirmed"
+	CommitmentFinalized = "finalized"
+)
+
+// 错误码
+const (
+	ErrorCodeSlotSkipped        = -32007 // slot被跳过或者不在节点的账本中
+	ErrorCodeSlotSkippedMissing = -32009 // slot被跳过
+)
+
+// Client json rpc客户端
+type Client struct {
+	rpcURI string
+}
+
+var defaultClient = &Client{}
+
+// StRpcError 接口返回的错误
+type StRpcError struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *StRpcError) Error() string {
+	return fmt.Sprintf("sol rpc err: %d %s", e.Code, e.Message)
+}
+
+type StRpcResp struct {
+	Result json.RawMessage `json:"result"`
+	Error  *StRpcError     `json:"error"`
+}
+
+type StAccountKey struct {
+	Pubkey   string `json:"pubkey"`
+	Signer   bool   `json:"signer"`
+	Writable bool   `json:"writable"`
+}
+
+// StInstruction jsonParsed 格式的指令 无法解析的指令 Parsed 为空
+type StInstruction struct {
+	Program   string          `json:"program"`
+	ProgramID string          `json:"programId"`
+	Parsed    json.RawMessage `json:"parsed"`
+}
+
+type StParsedInstruction struct {
+	Type string          `json:"type"`
+	Info json.RawMessage `json:"info"`
+}
+
+// StTransferInfo system transfer
+type StTransferInfo struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Lamports    int64  `json:"lamports"`
+}
+
+// StTokenTransferInfo spl-token transfer 和 transferChecked
+type StTokenTransferInfo struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Authority   string `json:"authority"`
+	Mint        string `json:"mint"`
+	Amount      string `json:"amount"`
+	TokenAmount struct {
+		Amount   string `json:"amount"`
+		Decimals int64  `json:"decimals"`
+	} `json:"tokenAmount"`
+}
+
+type StInnerInstruction struct {
+	Index        int64            `json:"index"`
+	Instructions []*StInstruction `json:"instructions"`
+}
+
+type StTokenBalance struct {
+	AccountIndex  int64  `json:"accountIndex"`
+	Mint          string `json:"mint"`
+	Owner         string `json:"owner"`
+	UITokenAmount struct {
+		Amount   string `json:"amount"`
+		Decimals int64  `json:"decimals"`
+	} `json:"uiTokenAmount"`
+}
+
+type StTransactionMeta struct {
+	Err               json.RawMessage       `json:"err"`
+	Fee               int64                 `json:"fee"`
+	PreBalances       []int64               `json:"preBalances"`
+	PostBalances      []int64               `json:"postBalances"`
+	PreTokenBalances  []*StTokenBalance     `json:"preTokenBalances"`
+	PostTokenBalances []*StTokenBalance     `json:"postTokenBalances"`
+	InnerInstructions []*StInnerInstruction `json:"innerInstructions"`
+}
+
+type StTransaction struct {
+	Meta        *StTransactionMeta `json:"meta"`
+	Transaction struct {
+		Signatures []string `json:"signatures"`
+		Message    struct {
+			AccountKeys     []*StAccountKey  `json:"accountKeys"`
+			Instructions    []*StInstruction `json:"instructions"`
+			RecentBlockhash string           `json:"recentBlockhash"`
+		} `json:"message"`
+	} `json:"transaction"`
+}
+
+type StBlock struct {
+	BlockHeight  int64            `json:"blockHeight"`
+	BlockTime    int64            `json:"blockTime"`
+	Blockhash    string           `json:"blockhash"`
+	ParentSlot   int64            `json:"parentSlot"`
+	Transactions []*StTransaction `json:"transactions"`
+}
+
+type StBlockhash struct {
+	Blockhash            string `json:"blockhash"`
+	LastValidBlockHeight int64  `json:"lastValidBlockHeight"`
+}
+
+type StAccountInfo struct {
+	Lamports   int64  `json:"lamports"`
+	Owner      string `json:"owner"`
+	Executable bool   `json:"executable"`
+}
+
+type StTokenAmount struct {
+	Amount   string `json:"amount"`
+	Decimals int64  `json:"decimals"`
+}
+
+type StSignatureStatus struct {
+	Slot               int64           `json:"slot"`
+	Err                json.RawMessage `json:"err"`
+	ConfirmationStatus string          `json:"confirmationStatus"`
+}
+
+// InitClient 初始化客户端
+func InitClient(uri string) {
+	defaultClient = NewClient(uri)
+}
+
+// NewClient 创建客户端
+func NewClient(uri string) *Client {
+	return &Client{
+		rpcURI: uri,
+	}
+}
+
+// DefaultClient 默认客户端
+func DefaultClient() *Client {
+	return defaultClient
+}
+
+func (client *Client) doReq(method string, params []interface{}, resp interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+	_, body, errs := gorequest.New().
+		Timeout(time.Minute * 5).
+		Post(client.rpcURI).
+		Send(gin.H{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"method":  method,
+			"params":  params,
+		}).
+		EndBytes()
+	if errs != nil {
+		return errs[0]
+	}
+	var rpcResp StRpcResp
+	err := json.Unmarshal(body, &rpcResp)
+	if err != nil {
+		return err
+	}
+	if rpcResp.Error != nil {
+		return rpcResp.Error
+	}
+	err = json.Unmarshal(rpcResp.Result, resp)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RpcGetSlot 获取最新slot
+func RpcGetSlot(commitment string) (int64, error) {
+	return defaultClient.RpcGetSlot(commitment)
+}
+
+// RpcGetSlot 获取最新slot
+func (client *Client) RpcGetSlot(commitment string) (int64, error) {
+	var resp int64
+	err := client.doReq(
+		"getSlot",
+		[]interface{}{
+			gin.H{
+				"commitment": commitment,
+			},
+		},
+		&resp,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return resp, nil
+}
+
+// RpcGetBlockHeight 获取最新块高度
+func RpcGetBlockHeight(commitment string) (int64, error) {
+	return defaultClient.RpcGetBlockHeight(commitment)
+}
+
+// RpcGetBlockHeight 获取最新块高度
+func (client *Client) RpcGetBlockHeight(commitment string) (int64, error) {
+	var resp int64
+	err := client.doReq(
+		"getBlockHeight",
+		[]interface{}{
+			gin.H{
+				"commitment": commitment,
+			},
+		},
+		&resp,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return resp, nil
+}
+
+// RpcGetBlock 获取块 跳过的slot返回nil
+func RpcGetBlock(slot int64) (*StBlock, error) {
+	return defaultClient.RpcGetBlock(slot)
+}
+
+// RpcGetBlock 获取块 跳过的slot返回nil
+func (client *Client) RpcGetBlock(slot int64) (*StBlock, error) {
+	var resp *StBlock
+	err := client.doReq(
+		"getBlock",
+		[]interface{}{
+			slot,
+			gin.H{
+				"encoding":                       "jsonParsed",
+				"transactionDetails":             "full",
+				"rewards":                        false,
+				"commitment":                     CommitmentFinalized,
+				"maxSupportedTransactionVersion": 0,
+			},
+		},
+		&resp,
+	)
+	if err != nil {
+		if rpcErr, ok := err.(*StRpcError); ok {
+			if rpcErr.Code == ErrorCodeSlotSkipped || rpcErr.Code == ErrorCodeSlotSkippedMissing {
+				return nil, nil
+			}
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RpcGetTransaction 获取已确认的交易 未找到时返回nil
+func RpcGetTransaction(signature string) (*StTransaction, error) {
+	return defaultClient.RpcGetTransaction(signature)
+}
+
+// RpcGetTransaction 获取已确认的交易 未找到时返回nil
+func (client *Client) RpcGetTransaction(signature string) (*StTransaction, error) {
+	var resp *StTransaction
+	err := client.doReq(
+		"getTransaction",
+		[]interface{}{
+			signature,
+			gin.H{
+				"encoding":                       "jsonParsed",
+				"commitment":                     CommitmentFinalized,
+				"maxSupportedTransactionVersion": 0,
+			},
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RpcGetBalance 获取余额 lamports
+func RpcGetBalance(address string) (int64, error) {
+	return defaultClient.RpcGetBalance(address)
+}
+
+// RpcGetBalance 获取余额 lamports
+func (client *Client) RpcGetBalance(address string) (int64, error) {
+	var resp struct {
+		Value int64 `json:"value"`
+	}
+	err := client.doReq(
+		"getBalance",
+		[]interface{}{
+			address,
+			gin.H{
+				"commitment": CommitmentFinalized,
+			},
+		},
+		&resp,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Value, nil
+}
+
+// RpcGetAccountInfo 获取账户信息 账户不存在时返回nil
+func RpcGetAccountInfo(address string) (*StAccountInfo, error) {
+	return defaultClient.RpcGetAccountInfo(address)
+}
+
+// RpcGetAccountInfo 获取账户信息 账户不存在时返回nil
+func (client *Client) RpcGetAccountInfo(address string) (*StAccountInfo, error) {
+	var resp struct {
+		Value *StAccountInfo `json:"value"`
+	}
+	err := client.doReq(
+		"getAccountInfo",
+		[]interface{}{
+			address,
+			gin.H{
+				"encoding":   "base64",
+				"commitment": CommitmentFinalized,
+			},
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Value, nil
+}
+
+// RpcGetTokenAccountBalance 获取代币账户余额
+func RpcGetTokenAccountBalance(tokenAccount string) (*StTokenAmount, error) {
+	return defaultClient.RpcGetTokenAccountBalance(tokenAccount)
+}
+
+// RpcGetTokenAccountBalance 获取代币账户余额
+func (client *Client) RpcGetTokenAccountBalance(tokenAccount string) (*StTokenAmount, error) {
+	var resp struct {
+		Value *StTokenAmount `json:"value"`
+	}
+	err := client.doReq(
+		"getTokenAccountBalance",
+		[]interface{}{
+			tokenAccount,
+			gin.H{
+				"commitment": CommitmentFinalized,
+			},
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Value == nil {
+		return nil, fmt.Errorf("no token account of: %s", tokenAccount)
+	}
+	return resp.Value, nil
+}
+
+// RpcGetMinimumBalanceForRentExemption 获取免租金需要的最小余额
+func RpcGetMinimumBalanceForRentExemption(dataSize int64) (int64, error) {
+	return defaultClient.RpcGetMinimumBalanceForRentExemption(dataSize)
+}
+
+// RpcGetMinimumBalanceForRentExemption 获取免租金需要的最小余额
+func (client *Client) RpcGetMinimumBalanceForRentExemption(dataSize int64) (int64, error) {
+	var resp int64
+	err := client.doReq(
+		"getMinimumBalanceForRentExemption",
+		[]interface{}{
+			dataSize,
+		},
+		&resp,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return resp, nil
+}
+
+// RpcGetLatestBlockhash 获取最新的blockhash
+func RpcGetLatestBlockhash() (*StBlockhash, error) {
+	return defaultClient.RpcGetLatestBlockhash()
+}
+
+// RpcGetLatestBlockhash 获取最新的blockhash
+func (client *Client) RpcGetLatestBlockhash() (*StBlockhash, error) {
+	var resp struct {
+		Value *StBlockhash `json:"value"`
+	}
+	err := client.doReq(
+		"getLatestBlockhash",
+		[]interface{}{
+			gin.H{
+				"commitment": CommitmentFinalized,
+			},
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Value == nil {
+		return nil, fmt.Errorf("no latest blockhash")
+	}
+	return resp.Value, nil
+}
+
+// RpcSendTransaction 发送签名后的交易
+func RpcSendTransaction(txBase64 string) (string, error) {
+	return defaultClient.RpcSendTransaction(txBase64)
+}
+
+// RpcSendTransaction 发送签名后的交易
+func (client *Client) RpcSendTransaction(txBase64 string) (string, error) {
+	var resp string
+	err := client.doReq(
+		"sendTransaction",
+		[]interface{}{
+			txBase64,
+			gin.H{
+				"encoding":            "base64",
+				"preflightCommitment": CommitmentFinalized,
+			},
+		},
+		&resp,
+	)
+	if err != nil {
+		return "", err
+	}
+	return resp, nil
+}
+
+// RpcGetSignatureStatuses 获取交易状态 未找到的交易为nil
+func RpcGetSignatureStatuses(signatures []string) ([]*StSignatureStatus, error) {
+	return defaultClient.RpcGetSignatureStatuses(signatures)
+}
+
+// RpcGetSignatureStatuses 获取交易状态 未找到的交易为nil
+func (client *Client) RpcGetSignatureStatuses(signatures []string) ([]*StSignatureStatus, error) {
+	var resp struct {
+		Value []*StSignatureStatus `json:"value"`
+	}
+	err := client.doReq(
+		"getSignatureStatuses",
+		[]interface{}{
+			signatures,
+			gin.H{
+				"searchTransactionHistory": true,
+			},
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Value) != len(signatures) {
+		return nil, fmt.Errorf("error signature statuses count: %d", len(resp.Value))
+	}
+	return resp.Value, nil
+}
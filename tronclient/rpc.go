@@ -0,0 +1,414 @@
+package tronclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/parnurzeal/gorequest"
+)
+
+// Client http api客户端
+type Client struct {
+	rpcURI string
+	apiKey string
+}
+
+var defaultClient = &Client{}
+
+type StRpcRespError struct {
+	Error string `json:"Error"`
+}
+
+type StBlockHeader struct {
+	RawData struct {
+		Number         int64  `json:"number"`
+		TxTrieRoot     string `json:"txTrieRoot"`
+		WitnessAddress string `json:"witness_address"`
+		ParentHash     string `json:"parentHash"`
+		Version        int64  `json:"version"`
+		Timestamp      int64  `json:"timestamp"`
+	} `json:"raw_data"`
+	WitnessSignature string `json:"witness_signature"`
+}
+
+type StContract struct {
+	Type      string `json:"type"`
+	Parameter struct {
+		Value   json.RawMessage `json:"value"`
+		TypeURL string          `json:"type_url"`
+	} `json:"parameter"`
+}
+
+type StTransaction struct {
+	TxID string `json:"txID"`
+	Ret  []struct {
+		ContractRet string `json:"contractRet"`
+	} `json:"ret"`
+	RawData struct {
+		Contract      []StContract `json:"contract"`
+		RefBlockBytes string       `json:"ref_block_bytes"`
+		RefBlockHash  string       `json:"ref_block_hash"`
+		Expiration    int64        `json:"expiration"`
+		FeeLimit      int64        `json:"fee_limit"`
+		Timestamp     int64        `json:"timestamp"`
+	} `json:"raw_data"`
+	RawDataHex string   `json:"raw_data_hex"`
+	Signature  []string `json:"signature"`
+}
+
+type StBlock struct {
+	BlockID      string          `json:"blockID"`
+	BlockHeader  StBlockHeader   `json:"block_header"`
+	Transactions []StTransaction `json:"transactions"`
+}
+
+type StTransferContract struct {
+	OwnerAddress string `json:"owner_address"`
+	ToAddress    string `json:"to_address"`
+	Amount       int64  `json:"amount"`
+}
+
+type StLog struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
+}
+
+type StTransactionInfo struct {
+	ID              string   `json:"id"`
+	Fee             int64    `json:"fee"`
+	BlockNumber     int64    `json:"blockNumber"`
+	BlockTimeStamp  int64    `json:"blockTimeStamp"`
+	ContractResult  []string `json:"contractResult"`
+	ContractAddress string   `json:"contract_address"`
+	Receipt         struct {
+		EnergyFee         int64  `json:"energy_fee"`
+		EnergyUsageTotal  int64  `json:"energy_usage_total"`
+		OriginEnergyUsage int64  `json:"origin_energy_usage"`
+		NetUsage          int64  `json:"net_usage"`
+		NetFee            int64  `json:"net_fee"`
+		Result            string `json:"result"`
+	} `json:"receipt"`
+	Log        []StLog `json:"log"`
+	Result     string  `json:"result"`
+	ResMessage string  `json:"resMessage"`
+}
+
+type StAccount struct {
+	Address    string `json:"address"`
+	Balance    int64  `json:"balance"`
+	CreateTime int64  `json:"create_time"`
+}
+
+type StAccountResource struct {
+	FreeNetUsed  int64 `json:"freeNetUsed"`
+	FreeNetLimit int64 `json:"freeNetLimit"`
+	NetUsed      int64 `json:"NetUsed"`
+	NetLimit     int64 `json:"NetLimit"`
+	EnergyUsed   int64 `json:"EnergyUsed"`
+	EnergyLimit  int64 `json:"EnergyLimit"`
+}
+
+type StTriggerConstantContract struct {
+	Result struct {
+		Result  bool   `json:"result"`
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"result"`
+	EnergyUsed     int64    `json:"energy_used"`
+	ConstantResult []string `json:"constant_result"`
+}
+
+type StBroadcast struct {
+	Result  bool   `json:"result"`
+	TxID    string `json:"txid"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type StDelegatedResource struct {
+	From                      string `json:"from"`
+	To                        string `json:"to"`
+	FrozenBalanceForBandwidth int64  `json:"frozen_balance_for_bandwidth"`
+	FrozenBalanceForEnergy    int64  `json:"frozen_balance_for_energy"`
+	ExpireTimeForBandwidth    int64  `json:"expire_time_for_bandwidth"`
+	ExpireTimeForEnergy       int64  `json:"expire_time_for_energy"`
+}
+
+// InitClient 初始化客户端
+// apiKey 为 TronGrid 的 TRON-PRO-API-KEY, 自建节点时为空
+func InitClient(uri string, apiKey string) {
+	defaultClient = NewClient(uri, apiKey)
+}
+
+// NewClient 创建客户端
+func NewClient(uri string, apiKey string) *Client {
+	return &Client{
+		rpcURI: uri,
+		apiKey: apiKey,
+	}
+}
+
+// DefaultClient 默认客户端
+func DefaultClient() *Client {
+	return defaultClient
+}
+
+func (client *Client) doReq(funURI string, arqs interface{}, resp interface{}) error {
+	req := gorequest.New().Timeout(time.Minute * 5).Post(client.rpcURI + funURI)
+	if client.apiKey != "" {
+		req = req.Set("TRON-PRO-API-KEY", client.apiKey)
+	}
+	_, body, errs := req.Send(arqs).EndBytes()
+	if errs != nil {
+		return errs[0]
+	}
+	// 请求错误时返回 {"Error": "..."}
+	var respErr StRpcRespError
+	err := json.Unmarshal(body, &respErr)
+	if err == nil && respErr.Error != "" {
+		return fmt.Errorf("%s %s", funURI, respErr.Error)
+	}
+	err = json.Unmarshal(body, resp)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RpcGetNowBlock 获取最新块
+func RpcGetNowBlock() (*StBlock, error) {
+	return defaultClient.RpcGetNowBlock()
+}
+
+// RpcGetNowBlock 获取最新块
+func (client *Client) RpcGetNowBlock() (*StBlock, error) {
+	var resp StBlock
+	err := client.doReq(
+		"/wallet/getnowblock",
+		gin.H{
+			"visible": true,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RpcGetBlockByNum 获取块
+func RpcGetBlockByNum(num int64) (*StBlock, error) {
+	return defaultClient.RpcGetBlockByNum(num)
+}
+
+// RpcGetBlockByNum 获取块
+func (client *Client) RpcGetBlockByNum(num int64) (*StBlock, error) {
+	var resp StBlock
+	err := client.doReq(
+		"/wallet/getblockbynum",
+		gin.H{
+			"num":     num,
+			"visible": true,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.BlockID == "" {
+		return nil, fmt.Errorf("no block of: %d", num)
+	}
+	return &resp, nil
+}
+
+// RpcGetTransactionInfoByBlockNum 获取块中所有交易的执行结果
+func RpcGetTransactionInfoByBlockNum(num int64) ([]*StTransactionInfo, error) {
+	return defaultClient.RpcGetTransactionInfoByBlockNum(num)
+}
+
+// RpcGetTransactionInfoByBlockNum 获取块中所有交易的执行结果
+func (client *Client) RpcGetTransactionInfoByBlockNum(num int64) ([]*StTransactionInfo, error) {
+	var resp []*StTransactionInfo
+	err := client.doReq(
+		"/wallet/gettransactioninfobyblocknum",
+		gin.H{
+			"num": num,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RpcGetTransactionInfoByID 获取交易执行结果 未上链时返回nil
+func RpcGetTransactionInfoByID(txID string) (*StTransactionInfo, error) {
+	return defaultClient.RpcGetTransactionInfoByID(txID)
+}
+
+// RpcGetTransactionInfoByID 获取交易执行结果 未上链时返回nil
+func (client *Client) RpcGetTransactionInfoByID(txID string) (*StTransactionInfo, error) {
+	var resp StTransactionInfo
+	err := client.doReq(
+		"/wallet/gettransactioninfobyid",
+		gin.H{
+			"value": txID,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ID == "" {
+		return nil, nil
+	}
+	return &resp, nil
+}
+
+// RpcGetAccount 获取账户信息 未激活的账户余额为0
+func RpcGetAccount(address string) (*StAccount, error) {
+	return defaultClient.RpcGetAccount(address)
+}
+
+// RpcGetAccount 获取账户信息 未激活的账户余额为0
+func (client *Client) RpcGetAccount(address string) (*StAccount, error) {
+	var resp StAccount
+	err := client.doReq(
+		"/wallet/getaccount",
+		gin.H{
+			"address": address,
+			"visible": true,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RpcGetAccountResource 获取账户带宽和能量
+func RpcGetAccountResource(address string) (*StAccountResource, error) {
+	return defaultClient.RpcGetAccountResource(address)
+}
+
+// RpcGetAccountResource 获取账户带宽和能量
+func (client *Client) RpcGetAccountResource(address string) (*StAccountResource, error) {
+	var resp StAccountResource
+	err := client.doReq(
+		"/wallet/getaccountresource",
+		gin.H{
+			"address": address,
+			"visible": true,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RpcTriggerConstantContract 调用合约只读方法
+func RpcTriggerConstantContract(ownerAddress string, contractAddress string, functionSelector string, parameter string) (*StTriggerConstantContract, error) {
+	return defaultClient.RpcTriggerConstantContract(ownerAddress, contractAddress, functionSelector, parameter)
+}
+
+// RpcTriggerConstantContract 调用合约只读方法
+func (client *Client) RpcTriggerConstantContract(ownerAddress string, contractAddress string, functionSelector string, parameter string) (*StTriggerConstantContract, error) {
+	var resp StTriggerConstantContract
+	err := client.doReq(
+		"/wallet/triggerconstantcontract",
+		gin.H{
+			"owner_address":     ownerAddress,
+			"contract_address":  contractAddress,
+			"function_selector": functionSelector,
+			"parameter":         parameter,
+			"visible":           true,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Result.Result {
+		return nil, fmt.Errorf("trigger constant contract err: %s %s", resp.Result.Code, resp.Result.Message)
+	}
+	return &resp, nil
+}
+
+// RpcBroadcastHex 广播签名后的交易
+func RpcBroadcastHex(txHex string) (*StBroadcast, error) {
+	return defaultClient.RpcBroadcastHex(txHex)
+}
+
+// RpcBroadcastHex 广播签名后的交易
+func (client *Client) RpcBroadcastHex(txHex string) (*StBroadcast, error) {
+	var resp StBroadcast
+	err := client.doReq(
+		"/wallet/broadcasthex",
+		gin.H{
+			"transaction": txHex,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RpcGetDelegatedResourceAccountIndex 获取代理过资源的目标地址
+func RpcGetDelegatedResourceAccountIndex(address string) ([]string, error) {
+	return defaultClient.RpcGetDelegatedResourceAccountIndex(address)
+}
+
+// RpcGetDelegatedResourceAccountIndex 获取代理过资源的目标地址
+func (client *Client) RpcGetDelegatedResourceAccountIndex(address string) ([]string, error) {
+	var resp struct {
+		Account    string   `json:"account"`
+		ToAccounts []string `json:"toAccounts"`
+	}
+	err := client.doReq(
+		"/wallet/getdelegatedresourceaccountindexv2",
+		gin.H{
+			"value":   address,
+			"visible": true,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToAccounts, nil
+}
+
+// RpcGetDelegatedResource 获取代理资源详情
+func RpcGetDelegatedResource(fromAddress string, toAddress string) ([]*StDelegatedResource, error) {
+	return defaultClient.RpcGetDelegatedResource(fromAddress, toAddress)
+}
+
+// RpcGetDelegatedResource 获取代理资源详情
+func (client *Client) RpcGetDelegatedResource(fromAddress string, toAddress string) ([]*StDelegatedResource, error) {
+	var resp struct {
+		DelegatedResource []*StDelegatedResource `json:"delegatedResource"`
+	}
+	err := client.doReq(
+		"/wallet/getdelegatedresourcev2",
+		gin.H{
+			"fromAddress": fromAddress,
+			"toAddress":   toAddress,
+			"visible":     true,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.DelegatedResource, nil
+}
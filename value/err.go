@@ -39,4 +39,28 @@ const (
 
 	ErrorSymbolNotSupport    = -10
 	ErrorSymbolNotSupportMsg = "symbol not support"
+
+	ErrorMemoWrong    = -11
+	ErrorMemoWrongMsg = "memo error"
+
+	ErrorWithdrawNotFound    = -12
+	ErrorWithdrawNotFoundMsg = "withdraw not found"
+
+	ErrorWithdrawCannotCancel    = -13
+	ErrorWithdrawCannotCancelMsg = "withdraw can not cancel"
+
+	ErrorWithdrawConflict    = -14
+	ErrorWithdrawConflictMsg = "out_serial conflict"
+
+	ErrorWithdrawBatchFail    = -15
+	ErrorWithdrawBatchFailMsg = "withdraw batch fail"
+
+	ErrorBalanceLimit    = -16
+	ErrorBalanceLimitMsg = "balance out of limit"
+
+	ErrorWithdrawDisable    = -17
+	ErrorWithdrawDisableMsg = "withdraw disable"
+
+	ErrorSymbolAmbiguous    = -18
+	ErrorSymbolAmbiguousMsg = "symbol ambiguous"
 )
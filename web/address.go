@@ -0,0 +1,373 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/hbtc"
+	"go-dc-wallet/heos"
+	"go-dc-wallet/heth"
+	"go-dc-wallet/hvalidate"
+	"go-dc-wallet/hxrp"
+	"go-dc-wallet/model"
+	"go-dc-wallet/value"
+	"go-dc-wallet/xenv"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/moremorefun/mcommon"
+)
+
+const (
+	// addressListDefaultLimit 地址列表默认每页数量
+	addressListDefaultLimit = 100
+	// addressListMaxLimit 地址列表每页最大数量
+	addressListMaxLimit = 500
+)
+
+func postAddressBatch(c *gin.Context) {
+	var req struct {
+		Symbol string `json:"symbol" binding:"required" validate:"oneof=eth btc eos ltc doge bch wax tlos trx sol xrp bnb_bsc matic_polygon eth_arbitrum"`
+		Count  int64  `json:"count" binding:"required,min=1,max=1000"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	productID := c.GetInt64("product_id")
+	if productID == 0 {
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	// 资产标识转换为所在链的币种
+	req.Symbol = getAddressCoinSymbol(req.Symbol)
+	addressSymbol := getAddressSymbol(req.Symbol)
+	var addresses []string
+	var eosColdAddressValue string
+	// 开始事物
+	isUseGinErr := true
+	err = mcommon.DbTransaction(c, xenv.DbCon, func(tx mcommon.DbExeAble) error {
+		// 获取可用地址
+		addressRows, err := app.SQLSelectTAddressKeyColFreeForUpdate(
+			c,
+			tx,
+			[]string{
+				model.DBColTAddressKeyID,
+				model.DBColTAddressKeyAddress,
+			},
+			addressSymbol,
+			req.Count,
+		)
+		if err != nil {
+			return err
+		}
+		if int64(len(addressRows)) < req.Count {
+			// 剩余地址不足 全部不分配
+			mcommon.GinDoRespErr(
+				c,
+				value.ErrorNoFreeAddress,
+				value.ErrorNoFreeAddressMsg,
+				gin.H{
+					"free_count": len(addressRows),
+				},
+			)
+			isUseGinErr = false
+			return fmt.Errorf("no enough free address")
+		}
+		var addressIDs []int64
+		for _, addressRow := range addressRows {
+			addressIDs = append(addressIDs, addressRow.ID)
+			addresses = append(addresses, addressRow.Address)
+		}
+		// 更新获取到的地址的使用状态
+		count, err := app.SQLUpdateTAddressKeyUseTagByIDs(
+			c,
+			tx,
+			addressIDs,
+			productID,
+		)
+		if err != nil {
+			return err
+		}
+		if count != int64(len(addressIDs)) {
+			return fmt.Errorf("update address use tag error")
+		}
+		eosColdAddressValue, err = getDepositColdAddress(c, tx, req.Symbol)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		if isUseGinErr {
+			mcommon.GinDoRespInternalErr(c)
+		}
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":       mcommon.ErrorSuccess,
+		"err_msg":     mcommon.ErrorSuccessMsg,
+		"addresses":   addresses,
+		"eos_address": eosColdAddressValue,
+	})
+}
+
+func postAddressList(c *gin.Context) {
+	var req struct {
+		Symbol string `json:"symbol" binding:"omitempty"`
+		Page   int64  `json:"page" binding:"omitempty,min=1"`
+		Limit  int64  `json:"limit" binding:"omitempty,min=1,max=500"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	// 资产标识转换为所在链的币种
+	req.Symbol = getAddressCoinSymbol(req.Symbol)
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	if req.Limit == 0 {
+		req.Limit = addressListDefaultLimit
+	}
+	if req.Limit > addressListMaxLimit {
+		req.Limit = addressListMaxLimit
+	}
+	addressSymbol := ""
+	if req.Symbol != "" {
+		addressSymbol = getAddressSymbol(req.Symbol)
+	}
+	productID := c.GetInt64("product_id")
+	if productID == 0 {
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	total, err := app.SQLGetTAddressKeyCountByProduct(
+		c,
+		xenv.DbCon,
+		productID,
+		addressSymbol,
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	addressRows, err := app.SQLSelectTAddressKeyColByProduct(
+		c,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAddressKeyID,
+			model.DBColTAddressKeySymbol,
+			model.DBColTAddressKeyAddress,
+		},
+		productID,
+		addressSymbol,
+		(req.Page-1)*req.Limit,
+		req.Limit,
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	// 按地址池分组 eos wax tlos xrp 的memo在不同地址池中会重复
+	addressesMap := make(map[string][]string)
+	for _, addressRow := range addressRows {
+		addressesMap[addressRow.Symbol] = append(addressesMap[addressRow.Symbol], addressRow.Address)
+	}
+	// 地址绑定的用户 地址池币种 => 地址 => 绑定
+	addressUserMaps := make(map[string]map[string]*model.DBTAddressUser)
+	for symbol, addresses := range addressesMap {
+		addressUserMap, err := app.SQLGetAddressUserMap(
+			c,
+			xenv.DbCon,
+			[]string{
+				model.DBColTAddressUserProductID,
+				model.DBColTAddressUserUserID,
+				model.DBColTAddressUserLabel,
+			},
+			heth.GetAddressUserSymbols(symbol),
+			addresses,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			mcommon.GinDoRespInternalErr(c)
+			return
+		}
+		addressUserMaps[symbol] = addressUserMap
+	}
+	items := make([]gin.H, 0, len(addressRows))
+	for _, addressRow := range addressRows {
+		item := gin.H{
+			"address": addressRow.Address,
+			"symbol":  addressRow.Symbol,
+			"user_id": "",
+			"label":   "",
+		}
+		addressUserRow, ok := addressUserMaps[addressRow.Symbol][addressRow.Address]
+		if ok && addressUserRow.ProductID == productID {
+			item["user_id"] = addressUserRow.UserID
+			item["label"] = addressUserRow.Label
+		}
+		items = append(items, item)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+		"total":   total,
+		"page":    req.Page,
+		"limit":   req.Limit,
+		"list":    items,
+	})
+}
+
+func postAddressValidate(c *gin.Context) {
+	var req struct {
+		Symbol  string `json:"symbol" binding:"required"`
+		Address string `json:"address" binding:"required"`
+		Memo    string `json:"memo" binding:"omitempty"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	symbols, err := getSymbols(c)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	assetID, errCode, errMsg := symbols.getAssetID(req.Symbol)
+	if errCode != mcommon.ErrorSuccess {
+		mcommon.GinDoRespErr(
+			c,
+			errCode,
+			errMsg,
+			nil,
+		)
+		return
+	}
+	if mcommon.IsStringInSlice(symbols.XrpSymbols, assetID) {
+		// memo 为 destination tag
+		_, err := hxrp.ParseDestinationTag(req.Memo)
+		if err != nil {
+			mcommon.GinDoRespErr(
+				c,
+				value.ErrorMemoWrong,
+				value.ErrorMemoWrongMsg,
+				nil,
+			)
+			return
+		}
+	}
+	addressResult, err := checkAddress(c, symbols, assetID, req.Address)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	if addressResult == nil {
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorSymbolNotSupport,
+			value.ErrorSymbolNotSupportMsg,
+			nil,
+		)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":        mcommon.ErrorSuccess,
+		"err_msg":      mcommon.ErrorSuccessMsg,
+		"symbol":       assetID,
+		"address":      addressResult.Address,
+		"is_valid":     addressResult.IsValid,
+		"reason":       addressResult.Reason,
+		"address_type": addressResult.AddressType,
+		"is_internal":  addressResult.IsInternal,
+	})
+}
+
+// checkAddress 按币种所在链检测提币地址 不支持的币种返回nil
+func checkAddress(ctx context.Context, symbols *StSymbols, symbol string, address string) (*hvalidate.StResult, error) {
+	if ethChain, ok := symbols.EthChainMap[symbol]; ok {
+		return hvalidate.CheckEth(ctx, xenv.DbCon, ethChain, address)
+	}
+	if mcommon.IsStringInSlice(symbols.BtcSymbols, symbol) {
+		chain := hbtc.GetChainByCoinSymbol(symbol)
+		if chain == nil {
+			// omni 代币
+			return hvalidate.CheckBtc(ctx, xenv.DbCon, hbtc.Btc, address, true)
+		}
+		return hvalidate.CheckBtc(ctx, xenv.DbCon, chain, address, false)
+	}
+	if eosChain, ok := symbols.EosChainMap[symbol]; ok {
+		return hvalidate.CheckEos(ctx, xenv.DbCon, eosChain, address)
+	}
+	if mcommon.IsStringInSlice(symbols.TrxSymbols, symbol) {
+		return hvalidate.CheckTrx(ctx, xenv.DbCon, address)
+	}
+	if mcommon.IsStringInSlice(symbols.SolSymbols, symbol) {
+		return hvalidate.CheckSol(ctx, xenv.DbCon, address)
+	}
+	if mcommon.IsStringInSlice(symbols.XrpSymbols, symbol) {
+		return hvalidate.CheckXrp(ctx, xenv.DbCon, address)
+	}
+	return nil, nil
+}
+
+// getAddressCoinSymbol 地址接口使用所在链的原生币种 资产标识转换为所在链的币种
+// 例如 eth:usdt => eth, bsc:usdt => bnb_bsc, omni:usdt => btc
+func getAddressCoinSymbol(symbol string) string {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	network, _, ok := app.SplitAssetID(symbol)
+	if !ok {
+		return symbol
+	}
+	if network == hbtc.OmniNetwork {
+		return hbtc.CoinSymbol
+	}
+	if evmChain := heth.GetChain(network); evmChain != nil {
+		return evmChain.GetLegacySymbol(evmChain.NativeSymbol)
+	}
+	return network
+}
+
+// getAddressSymbol 获取币种对应的地址池币种 evm链共用eth地址时从eth地址池中获取
+func getAddressSymbol(symbol string) string {
+	for _, evmChain := range heth.GetEnableChains() {
+		if evmChain.GetLegacySymbol(evmChain.NativeSymbol) == symbol {
+			return evmChain.GetAddressSymbol()
+		}
+	}
+	return symbol
+}
+
+// getDepositColdAddress 获取冷钱包地址 eos wax tlos 充币时使用冷钱包地址和memo, xrp 使用冷钱包地址和 destination tag
+func getDepositColdAddress(ctx context.Context, tx mcommon.DbExeAble, symbol string) (string, error) {
+	if heos.GetChain(symbol) == nil && symbol != hxrp.CoinSymbol {
+		return "", nil
+	}
+	coldAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+		ctx,
+		tx,
+		fmt.Sprintf("cold_wallet_address_%s", symbol),
+	)
+	if err != nil {
+		return "", err
+	}
+	coldAddressValue = strings.TrimSpace(coldAddressValue)
+	if coldAddressValue == "" {
+		return "", fmt.Errorf("eosColdAddressValue null")
+	}
+	return coldAddressValue, nil
+}
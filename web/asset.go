@@ -0,0 +1,223 @@
+package web
+
+import (
+	"context"
+	"go-dc-wallet/hbtc"
+	"go-dc-wallet/hsol"
+	"go-dc-wallet/htrx"
+	"go-dc-wallet/hxrp"
+	"go-dc-wallet/xenv"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/moremorefun/mcommon"
+)
+
+// StWithdrawFee 提币预计手续费
+type StWithdrawFee struct {
+	Fee       string // 手续费金额
+	FeeSymbol string // 手续费币种
+}
+
+func postAssets(c *gin.Context) {
+	symbols, err := getSymbols(c)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	// 各链的充币确认数
+	confirmNumMap := make(map[string]int64)
+	items := make([]gin.H, 0, len(symbols.DecimalsMap))
+	for _, symbol := range getSortedSymbols(symbols) {
+		chainSymbol := symbols.ChainMap[symbol]
+		confirmNum, ok := confirmNumMap[chainSymbol]
+		if !ok {
+			confirmNum, err = getConfirmNum(c, symbols, symbol)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				mcommon.GinDoRespInternalErr(c)
+				return
+			}
+			confirmNumMap[chainSymbol] = confirmNum
+		}
+		withdrawMin := ""
+		if minBalance, ok := symbols.WithdrawMinMap[symbol]; ok {
+			withdrawMin = minBalance.String()
+		}
+		withdrawMax := ""
+		if maxBalance, ok := symbols.WithdrawMaxMap[symbol]; ok {
+			withdrawMax = maxBalance.String()
+		}
+		items = append(items, gin.H{
+			"symbol":          symbol,
+			"chain":           chainSymbol,
+			"decimals":        symbols.DecimalsMap[symbol],
+			"is_token":        isTokenSymbol(symbols, symbol),
+			"withdraw_min":    withdrawMin,
+			"withdraw_max":    withdrawMax,
+			"confirm_num":     confirmNum,
+			"deposit_enable":  !symbols.DepositDisableMap[symbol],
+			"withdraw_enable": !symbols.WithdrawDisableMap[symbol],
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+		"list":    items,
+	})
+}
+
+func postWithdrawEstimate(c *gin.Context) {
+	var req struct {
+		Symbol string `json:"symbol" binding:"omitempty"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	symbols, err := getSymbols(c)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	var querySymbols []string
+	if req.Symbol != "" {
+		assetID, errCode, errMsg := symbols.getAssetID(req.Symbol)
+		if errCode != mcommon.ErrorSuccess {
+			mcommon.GinDoRespErr(
+				c,
+				errCode,
+				errMsg,
+				nil,
+			)
+			return
+		}
+		querySymbols = append(querySymbols, assetID)
+	} else {
+		querySymbols = getSortedSymbols(symbols)
+	}
+	// 同一条链的原生币和代币手续费相同 只查询一次
+	feeMap := make(map[string]*StWithdrawFee)
+	items := make([]gin.H, 0, len(querySymbols))
+	for _, symbol := range querySymbols {
+		isToken := isTokenSymbol(symbols, symbol)
+		feeKey := symbols.ChainMap[symbol]
+		if isToken {
+			feeKey += "_token"
+		}
+		fee, ok := feeMap[feeKey]
+		if !ok {
+			fee, err = getWithdrawFee(c, symbols, symbol, isToken)
+			if err != nil {
+				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				mcommon.GinDoRespInternalErr(c)
+				return
+			}
+			feeMap[feeKey] = fee
+		}
+		items = append(items, gin.H{
+			"symbol":     symbol,
+			"fee":        fee.Fee,
+			"fee_symbol": fee.FeeSymbol,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+		"list":    items,
+	})
+}
+
+// getSortedSymbols 获取排序后的全部币种
+func getSortedSymbols(symbols *StSymbols) []string {
+	var sortedSymbols []string
+	for symbol := range symbols.DecimalsMap {
+		sortedSymbols = append(sortedSymbols, symbol)
+	}
+	sort.Strings(sortedSymbols)
+	return sortedSymbols
+}
+
+// isTokenSymbol 是否是链上的代币 原生币返回false
+func isTokenSymbol(symbols *StSymbols, symbol string) bool {
+	if ethChain, ok := symbols.EthChainMap[symbol]; ok {
+		return symbol != ethChain.GetCoinSymbol()
+	}
+	if mcommon.IsStringInSlice(symbols.BtcSymbols, symbol) {
+		// omni 代币
+		return hbtc.GetChainByCoinSymbol(symbol) == nil
+	}
+	if eosChain, ok := symbols.EosChainMap[symbol]; ok {
+		return symbol != eosChain.GetCoinSymbol()
+	}
+	if mcommon.IsStringInSlice(symbols.TrxSymbols, symbol) {
+		return symbol != htrx.GetCoinSymbol()
+	}
+	if mcommon.IsStringInSlice(symbols.SolSymbols, symbol) {
+		return symbol != hsol.GetCoinSymbol()
+	}
+	return false
+}
+
+// getConfirmNum 获取币种的充币确认数 eos sol xrp 使用不可逆区块 确认数为1
+func getConfirmNum(ctx context.Context, symbols *StSymbols, symbol string) (int64, error) {
+	if ethChain, ok := symbols.EthChainMap[symbol]; ok {
+		return ethChain.GetConfirmNum(ctx, xenv.DbCon)
+	}
+	if mcommon.IsStringInSlice(symbols.BtcSymbols, symbol) {
+		chain := hbtc.GetChainByCoinSymbol(symbol)
+		if chain == nil {
+			// omni 代币
+			chain = hbtc.Btc
+		}
+		return chain.GetConfirmNum(ctx, xenv.DbCon)
+	}
+	if mcommon.IsStringInSlice(symbols.TrxSymbols, symbol) {
+		return htrx.GetConfirmNum(ctx, xenv.DbCon)
+	}
+	return 1, nil
+}
+
+// getWithdrawFee 获取币种当前提币预计的手续费
+func getWithdrawFee(ctx context.Context, symbols *StSymbols, symbol string, isToken bool) (*StWithdrawFee, error) {
+	var err error
+	fee := &StWithdrawFee{
+		Fee: "0",
+	}
+	if ethChain, ok := symbols.EthChainMap[symbol]; ok {
+		fee.FeeSymbol = ethChain.GetCoinSymbol()
+		fee.Fee, err = ethChain.GetWithdrawFee(ctx, xenv.DbCon, isToken)
+	} else if mcommon.IsStringInSlice(symbols.BtcSymbols, symbol) {
+		chain := hbtc.GetChainByCoinSymbol(symbol)
+		if chain == nil {
+			// omni 代币 手续费为btc
+			fee.FeeSymbol = hbtc.Btc.GetCoinSymbol()
+			fee.Fee, err = hbtc.OmniGetWithdrawFee(ctx, xenv.DbCon)
+		} else {
+			fee.FeeSymbol = chain.GetCoinSymbol()
+			fee.Fee, err = chain.GetWithdrawFee(ctx, xenv.DbCon)
+		}
+	} else if eosChain, ok := symbols.EosChainMap[symbol]; ok {
+		// eos wax tlos 使用资源 没有手续费
+		fee.FeeSymbol = eosChain.GetCoinSymbol()
+	} else if mcommon.IsStringInSlice(symbols.TrxSymbols, symbol) {
+		fee.FeeSymbol = htrx.GetCoinSymbol()
+		fee.Fee, err = htrx.GetWithdrawFee(isToken)
+	} else if mcommon.IsStringInSlice(symbols.SolSymbols, symbol) {
+		fee.FeeSymbol = hsol.GetCoinSymbol()
+		fee.Fee = hsol.GetWithdrawFee()
+	} else if mcommon.IsStringInSlice(symbols.XrpSymbols, symbol) {
+		fee.FeeSymbol = hxrp.GetCoinSymbol()
+		fee.Fee, err = hxrp.GetWithdrawFee(ctx, xenv.DbCon)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
@@ -0,0 +1,367 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/hbtc"
+	"go-dc-wallet/heth"
+	"go-dc-wallet/hsol"
+	"go-dc-wallet/htrx"
+	"go-dc-wallet/hxrp"
+	"go-dc-wallet/model"
+	"go-dc-wallet/solclient"
+	"go-dc-wallet/tronclient"
+	"go-dc-wallet/value"
+	"go-dc-wallet/xenv"
+	"go-dc-wallet/xrpclient"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/moremorefun/mcommon"
+)
+
+const (
+	// depositListDefaultLimit 充币列表默认每页数量
+	depositListDefaultLimit = 20
+	// depositListMaxLimit 充币列表每页最大数量
+	depositListMaxLimit = 50
+)
+
+func postDepositList(c *gin.Context) {
+	var req struct {
+		Cursor string `json:"cursor" binding:"omitempty"`
+		Limit  int64  `json:"limit" binding:"omitempty,min=1,max=50"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	doDepositList(c, "", "", req.Cursor, req.Limit)
+}
+
+func postDepositQuery(c *gin.Context) {
+	var req struct {
+		TxHash  string `json:"tx_hash" binding:"omitempty"`
+		Address string `json:"address" binding:"omitempty"`
+		Cursor  string `json:"cursor" binding:"omitempty"`
+		Limit   int64  `json:"limit" binding:"omitempty,min=1,max=50"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	req.TxHash = strings.TrimSpace(req.TxHash)
+	req.Address = strings.TrimSpace(req.Address)
+	if req.TxHash == "" && req.Address == "" {
+		// tx_hash 和 address 至少需要一个
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorBind,
+			value.ErrorBindMsg,
+			nil,
+		)
+		return
+	}
+	doDepositList(c, req.TxHash, req.Address, req.Cursor, req.Limit)
+}
+
+// doDepositList 按游标获取充币列表
+func doDepositList(c *gin.Context, txHash string, address string, cursorStr string, limit int64) {
+	if limit == 0 {
+		limit = depositListDefaultLimit
+	}
+	if limit > depositListMaxLimit {
+		limit = depositListMaxLimit
+	}
+	var cursor *app.StDepositCursor
+	if cursorStr != "" {
+		var err error
+		cursor, err = parseDepositCursor(cursorStr)
+		if err != nil {
+			mcommon.GinDoRespErr(
+				c,
+				value.ErrorBind,
+				value.ErrorBindMsg,
+				nil,
+			)
+			return
+		}
+	}
+	// 获取产品id
+	productID := c.GetInt64("product_id")
+	if productID == 0 {
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	// 每个充币表都取 limit+1 条 合并排序后判断是否还有下一页
+	var depositRows []*app.DBDeposit
+	for _, source := range app.DepositSources {
+		rows, err := app.SQLSelectDepositByCursor(
+			c,
+			xenv.DbCon,
+			source,
+			productID,
+			txHash,
+			address,
+			cursor,
+			limit+1,
+		)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			mcommon.GinDoRespInternalErr(c)
+			return
+		}
+		depositRows = append(depositRows, rows...)
+	}
+	sort.Slice(depositRows, func(i, j int) bool {
+		if depositRows[i].CreateTime != depositRows[j].CreateTime {
+			return depositRows[i].CreateTime > depositRows[j].CreateTime
+		}
+		if depositRows[i].Source != depositRows[j].Source {
+			return depositRows[i].Source < depositRows[j].Source
+		}
+		return depositRows[i].ID > depositRows[j].ID
+	})
+	nextCursor := ""
+	if int64(len(depositRows)) > limit {
+		depositRows = depositRows[:limit]
+		lastRow := depositRows[len(depositRows)-1]
+		nextCursor = fmt.Sprintf("%d_%d_%d", lastRow.CreateTime, lastRow.Source, lastRow.ID)
+	}
+	items, err := getDepositItems(c, depositRows)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":       mcommon.ErrorSuccess,
+		"err_msg":     mcommon.ErrorSuccessMsg,
+		"next_cursor": nextCursor,
+		"list":        items,
+	})
+}
+
+// parseDepositCursor 解析游标 格式为 create_time_source_id
+func parseDepositCursor(cursorStr string) (*app.StDepositCursor, error) {
+	parts := strings.Split(cursorStr, "_")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("error cursor: %s", cursorStr)
+	}
+	var values []int64
+	for _, part := range parts {
+		v, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return &app.StDepositCursor{
+		CreateTime: values[0],
+		Source:     values[1],
+		ID:         values[2],
+	}, nil
+}
+
+// getDepositItems 获取充币的返回信息
+func getDepositItems(ctx context.Context, depositRows []*app.DBDeposit) ([]gin.H, error) {
+	symbols, err := getSymbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 获取代币币种
+	var tokenIDs []int64
+	var tokenTrxIDs []int64
+	var tokenSolIDs []int64
+	for _, depositRow := range depositRows {
+		switch depositRow.Source {
+		case app.DepositSourceTxErc20:
+			tokenIDs = append(tokenIDs, depositRow.TokenID)
+		case app.DepositSourceTrx:
+			if depositRow.TokenID > 0 {
+				tokenTrxIDs = append(tokenTrxIDs, depositRow.TokenID)
+			}
+		case app.DepositSourceSol:
+			if depositRow.TokenID > 0 {
+				tokenSolIDs = append(tokenSolIDs, depositRow.TokenID)
+			}
+		}
+	}
+	tokenRows, err := model.SQLSelectTAppConfigTokenCol(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAppConfigTokenID,
+			model.DBColTAppConfigTokenTokenSymbol,
+		},
+		tokenIDs,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tokenMap := make(map[int64]*model.DBTAppConfigToken)
+	for _, tokenRow := range tokenRows {
+		tokenMap[tokenRow.ID] = tokenRow
+	}
+	tokenTrxRows, err := model.SQLSelectTAppConfigTokenTrxCol(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAppConfigTokenTrxID,
+			model.DBColTAppConfigTokenTrxTokenSymbol,
+		},
+		tokenTrxIDs,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tokenTrxMap := make(map[int64]string)
+	for _, tokenRow := range tokenTrxRows {
+		tokenTrxMap[tokenRow.ID] = htrx.GetTokenSymbol(tokenRow)
+	}
+	tokenSolRows, err := model.SQLSelectTAppConfigTokenSolCol(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAppConfigTokenSolID,
+			model.DBColTAppConfigTokenSolTokenSymbol,
+		},
+		tokenSolIDs,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tokenSolMap := make(map[int64]string)
+	for _, tokenRow := range tokenSolRows {
+		tokenSolMap[tokenRow.ID] = hsol.GetTokenSymbol(tokenRow)
+	}
+	// map[链] => 最新块高 同一次请求中只获取一次
+	heightMap := make(map[string]int64)
+	getHeight := func(key string, rpcFunc func() (int64, error)) int64 {
+		height, ok := heightMap[key]
+		if ok {
+			return height
+		}
+		height, err := rpcFunc()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		}
+		heightMap[key] = height
+		return height
+	}
+
+	items := make([]gin.H, 0, len(depositRows))
+	for _, depositRow := range depositRows {
+		symbol := depositRow.Symbol
+		// 获取所在链的最新块高 链未开启时不计算确认数
+		var height int64
+		switch depositRow.Source {
+		case app.DepositSourceTx, app.DepositSourceTxErc20:
+			chain := heth.GetChain(depositRow.ChainSymbol)
+			if chain == nil {
+				symbol = depositRow.ChainSymbol
+				break
+			}
+			if depositRow.Source == app.DepositSourceTx {
+				symbol = chain.GetCoinSymbol()
+			} else if tokenRow, ok := tokenMap[depositRow.TokenID]; ok {
+				symbol = chain.GetTokenSymbol(tokenRow)
+			}
+			if chain.GetEnable() {
+				height = getHeight(chain.Symbol, func() (int64, error) {
+					return chain.GetClient().RpcBlockNumber(ctx)
+				})
+			}
+		case app.DepositSourceBtc, app.DepositSourceBtcToken:
+			chain := hbtc.GetChain(depositRow.Symbol)
+			if depositRow.Source == app.DepositSourceBtcToken || chain == nil {
+				// omni 代币
+				chain = hbtc.Btc
+				symbol = hbtc.OmniGetTokenSymbol(depositRow.Symbol)
+			} else {
+				symbol = chain.GetCoinSymbol()
+			}
+			if chain.GetEnable() {
+				height = getHeight(chain.Symbol, chain.GetClient().RpcGetBlockCount)
+			}
+		case app.DepositSourceEos:
+			// 旧版本的充币币种不带链标识 可以确定时转换为资产标识
+			if assetID, errCode, _ := symbols.getAssetID(depositRow.Symbol); errCode == mcommon.ErrorSuccess {
+				symbol = assetID
+			}
+			chain, ok := symbols.EosChainMap[symbol]
+			if ok {
+				height = getHeight(chain.Symbol, func() (int64, error) {
+					rpcInfo, err := chain.GetClient().RpcChainGetInfo()
+					if err != nil {
+						return 0, err
+					}
+					return rpcInfo.HeadBlockNum, nil
+				})
+			}
+		case app.DepositSourceTrx:
+			symbol = htrx.GetCoinSymbol()
+			if depositRow.TokenID > 0 {
+				symbol = tokenTrxMap[depositRow.TokenID]
+			}
+			if xenv.Cfg.TrxEnable {
+				height = getHeight(htrx.CoinSymbol, func() (int64, error) {
+					rpcBlock, err := tronclient.RpcGetNowBlock()
+					if err != nil {
+						return 0, err
+					}
+					return rpcBlock.BlockHeader.RawData.Number, nil
+				})
+			}
+		case app.DepositSourceSol:
+			symbol = hsol.GetCoinSymbol()
+			if depositRow.TokenID > 0 {
+				symbol = tokenSolMap[depositRow.TokenID]
+			}
+			if xenv.Cfg.SolEnable {
+				height = getHeight(hsol.CoinSymbol, func() (int64, error) {
+					return solclient.RpcGetSlot(solclient.CommitmentFinalized)
+				})
+			}
+		case app.DepositSourceXrp:
+			symbol = hxrp.GetCoinSymbol()
+			if xenv.Cfg.XrpEnable {
+				height = getHeight(hxrp.CoinSymbol, func() (int64, error) {
+					return xrpclient.RpcLedgerIndex(xrpclient.LedgerValidated)
+				})
+			}
+		}
+		var confirmations int64
+		if depositRow.BlockNum > 0 && height >= depositRow.BlockNum {
+			confirmations = height - depositRow.BlockNum + 1
+		}
+		items = append(items, gin.H{
+			"symbol":        symbol,
+			"address":       depositRow.Address,
+			"memo":          depositRow.Memo,
+			"balance":       depositRow.BalanceReal,
+			"tx_hash":       depositRow.TxHash,
+			"tx_index":      depositRow.TxIndex,
+			"block_num":     depositRow.BlockNum,
+			"confirmations": confirmations,
+			"notify_status": depositRow.HandleStatus,
+			"create_time":   depositRow.CreateTime,
+		})
+	}
+	return items, nil
+}
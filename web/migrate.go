@@ -0,0 +1,267 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/hbtc"
+	"go-dc-wallet/hsol"
+	"go-dc-wallet/htrx"
+	"go-dc-wallet/hxrp"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"regexp"
+	"strings"
+
+	"github.com/moremorefun/mcommon"
+)
+
+var (
+	legacyEthAddressRe = regexp.MustCompile("^0x[0-9a-fA-F]{40}$")
+	legacyEosAccountRe = regexp.MustCompile("^[a-z1-5.]{1,12}$")
+)
+
+// MigrateLegacySymbols 将旧版本不带链标识的币种转换为资产标识
+// 转换 t_withdraw t_tx_eos t_send_eos 中的币种, 旧币种对应多个资产时根据地址确定所在链
+// 无法确定的记录只输出日志 需要手动处理
+func MigrateLegacySymbols(ctx context.Context) error {
+	symbols, err := getSymbols(ctx)
+	if err != nil {
+		return err
+	}
+	err = migrateWithdrawSymbols(ctx, symbols)
+	if err != nil {
+		return err
+	}
+	err = migrateTxEosSymbols(ctx, symbols)
+	if err != nil {
+		return err
+	}
+	err = migrateSendEosSymbols(ctx, symbols)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// migrateWithdrawSymbols 转换提币币种 根据收币地址格式排除其他链的资产
+func migrateWithdrawSymbols(ctx context.Context, symbols *StSymbols) error {
+	withdrawRows, err := app.SQLSelectTWithdrawColByLegacySymbol(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawSymbol,
+			model.DBColTWithdrawToAddress,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	updateIDsMap := make(map[string]map[string][]int64)
+	for _, withdrawRow := range withdrawRows {
+		var assetIDs []string
+		for _, assetID := range symbols.LegacyMap[strings.ToLower(withdrawRow.Symbol)] {
+			if isLegacyAddressMatch(symbols, assetID, withdrawRow.ToAddress) {
+				assetIDs = append(assetIDs, assetID)
+			}
+		}
+		if len(assetIDs) != 1 {
+			mcommon.Log.Warnf("withdraw %d symbol %s to %s can not migrate: %v", withdrawRow.ID, withdrawRow.Symbol, withdrawRow.ToAddress, assetIDs)
+			continue
+		}
+		addMigrateID(updateIDsMap, withdrawRow.Symbol, assetIDs[0], withdrawRow.ID)
+	}
+	for legacySymbol, idsMap := range updateIDsMap {
+		for assetID, ids := range idsMap {
+			count, err := app.SQLUpdateTWithdrawSymbolByIDs(
+				ctx,
+				xenv.DbCon,
+				ids,
+				legacySymbol,
+				assetID,
+			)
+			if err != nil {
+				return err
+			}
+			mcommon.Log.Infof("withdraw symbol %s => %s: %d", legacySymbol, assetID, count)
+		}
+	}
+	return nil
+}
+
+// migrateTxEosSymbols 转换eos充币币种 多条链上有相同代币时根据冷钱包地址确定所在链
+func migrateTxEosSymbols(ctx context.Context, symbols *StSymbols) error {
+	txRows, err := app.SQLSelectTTxEosColByLegacySymbol(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTTxEosID,
+			model.DBColTTxEosSymbol,
+			model.DBColTTxEosToAddress,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if len(txRows) == 0 {
+		return nil
+	}
+	coldAddressMap, err := getEosWalletAddressMap(ctx, symbols, "cold_wallet_address")
+	if err != nil {
+		return err
+	}
+	updateIDsMap := make(map[string]map[string][]int64)
+	for _, txRow := range txRows {
+		assetIDs := getLegacyEosAssetIDs(symbols, txRow.Symbol, coldAddressMap, txRow.ToAddress)
+		if len(assetIDs) != 1 {
+			mcommon.Log.Warnf("tx eos %d symbol %s to %s can not migrate: %v", txRow.ID, txRow.Symbol, txRow.ToAddress, assetIDs)
+			continue
+		}
+		addMigrateID(updateIDsMap, txRow.Symbol, assetIDs[0], txRow.ID)
+	}
+	for legacySymbol, idsMap := range updateIDsMap {
+		for assetID, ids := range idsMap {
+			count, err := app.SQLUpdateTTxEosSymbolByIDs(
+				ctx,
+				xenv.DbCon,
+				ids,
+				legacySymbol,
+				assetID,
+			)
+			if err != nil {
+				return err
+			}
+			mcommon.Log.Infof("tx eos symbol %s => %s: %d", legacySymbol, assetID, count)
+		}
+	}
+	return nil
+}
+
+// migrateSendEosSymbols 转换eos打币币种 多条链上有相同代币时根据热钱包地址确定所在链
+func migrateSendEosSymbols(ctx context.Context, symbols *StSymbols) error {
+	sendRows, err := app.SQLSelectTSendEosColByLegacySymbol(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTSendEosID,
+			model.DBColTSendEosSymbol,
+			model.DBColTSendEosFromAddress,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if len(sendRows) == 0 {
+		return nil
+	}
+	hotAddressMap, err := getEosWalletAddressMap(ctx, symbols, "hot_wallet_address")
+	if err != nil {
+		return err
+	}
+	updateIDsMap := make(map[string]map[string][]int64)
+	for _, sendRow := range sendRows {
+		assetIDs := getLegacyEosAssetIDs(symbols, sendRow.Symbol, hotAddressMap, sendRow.FromAddress)
+		if len(assetIDs) != 1 {
+			mcommon.Log.Warnf("send eos %d symbol %s from %s can not migrate: %v", sendRow.ID, sendRow.Symbol, sendRow.FromAddress, assetIDs)
+			continue
+		}
+		addMigrateID(updateIDsMap, sendRow.Symbol, assetIDs[0], sendRow.ID)
+	}
+	for legacySymbol, idsMap := range updateIDsMap {
+		for assetID, ids := range idsMap {
+			count, err := app.SQLUpdateTSendEosSymbolByIDs(
+				ctx,
+				xenv.DbCon,
+				ids,
+				legacySymbol,
+				assetID,
+			)
+			if err != nil {
+				return err
+			}
+			mcommon.Log.Infof("send eos symbol %s => %s: %d", legacySymbol, assetID, count)
+		}
+	}
+	return nil
+}
+
+// addMigrateID 按旧币种和资产标识分组记录id
+func addMigrateID(updateIDsMap map[string]map[string][]int64, legacySymbol string, assetID string, id int64) {
+	idsMap, ok := updateIDsMap[legacySymbol]
+	if !ok {
+		idsMap = make(map[string][]int64)
+		updateIDsMap[legacySymbol] = idsMap
+	}
+	idsMap[assetID] = append(idsMap[assetID], id)
+}
+
+// getEosWalletAddressMap 获取eos wax tlos 的钱包地址 资产标识 => 地址
+func getEosWalletAddressMap(ctx context.Context, symbols *StSymbols, keyPrefix string) (map[string]string, error) {
+	var keys []string
+	for _, chain := range symbols.EosChainMap {
+		keys = append(keys, fmt.Sprintf("%s_%s", keyPrefix, chain.Symbol))
+	}
+	configMap, err := app.SQLGetAppConfigStrMap(
+		ctx,
+		xenv.DbCon,
+		keys,
+	)
+	if err != nil {
+		return nil, err
+	}
+	addressMap := make(map[string]string)
+	for assetID, chain := range symbols.EosChainMap {
+		addressMap[assetID] = strings.TrimSpace(configMap[fmt.Sprintf("%s_%s", keyPrefix, chain.Symbol)])
+	}
+	return addressMap, nil
+}
+
+// getLegacyEosAssetIDs 获取旧币种对应的eos wax tlos 资产 多个时只保留钱包地址相同的链
+func getLegacyEosAssetIDs(symbols *StSymbols, legacySymbol string, walletAddressMap map[string]string, address string) []string {
+	var assetIDs []string
+	for _, assetID := range symbols.LegacyMap[strings.ToLower(legacySymbol)] {
+		if _, ok := symbols.EosChainMap[assetID]; ok {
+			assetIDs = append(assetIDs, assetID)
+		}
+	}
+	if len(assetIDs) <= 1 {
+		return assetIDs
+	}
+	var matchIDs []string
+	for _, assetID := range assetIDs {
+		if walletAddressMap[assetID] == address {
+			matchIDs = append(matchIDs, assetID)
+		}
+	}
+	return matchIDs
+}
+
+// isLegacyAddressMatch 地址格式是否符合资产所在的链 不访问节点
+func isLegacyAddressMatch(symbols *StSymbols, assetID string, address string) bool {
+	if _, ok := symbols.EthChainMap[assetID]; ok {
+		return legacyEthAddressRe.MatchString(address)
+	}
+	if mcommon.IsStringInSlice(symbols.BtcSymbols, assetID) {
+		chain := hbtc.GetChainByCoinSymbol(assetID)
+		if chain == nil {
+			// omni 代币
+			chain = hbtc.Btc
+		}
+		_, err := chain.Network().DecodeAddress(address)
+		return err == nil
+	}
+	if _, ok := symbols.EosChainMap[assetID]; ok {
+		return legacyEosAccountRe.MatchString(address)
+	}
+	if mcommon.IsStringInSlice(symbols.TrxSymbols, assetID) {
+		return htrx.IsValidAddress(address)
+	}
+	if mcommon.IsStringInSlice(symbols.SolSymbols, assetID) {
+		return hsol.IsValidAddress(address)
+	}
+	if mcommon.IsStringInSlice(symbols.XrpSymbols, assetID) {
+		return hxrp.IsValidAddress(address)
+	}
+	return false
+}
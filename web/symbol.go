@@ -0,0 +1,249 @@
+package web
+
+import (
+	"context"
+	"go-dc-wallet/app"
+	"go-dc-wallet/hbtc"
+	"go-dc-wallet/heos"
+	"go-dc-wallet/heth"
+	"go-dc-wallet/hsol"
+	"go-dc-wallet/htrx"
+	"go-dc-wallet/hxrp"
+	"go-dc-wallet/model"
+	"go-dc-wallet/value"
+	"go-dc-wallet/xenv"
+	"strings"
+
+	"github.com/moremorefun/mcommon"
+	"github.com/shopspring/decimal"
+)
+
+// StSymbols 已开启的币种信息
+type StSymbols struct {
+	DecimalsMap map[string]int64
+	EthChainMap map[string]*heth.Chain // eth bsc polygon arbitrum
+	BtcSymbols  []string               // btc omni ltc doge bch
+	EosChainMap map[string]*heos.Chain // eos wax tlos
+	TrxSymbols  []string
+	SolSymbols  []string
+	XrpSymbols  []string
+
+	ChainMap           map[string]string          // 币种所在的链
+	LegacyMap          map[string][]string        // 旧版本不带链标识的币种 => 资产标识
+	WithdrawMinMap     map[string]decimal.Decimal // 单笔最小提币金额 没有配置时不限制
+	WithdrawMaxMap     map[string]decimal.Decimal // 单笔最大提币金额 没有配置时不限制
+	WithdrawDisableMap map[string]bool            // 关闭提币的币种
+	DepositDisableMap  map[string]bool            // 关闭充币的币种
+}
+
+// getSymbols 获取已开启的币种信息 币种均为资产标识 例如 eth:usdt omni:usdt
+func getSymbols(ctx context.Context) (*StSymbols, error) {
+	symbols := &StSymbols{
+		DecimalsMap: make(map[string]int64),
+		EthChainMap: make(map[string]*heth.Chain),
+		EosChainMap: make(map[string]*heos.Chain),
+
+		ChainMap:           make(map[string]string),
+		LegacyMap:          make(map[string][]string),
+		WithdrawMinMap:     make(map[string]decimal.Decimal),
+		WithdrawMaxMap:     make(map[string]decimal.Decimal),
+		WithdrawDisableMap: make(map[string]bool),
+		DepositDisableMap:  make(map[string]bool),
+	}
+	// eth bsc polygon arbitrum 信息
+	for _, chain := range heth.GetEnableChains() {
+		symbols.EthChainMap[chain.GetCoinSymbol()] = chain
+		symbols.addSymbol(chain.GetCoinSymbol(), chain.GetLegacySymbol(chain.NativeSymbol), 18, chain.Symbol)
+		// 获取链上的所有代币币种
+		tokenRows, err := app.SQLSelectTAppConfigTokenColByChainSymbol(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigTokenTokenSymbol,
+				model.DBColTAppConfigTokenTokenDecimals,
+			},
+			chain.Symbol,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, tokenRow := range tokenRows {
+			symbols.EthChainMap[chain.GetTokenSymbol(tokenRow)] = chain
+			symbols.addSymbol(chain.GetTokenSymbol(tokenRow), chain.GetLegacySymbol(tokenRow.TokenSymbol), tokenRow.TokenDecimals, chain.Symbol)
+		}
+	}
+	// btc 信息
+	symbols.BtcSymbols = append(symbols.BtcSymbols, hbtc.Btc.GetCoinSymbol())
+	symbols.addSymbol(hbtc.Btc.GetCoinSymbol(), hbtc.CoinSymbol, 8, hbtc.Btc.Symbol)
+	tokenBtcRows, err := model.SQLSelectTAppConfigTokenBtcColKV(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAppConfigTokenBtcTokenSymbol,
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, tokenRow := range tokenBtcRows {
+		symbols.BtcSymbols = append(symbols.BtcSymbols, hbtc.OmniGetTokenSymbol(tokenRow.TokenSymbol))
+		symbols.addSymbol(hbtc.OmniGetTokenSymbol(tokenRow.TokenSymbol), tokenRow.TokenSymbol, 8, hbtc.Btc.Symbol)
+	}
+	// ltc doge bch 信息
+	for _, chain := range hbtc.GetEnableChains() {
+		if chain == hbtc.Btc {
+			continue
+		}
+		symbols.BtcSymbols = append(symbols.BtcSymbols, chain.GetCoinSymbol())
+		symbols.addSymbol(chain.GetCoinSymbol(), chain.Symbol, 8, chain.Symbol)
+	}
+	// eos wax tlos 信息
+	for _, chain := range heos.GetEnableChains() {
+		tokenEosRows, err := chain.GetTokenRows(
+			ctx,
+			xenv.DbCon,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, tokenRow := range tokenEosRows {
+			symbols.EosChainMap[chain.GetTokenSymbol(tokenRow)] = chain
+			symbols.addSymbol(chain.GetTokenSymbol(tokenRow), tokenRow.TokenSymbol, tokenRow.TokenDecimals, chain.Symbol)
+		}
+	}
+	// trx trc20 信息
+	if xenv.Cfg.TrxEnable {
+		symbols.TrxSymbols = append(symbols.TrxSymbols, htrx.GetCoinSymbol())
+		symbols.addSymbol(htrx.GetCoinSymbol(), htrx.CoinSymbol, htrx.TrxDecimals, htrx.CoinSymbol)
+		tokenTrxRows, err := app.SQLSelectTAppConfigTokenTrxColAll(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigTokenTrxTokenSymbol,
+				model.DBColTAppConfigTokenTrxTokenDecimals,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, tokenRow := range tokenTrxRows {
+			symbols.TrxSymbols = append(symbols.TrxSymbols, htrx.GetTokenSymbol(tokenRow))
+			symbols.addSymbol(htrx.GetTokenSymbol(tokenRow), tokenRow.TokenSymbol, tokenRow.TokenDecimals, htrx.CoinSymbol)
+		}
+	}
+	// sol spl 信息
+	if xenv.Cfg.SolEnable {
+		symbols.SolSymbols = append(symbols.SolSymbols, hsol.GetCoinSymbol())
+		symbols.addSymbol(hsol.GetCoinSymbol(), hsol.CoinSymbol, hsol.SolDecimals, hsol.CoinSymbol)
+		tokenSolRows, err := app.SQLSelectTAppConfigTokenSolColAll(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigTokenSolTokenSymbol,
+				model.DBColTAppConfigTokenSolTokenDecimals,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, tokenRow := range tokenSolRows {
+			symbols.SolSymbols = append(symbols.SolSymbols, hsol.GetTokenSymbol(tokenRow))
+			symbols.addSymbol(hsol.GetTokenSymbol(tokenRow), tokenRow.TokenSymbol, tokenRow.TokenDecimals, hsol.CoinSymbol)
+		}
+	}
+	// xrp 信息
+	if xenv.Cfg.XrpEnable {
+		symbols.XrpSymbols = append(symbols.XrpSymbols, hxrp.GetCoinSymbol())
+		symbols.addSymbol(hxrp.GetCoinSymbol(), hxrp.CoinSymbol, hxrp.XrpDecimals, hxrp.CoinSymbol)
+	}
+	// 提币限制
+	err = symbols.loadAssetLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return symbols, nil
+}
+
+// addSymbol 添加币种信息
+func (symbols *StSymbols) addSymbol(assetID string, legacySymbol string, decimals int64, chainSymbol string) {
+	symbols.DecimalsMap[assetID] = decimals
+	symbols.ChainMap[assetID] = chainSymbol
+	legacySymbol = strings.ToLower(legacySymbol)
+	if !mcommon.IsStringInSlice(symbols.LegacyMap[legacySymbol], assetID) {
+		symbols.LegacyMap[legacySymbol] = append(symbols.LegacyMap[legacySymbol], assetID)
+	}
+}
+
+// getAssetID 获取币种的资产标识
+// 兼容旧版本不带链标识的币种 对应多条链上的资产时无法确定 返回错误
+func (symbols *StSymbols) getAssetID(symbol string) (string, int64, string) {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	if _, ok := symbols.DecimalsMap[symbol]; ok {
+		return symbol, mcommon.ErrorSuccess, mcommon.ErrorSuccessMsg
+	}
+	assetIDs := symbols.LegacyMap[symbol]
+	if len(assetIDs) == 0 {
+		return "", value.ErrorSymbolNotSupport, value.ErrorSymbolNotSupportMsg
+	}
+	if len(assetIDs) > 1 {
+		return "", value.ErrorSymbolAmbiguous, value.ErrorSymbolAmbiguousMsg
+	}
+	return assetIDs[0], mcommon.ErrorSuccess, mcommon.ErrorSuccessMsg
+}
+
+// loadAssetLimit 获取充提币限制配置
+// withdraw_min_balance_{symbol} withdraw_max_balance_{symbol} 为字符串配置
+// withdraw_disable_{symbol} deposit_disable_{symbol} 为整数配置 1为关闭
+func (symbols *StSymbols) loadAssetLimit(ctx context.Context) error {
+	var strKeys []string
+	var intKeys []string
+	for symbol := range symbols.DecimalsMap {
+		strKeys = append(strKeys, "withdraw_min_balance_"+symbol, "withdraw_max_balance_"+symbol)
+		intKeys = append(intKeys, "withdraw_disable_"+symbol, "deposit_disable_"+symbol)
+	}
+	strMap, err := app.SQLGetAppConfigStrMap(
+		ctx,
+		xenv.DbCon,
+		strKeys,
+	)
+	if err != nil {
+		return err
+	}
+	intMap, err := app.SQLGetAppConfigIntMap(
+		ctx,
+		xenv.DbCon,
+		intKeys,
+	)
+	if err != nil {
+		return err
+	}
+	for symbol := range symbols.DecimalsMap {
+		minValue := strings.TrimSpace(strMap["withdraw_min_balance_"+symbol])
+		if minValue != "" {
+			minBalance, err := decimal.NewFromString(minValue)
+			if err != nil {
+				return err
+			}
+			symbols.WithdrawMinMap[symbol] = minBalance
+		}
+		maxValue := strings.TrimSpace(strMap["withdraw_max_balance_"+symbol])
+		if maxValue != "" {
+			maxBalance, err := decimal.NewFromString(maxValue)
+			if err != nil {
+				return err
+			}
+			symbols.WithdrawMaxMap[symbol] = maxBalance
+		}
+		if intMap["withdraw_disable_"+symbol] == 1 {
+			symbols.WithdrawDisableMap[symbol] = true
+		}
+		if intMap["deposit_disable_"+symbol] == 1 {
+			symbols.DepositDisableMap[symbol] = true
+		}
+	}
+	return nil
+}
@@ -1,24 +1,19 @@
 package web
 
 import (
+	"context"
 	"fmt"
 	"go-dc-wallet/app"
-	"go-dc-wallet/eosclient"
-	"go-dc-wallet/hbtc"
-	"go-dc-wallet/heos"
-	"go-dc-wallet/heth"
+	"go-dc-wallet/hxrp"
 	"go-dc-wallet/model"
 	"go-dc-wallet/value"
 	"go-dc-wallet/xenv"
 	"net/http"
-	"regexp"
 	"strings"
 	"time"
 
 	"github.com/moremorefun/mcommon"
 
-	"github.com/btcsuite/btcutil"
-
 	"github.com/shopspring/decimal"
 
 	"github.com/gin-gonic/gin"
@@ -27,12 +22,25 @@ import (
 
 func Start(r *gin.Engine) {
 	r.POST("/api/address", productReq, postAddress)
+	r.POST("/api/address/batch", productReq, postAddressBatch)
+	r.POST("/api/address/list", productReq, postAddressList)
+	r.POST("/api/address/validate", productReq, postAddressValidate)
 	r.POST("/api/withdraw", productReq, postWithdraw)
+	r.POST("/api/withdraw/query", productReq, postWithdrawQuery)
+	r.POST("/api/withdraw/list", productReq, postWithdrawList)
+	r.POST("/api/withdraw/cancel", productReq, postWithdrawCancel)
+	r.POST("/api/withdraw/batch", productReq, postWithdrawBatch)
+	r.POST("/api/withdraw/estimate", productReq, postWithdrawEstimate)
+	r.POST("/api/assets", productReq, postAssets)
+	r.POST("/api/deposit/list", productReq, postDepositList)
+	r.POST("/api/deposit/query", productReq, postDepositQuery)
 }
 
 func postAddress(c *gin.Context) {
 	var req struct {
-		Symbol string `json:"symbol" binding:"required" validate:"oneof=eth btc eos"`
+		Symbol string `json:"symbol" binding:"required" validate:"oneof=eth btc eos ltc doge bch wax tlos trx sol xrp bnb_bsc matic_polygon eth_arbitrum"`
+		UserID string `json:"user_id" binding:"omitempty,max=64"`
+		Label  string `json:"label" binding:"omitempty,max=128"`
 	}
 	err := c.ShouldBindBodyWith(&req, binding.JSON)
 	if err != nil {
@@ -45,74 +53,108 @@ func postAddress(c *gin.Context) {
 		mcommon.GinDoRespInternalErr(c)
 		return
 	}
+	// 资产标识转换为所在链的币种
+	req.Symbol = getAddressCoinSymbol(req.Symbol)
+	// 地址池币种
+	addressSymbol := getAddressSymbol(req.Symbol)
 	var addressRow *model.DBTAddressKey
+	var addressUserRow *model.DBTAddressUser
 	var eosColdAddressValue string
 	// 开始事物
 	isUseGinErr := true
 	err = mcommon.DbTransaction(c, xenv.DbCon, func(tx mcommon.DbExeAble) error {
-		// 获取可用地址
 		var err error
-		addressRow, err = app.SQLGetTAddressKeyColFreeForUpdate(
-			c,
-			tx,
-			[]string{
-				model.DBColTAddressKeyID,
-				model.DBColTAddressKeyAddress,
-			},
-			req.Symbol,
-		)
-		if err != nil {
-			return err
+		if req.UserID != "" {
+			// 用户已经绑定的地址
+			addressUserRow, err = app.SQLGetTAddressUserColForUpdate(
+				c,
+				tx,
+				model.DBColTAddressUserAll,
+				productID,
+				req.UserID,
+				req.Symbol,
+			)
+			if err != nil {
+				return err
+			}
+			if addressUserRow != nil {
+				addressRow = &model.DBTAddressKey{
+					Address: addressUserRow.Address,
+				}
+			}
 		}
 		if addressRow == nil {
-			// 没有可用地址了
-			mcommon.GinDoRespErr(
+			// 获取可用地址
+			addressRow, err = app.SQLGetTAddressKeyColFreeForUpdate(
 				c,
-				value.ErrorNoFreeAddress,
-				value.ErrorNoFreeAddressMsg,
-				nil,
+				tx,
+				[]string{
+					model.DBColTAddressKeyID,
+					model.DBColTAddressKeyAddress,
+				},
+				addressSymbol,
 			)
-			isUseGinErr = false
-			return fmt.Errorf("no free address")
-		}
-		// 更新获取到的地址的使用状态
-		count, err := mcommon.DbUpdateKV(
-			c,
-			tx,
-			model.DbTableTAddressKey,
-			mcommon.H{
-				model.DBColShortTAddressKeyUseTag: productID,
-			},
-			[]string{
-				model.DBColShortTAddressKeyID,
-			},
-			[]interface{}{
-				addressRow.ID,
-			},
-		)
-		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-			return err
-		}
-		if count <= 0 {
-			return fmt.Errorf("update address use tag error")
-		}
-		if req.Symbol == "eos" {
-			// 获取冷钱包地址
-			eosColdAddressValue, err = app.SQLGetTAppConfigStrValueByK(
+			if err != nil {
+				return err
+			}
+			if addressRow == nil {
+				// 没有可用地址了
+				mcommon.GinDoRespErr(
+					c,
+					value.ErrorNoFreeAddress,
+					value.ErrorNoFreeAddressMsg,
+					nil,
+				)
+				isUseGinErr = false
+				return fmt.Errorf("no free address")
+			}
+			// 更新获取到的地址的使用状态
+			count, err := mcommon.DbUpdateKV(
 				c,
 				tx,
-				"cold_wallet_address_eos",
+				model.DbTableTAddressKey,
+				mcommon.H{
+					model.DBColShortTAddressKeyUseTag: productID,
+				},
+				[]string{
+					model.DBColShortTAddressKeyID,
+				},
+				[]interface{}{
+					addressRow.ID,
+				},
 			)
 			if err != nil {
 				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 				return err
 			}
-			eosColdAddressValue = strings.TrimSpace(eosColdAddressValue)
-			if eosColdAddressValue == "" {
-				mcommon.Log.Errorf("eosColdAddressValue null")
-				return fmt.Errorf("eosColdAddressValue null")
+			if count <= 0 {
+				return fmt.Errorf("update address use tag error")
 			}
+			if req.UserID != "" {
+				// 绑定用户和地址
+				addressUserRow = &model.DBTAddressUser{
+					ProductID:  productID,
+					UserID:     req.UserID,
+					Symbol:     req.Symbol,
+					Address:    addressRow.Address,
+					Label:      req.Label,
+					CreateTime: time.Now().Unix(),
+				}
+				_, err = model.SQLCreateTAddressUser(
+					c,
+					tx,
+					addressUserRow,
+					false,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		eosColdAddressValue, err = getDepositColdAddress(c, tx, req.Symbol)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return err
 		}
 		return nil
 	})
@@ -123,186 +165,53 @@ func postAddress(c *gin.Context) {
 		}
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{
+	resp := gin.H{
 		"error":       mcommon.ErrorSuccess,
 		"err_msg":     mcommon.ErrorSuccessMsg,
 		"address":     addressRow.Address,
 		"eos_address": eosColdAddressValue,
-	})
+	}
+	if addressUserRow != nil {
+		resp["user_id"] = addressUserRow.UserID
+		resp["label"] = addressUserRow.Label
+	}
+	c.JSON(http.StatusOK, resp)
 }
 
 func postWithdraw(c *gin.Context) {
-	var req struct {
-		Symbol    string `json:"symbol" binding:"required"`
-		OutSerial string `json:"out_serial" binding:"required" validate:"max=40"`
-		Address   string `json:"address" binding:"required"`
-		Balance   string `json:"balance" binding:"required"`
-		Memo      string `json:"memo" binding:"omitempty"`
-	}
+	var req StWithdrawArgs
 	err := c.ShouldBindBodyWith(&req, binding.JSON)
 	if err != nil {
 		mcommon.Log.Warnf("req args error: %#v", err)
 		mcommon.GinFillBindError(c, err)
 		return
 	}
-	// 将币种小写
-	req.Symbol = strings.ToLower(req.Symbol)
 	// 获取产品id
 	productID := c.GetInt64("product_id")
 	if productID == 0 {
 		mcommon.GinDoRespInternalErr(c)
 		return
 	}
-	// eth 信息
-	tokenDecimalsMap := make(map[string]int64)
-	ethSymbols := []string{heth.CoinSymbol}
-	tokenDecimalsMap[heth.CoinSymbol] = 18
-	// 获取所有eth代币币种
-	tokenRows, err := model.SQLSelectTAppConfigTokenColKV(
-		c,
-		xenv.DbCon,
-		[]string{
-			model.DBColTAppConfigTokenTokenSymbol,
-			model.DBColTAppConfigTokenTokenDecimals,
-		},
-		nil,
-		nil,
-		nil,
-		nil,
-	)
+	// 已开启的币种
+	symbols, err := getSymbols(c)
 	if err != nil {
 		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 		mcommon.GinDoRespInternalErr(c)
 		return
 	}
-	for _, tokenRow := range tokenRows {
-		tokenRow.TokenSymbol = strings.ToLower(tokenRow.TokenSymbol)
-
-		ethSymbols = append(ethSymbols, tokenRow.TokenSymbol)
-		tokenDecimalsMap[tokenRow.TokenSymbol] = tokenRow.TokenDecimals
-	}
-	// btc 信息
-	btcSymbols := []string{hbtc.CoinSymbol}
-	tokenDecimalsMap[hbtc.CoinSymbol] = 8
-	tokenBtcRows, err := model.SQLSelectTAppConfigTokenBtcColKV(
-		c,
-		xenv.DbCon,
-		[]string{
-			model.DBColTAppConfigTokenBtcTokenSymbol,
-		},
-		nil,
-		nil,
-		nil,
-		nil,
-	)
-	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-		mcommon.GinDoRespInternalErr(c)
-		return
-	}
-	for _, tokenRow := range tokenBtcRows {
-		tokenRow.TokenSymbol = strings.ToLower(tokenRow.TokenSymbol)
-
-		btcSymbols = append(btcSymbols, tokenRow.TokenSymbol)
-		tokenDecimalsMap[tokenRow.TokenSymbol] = 8
-	}
-	// eos 信息
-	tokenDecimalsMap[heos.CoinSymbol] = 4
-	// 验证金额
-	tokenDecimals, ok := tokenDecimalsMap[req.Symbol]
-	if !ok {
-		mcommon.GinDoRespErr(
-			c,
-			value.ErrorSymbolNotSupport,
-			value.ErrorSymbolNotSupportMsg,
-			nil,
-		)
-		return
-	}
-	balanceObj, err := decimal.NewFromString(req.Balance)
-	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-		mcommon.GinDoRespErr(
-			c,
-			value.ErrorBalanceFormat,
-			value.ErrorBalanceFormatMsg,
-			nil,
-		)
-		return
-	}
-	if balanceObj.LessThanOrEqual(decimal.NewFromInt(0)) {
-		mcommon.GinDoRespErr(
-			c,
-			value.ErrorBalanceFormat,
-			value.ErrorBalanceFormatMsg,
-			nil,
-		)
-		return
-	}
-	if balanceObj.Exponent() < -int32(tokenDecimals) {
+	// 验证参数
+	balanceObj, errCode, errMsg := checkWithdrawArgs(c, symbols, &req)
+	if errCode != mcommon.ErrorSuccess {
 		mcommon.GinDoRespErr(
 			c,
-			value.ErrorBalanceFormat,
-			value.ErrorBalanceFormatMsg,
-			nil,
-		)
-		return
-	}
-	if mcommon.IsStringInSlice(ethSymbols, req.Symbol) {
-		// 验证地址
-		req.Address = strings.ToLower(req.Address)
-		re := regexp.MustCompile("^0x[0-9a-fA-F]{40}$")
-		if !re.MatchString(req.Address) {
-			mcommon.GinDoRespErr(
-				c,
-				value.ErrorAddressWrong,
-				value.ErrorAddressWrongMsg,
-				nil,
-			)
-			return
-		}
-
-	} else if mcommon.IsStringInSlice(btcSymbols, req.Symbol) {
-		// 验证地址
-		_, err := btcutil.DecodeAddress(
-			req.Address,
-			hbtc.GetNetwork(xenv.Cfg.BtcNetworkType).Params,
-		)
-		if err != nil {
-			mcommon.GinDoRespErr(
-				c,
-				value.ErrorAddressWrong,
-				value.ErrorAddressWrongMsg,
-				nil,
-			)
-			return
-		}
-	} else if req.Symbol == heos.CoinSymbol {
-		// eos
-		// 验证地址
-		_, err := eosclient.RpcChainGetAccount(
-			req.Address,
-		)
-		if err != nil {
-			mcommon.GinDoRespErr(
-				c,
-				value.ErrorAddressWrong,
-				value.ErrorAddressWrongMsg,
-				nil,
-			)
-			return
-		}
-	} else {
-		mcommon.GinDoRespErr(
-			c,
-			value.ErrorSymbolNotSupport,
-			value.ErrorSymbolNotSupportMsg,
+			errCode,
+			errMsg,
 			nil,
 		)
 		return
 	}
 	now := time.Now().Unix()
-	_, err = model.SQLCreateTWithdraw(
+	withdrawID, err := model.SQLCreateTWithdraw(
 		c,
 		xenv.DbCon,
 		&model.DBTWithdraw{
@@ -325,8 +234,152 @@ func postWithdraw(c *gin.Context) {
 		mcommon.GinDoRespInternalErr(c)
 		return
 	}
+	if withdrawID > 0 {
+		c.JSON(http.StatusOK, gin.H{
+			"error":   mcommon.ErrorSuccess,
+			"err_msg": mcommon.ErrorSuccessMsg,
+			"id":      withdrawID,
+			"status":  app.WithdrawStatusInit,
+		})
+		return
+	}
+	// out_serial 已经存在 检测是否为重复提交
+	withdrawRow, err := model.SQLGetTWithdrawColKV(
+		c,
+		xenv.DbCon,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawMemo,
+			model.DBColTWithdrawSymbol,
+			model.DBColTWithdrawBalanceReal,
+			model.DBColTWithdrawHandleStatus,
+		},
+		[]string{
+			model.DBColShortTWithdrawProductID,
+			model.DBColShortTWithdrawOutSerial,
+		},
+		[]interface{}{
+			productID,
+			req.OutSerial,
+		},
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	if withdrawRow == nil {
+		mcommon.Log.Errorf("no withdraw: %d %s", productID, req.OutSerial)
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	if !isWithdrawSame(symbols, withdrawRow, req.Symbol, req.Address, req.Memo, balanceObj) {
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorWithdrawConflict,
+			value.ErrorWithdrawConflictMsg,
+			nil,
+		)
+		return
+	}
 	c.JSON(http.StatusOK, gin.H{
 		"error":   mcommon.ErrorSuccess,
 		"err_msg": mcommon.ErrorSuccessMsg,
+		"id":      withdrawRow.ID,
+		"status":  withdrawRow.HandleStatus,
 	})
 }
+
+// StWithdrawArgs 提币参数
+type StWithdrawArgs struct {
+	Symbol    string `json:"symbol" binding:"required"`
+	OutSerial string `json:"out_serial" binding:"required" validate:"max=40"`
+	Address   string `json:"address" binding:"required"`
+	Balance   string `json:"balance" binding:"required"`
+	Memo      string `json:"memo" binding:"omitempty"`
+}
+
+// checkWithdrawArgs 验证提币参数 并格式化地址和memo
+func checkWithdrawArgs(ctx context.Context, symbols *StSymbols, args *StWithdrawArgs) (decimal.Decimal, int64, string) {
+	var balanceObj decimal.Decimal
+	// 转换为资产标识
+	assetID, errCode, errMsg := symbols.getAssetID(args.Symbol)
+	if errCode != mcommon.ErrorSuccess {
+		return balanceObj, errCode, errMsg
+	}
+	args.Symbol = assetID
+	// 验证金额
+	tokenDecimals := symbols.DecimalsMap[args.Symbol]
+	balanceObj, err := decimal.NewFromString(args.Balance)
+	if err != nil {
+		return balanceObj, value.ErrorBalanceFormat, value.ErrorBalanceFormatMsg
+	}
+	if balanceObj.LessThanOrEqual(decimal.NewFromInt(0)) {
+		return balanceObj, value.ErrorBalanceFormat, value.ErrorBalanceFormatMsg
+	}
+	if balanceObj.Exponent() < -int32(tokenDecimals) {
+		return balanceObj, value.ErrorBalanceFormat, value.ErrorBalanceFormatMsg
+	}
+	// 验证提币限制
+	if symbols.WithdrawDisableMap[args.Symbol] {
+		return balanceObj, value.ErrorWithdrawDisable, value.ErrorWithdrawDisableMsg
+	}
+	if minBalance, ok := symbols.WithdrawMinMap[args.Symbol]; ok && balanceObj.LessThan(minBalance) {
+		return balanceObj, value.ErrorBalanceLimit, value.ErrorBalanceLimitMsg
+	}
+	if maxBalance, ok := symbols.WithdrawMaxMap[args.Symbol]; ok && balanceObj.GreaterThan(maxBalance) {
+		return balanceObj, value.ErrorBalanceLimit, value.ErrorBalanceLimitMsg
+	}
+	// 验证地址
+	addressResult, err := checkAddress(ctx, symbols, args.Symbol, args.Address)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		return balanceObj, mcommon.ErrorInternal, mcommon.ErrorInternalMsg
+	}
+	if addressResult == nil {
+		return balanceObj, value.ErrorSymbolNotSupport, value.ErrorSymbolNotSupportMsg
+	}
+	if !addressResult.IsValid {
+		return balanceObj, value.ErrorAddressWrong, value.ErrorAddressWrongMsg
+	}
+	args.Address = addressResult.Address
+	if mcommon.IsStringInSlice(symbols.XrpSymbols, args.Symbol) {
+		// memo 为 destination tag
+		_, err := hxrp.ParseDestinationTag(args.Memo)
+		if err != nil {
+			return balanceObj, value.ErrorMemoWrong, value.ErrorMemoWrongMsg
+		}
+		args.Memo = strings.TrimSpace(args.Memo)
+	}
+	return balanceObj, mcommon.ErrorSuccess, mcommon.ErrorSuccessMsg
+}
+
+// isWithdrawSame 已有提币和提交的参数是否一致
+// 已有提币可能是旧版本的币种或未格式化的地址 按提交参数相同的方式格式化后比较
+func isWithdrawSame(symbols *StSymbols, withdrawRow *model.DBTWithdraw, symbol, address, memo string, balanceObj decimal.Decimal) bool {
+	rowSymbol := withdrawRow.Symbol
+	if assetID, errCode, _ := symbols.getAssetID(rowSymbol); errCode == mcommon.ErrorSuccess {
+		rowSymbol = assetID
+	}
+	if rowSymbol != symbol {
+		return false
+	}
+	rowAddress := strings.TrimSpace(withdrawRow.ToAddress)
+	if _, ok := symbols.EthChainMap[symbol]; ok {
+		// evm地址不区分大小写
+		rowAddress = strings.ToLower(rowAddress)
+	}
+	rowMemo := withdrawRow.Memo
+	if mcommon.IsStringInSlice(symbols.XrpSymbols, symbol) {
+		rowMemo = strings.TrimSpace(rowMemo)
+	}
+	if rowAddress != address || rowMemo != memo {
+		return false
+	}
+	rowBalanceObj, err := decimal.NewFromString(withdrawRow.BalanceReal)
+	if err != nil {
+		return false
+	}
+	return rowBalanceObj.Equal(balanceObj)
+}
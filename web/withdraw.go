@@ -0,0 +1,914 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/hbtc"
+	"go-dc-wallet/heth"
+	"go-dc-wallet/hsol"
+	"go-dc-wallet/htrx"
+	"go-dc-wallet/hxrp"
+	"go-dc-wallet/model"
+	"go-dc-wallet/solclient"
+	"go-dc-wallet/tronclient"
+	"go-dc-wallet/value"
+	"go-dc-wallet/xenv"
+	"go-dc-wallet/xrpclient"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/moremorefun/mcommon"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// withdrawListDefaultLimit 提币列表默认每页数量
+	withdrawListDefaultLimit = 20
+	// withdrawListMaxLimit 提币列表每页最大数量 每条记录可能需要查询节点获取确认数
+	withdrawListMaxLimit = 50
+)
+
+// StWithdrawSendInfo 提币对应的打币信息
+type StWithdrawSendInfo struct {
+	Confirmations int64
+	Fee           string
+	FeeSymbol     string
+}
+
+func postWithdrawQuery(c *gin.Context) {
+	var req struct {
+		OutSerial string `json:"out_serial" binding:"required"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	// 获取产品id
+	productID := c.GetInt64("product_id")
+	if productID == 0 {
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	withdrawRow, err := model.SQLGetTWithdrawColKV(
+		c,
+		xenv.DbCon,
+		model.DBColTWithdrawAll,
+		[]string{
+			model.DBColShortTWithdrawProductID,
+			model.DBColShortTWithdrawOutSerial,
+		},
+		[]interface{}{
+			productID,
+			req.OutSerial,
+		},
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	if withdrawRow == nil {
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorWithdrawNotFound,
+			value.ErrorWithdrawNotFoundMsg,
+			nil,
+		)
+		return
+	}
+	items, err := getWithdrawItems(c, []*model.DBTWithdraw{withdrawRow})
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":    mcommon.ErrorSuccess,
+		"err_msg":  mcommon.ErrorSuccessMsg,
+		"withdraw": items[0],
+	})
+}
+
+func postWithdrawList(c *gin.Context) {
+	var req struct {
+		Symbol    string `json:"symbol" binding:"omitempty"`
+		Status    *int64 `json:"status" binding:"omitempty,min=0,max=5"`
+		StartTime int64  `json:"start_time" binding:"omitempty,min=0"`
+		EndTime   int64  `json:"end_time" binding:"omitempty,min=0"`
+		Page      int64  `json:"page" binding:"omitempty,min=1"`
+		Limit     int64  `json:"limit" binding:"omitempty,min=1,max=50"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	// 将币种小写
+	req.Symbol = strings.ToLower(req.Symbol)
+	if req.Symbol != "" {
+		// 转换为资产标识
+		symbols, err := getSymbols(c)
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			mcommon.GinDoRespInternalErr(c)
+			return
+		}
+		if assetID, errCode, _ := symbols.getAssetID(req.Symbol); errCode == mcommon.ErrorSuccess {
+			req.Symbol = assetID
+		}
+	}
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	if req.Limit == 0 {
+		req.Limit = withdrawListDefaultLimit
+	}
+	if req.Limit > withdrawListMaxLimit {
+		req.Limit = withdrawListMaxLimit
+	}
+	status := int64(-1)
+	if req.Status != nil {
+		status = *req.Status
+	}
+	// 获取产品id
+	productID := c.GetInt64("product_id")
+	if productID == 0 {
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	total, err := app.SQLGetTWithdrawCountByFilter(
+		c,
+		xenv.DbCon,
+		productID,
+		req.Symbol,
+		status,
+		req.StartTime,
+		req.EndTime,
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	withdrawRows, err := app.SQLSelectTWithdrawColByFilter(
+		c,
+		xenv.DbCon,
+		model.DBColTWithdrawAll,
+		productID,
+		req.Symbol,
+		status,
+		req.StartTime,
+		req.EndTime,
+		(req.Page-1)*req.Limit,
+		req.Limit,
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	items, err := getWithdrawItems(c, withdrawRows)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+		"total":   total,
+		"page":    req.Page,
+		"limit":   req.Limit,
+		"list":    items,
+	})
+}
+
+func postWithdrawCancel(c *gin.Context) {
+	var req struct {
+		OutSerial string `json:"out_serial" binding:"required"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	// 获取产品id
+	productID := c.GetInt64("product_id")
+	if productID == 0 {
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	withdrawRow, err := model.SQLGetTWithdrawColKV(
+		c,
+		xenv.DbCon,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawHandleStatus,
+		},
+		[]string{
+			model.DBColShortTWithdrawProductID,
+			model.DBColShortTWithdrawOutSerial,
+		},
+		[]interface{}{
+			productID,
+			req.OutSerial,
+		},
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	if withdrawRow == nil {
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorWithdrawNotFound,
+			value.ErrorWithdrawNotFoundMsg,
+			nil,
+		)
+		return
+	}
+	if withdrawRow.HandleStatus == app.WithdrawStatusCancel {
+		// 已经取消
+		c.JSON(http.StatusOK, gin.H{
+			"error":   mcommon.ErrorSuccess,
+			"err_msg": mcommon.ErrorSuccessMsg,
+		})
+		return
+	}
+	// 开始事物 和打币任务一样锁定待处理的提币
+	isUseGinErr := true
+	err = mcommon.DbTransaction(c, xenv.DbCon, func(tx mcommon.DbExeAble) error {
+		withdrawRow, err := app.SQLGetTWithdrawColForUpdate(
+			c,
+			tx,
+			model.DBColTWithdrawAll,
+			withdrawRow.ID,
+			app.WithdrawStatusInit,
+		)
+		if err != nil {
+			return err
+		}
+		isCanCancel := withdrawRow != nil && withdrawRow.TxHash == ""
+		if isCanCancel {
+			sendCount, err := app.SQLGetWithdrawSendCount(
+				c,
+				tx,
+				withdrawRow.ID,
+			)
+			if err != nil {
+				return err
+			}
+			isCanCancel = sendCount == 0
+		}
+		if !isCanCancel {
+			// 已经生成了交易
+			mcommon.GinDoRespErr(
+				c,
+				value.ErrorWithdrawCannotCancel,
+				value.ErrorWithdrawCannotCancelMsg,
+				nil,
+			)
+			isUseGinErr = false
+			return fmt.Errorf("withdraw can not cancel")
+		}
+		productRow, err := model.SQLGetTProductCol(
+			c,
+			tx,
+			[]string{
+				model.DBColTProductAppName,
+				model.DBColTProductAppSk,
+				model.DBColTProductCbURL,
+			},
+			productID,
+		)
+		if err != nil {
+			return err
+		}
+		if productRow == nil {
+			return fmt.Errorf("no product: %d", productID)
+		}
+		now := time.Now().Unix()
+		count, err := app.SQLUpdateTWithdrawStatusByIDs(
+			c,
+			tx,
+			[]int64{withdrawRow.ID},
+			&model.DBTWithdraw{
+				HandleStatus: app.WithdrawStatusCancel,
+				HandleMsg:    "cancel",
+				HandleTime:   now,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if count <= 0 {
+			return fmt.Errorf("update withdraw status error")
+		}
+		// 创建取消通知
+		reqObj := gin.H{
+			"tx_hash":     "",
+			"balance":     withdrawRow.BalanceReal,
+			"app_name":    productRow.AppName,
+			"out_serial":  withdrawRow.OutSerial,
+			"address":     withdrawRow.ToAddress,
+			"symbol":      withdrawRow.Symbol,
+			"notify_type": app.NotifyTypeWithdrawCancel,
+		}
+		reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
+		notifyMsg, err := json.Marshal(reqObj)
+		if err != nil {
+			return err
+		}
+		_, err = model.SQLCreateTProductNotify(
+			c,
+			tx,
+			&model.DBTProductNotify{
+				Nonce:        mcommon.GetUUIDStr(),
+				ProductID:    productID,
+				ItemType:     app.SendRelationTypeWithdraw,
+				ItemID:       withdrawRow.ID,
+				NotifyType:   app.NotifyTypeWithdrawCancel,
+				TokenSymbol:  withdrawRow.Symbol,
+				URL:          productRow.CbURL,
+				Msg:          string(notifyMsg),
+				HandleStatus: app.NotifyStatusInit,
+				HandleMsg:    "",
+				CreateTime:   now,
+				UpdateTime:   now,
+			},
+			true,
+		)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		if isUseGinErr {
+			mcommon.GinDoRespInternalErr(c)
+		}
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+	})
+}
+
+func postWithdrawBatch(c *gin.Context) {
+	var req struct {
+		Items       []StWithdrawArgs `json:"items" binding:"required,min=1,max=500,dive"`
+		IsAllOrNone bool             `json:"is_all_or_none" binding:"omitempty"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	// 获取产品id
+	productID := c.GetInt64("product_id")
+	if productID == 0 {
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	// 已开启的币种
+	symbols, err := getSymbols(c)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	// 逐条验证参数
+	results := make([]gin.H, len(req.Items))
+	balanceObjs := make([]decimal.Decimal, len(req.Items))
+	// 同一批次中重复的商户订单号 对应第一次出现的位置
+	outSerialIndexMap := make(map[string]int)
+	var outSerials []string
+	isAllOk := true
+	for i := range req.Items {
+		item := &req.Items[i]
+		results[i] = gin.H{
+			"out_serial": item.OutSerial,
+			"error":      mcommon.ErrorSuccess,
+			"err_msg":    mcommon.ErrorSuccessMsg,
+		}
+		balanceObj, errCode, errMsg := checkWithdrawArgs(c, symbols, item)
+		if errCode == mcommon.ErrorSuccess {
+			if firstIndex, ok := outSerialIndexMap[item.OutSerial]; ok {
+				first := &req.Items[firstIndex]
+				if !isWithdrawSame(
+					symbols,
+					&model.DBTWithdraw{
+						ToAddress:   first.Address,
+						Memo:        first.Memo,
+						Symbol:      first.Symbol,
+						BalanceReal: first.Balance,
+					},
+					item.Symbol,
+					item.Address,
+					item.Memo,
+					balanceObj,
+				) {
+					errCode = value.ErrorWithdrawConflict
+					errMsg = value.ErrorWithdrawConflictMsg
+				}
+			} else {
+				outSerialIndexMap[item.OutSerial] = i
+				outSerials = append(outSerials, item.OutSerial)
+			}
+		}
+		if errCode != mcommon.ErrorSuccess {
+			results[i]["error"] = errCode
+			results[i]["err_msg"] = errMsg
+			isAllOk = false
+		}
+		balanceObjs[i] = balanceObj
+	}
+	if req.IsAllOrNone && !isAllOk {
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorWithdrawBatchFail,
+			value.ErrorWithdrawBatchFailMsg,
+			gin.H{
+				"list": results,
+			},
+		)
+		return
+	}
+	// 开始事物 锁定已经存在的商户订单号 只插入新的提币
+	withdrawMap := make(map[string]*model.DBTWithdraw)
+	isUseGinErr := true
+	err = mcommon.DbTransaction(c, xenv.DbCon, func(tx mcommon.DbExeAble) error {
+		withdrawCols := []string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawOutSerial,
+			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawMemo,
+			model.DBColTWithdrawSymbol,
+			model.DBColTWithdrawBalanceReal,
+			model.DBColTWithdrawHandleStatus,
+		}
+		withdrawRows, err := app.SQLSelectTWithdrawColByOutSerialsForUpdate(
+			c,
+			tx,
+			withdrawCols,
+			productID,
+			outSerials,
+		)
+		if err != nil {
+			return err
+		}
+		for _, withdrawRow := range withdrawRows {
+			withdrawMap[withdrawRow.OutSerial] = withdrawRow
+		}
+		now := time.Now().Unix()
+		var newRows []*model.DBTWithdraw
+		for _, outSerial := range outSerials {
+			i := outSerialIndexMap[outSerial]
+			item := &req.Items[i]
+			withdrawRow, ok := withdrawMap[outSerial]
+			if !ok {
+				newRows = append(newRows, &model.DBTWithdraw{
+					ProductID:    productID,
+					OutSerial:    item.OutSerial,
+					ToAddress:    item.Address,
+					Memo:         item.Memo,
+					Symbol:       item.Symbol,
+					BalanceReal:  item.Balance,
+					TxHash:       "",
+					CreateTime:   now,
+					HandleStatus: app.WithdrawStatusInit,
+					HandleMsg:    "",
+					HandleTime:   now,
+				})
+				continue
+			}
+			if !isWithdrawSame(symbols, withdrawRow, item.Symbol, item.Address, item.Memo, balanceObjs[i]) {
+				results[i]["error"] = value.ErrorWithdrawConflict
+				results[i]["err_msg"] = value.ErrorWithdrawConflictMsg
+				isAllOk = false
+			}
+		}
+		if req.IsAllOrNone && !isAllOk {
+			mcommon.GinDoRespErr(
+				c,
+				value.ErrorWithdrawBatchFail,
+				value.ErrorWithdrawBatchFailMsg,
+				gin.H{
+					"list": results,
+				},
+			)
+			isUseGinErr = false
+			return fmt.Errorf("withdraw batch conflict")
+		}
+		// 已经存在的提币保持不变
+		_, err = model.SQLCreateManyTWithdraw(
+			c,
+			tx,
+			newRows,
+			true,
+		)
+		if err != nil {
+			return err
+		}
+		// 获取新建的提币id
+		withdrawRows, err = app.SQLSelectTWithdrawColByOutSerialsForUpdate(
+			c,
+			tx,
+			withdrawCols,
+			productID,
+			outSerials,
+		)
+		if err != nil {
+			return err
+		}
+		for _, withdrawRow := range withdrawRows {
+			withdrawMap[withdrawRow.OutSerial] = withdrawRow
+		}
+		// 并发请求先插入了相同的商户订单号时 需要重新比较
+		for _, newRow := range newRows {
+			i := outSerialIndexMap[newRow.OutSerial]
+			withdrawRow, ok := withdrawMap[newRow.OutSerial]
+			if ok && !isWithdrawSame(symbols, withdrawRow, newRow.Symbol, newRow.ToAddress, newRow.Memo, balanceObjs[i]) {
+				results[i]["error"] = value.ErrorWithdrawConflict
+				results[i]["err_msg"] = value.ErrorWithdrawConflictMsg
+				isAllOk = false
+			}
+		}
+		if req.IsAllOrNone && !isAllOk {
+			mcommon.GinDoRespErr(
+				c,
+				value.ErrorWithdrawBatchFail,
+				value.ErrorWithdrawBatchFailMsg,
+				gin.H{
+					"list": results,
+				},
+			)
+			isUseGinErr = false
+			return fmt.Errorf("withdraw batch conflict")
+		}
+		return nil
+	})
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		if isUseGinErr {
+			mcommon.GinDoRespInternalErr(c)
+		}
+		return
+	}
+	for i := range req.Items {
+		if results[i]["error"] != mcommon.ErrorSuccess {
+			continue
+		}
+		// 同一批次中重复的商户订单号 结果与第一次出现时相同
+		firstIndex := outSerialIndexMap[req.Items[i].OutSerial]
+		if results[firstIndex]["error"] != mcommon.ErrorSuccess {
+			results[i]["error"] = results[firstIndex]["error"]
+			results[i]["err_msg"] = results[firstIndex]["err_msg"]
+			continue
+		}
+		withdrawRow, ok := withdrawMap[req.Items[i].OutSerial]
+		if !ok {
+			continue
+		}
+		results[i]["id"] = withdrawRow.ID
+		results[i]["status"] = withdrawRow.HandleStatus
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+		"list":    results,
+	})
+}
+
+// getWithdrawItems 获取提币的返回信息
+func getWithdrawItems(ctx context.Context, withdrawRows []*model.DBTWithdraw) ([]gin.H, error) {
+	symbols, err := getSymbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var withdrawIDs []int64
+	for _, withdrawRow := range withdrawRows {
+		withdrawIDs = append(withdrawIDs, withdrawRow.ID)
+	}
+	// eth bsc polygon arbitrum 打币信息
+	sendRows, err := model.SQLSelectTSendColKV(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTSendRelatedID,
+			model.DBColTSendTxID,
+			model.DBColTSendGasPrice,
+		},
+		[]string{
+			model.DBColShortTSendRelatedType,
+			model.DBColShortTSendRelatedID,
+		},
+		[]interface{}{
+			app.SendRelationTypeWithdraw,
+			withdrawIDs,
+		},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	sendMap := make(map[string]*model.DBTSend)
+	for _, sendRow := range sendRows {
+		sendMap[sendRow.TxID] = sendRow
+	}
+	// btc omni ltc doge bch 打币信息
+	sendBtcRows, err := model.SQLSelectTSendBtcColKV(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTSendBtcRelatedID,
+			model.DBColTSendBtcTxID,
+			model.DBColTSendBtcGas,
+			model.DBColTSendBtcGasPrice,
+			model.DBColTSendBtcBlockNum,
+		},
+		[]string{
+			model.DBColShortTSendBtcRelatedType,
+			model.DBColShortTSendBtcRelatedID,
+		},
+		[]interface{}{
+			app.SendRelationTypeWithdraw,
+			withdrawIDs,
+		},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	sendBtcMap := make(map[int64]*model.DBTSendBtc)
+	for _, sendBtcRow := range sendBtcRows {
+		sendBtcMap[sendBtcRow.RelatedID] = sendBtcRow
+	}
+	// eos wax tlos 打币信息
+	sendEosRows, err := model.SQLSelectTSendEosColKV(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTSendEosTxHash,
+			model.DBColTSendEosBlockNum,
+		},
+		[]string{
+			model.DBColShortTSendEosWithdrawID,
+		},
+		[]interface{}{
+			withdrawIDs,
+		},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	sendEosMap := make(map[string]*model.DBTSendEos)
+	for _, sendEosRow := range sendEosRows {
+		sendEosMap[sendEosRow.TxHash] = sendEosRow
+	}
+	// trx trc20 打币信息
+	sendTrxRows, err := model.SQLSelectTSendTrxColKV(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTSendTrxTxID,
+			model.DBColTSendTrxBlockNum,
+			model.DBColTSendTrxFee,
+		},
+		[]string{
+			model.DBColShortTSendTrxRelatedType,
+			model.DBColShortTSendTrxRelatedID,
+		},
+		[]interface{}{
+			app.SendRelationTypeWithdraw,
+			withdrawIDs,
+		},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	sendTrxMap := make(map[string]*model.DBTSendTrx)
+	for _, sendTrxRow := range sendTrxRows {
+		sendTrxMap[sendTrxRow.TxID] = sendTrxRow
+	}
+	// sol spl 打币信息
+	sendSolRows, err := model.SQLSelectTSendSolColKV(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTSendSolTxID,
+			model.DBColTSendSolBlockNum,
+			model.DBColTSendSolFee,
+		},
+		[]string{
+			model.DBColShortTSendSolRelatedType,
+			model.DBColShortTSendSolRelatedID,
+		},
+		[]interface{}{
+			app.SendRelationTypeWithdraw,
+			withdrawIDs,
+		},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	sendSolMap := make(map[string]*model.DBTSendSol)
+	for _, sendSolRow := range sendSolRows {
+		sendSolMap[sendSolRow.TxID] = sendSolRow
+	}
+	// xrp 打币信息
+	sendXrpRows, err := model.SQLSelectTSendXrpColKV(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTSendXrpTxHash,
+			model.DBColTSendXrpBlockNum,
+			model.DBColTSendXrpFee,
+		},
+		[]string{
+			model.DBColShortTSendXrpWithdrawID,
+		},
+		[]interface{}{
+			withdrawIDs,
+		},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	sendXrpMap := make(map[string]*model.DBTSendXrp)
+	for _, sendXrpRow := range sendXrpRows {
+		sendXrpMap[sendXrpRow.TxHash] = sendXrpRow
+	}
+	// 各链的最新块高 同一次请求中只获取一次
+	blockNumMap := make(map[string]int64)
+
+	items := make([]gin.H, 0, len(withdrawRows))
+	for _, withdrawRow := range withdrawRows {
+		// 旧版本的提币币种不带链标识 可以确定时转换为资产标识
+		if assetID, errCode, _ := symbols.getAssetID(withdrawRow.Symbol); errCode == mcommon.ErrorSuccess {
+			withdrawRow.Symbol = assetID
+		}
+		info := &StWithdrawSendInfo{}
+		if withdrawRow.TxHash != "" {
+			if chain, ok := symbols.EthChainMap[withdrawRow.Symbol]; ok {
+				info = getEthWithdrawSendInfo(ctx, chain, sendMap[withdrawRow.TxHash], blockNumMap)
+			} else if mcommon.IsStringInSlice(symbols.BtcSymbols, withdrawRow.Symbol) {
+				info = getBtcWithdrawSendInfo(withdrawRow, sendBtcMap[withdrawRow.ID], blockNumMap)
+			} else if chain, ok := symbols.EosChainMap[withdrawRow.Symbol]; ok {
+				// eos wax tlos 使用资源抵押 没有手续费
+				info.Fee = "0"
+				info.FeeSymbol = chain.GetCoinSymbol()
+				if sendEosRow, ok := sendEosMap[withdrawRow.TxHash]; ok {
+					info.Confirmations = getConfirmations(blockNumMap, chain.Symbol, sendEosRow.BlockNum, func() (int64, error) {
+						rpcChainInfo, err := chain.GetClient().RpcChainGetInfo()
+						if err != nil {
+							return 0, err
+						}
+						return rpcChainInfo.HeadBlockNum, nil
+					})
+				}
+			} else if mcommon.IsStringInSlice(symbols.TrxSymbols, withdrawRow.Symbol) {
+				info.FeeSymbol = htrx.GetCoinSymbol()
+				if sendTrxRow, ok := sendTrxMap[withdrawRow.TxHash]; ok && sendTrxRow.BlockNum > 0 {
+					info.Fee = sendTrxRow.Fee
+					info.Confirmations = getConfirmations(blockNumMap, info.FeeSymbol, sendTrxRow.BlockNum, func() (int64, error) {
+						rpcBlock, err := tronclient.RpcGetNowBlock()
+						if err != nil {
+							return 0, err
+						}
+						return rpcBlock.BlockHeader.RawData.Number, nil
+					})
+				}
+			} else if mcommon.IsStringInSlice(symbols.SolSymbols, withdrawRow.Symbol) {
+				info.FeeSymbol = hsol.GetCoinSymbol()
+				if sendSolRow, ok := sendSolMap[withdrawRow.TxHash]; ok && sendSolRow.BlockNum > 0 {
+					info.Fee = sendSolRow.Fee
+					info.Confirmations = getConfirmations(blockNumMap, info.FeeSymbol, sendSolRow.BlockNum, func() (int64, error) {
+						return solclient.RpcGetSlot(solclient.CommitmentFinalized)
+					})
+				}
+			} else if mcommon.IsStringInSlice(symbols.XrpSymbols, withdrawRow.Symbol) {
+				info.FeeSymbol = hxrp.GetCoinSymbol()
+				if sendXrpRow, ok := sendXrpMap[withdrawRow.TxHash]; ok && sendXrpRow.BlockNum > 0 {
+					info.Fee = sendXrpRow.Fee
+					info.Confirmations = getConfirmations(blockNumMap, info.FeeSymbol, sendXrpRow.BlockNum, func() (int64, error) {
+						return xrpclient.RpcLedgerIndex(xrpclient.LedgerValidated)
+					})
+				}
+			}
+		}
+		if info.Confirmations == 0 && withdrawRow.HandleStatus == app.WithdrawStatusConfirm {
+			// 无法获取确认数的旧数据 已确认时返回1
+			info.Confirmations = 1
+		}
+		items = append(items, gin.H{
+			"out_serial":    withdrawRow.OutSerial,
+			"symbol":        withdrawRow.Symbol,
+			"address":       withdrawRow.ToAddress,
+			"memo":          withdrawRow.Memo,
+			"balance":       withdrawRow.BalanceReal,
+			"status":        withdrawRow.HandleStatus,
+			"tx_hash":       withdrawRow.TxHash,
+			"confirmations": info.Confirmations,
+			"fee":           info.Fee,
+			"fee_symbol":    info.FeeSymbol,
+			"create_time":   withdrawRow.CreateTime,
+			"handle_time":   withdrawRow.HandleTime,
+		})
+	}
+	return items, nil
+}
+
+// getConfirmations 根据交易所在块高计算确认数 各链的最新块高在同一次请求中只获取一次
+func getConfirmations(blockNumMap map[string]int64, key string, txBlockNum int64, getBlockNum func() (int64, error)) int64 {
+	if txBlockNum <= 0 {
+		// 还未记录所在块高
+		return 0
+	}
+	blockNum, ok := blockNumMap[key]
+	if !ok {
+		var err error
+		blockNum, err = getBlockNum()
+		if err != nil {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			return 0
+		}
+		blockNumMap[key] = blockNum
+	}
+	if blockNum < txBlockNum {
+		return 0
+	}
+	return blockNum - txBlockNum + 1
+}
+
+// getEthWithdrawSendInfo 获取 eth bsc polygon arbitrum 提币的确认数和实际手续费
+func getEthWithdrawSendInfo(ctx context.Context, chain *heth.Chain, sendRow *model.DBTSend, blockNumMap map[string]int64) *StWithdrawSendInfo {
+	info := &StWithdrawSendInfo{
+		FeeSymbol: chain.GetCoinSymbol(),
+	}
+	if sendRow == nil {
+		return info
+	}
+	receipt, err := chain.GetClient().RpcTransactionReceipt(ctx, sendRow.TxID)
+	if err != nil {
+		if err != ethereum.NotFound {
+			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		}
+		return info
+	}
+	info.Fee = decimal.NewFromInt(int64(receipt.GasUsed)).
+		Mul(decimal.NewFromInt(sendRow.GasPrice)).
+		Div(decimal.NewFromInt(1e18)).
+		String()
+	info.Confirmations = getConfirmations(blockNumMap, chain.Symbol, receipt.BlockNumber.Int64(), func() (int64, error) {
+		return chain.GetClient().RpcBlockNumber(ctx)
+	})
+	return info
+}
+
+// getBtcWithdrawSendInfo 获取 btc omni ltc doge bch 提币的确认数和手续费
+// 多笔提币打包在同一个交易中时 整个交易的手续费记录在第一笔提币上
+func getBtcWithdrawSendInfo(withdrawRow *model.DBTWithdraw, sendBtcRow *model.DBTSendBtc, blockNumMap map[string]int64) *StWithdrawSendInfo {
+	chain := hbtc.GetChainByCoinSymbol(withdrawRow.Symbol)
+	if chain == nil {
+		// omni 代币
+		chain = hbtc.Btc
+	}
+	info := &StWithdrawSendInfo{
+		FeeSymbol: chain.GetCoinSymbol(),
+	}
+	if sendBtcRow == nil {
+		return info
+	}
+	info.Fee = decimal.NewFromInt(sendBtcRow.Gas * sendBtcRow.GasPrice).
+		Div(decimal.NewFromInt(1e8)).
+		String()
+	info.Confirmations = getConfirmations(blockNumMap, chain.Symbol, sendBtcRow.BlockNum, func() (int64, error) {
+		return chain.GetClient().RpcGetBlockCount()
+	})
+	return info
+}
@@ -4,6 +4,9 @@ import (
 	"go-dc-wallet/eosclient"
 	"go-dc-wallet/ethclient"
 	"go-dc-wallet/omniclient"
+	"go-dc-wallet/solclient"
+	"go-dc-wallet/tronclient"
+	"go-dc-wallet/xrpclient"
 	"math/rand"
 	"strings"
 	"time"
@@ -30,14 +33,70 @@ type config struct {
 
 	BtcNetworkType string `env:"BTC-NETWORK-TYPE" default:"btc"`
 
-	EthRPC string `env:"ETH_RPC"`
+	EthRPC     string `env:"ETH_RPC"`
+	EthChainID int64  `env:"ETH_CHAIN_ID"`
+
+	BscEnable       bool   `env:"BSC_ENABLE"`
+	BscRPC          string `env:"BSC_RPC"`
+	BscChainID      int64  `env:"BSC_CHAIN_ID" default:"56"`
+	BscShareAddress bool   `env:"BSC_SHARE_ADDRESS"`
+
+	PolygonEnable       bool   `env:"POLYGON_ENABLE"`
+	PolygonRPC          string `env:"POLYGON_RPC"`
+	PolygonChainID      int64  `env:"POLYGON_CHAIN_ID" default:"137"`
+	PolygonShareAddress bool   `env:"POLYGON_SHARE_ADDRESS"`
+
+	ArbitrumEnable       bool   `env:"ARBITRUM_ENABLE"`
+	ArbitrumRPC          string `env:"ARBITRUM_RPC"`
+	ArbitrumChainID      int64  `env:"ARBITRUM_CHAIN_ID" default:"42161"`
+	ArbitrumShareAddress bool   `env:"ARBITRUM_SHARE_ADDRESS"`
 
 	OmniRPCHost string `env:"OMNI_RPC_HOST"`
 	OmniRPCUser string `env:"OMNI_RPC_USER"`
 	OmniRPCPwd  string `env:"OMNI_RPC_PWD"`
 
-	EosRPC    string `env:"EOS_RPC"`
-	EosEnable bool   `env:"EOS_ENABLE"`
+	EosRPC      string `env:"EOS_RPC"`
+	EosTraceRPC string `env:"EOS_TRACE_RPC"`
+	EosChainID  string `env:"EOS_CHAIN_ID"`
+	EosEnable   bool   `env:"EOS_ENABLE"`
+
+	WaxEnable   bool   `env:"WAX_ENABLE"`
+	WaxRPC      string `env:"WAX_RPC"`
+	WaxTraceRPC string `env:"WAX_TRACE_RPC"`
+	WaxChainID  string `env:"WAX_CHAIN_ID"`
+
+	TlosEnable   bool   `env:"TLOS_ENABLE"`
+	TlosRPC      string `env:"TLOS_RPC"`
+	TlosTraceRPC string `env:"TLOS_TRACE_RPC"`
+	TlosChainID  string `env:"TLOS_CHAIN_ID"`
+
+	LtcEnable      bool   `env:"LTC_ENABLE"`
+	LtcNetworkType string `env:"LTC-NETWORK-TYPE" default:"ltc"`
+	LtcRPCHost     string `env:"LTC_RPC_HOST"`
+	LtcRPCUser     string `env:"LTC_RPC_USER"`
+	LtcRPCPwd      string `env:"LTC_RPC_PWD"`
+
+	DogeEnable      bool   `env:"DOGE_ENABLE"`
+	DogeNetworkType string `env:"DOGE-NETWORK-TYPE" default:"doge"`
+	DogeRPCHost     string `env:"DOGE_RPC_HOST"`
+	DogeRPCUser     string `env:"DOGE_RPC_USER"`
+	DogeRPCPwd      string `env:"DOGE_RPC_PWD"`
+
+	BchEnable      bool   `env:"BCH_ENABLE"`
+	BchNetworkType string `env:"BCH-NETWORK-TYPE" default:"bch"`
+	BchRPCHost     string `env:"BCH_RPC_HOST"`
+	BchRPCUser     string `env:"BCH_RPC_USER"`
+	BchRPCPwd      string `env:"BCH_RPC_PWD"`
+
+	TrxEnable bool   `env:"TRX_ENABLE"`
+	TrxRPC    string `env:"TRX_RPC"`
+	TrxAPIKey string `env:"TRX_API_KEY"`
+
+	SolEnable bool   `env:"SOL_ENABLE"`
+	SolRPC    string `env:"SOL_RPC"`
+
+	XrpEnable bool   `env:"XRP_ENABLE"`
+	XrpRPC    string `env:"XRP_RPC"`
 }
 
 // Cfg
@@ -70,10 +129,26 @@ func EnvCreate() {
 	DbCon = mcommon.DbCreate(Cfg.MySqlDataSourceName, Cfg.MySqlIsShowSQL)
 	// 初始化eth rpc
 	ethclient.InitClient(Cfg.EthRPC)
+	// 初始化其他evm链 rpc
+	if Cfg.BscEnable {
+		ethclient.InitChainClient("bsc", Cfg.BscRPC)
+	}
+	if Cfg.PolygonEnable {
+		ethclient.InitChainClient("polygon", Cfg.PolygonRPC)
+	}
+	if Cfg.ArbitrumEnable {
+		ethclient.InitChainClient("arbitrum", Cfg.ArbitrumRPC)
+	}
 	// 初始化omni rpc
 	omniclient.InitClient(Cfg.OmniRPCHost, Cfg.OmniRPCUser, Cfg.OmniRPCPwd)
 	// 初始化eos rpc
-	eosclient.InitClient(Cfg.EosRPC)
+	eosclient.InitClient(Cfg.EosRPC, Cfg.EosTraceRPC)
+	// 初始化tron http api
+	tronclient.InitClient(Cfg.TrxRPC, Cfg.TrxAPIKey)
+	// 初始化solana rpc
+	solclient.InitClient(Cfg.SolRPC)
+	// 初始化xrp rpc
+	xrpclient.InitClient(Cfg.XrpRPC)
 }
 
 // EnvDestroy 销毁运行环境
@@ -0,0 +1,321 @@
+package xrpclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/parnurzeal/gorequest"
+)
+
+// ledger 级别
+const (
+	LedgerValidated = "validated"
+	LedgerCurrent   = "current"
+)
+
+// 错误
+const (
+	ErrorActNotFound = "actNotFound" // 账户不存在
+	ErrorTxnNotFound = "txnNotFound" // 交易不存在
+)
+
+// Client json rpc客户端
+type Client struct {
+	rpcURI string
+}
+
+var defaultClient = &Client{}
+
+// StRpcError 接口返回的错误
+type StRpcError struct {
+	Status       string `json:"status"`
+	Err          string `json:"error"`
+	ErrorCode    int64  `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+	SearchedAll  bool   `json:"searched_all"`
+}
+
+func (e *StRpcError) Error() string {
+	return fmt.Sprintf("xrp rpc err: %s %d %s", e.Err, e.ErrorCode, e.ErrorMessage)
+}
+
+type StRpcResp struct {
+	Result json.RawMessage `json:"result"`
+}
+
+// StTransactionMeta 交易执行结果
+// delivered_amount 为实际到账金额 部分支付时小于 Amount
+// xrp 为 drops 字符串, 其他代币为对象, 早期ledger中为 unavailable
+type StTransactionMeta struct {
+	TransactionResult string          `json:"TransactionResult"`
+	DeliveredAmount   json.RawMessage `json:"delivered_amount"`
+}
+
+type StTransaction struct {
+	Hash            string             `json:"hash"`
+	TransactionType string             `json:"TransactionType"`
+	Account         string             `json:"Account"`
+	Destination     string             `json:"Destination"`
+	DestinationTag  *int64             `json:"DestinationTag"`
+	Amount          json.RawMessage    `json:"Amount"`
+	Flags           int64              `json:"Flags"`
+	Sequence        int64              `json:"Sequence"`
+	Fee             string             `json:"Fee"`
+	LedgerIndex     int64              `json:"ledger_index"`
+	Validated       bool               `json:"validated"`
+	MetaData        *StTransactionMeta `json:"metaData"`
+	Meta            *StTransactionMeta `json:"meta"`
+}
+
+// GetMeta 获取交易执行结果 ledger 接口为 metaData, tx 接口为 meta
+func (t *StTransaction) GetMeta() *StTransactionMeta {
+	if t.MetaData != nil {
+		return t.MetaData
+	}
+	return t.Meta
+}
+
+type StLedger struct {
+	LedgerHash   string           `json:"ledger_hash"`
+	LedgerIndex  int64            `json:"ledger_index"`
+	Validated    bool             `json:"validated"`
+	Transactions []*StTransaction `json:"-"`
+}
+
+type StAccountData struct {
+	Account    string `json:"Account"`
+	Balance    string `json:"Balance"`
+	Flags      int64  `json:"Flags"`
+	OwnerCount int64  `json:"OwnerCount"`
+	Sequence   int64  `json:"Sequence"`
+}
+
+type StValidatedLedger struct {
+	Seq         int64 `json:"seq"`
+	BaseFee     int64 `json:"base_fee"`
+	ReserveBase int64 `json:"reserve_base"`
+	ReserveInc  int64 `json:"reserve_inc"`
+}
+
+type StSubmitResult struct {
+	EngineResult        string `json:"engine_result"`
+	EngineResultMessage string `json:"engine_result_message"`
+	TxJSON              struct {
+		Hash string `json:"hash"`
+	} `json:"tx_json"`
+}
+
+// InitClient 初始化客户端
+func InitClient(uri string) {
+	defaultClient = NewClient(uri)
+}
+
+// NewClient 创建客户端
+func NewClient(uri string) *Client {
+	return &Client{
+		rpcURI: uri,
+	}
+}
+
+// DefaultClient 默认客户端
+func DefaultClient() *Client {
+	return defaultClient
+}
+
+func (client *Client) doReq(method string, params gin.H, resp interface{}) error {
+	if params == nil {
+		params = gin.H{}
+	}
+	_, body, errs := gorequest.New().
+		Timeout(time.Minute * 5).
+		Post(client.rpcURI).
+		Send(gin.H{
+			"method": method,
+			"params": []interface{}{params},
+		}).
+		EndBytes()
+	if errs != nil {
+		return errs[0]
+	}
+	var rpcResp StRpcResp
+	err := json.Unmarshal(body, &rpcResp)
+	if err != nil {
+		return err
+	}
+	var rpcErr StRpcError
+	err = json.Unmarshal(rpcResp.Result, &rpcErr)
+	if err != nil {
+		return err
+	}
+	if rpcErr.Status == "error" {
+		return &rpcErr
+	}
+	err = json.Unmarshal(rpcResp.Result, resp)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RpcLedgerIndex 获取最新的ledger序号
+func RpcLedgerIndex(ledgerIndex string) (int64, error) {
+	return defaultClient.RpcLedgerIndex(ledgerIndex)
+}
+
+// RpcLedgerIndex 获取最新的ledger序号
+func (client *Client) RpcLedgerIndex(ledgerIndex string) (int64, error) {
+	var resp struct {
+		LedgerIndex        int64 `json:"ledger_index"`
+		LedgerCurrentIndex int64 `json:"ledger_current_index"`
+	}
+	err := client.doReq(
+		"ledger",
+		gin.H{
+			"ledger_index": ledgerIndex,
+		},
+		&resp,
+	)
+	if err != nil {
+		return 0, err
+	}
+	if resp.LedgerIndex == 0 {
+		// current ledger 只返回 ledger_current_index
+		return resp.LedgerCurrentIndex, nil
+	}
+	return resp.LedgerIndex, nil
+}
+
+// RpcLedger 获取ledger和其中的交易
+func RpcLedger(ledgerIndex int64) (*StLedger, error) {
+	return defaultClient.RpcLedger(ledgerIndex)
+}
+
+// RpcLedger 获取ledger和其中的交易
+func (client *Client) RpcLedger(ledgerIndex int64) (*StLedger, error) {
+	var resp struct {
+		StLedger
+		Ledger struct {
+			Transactions []*StTransaction `json:"transactions"`
+		} `json:"ledger"`
+	}
+	err := client.doReq(
+		"ledger",
+		gin.H{
+			"ledger_index": ledgerIndex,
+			"transactions": true,
+			"expand":       true,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp.StLedger.Transactions = resp.Ledger.Transactions
+	return &resp.StLedger, nil
+}
+
+// RpcAccountInfo 获取账户信息 账户不存在时返回nil
+func RpcAccountInfo(address string, ledgerIndex string) (*StAccountData, error) {
+	return defaultClient.RpcAccountInfo(address, ledgerIndex)
+}
+
+// RpcAccountInfo 获取账户信息 账户不存在时返回nil
+func (client *Client) RpcAccountInfo(address string, ledgerIndex string) (*StAccountData, error) {
+	var resp struct {
+		AccountData *StAccountData `json:"account_data"`
+	}
+	err := client.doReq(
+		"account_info",
+		gin.H{
+			"account":      address,
+			"ledger_index": ledgerIndex,
+		},
+		&resp,
+	)
+	if err != nil {
+		if rpcErr, ok := err.(*StRpcError); ok && rpcErr.Err == ErrorActNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.AccountData, nil
+}
+
+// RpcServerState 获取最新validated ledger的手续费和保留金额 单位为drops
+func RpcServerState() (*StValidatedLedger, error) {
+	return defaultClient.RpcServerState()
+}
+
+// RpcServerState 获取最新validated ledger的手续费和保留金额 单位为drops
+func (client *Client) RpcServerState() (*StValidatedLedger, error) {
+	var resp struct {
+		State struct {
+			ValidatedLedger *StValidatedLedger `json:"validated_ledger"`
+		} `json:"state"`
+	}
+	err := client.doReq(
+		"server_state",
+		nil,
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.State.ValidatedLedger == nil {
+		return nil, fmt.Errorf("no validated ledger")
+	}
+	return resp.State.ValidatedLedger, nil
+}
+
+// RpcSubmit 发送已签名的交易
+func RpcSubmit(txBlob string) (*StSubmitResult, error) {
+	return defaultClient.RpcSubmit(txBlob)
+}
+
+// RpcSubmit 发送已签名的交易
+func (client *Client) RpcSubmit(txBlob string) (*StSubmitResult, error) {
+	var resp StSubmitResult
+	err := client.doReq(
+		"submit",
+		gin.H{
+			"tx_blob": txBlob,
+		},
+		&resp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RpcTx 在ledger范围内查询交易
+// 交易不存在时返回nil, searchedAll 为节点是否有范围内全部的ledger
+func RpcTx(txHash string, minLedger int64, maxLedger int64) (*StTransaction, bool, error) {
+	return defaultClient.RpcTx(txHash, minLedger, maxLedger)
+}
+
+// RpcTx 在ledger范围内查询交易
+// 交易不存在时返回nil, searchedAll 为节点是否有范围内全部的ledger
+func (client *Client) RpcTx(txHash string, minLedger int64, maxLedger int64) (*StTransaction, bool, error) {
+	var resp StTransaction
+	err := client.doReq(
+		"tx",
+		gin.H{
+			"transaction": txHash,
+			"binary":      false,
+			"min_ledger":  minLedger,
+			"max_ledger":  maxLedger,
+		},
+		&resp,
+	)
+	if err != nil {
+		if rpcErr, ok := err.(*StRpcError); ok && rpcErr.Err == ErrorTxnNotFound {
+			return nil, rpcErr.SearchedAll, nil
+		}
+		return nil, false, err
+	}
+	return &resp, false, nil
+}